@@ -0,0 +1,126 @@
+package command
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/coverage"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/utility"
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+)
+
+type attachCoverage struct {
+	// Files is a list of coverage reports, using gitignore syntax.
+	Files []string `mapstructure:"files" plugin:"expand"`
+
+	// Format is the format of the coverage reports, which is one of "go"
+	// for Go cover profiles, "lcov" or "cobertura". If it is not set, the
+	// format of each report is detected from its content.
+	Format string `mapstructure:"format" plugin:"expand"`
+
+	// Prefix is an optional directory prefix to start file globbing in, relative to Evergreen's working directory.
+	Prefix string `mapstructure:"prefix" plugin:"expand"`
+
+	// Optional, when set to true, causes this command to be skipped over
+	// without an error when no coverage reports match the files.
+	Optional bool `mapstructure:"optional"`
+
+	base
+}
+
+func attachCoverageFactory() Command   { return &attachCoverage{} }
+func (c *attachCoverage) Name() string { return "attach.coverage" }
+
+func (c *attachCoverage) ParseParams(params map[string]interface{}) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrapf(err, "error decoding '%s' params", c.Name())
+	}
+
+	if len(c.Files) == 0 {
+		return errors.Errorf("error validating params: must specify at least one "+
+			"file pattern to parse: '%+v'", params)
+	}
+	if c.Format != "" && !utility.StringSliceContains(coverage.ValidFormats, c.Format) {
+		return errors.Errorf("error validating params: format '%s' must be one of %v", c.Format, coverage.ValidFormats)
+	}
+	return nil
+}
+
+func (c *attachCoverage) Execute(ctx context.Context,
+	comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+
+	var err error
+
+	if err = util.ExpandValues(c, conf.Expansions); err != nil {
+		err = errors.Wrap(err, "error expanding params")
+		logger.Task().Error(err)
+		return err
+	}
+	if c.Format != "" && !utility.StringSliceContains(coverage.ValidFormats, c.Format) {
+		err = errors.Errorf("format '%s' must be one of %v", c.Format, coverage.ValidFormats)
+		logger.Task().Error(err)
+		return err
+	}
+
+	workDir := getJoinedWithWorkDir(conf, c.Prefix)
+	include := utility.NewGitIgnoreFileMatcher(workDir, c.Files...)
+	b := utility.FileListBuilder{
+		WorkingDir: workDir,
+		Include:    include,
+	}
+	if c.Files, err = b.Build(); err != nil {
+		err = errors.Wrap(err, "problem building wildcard paths")
+		logger.Task().Error(err)
+		return err
+	}
+
+	if len(c.Files) == 0 {
+		err = errors.New("expanded file specification had no items")
+		logger.Task().Error(err)
+		if c.Optional {
+			return nil
+		}
+		return err
+	}
+
+	report := coverage.NewReport()
+	for _, fn := range c.Files {
+		if err = readCoverageReport(report, workDir, fn, c.Format); err != nil {
+			logger.Task().Error(err)
+			return err
+		}
+	}
+
+	files := report.Files()
+	if len(files) == 0 {
+		logger.Task().Warning("coverage reports did not cover any files")
+		return nil
+	}
+
+	td := client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
+	if err = comm.SendCoverage(ctx, td, files); err != nil {
+		return errors.Wrap(err, "sending coverage")
+	}
+
+	logger.Task().Infof("'%s' attached coverage of %d files from %d reports to task", c.Name(), len(files), len(c.Files))
+	return nil
+}
+
+func readCoverageReport(report *coverage.Report, wd, fn, format string) error {
+	if !filepath.IsAbs(fn) {
+		fn = filepath.Join(wd, fn)
+	}
+
+	file, err := os.Open(fn)
+	if err != nil {
+		return errors.Wrapf(err, "problem opening file '%s'", fn)
+	}
+	defer file.Close()
+
+	return errors.Wrapf(report.Add(file, format), "problem reading coverage report '%s'", fn)
+}
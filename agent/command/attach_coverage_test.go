@@ -0,0 +1,114 @@
+package command
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/coverage"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type CoverageSuite struct {
+	suite.Suite
+	cmd    *attachCoverage
+	conf   *internal.TaskConfig
+	comm   client.Communicator
+	logger client.LoggerProducer
+	mock   *client.Mock
+	ctx    context.Context
+	cancel context.CancelFunc
+	tmpdir string
+}
+
+func TestCoverageSuite(t *testing.T) {
+	suite.Run(t, new(CoverageSuite))
+}
+
+func (s *CoverageSuite) SetupSuite() {
+	var err error
+	s.tmpdir, err = ioutil.TempDir("", "evergreen.command.attach_coverage.test")
+	s.Require().NoError(err)
+
+	s.Require().NoError(ioutil.WriteFile(filepath.Join(s.tmpdir, "unit.out"), []byte(
+		"mode: set\nexample.com/pkg/a.go:1.1,2.2 1 1\nexample.com/pkg/a.go:3.1,3.5 1 0\n"), 0644))
+	s.Require().NoError(ioutil.WriteFile(filepath.Join(s.tmpdir, "integration.out"), []byte(
+		"mode: set\nexample.com/pkg/a.go:3.1,3.5 1 1\nexample.com/pkg/b.go:1.1,1.5 1 0\n"), 0644))
+	s.Require().NoError(ioutil.WriteFile(filepath.Join(s.tmpdir, "lcov.info"), []byte(
+		"SF:src/c.js\nDA:1,1\nend_of_record\n"), 0644))
+}
+
+func (s *CoverageSuite) TearDownSuite() {
+	s.Require().NoError(os.RemoveAll(s.tmpdir))
+}
+
+func (s *CoverageSuite) SetupTest() {
+	var err error
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.comm = client.NewMock("http://localhost.com")
+	s.conf = &internal.TaskConfig{Expansions: &util.Expansions{}, Task: &task.Task{Id: "task"}, Project: &model.Project{}}
+	s.logger, err = s.comm.GetLoggerProducer(s.ctx, client.TaskData{ID: s.conf.Task.Id, Secret: s.conf.Task.Secret}, nil)
+	s.NoError(err)
+	s.cmd = attachCoverageFactory().(*attachCoverage)
+	s.conf.WorkDir = s.tmpdir
+	s.mock = s.comm.(*client.Mock)
+}
+
+func (s *CoverageSuite) TearDownTest() {
+	s.cancel()
+}
+
+func (s *CoverageSuite) TestParseParams() {
+	s.Error(s.cmd.ParseParams(map[string]interface{}{}))
+	s.Error(s.cmd.ParseParams(map[string]interface{}{
+		"files":  []string{"*.out"},
+		"format": "jacoco",
+	}))
+	s.NoError(s.cmd.ParseParams(map[string]interface{}{
+		"files":  []string{"*.out"},
+		"format": coverage.FormatGo,
+	}))
+	s.Equal([]string{"*.out"}, s.cmd.Files)
+	s.Equal(coverage.FormatGo, s.cmd.Format)
+}
+
+func (s *CoverageSuite) TestCombinesReports() {
+	s.cmd.Files = []string{"*.out"}
+	s.cmd.Format = coverage.FormatGo
+	s.NoError(s.cmd.Execute(s.ctx, s.comm, s.logger, s.conf))
+
+	s.Equal([]coverage.FileCoverage{
+		{Path: "example.com/pkg/a.go", Summary: coverage.Summary{LinesCovered: 3, LinesTotal: 3}},
+		{Path: "example.com/pkg/b.go", Summary: coverage.Summary{LinesCovered: 0, LinesTotal: 1}},
+	}, s.mock.Coverage[s.conf.Task.Id])
+}
+
+func (s *CoverageSuite) TestDetectsFormats() {
+	s.cmd.Files = []string{"unit.out", "lcov.info"}
+	s.NoError(s.cmd.Execute(s.ctx, s.comm, s.logger, s.conf))
+	s.Len(s.mock.Coverage[s.conf.Task.Id], 2)
+}
+
+func (s *CoverageSuite) TestErrorsWithWrongFormat() {
+	s.cmd.Files = []string{"lcov.info"}
+	s.cmd.Format = coverage.FormatGo
+	s.Error(s.cmd.Execute(s.ctx, s.comm, s.logger, s.conf))
+	s.Empty(s.mock.Coverage[s.conf.Task.Id])
+}
+
+func (s *CoverageSuite) TestMissingFiles() {
+	s.cmd.Files = []string{"coverage.xml"}
+	s.Error(s.cmd.Execute(s.ctx, s.comm, s.logger, s.conf))
+
+	s.cmd.Files = []string{"coverage.xml"}
+	s.cmd.Optional = true
+	s.NoError(s.cmd.Execute(s.ctx, s.comm, s.logger, s.conf))
+	s.Empty(s.mock.Coverage[s.conf.Task.Id])
+}
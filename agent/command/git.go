@@ -71,16 +71,22 @@ type cloneOpts struct {
 	shallowClone       bool
 	recurseSubmodules  bool
 	mergeTestRequester bool
+	// gitHosted indicates that the repository is on a plain git server, so
+	// the location is used as-is rather than derived from the owner and repo.
+	gitHosted bool
 }
 
 func (opts cloneOpts) validate() error {
 	catcher := grip.NewBasicCatcher()
-	if opts.owner == "" {
+	if opts.owner == "" && !opts.gitHosted {
 		catcher.New("missing required owner")
 	}
-	if opts.repo == "" {
+	if opts.repo == "" && !opts.gitHosted {
 		catcher.New("missing required repo")
 	}
+	if opts.gitHosted && opts.method == distro.CloneMethodOAuth {
+		catcher.New("cannot clone using OAuth from a git server")
+	}
 	if opts.location == "" {
 		catcher.New("missing required location")
 	}
@@ -276,7 +282,11 @@ func (c *gitFetchProject) buildCloneCommand(ctx context.Context, conf *internal.
 		}
 
 	} else {
-		if opts.shallowClone {
+		if conf.Task.Requester == evergreen.GithubMergeRequester {
+			// The head commit of a GitHub merge queue group is only on the
+			// group's gh-readonly-queue ref, which isn't fetched by the clone.
+			gitCommands = append(gitCommands, fmt.Sprintf("git fetch origin %s", conf.Task.Revision))
+		} else if opts.shallowClone {
 			gitCommands = append(gitCommands, fmt.Sprintf("git log HEAD..%s || git fetch --unshallow", conf.Task.Revision))
 		}
 		if !opts.mergeTestRequester {
@@ -373,7 +383,14 @@ func (c *gitFetchProject) opts(projectMethod, projectToken string, conf *interna
 		recurseSubmodules:  c.RecurseSubmodules,
 		mergeTestRequester: conf.Task.Requester == evergreen.MergeTestRequester,
 	}
-	if err := opts.setLocation(); err != nil {
+	if conf.ProjectRef.IsGitHosted() {
+		// GitHub tokens don't apply to other git servers, so clone directly
+		// from the URL and rely on the host's own credentials.
+		opts.gitHosted = true
+		opts.method = distro.CloneMethodLegacySSH
+		opts.token = ""
+		opts.location = conf.ProjectRef.GitURL
+	} else if err := opts.setLocation(); err != nil {
 		return opts, errors.Wrap(err, "failed to set location to clone from")
 	}
 	if err := opts.validate(); err != nil {
@@ -545,21 +562,25 @@ func (c *gitFetchProject) fetchModuleSource(ctx context.Context,
 		}
 	}
 	var owner, repo string
-	owner, repo, err = thirdparty.ParseGitUrl(module.Repo)
-	if err != nil {
-		return err
+	gitHosted := conf.ProjectRef.IsGitHosted()
+	if !gitHosted {
+		owner, repo, err = thirdparty.ParseGitUrl(module.Repo)
+		if err != nil {
+			return err
+		}
 	}
 
 	opts := cloneOpts{
-		location: module.Repo,
-		owner:    owner,
-		repo:     repo,
-		branch:   "",
-		dir:      moduleBase,
+		location:  module.Repo,
+		owner:     owner,
+		repo:      repo,
+		branch:    "",
+		dir:       moduleBase,
+		gitHosted: gitHosted,
 	}
 	// Module's location takes precedence over the project-level clone
 	// method.
-	if strings.Contains(opts.location, "git@github.com:") {
+	if gitHosted || strings.Contains(opts.location, "git@github.com:") {
 		opts.method = distro.CloneMethodLegacySSH
 	} else {
 		opts.method = projectMethod
@@ -614,6 +635,48 @@ func (c *gitFetchProject) applyAdditionalPatch(ctx context.Context,
 	return nil
 }
 
+// applyStackedOnPatches applies the changes of the patches that the given
+// patch is stacked on, starting from the bottom of the stack. It errors if the
+// patches are stacked on each other in a cycle.
+func (c *gitFetchProject) applyStackedOnPatches(ctx context.Context,
+	conf *internal.TaskConfig,
+	comm client.Communicator,
+	logger client.LoggerProducer,
+	td client.TaskData,
+	p *patch.Patch) error {
+	stack := []*patch.Patch{}
+	visited := map[string]bool{p.Id.Hex(): true}
+	for parentID := p.StackedOn; parentID != ""; {
+		if visited[parentID] {
+			return errors.Errorf("patch '%s' is stacked on itself through patch '%s'", p.Id.Hex(), parentID)
+		}
+		visited[parentID] = true
+		if len(stack) >= patch.MaxStackDepth {
+			return errors.Errorf("patch stack is deeper than the maximum of %d patches", patch.MaxStackDepth)
+		}
+		parent, err := comm.GetTaskPatch(ctx, td, parentID)
+		if err != nil {
+			return errors.Wrapf(err, "unable to get stacked on patch '%s'", parentID)
+		}
+		if parent == nil {
+			return errors.Errorf("stacked on patch '%s' not found", parentID)
+		}
+		stack = append([]*patch.Patch{parent}, stack...)
+		parentID = parent.StackedOn
+	}
+
+	for _, parent := range stack {
+		logger.Task().Infof("applying changes from stacked on patch '%s'", parent.Id.Hex())
+		if err := c.getPatchContents(ctx, comm, logger, conf, parent); err != nil {
+			return errors.Wrap(err, "Failed to get patch contents")
+		}
+		if err := c.applyPatch(ctx, logger, conf, reorderPatches(parent.Patches)); err != nil {
+			return errors.Wrapf(err, "error applying stacked on patch '%s'", parent.Id.Hex())
+		}
+	}
+	return nil
+}
+
 func (c *gitFetchProject) fetch(ctx context.Context,
 	comm client.Communicator,
 	logger client.LoggerProducer,
@@ -681,6 +744,16 @@ func (c *gitFetchProject) fetch(ctx context.Context,
 			return err
 		}
 
+		// A stacked patch's diff is made on top of the changes of the patches
+		// it's stacked on. Commit queue patches get those changes from the
+		// items ahead of them in the queue instead.
+		if p.IsStacked() && conf.Task.Requester != evergreen.MergeTestRequester {
+			if err = c.applyStackedOnPatches(ctx, conf, comm, logger, td, p); err != nil {
+				logger.Execution().Error(err.Error())
+				return err
+			}
+		}
+
 		// in order for the main commit's manifest to include module changes commit queue
 		// commits need to be in the correct order, first modules and then the main patch
 		// reorder patches so the main patch gets applied last
@@ -694,20 +767,20 @@ func (c *gitFetchProject) fetch(ctx context.Context,
 	return nil
 }
 
-// reorder a slice of ModulePatches so the main patch is last
+// reorder a slice of ModulePatches so the main patches are last, keeping the
+// relative order of the patches otherwise
 func reorderPatches(originalPatches []patch.ModulePatch) []patch.ModulePatch {
-	patches := make([]patch.ModulePatch, len(originalPatches))
-	index := 0
+	patches := make([]patch.ModulePatch, 0, len(originalPatches))
+	mainPatches := []patch.ModulePatch{}
 	for _, mp := range originalPatches {
 		if mp.ModuleName == "" {
-			patches[len(patches)-1] = mp
+			mainPatches = append(mainPatches, mp)
 		} else {
-			patches[index] = mp
-			index++
+			patches = append(patches, mp)
 		}
 	}
 
-	return patches
+	return append(patches, mainPatches...)
 }
 
 func (c *gitFetchProject) logModuleRevision(logger client.LoggerProducer, revision, module, reason string) {
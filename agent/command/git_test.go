@@ -16,6 +16,7 @@ import (
 	"github.com/evergreen-ci/evergreen/agent/internal"
 	"github.com/evergreen-ci/evergreen/agent/internal/client"
 	agentutil "github.com/evergreen-ci/evergreen/agent/internal/testutil"
+	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/build"
@@ -548,6 +549,34 @@ func (s *GitGetProjectSuite) TestBuildCommandForPullRequests() {
 	s.Equal("git log --oneline -n 10", cmds[8])
 }
 
+func (s *GitGetProjectSuite) TestBuildCommandForGithubMergeQueue() {
+	conf := s.taskConfig1
+	conf.Task.Requester = evergreen.GithubMergeRequester
+	conf.Task.Revision = "d0d878e81b303fd2abbf09331e54af41d6cd0c7d"
+	c := gitFetchProject{
+		Directory: "dir",
+	}
+
+	opts := cloneOpts{
+		method:       distro.CloneMethodLegacySSH,
+		branch:       conf.ProjectRef.Branch,
+		owner:        conf.ProjectRef.Owner,
+		repo:         conf.ProjectRef.Repo,
+		dir:          c.Directory,
+		shallowClone: true,
+	}
+	s.Require().NoError(opts.setLocation())
+
+	cmds, err := c.buildCloneCommand(context.Background(), conf, nil, opts)
+	s.NoError(err)
+	s.Require().Len(cmds, 8)
+	s.Equal("git clone 'git@github.com:deafgoat/mci_test.git' 'dir' --depth 100 --branch 'master'", cmds[3])
+	s.Equal("cd dir", cmds[4])
+	s.Equal("git fetch origin d0d878e81b303fd2abbf09331e54af41d6cd0c7d", cmds[5])
+	s.Equal("git reset --hard d0d878e81b303fd2abbf09331e54af41d6cd0c7d", cmds[6])
+	s.Equal("git log --oneline -n 10", cmds[7])
+}
+
 func (s *GitGetProjectSuite) TestBuildCommandForCLIMergeTests() {
 	conf := s.taskConfig2
 	c := gitFetchProject{
@@ -832,6 +861,36 @@ func (s *GitGetProjectSuite) TestCloneOptsSetLocationGitHub() {
 	s.Error(opts.setLocation())
 }
 
+func (s *GitGetProjectSuite) TestCloneOptsGitHosted() {
+	c := &gitFetchProject{Directory: "dir"}
+	conf := &internal.TaskConfig{
+		ProjectRef: &model.ProjectRef{
+			Owner:    "foo",
+			Repo:     "bar",
+			Branch:   "main",
+			CodeHost: thirdparty.CodeHostGit,
+			GitURL:   "ssh://git.example.com/foo/bar.git",
+		},
+		Distro: &apimodels.DistroView{},
+		Task:   &task.Task{},
+	}
+	opts, err := c.opts(distro.CloneMethodOAuth, projectGitHubToken, conf)
+	s.Require().NoError(err)
+	s.Equal("ssh://git.example.com/foo/bar.git", opts.location)
+	s.Equal(distro.CloneMethodLegacySSH, opts.method)
+	s.Empty(opts.token)
+
+	cmds, err := opts.getCloneCommand()
+	s.Require().NoError(err)
+	s.Equal("git clone 'ssh://git.example.com/foo/bar.git' 'dir' --branch 'main'", cmds[0])
+
+	opts = cloneOpts{location: "https://git.example.com/mod.git", gitHosted: true, method: distro.CloneMethodLegacySSH}
+	s.NoError(opts.validate())
+	opts.method = distro.CloneMethodOAuth
+	opts.token = "token"
+	s.Error(opts.validate())
+}
+
 func (s *GitGetProjectSuite) TestGetProjectMethodAndToken() {
 	var token string
 	var method string
@@ -908,6 +967,35 @@ func (s *GitGetProjectSuite) TestReorderPatches() {
 	s.Equal("m0", patches[0].ModuleName)
 	s.Equal("m1", patches[1].ModuleName)
 	s.Equal("", patches[2].ModuleName)
+
+	patches = []patch.ModulePatch{
+		{ModuleName: "", Githash: "a"},
+		{ModuleName: "m0"},
+		{ModuleName: "", Githash: "b"},
+	}
+	patches = reorderPatches(patches)
+	s.Require().Len(patches, 3)
+	s.Equal("m0", patches[0].ModuleName)
+	s.Equal("a", patches[1].Githash)
+	s.Equal("b", patches[2].Githash)
+}
+
+func (s *GitGetProjectSuite) TestApplyStackedOnPatchesDetectsCycles() {
+	const patchID = "aabbccddeeff112233445566"
+	p := &patch.Patch{
+		Id:        patch.NewId(patchID),
+		StackedOn: patchID,
+	}
+	ctx := context.WithValue(context.Background(), "patch", p)
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	comm := client.NewMock("http://localhost.com")
+	logger := client.NewSingleChannelLogHarness("test", send.MakeInternalLogger())
+	c := &gitFetchProject{}
+
+	err := c.applyStackedOnPatches(ctx, s.taskConfig1, comm, logger, client.TaskData{}, p)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "stacked on itself")
 }
 
 func (s *GitGetProjectSuite) TestMergeMultiplePatches() {
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"context"
+	"sync"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+)
+
+// localCommands are the commands that only act on the local file system and
+// local processes, so they can run unmodified outside of an Evergreen host.
+// Every other registered command needs the Evergreen service or a remote
+// resource (e.g. S3 or a cloud provider) and is stubbed out when rendered
+// with RenderLocal.
+var localCommands = []string{
+	"archive.targz_pack",
+	"archive.targz_extract",
+	"archive.zip_pack",
+	"archive.zip_extract",
+	"archive.auto_extract",
+	"expansions.update",
+	"expansions.write",
+	"shell.cleanup",
+	evergreen.ShellExecCommandName,
+	"shell.track",
+	"subprocess.exec",
+	"subprocess.scripting",
+	"setup.initial",
+	"timeout.update",
+}
+
+var (
+	localRegistry     *commandRegistry
+	localRegistryOnce sync.Once
+)
+
+// RenderLocal is the same as Render, except that commands that cannot run
+// outside of an Evergreen host are replaced by stubs that only log that they
+// were skipped.
+func RenderLocal(c model.PluginCommandConf, fns map[string]*model.YAMLCommandSet) ([]Command, error) {
+	localRegistryOnce.Do(func() {
+		localRegistry = newLocalCommandRegistry(evgRegistry)
+	})
+	return localRegistry.renderCommands(c, fns)
+}
+
+// IsLocalCommand returns whether the named command runs for real (rather than
+// as a stub) when rendered with RenderLocal.
+func IsLocalCommand(name string) bool {
+	return utility.StringSliceContains(localCommands, name)
+}
+
+func newLocalCommandRegistry(r *commandRegistry) *commandRegistry {
+	local := newCommandRegistry()
+	for _, name := range r.registeredCommandNames() {
+		factory, _ := r.getCommandFactory(name)
+		if !IsLocalCommand(name) {
+			factory = localStubFactory(name)
+		}
+		grip.EmergencyPanic(local.registerCommand(name, factory))
+	}
+	return local
+}
+
+type localStub struct {
+	name   string
+	params map[string]interface{}
+	base
+}
+
+func localStubFactory(name string) CommandFactory {
+	return func() Command { return &localStub{name: name} }
+}
+
+func (c *localStub) Name() string { return c.name }
+
+// ParseParams does not validate the parameters, since the stub never uses
+// them except to log what the real command would have received.
+func (c *localStub) ParseParams(params map[string]interface{}) error {
+	c.params = params
+	return nil
+}
+
+func (c *localStub) Execute(ctx context.Context,
+	comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+
+	logger.Task().Warningf("Skipping command '%s' because it cannot run outside of Evergreen.", c.name)
+	if len(c.params) != 0 {
+		logger.Task().Debug(message.Fields{
+			"message": "parameters for skipped command",
+			"command": c.name,
+			"params":  c.params,
+		})
+	}
+	return nil
+}
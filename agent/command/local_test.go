@@ -0,0 +1,60 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderLocal(t *testing.T) {
+	t.Run("StubsRemoteCommands", func(t *testing.T) {
+		for _, name := range []string{"s3.put", evergreen.HostCreateCommandName, "attach.results", "git.get_project"} {
+			cmds, err := RenderLocal(model.PluginCommandConf{Command: name}, nil)
+			require.NoError(t, err, name)
+			require.Len(t, cmds, 1)
+			assert.IsType(t, &localStub{}, cmds[0], name)
+			assert.Equal(t, name, cmds[0].Name())
+		}
+	})
+	t.Run("StubIgnoresInvalidParams", func(t *testing.T) {
+		_, err := Render(model.PluginCommandConf{Command: "s3.put"}, nil)
+		assert.Error(t, err)
+
+		cmds, err := RenderLocal(model.PluginCommandConf{Command: "s3.put"}, nil)
+		require.NoError(t, err)
+		assert.Len(t, cmds, 1)
+	})
+	t.Run("KeepsLocalCommands", func(t *testing.T) {
+		cmds, err := RenderLocal(model.PluginCommandConf{
+			Command: evergreen.ShellExecCommandName,
+			Params:  map[string]interface{}{"script": "echo hi"},
+		}, nil)
+		require.NoError(t, err)
+		require.Len(t, cmds, 1)
+		assert.IsType(t, &shellExec{}, cmds[0])
+	})
+	t.Run("RendersFunctions", func(t *testing.T) {
+		fns := map[string]*model.YAMLCommandSet{
+			"upload": {
+				MultiCommand: []model.PluginCommandConf{
+					{Command: evergreen.ShellExecCommandName, Params: map[string]interface{}{"script": "echo hi"}},
+					{Command: "s3.put"},
+				},
+			},
+		}
+		cmds, err := RenderLocal(model.PluginCommandConf{Function: "upload"}, fns)
+		require.NoError(t, err)
+		require.Len(t, cmds, 2)
+		assert.IsType(t, &shellExec{}, cmds[0])
+		assert.IsType(t, &localStub{}, cmds[1])
+	})
+	t.Run("AllLocalCommandsAreRegistered", func(t *testing.T) {
+		for _, name := range localCommands {
+			_, ok := GetCommandFactory(name)
+			assert.True(t, ok, name)
+		}
+	})
+}
@@ -2,9 +2,11 @@ package command
 
 import (
 	"context"
+	"path"
 
 	"github.com/evergreen-ci/evergreen/agent/internal"
 	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/perf"
 	"github.com/evergreen-ci/evergreen/util"
 	"github.com/evergreen-ci/poplar"
 	"github.com/evergreen-ci/poplar/rpc"
@@ -67,6 +69,14 @@ func (c *perfSend) Execute(ctx context.Context,
 	}
 	c.addEvgData(report, conf)
 
+	// Send the metrics to Evergreen for change-point detection. This is in
+	// addition to sending them to Cedar, so failing to send them doesn't
+	// fail the command.
+	td := client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
+	if err = comm.SendPerfResults(ctx, td, perfResults(report)); err != nil {
+		logger.Task().Warning(errors.Wrap(err, "problem sending perf results to evergreen"))
+	}
+
 	// Send data to Cedar.
 	conn, err := comm.GetCedarGRPCConn(ctx)
 	if err != nil {
@@ -95,3 +105,90 @@ func (c *perfSend) addEvgData(report *poplar.Report, conf *internal.TaskConfig)
 	report.BucketConf.Prefix = c.Prefix
 	report.BucketConf.Region = c.Region
 }
+
+// perfResults returns the metrics of the report's tests and subtests, which
+// are named by their path from the top-level test. Metrics that aren't
+// numeric are skipped, and a test that ran more than once, such as in multiple
+// trials, reports the mean of each of its metrics.
+func perfResults(report *poplar.Report) []perf.TestResult {
+	type metricSum struct {
+		name  string
+		sum   float64
+		count int
+	}
+	type testSums struct {
+		name    string
+		metrics []*metricSum
+	}
+	var tests []*testSums
+	byName := map[string]*testSums{}
+
+	var addTest func(parent string, test poplar.Test)
+	addTest = func(parent string, test poplar.Test) {
+		name := path.Join(parent, test.Info.TestName)
+		sums, ok := byName[name]
+		if !ok {
+			sums = &testSums{name: name}
+			byName[name] = sums
+			tests = append(tests, sums)
+		}
+		for _, m := range test.Metrics {
+			value, ok := perfMetricValue(m.Value)
+			if !ok {
+				continue
+			}
+			var ms *metricSum
+			for _, existing := range sums.metrics {
+				if existing.name == m.Name {
+					ms = existing
+					break
+				}
+			}
+			if ms == nil {
+				ms = &metricSum{name: m.Name}
+				sums.metrics = append(sums.metrics, ms)
+			}
+			ms.sum += value
+			ms.count++
+		}
+		for _, subtest := range test.SubTests {
+			addTest(name, subtest)
+		}
+	}
+	for _, test := range report.Tests {
+		addTest("", test)
+	}
+
+	results := []perf.TestResult{}
+	for _, test := range tests {
+		if len(test.metrics) == 0 {
+			continue
+		}
+		result := perf.TestResult{TestName: test.name}
+		for _, ms := range test.metrics {
+			result.Metrics = append(result.Metrics, perf.Metric{
+				Name:  ms.name,
+				Value: ms.sum / float64(ms.count),
+			})
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func perfMetricValue(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
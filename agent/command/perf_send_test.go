@@ -5,9 +5,11 @@ import (
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/model/perf"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/poplar"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPerfSendParseParams(t *testing.T) {
@@ -80,3 +82,47 @@ func TestPerfSendAddEvgData(t *testing.T) {
 	cmd.addEvgData(report, conf)
 	assert.Equal(t, expectedReport, report)
 }
+
+func TestPerfSendResults(t *testing.T) {
+	report := &poplar.Report{
+		Tests: []poplar.Test{
+			{
+				Info: poplar.TestInfo{TestName: "insert", Trial: 0},
+				Metrics: []poplar.TestMetrics{
+					{Name: "ops_per_sec", Value: 100},
+					{Name: "latency", Value: 2.5},
+					{Name: "histogram", Value: []int{1, 2}},
+				},
+			},
+			{
+				Info: poplar.TestInfo{TestName: "insert", Trial: 1},
+				Metrics: []poplar.TestMetrics{
+					{Name: "ops_per_sec", Value: int64(200)},
+				},
+			},
+			{
+				Info: poplar.TestInfo{TestName: "suite"},
+				SubTests: []poplar.Test{
+					{
+						Info:    poplar.TestInfo{TestName: "find"},
+						Metrics: []poplar.TestMetrics{{Name: "latency", Value: float32(1)}},
+					},
+				},
+			},
+		},
+	}
+
+	results := perfResults(report)
+	require.Len(t, results, 2)
+	assert.Equal(t, perf.TestResult{
+		TestName: "insert",
+		Metrics: []perf.Metric{
+			{Name: "ops_per_sec", Value: 150},
+			{Name: "latency", Value: 2.5},
+		},
+	}, results[0])
+	assert.Equal(t, perf.TestResult{
+		TestName: "suite/find",
+		Metrics:  []perf.Metric{{Name: "latency", Value: 1}},
+	}, results[1])
+}
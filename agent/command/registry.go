@@ -25,7 +25,11 @@ func init() {
 		"archive.auto_extract":          autoExtractFactory,
 		"attach.results":                attachResultsFactory,
 		"attach.xunit_results":          xunitResultsFactory,
+		"attach.tap_results":            tapResultsFactory,
+		"attach.trx_results":            trxResultsFactory,
+		"attach.cucumber_results":       cucumberResultsFactory,
 		"attach.artifacts":              attachArtifactsFactory,
+		"attach.coverage":               attachCoverageFactory,
 		evergreen.HostCreateCommandName: createHostFactory,
 		"host.list":                     listHostFactory,
 		"expansions.fetch_vars":         fetchVarsFactory,
@@ -0,0 +1,149 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/pkg/errors"
+)
+
+func cucumberResultsFactory() Command {
+	return &reportResults{name: "attach.cucumber_results", parser: parseCucumberResults}
+}
+
+type cucumberFeature struct {
+	URI      string            `json:"uri"`
+	Name     string            `json:"name"`
+	Tags     []cucumberTag     `json:"tags"`
+	Elements []cucumberElement `json:"elements"`
+}
+
+type cucumberElement struct {
+	Name    string         `json:"name"`
+	Type    string         `json:"type"`
+	Keyword string         `json:"keyword"`
+	Line    int            `json:"line"`
+	Tags    []cucumberTag  `json:"tags"`
+	Before  []cucumberStep `json:"before"`
+	Steps   []cucumberStep `json:"steps"`
+	After   []cucumberStep `json:"after"`
+}
+
+type cucumberTag struct {
+	Name string `json:"name"`
+}
+
+// cucumberStep is a step or a hook.
+type cucumberStep struct {
+	Keyword string         `json:"keyword"`
+	Name    string         `json:"name"`
+	Result  cucumberResult `json:"result"`
+}
+
+type cucumberResult struct {
+	Status string `json:"status"`
+	// Duration is in nanoseconds.
+	Duration     int64  `json:"duration"`
+	ErrorMessage string `json:"error_message"`
+}
+
+// parseCucumberResults parses a Cucumber JSON report, where each scenario is a
+// test. Background steps are counted as part of the scenario that follows
+// them.
+func parseCucumberResults(r io.Reader) ([]reportTestCase, error) {
+	var features []cucumberFeature
+	if err := json.NewDecoder(r).Decode(&features); err != nil {
+		return nil, errors.Wrap(err, "decoding Cucumber JSON report")
+	}
+
+	var tests []reportTestCase
+	for _, feature := range features {
+		featureName := feature.Name
+		if featureName == "" {
+			featureName = feature.URI
+		}
+
+		var background []cucumberStep
+		for _, element := range feature.Elements {
+			if element.Type == "background" {
+				background = element.Steps
+				continue
+			}
+
+			steps := append([]cucumberStep{}, element.Before...)
+			steps = append(steps, background...)
+			steps = append(steps, element.Steps...)
+			steps = append(steps, element.After...)
+			background = nil
+
+			name := element.Name
+			if name == "" {
+				name = fmt.Sprintf("%s (line %d)", element.Keyword, element.Line)
+			}
+			tc := makeCucumberTestCase(fmt.Sprintf("%s: %s", featureName, name), steps)
+			// Scenarios inherit their feature's tags.
+			tc.tags = cucumberTagNames(append(append([]cucumberTag{}, feature.Tags...), element.Tags...))
+			tests = append(tests, tc)
+		}
+	}
+
+	return tests, nil
+}
+
+func makeCucumberTestCase(name string, steps []cucumberStep) reportTestCase {
+	tc := reportTestCase{
+		name:   name,
+		status: evergreen.TestSucceededStatus,
+	}
+	var stepLines []string
+	for _, step := range steps {
+		tc.duration += time.Duration(step.Result.Duration)
+		tc.status = worseTestStatus(tc.status, cucumberStatusToTestStatus(step.Result.Status))
+
+		if step.Name != "" || step.Keyword != "" {
+			stepLines = append(stepLines, fmt.Sprintf("%s%s ... %s", step.Keyword, step.Name, step.Result.Status))
+		}
+		stepLines = append(stepLines, splitReportOutput(step.Result.ErrorMessage)...)
+		if tc.failureMessage == "" && step.Result.ErrorMessage != "" {
+			tc.failureMessage, tc.stackTrace = splitFailureOutput(step.Result.ErrorMessage)
+		}
+	}
+
+	// Passing scenarios are only listed in the report's summary log.
+	if tc.status != evergreen.TestSucceededStatus {
+		tc.logLines = stepLines
+	}
+
+	return tc
+}
+
+// cucumberTagNames returns the unique names of the tags without their leading
+// "@".
+func cucumberTagNames(tags []cucumberTag) []string {
+	var names []string
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		name := strings.TrimPrefix(tag.Name, "@")
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	return names
+}
+
+func cucumberStatusToTestStatus(status string) string {
+	switch strings.ToLower(status) {
+	case "passed":
+		return evergreen.TestSucceededStatus
+	case "skipped", "pending", "undefined":
+		return evergreen.TestSkippedStatus
+	default:
+		return evergreen.TestFailedStatus
+	}
+}
@@ -0,0 +1,48 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCucumberResults(t *testing.T) {
+	f, err := os.Open(filepath.Join(testutil.GetDirectoryOfFile(), "testdata", "cucumber", "report.json"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	tests, err := parseCucumberResults(f)
+	require.NoError(t, err)
+	require.Len(t, tests, 3)
+
+	assert.Equal(t, "Login: Successful login", tests[0].name)
+	assert.Equal(t, evergreen.TestSucceededStatus, tests[0].status)
+	assert.Equal(t, 6*time.Millisecond, tests[0].duration)
+	assert.Empty(t, tests[0].logLines)
+	assert.Equal(t, []string{"auth"}, tests[0].tags)
+	assert.Empty(t, tests[0].failureMessage)
+
+	assert.Equal(t, "Login: Wrong password", tests[1].name)
+	assert.Equal(t, evergreen.TestFailedStatus, tests[1].status)
+	assert.Equal(t, 3500*time.Microsecond, tests[1].duration)
+	assert.Equal(t, []string{
+		"When they log in with the wrong password ... passed",
+		"Then they see an error ... failed",
+		"expected error message",
+		"but got none",
+		"And they stay on the login page ... skipped",
+	}, tests[1].logLines)
+	assert.Equal(t, []string{"auth", "smoke"}, tests[1].tags)
+	assert.Equal(t, "expected error message", tests[1].failureMessage)
+	assert.Equal(t, "but got none", tests[1].stackTrace)
+
+	assert.Equal(t, "Login: Password reset", tests[2].name)
+	assert.Equal(t, evergreen.TestSkippedStatus, tests[2].status)
+	assert.Equal(t, []string{"When they reset their password ... undefined"}, tests[2].logLines)
+}
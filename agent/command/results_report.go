@@ -0,0 +1,257 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/utility"
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+)
+
+// reportTestCase is a single test case read from a third-party test report.
+type reportTestCase struct {
+	name     string
+	status   string
+	duration time.Duration
+	// start is when the test started, if the report records it.
+	start time.Time
+	// logLines is the test's own output. Tests without any output are
+	// logged together in the report's summary log.
+	logLines []string
+
+	failureMessage string
+	stackTrace     string
+	tags           []string
+	owners         []string
+}
+
+// reportParser parses a test report into its test cases.
+type reportParser func(io.Reader) ([]reportTestCase, error)
+
+// reportResults is the common implementation of the commands that attach
+// results from a third-party test report format.
+type reportResults struct {
+	// Files are the paths to the report files relative to the working
+	// directory. Supports globbing.
+	Files []string `mapstructure:"files" plugin:"expand"`
+
+	// Optional, when set to true, causes this command to be skipped over
+	// without an error when no files are found to be parsed.
+	OptionalOutput   string `mapstructure:"optional_output" plugin:"expand"`
+	outputIsOptional bool
+
+	name   string
+	parser reportParser
+	base
+}
+
+func (c *reportResults) Name() string { return c.name }
+
+func (c *reportResults) ParseParams(params map[string]interface{}) error {
+	var err error
+	if err = mapstructure.Decode(params, c); err != nil {
+		return errors.Wrapf(err, "error decoding '%s' params", c.Name())
+	}
+
+	if c.OptionalOutput != "" {
+		c.outputIsOptional, err = strconv.ParseBool(c.OptionalOutput)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	if len(c.Files) == 0 {
+		return errors.Errorf("error validating params: must specify at least one "+
+			"file pattern to parse: '%+v'", params)
+	}
+	return nil
+}
+
+func (c *reportResults) Execute(ctx context.Context,
+	comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+
+	if err := util.ExpandValues(c, conf.Expansions); err != nil {
+		return errors.Wrap(err, "error expanding params")
+	}
+
+	// All file patterns should be relative to the task's working directory.
+	patterns := make([]string, 0, len(c.Files))
+	for _, file := range c.Files {
+		patterns = append(patterns, getJoinedWithWorkDir(conf, file))
+	}
+	files, err := globFiles(patterns...)
+	if err != nil {
+		return errors.Wrap(err, "obtaining names of report files")
+	}
+	if len(files) == 0 {
+		if c.outputIsOptional {
+			logger.Task().Info("No report files found to parse.")
+			return nil
+		}
+		return errors.New("no report files found to parse")
+	}
+
+	var (
+		logs    []model.TestLog
+		results [][]task.TestResult
+	)
+	for _, file := range files {
+		if ctx.Err() != nil {
+			return errors.New("operation canceled")
+		}
+
+		fileLogs, fileResults, err := c.parseReportFile(conf, file)
+		if err != nil {
+			// don't bomb out on a single bad file
+			logger.Task().Errorf("Error parsing report file '%s': %s", file, err)
+			continue
+		}
+		numResults := 0
+		for _, res := range fileResults {
+			numResults += len(res)
+		}
+		logger.Task().Infof("Parsed %d test results from '%s'.", numResults, file)
+		logs = append(logs, fileLogs...)
+		results = append(results, fileResults...)
+	}
+	if len(results) == 0 {
+		return errors.New("report files contained no results")
+	}
+
+	return errors.Wrap(sendTestLogsAndResults(ctx, comm, logger, conf, logs, results), "sending test logs and test results")
+}
+
+// parseReportFile parses a single report file into test logs and the results
+// that refer to each log. Every test with its own output gets its own log, and
+// the rest share a summary log for the file.
+func (c *reportResults) parseReportFile(conf *internal.TaskConfig, file string) ([]model.TestLog, [][]task.TestResult, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "opening file")
+	}
+	defer f.Close()
+
+	testCases, err := c.parser(f)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "parsing file")
+	}
+	if len(testCases) == 0 {
+		return nil, nil, errors.New("no results found")
+	}
+
+	_, reportName := filepath.Split(file)
+	summary := makeReportTestLog(conf, reportName)
+	var (
+		logs           []model.TestLog
+		results        [][]task.TestResult
+		summaryResults []task.TestResult
+	)
+	now := time.Now()
+	for _, tc := range testCases {
+		start := tc.start
+		if start.IsZero() {
+			start = now
+		}
+		res := task.TestResult{
+			TestFile:  tc.name,
+			Status:    tc.status,
+			StartTime: float64(start.Unix()),
+			EndTime:   float64(start.Unix()) + tc.duration.Seconds(),
+
+			FailureMessage: tc.failureMessage,
+			StackTrace:     tc.stackTrace,
+			Tags:           tc.tags,
+			Owners:         tc.owners,
+		}
+		summary.Lines = append(summary.Lines, fmt.Sprintf("%s: %s (%s)", strings.ToUpper(tc.status), tc.name, tc.duration.String()))
+
+		if len(tc.logLines) == 0 {
+			res.LogTestName = summary.Name
+			res.LineNum = len(summary.Lines) - 1
+			summaryResults = append(summaryResults, res)
+			continue
+		}
+
+		log := makeReportTestLog(conf, util.CleanForPath(tc.name))
+		log.Lines = tc.logLines
+		res.LogTestName = log.Name
+		logs = append(logs, log)
+		results = append(results, []task.TestResult{res})
+	}
+
+	logs = append(logs, summary)
+	results = append(results, summaryResults)
+	return logs, results, nil
+}
+
+func makeReportTestLog(conf *internal.TaskConfig, name string) model.TestLog {
+	if conf.ProjectRef.IsCedarTestResultsEnabled() {
+		// When sending test logs to cedar we need to use a unique string
+		// since there may be duplicate test names.
+		name = utility.RandomString()
+	}
+	return model.TestLog{
+		Name:          name,
+		Task:          conf.Task.Id,
+		TaskExecution: conf.Task.Execution,
+	}
+}
+
+// splitReportOutput splits captured test output into log lines.
+func splitReportOutput(output string) []string {
+	output = strings.TrimSpace(output)
+	if output == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(output, "\r\n", "\n"), "\n")
+}
+
+// splitFailureOutput splits a test failure's output into its message, which
+// is the first line, and the stack trace or details that follow it.
+func splitFailureOutput(output string) (string, string) {
+	lines := splitReportOutput(output)
+	if len(lines) == 0 {
+		return "", ""
+	}
+	return strings.TrimSpace(lines[0]), strings.TrimSpace(strings.Join(lines[1:], "\n"))
+}
+
+// splitReportList splits a comma-separated list of test tags or owners.
+func splitReportList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// reportStatusPriority orders test statuses from least to most severe, for
+// rolling up the status of a test from its parts.
+var reportStatusPriority = map[string]int{
+	evergreen.TestSucceededStatus:      0,
+	evergreen.TestSkippedStatus:        1,
+	evergreen.TestSilentlyFailedStatus: 2,
+	evergreen.TestFailedStatus:         3,
+}
+
+func worseTestStatus(a, b string) string {
+	if reportStatusPriority[b] > reportStatusPriority[a] {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,64 @@
+package command
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportResultsParseParams(t *testing.T) {
+	cmd := tapResultsFactory()
+	assert.Equal(t, "attach.tap_results", cmd.Name())
+	assert.Error(t, cmd.ParseParams(map[string]interface{}{}))
+	assert.Error(t, cmd.ParseParams(map[string]interface{}{
+		"files":           []string{"*.tap"},
+		"optional_output": "maybe",
+	}))
+	assert.NoError(t, cmd.ParseParams(map[string]interface{}{
+		"files":           []string{"*.tap"},
+		"optional_output": "true",
+	}))
+}
+
+func TestReportResultsParseReportFile(t *testing.T) {
+	conf := &internal.TaskConfig{
+		Task:       &task.Task{Id: "task", Execution: 1},
+		ProjectRef: &model.ProjectRef{},
+	}
+	cmd := cucumberResultsFactory().(*reportResults)
+
+	logs, results, err := cmd.parseReportFile(conf, filepath.Join(testutil.GetDirectoryOfFile(), "testdata", "cucumber", "report.json"))
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+	require.Len(t, results, 3)
+
+	// Failing and skipped scenarios get their own logs.
+	for i, name := range []string{"Login__Wrong_password", "Login__Password_reset"} {
+		assert.Equal(t, name, logs[i].Name)
+		assert.Equal(t, "task", logs[i].Task)
+		assert.Equal(t, 1, logs[i].TaskExecution)
+		require.Len(t, results[i], 1)
+		assert.Equal(t, name, results[i][0].LogTestName)
+	}
+	assert.Equal(t, evergreen.TestFailedStatus, results[0][0].Status)
+	assert.Equal(t, "expected error message", results[0][0].FailureMessage)
+	assert.Equal(t, []string{"auth", "smoke"}, results[0][0].Tags)
+
+	// Passing scenarios point at their line in the summary log.
+	summary := logs[2]
+	assert.Equal(t, "report.json", summary.Name)
+	assert.Len(t, summary.Lines, 3)
+	require.Len(t, results[2], 1)
+	assert.Equal(t, "Login: Successful login", results[2][0].TestFile)
+	assert.Equal(t, evergreen.TestSucceededStatus, results[2][0].Status)
+	assert.Equal(t, "report.json", results[2][0].LogTestName)
+	assert.Equal(t, 0, results[2][0].LineNum)
+	assert.Equal(t, "PASS: Login: Successful login (6ms)", summary.Lines[0])
+}
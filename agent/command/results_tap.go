@@ -0,0 +1,163 @@
+package command
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/pkg/errors"
+)
+
+func tapResultsFactory() Command {
+	return &reportResults{name: "attach.tap_results", parser: parseTAPResults}
+}
+
+var (
+	tapTestPointRegex = regexp.MustCompile(`^(ok|not ok)\b\s*(\d+)?\s*(?:-\s*)?(.*)$`)
+	tapDirectiveRegex = regexp.MustCompile(`(?i)(?:^|\s)#\s*(skip|todo)\S*\s*(.*)$`)
+	tapTimeRegex      = regexp.MustCompile(`#\s*time=([0-9.]+)(ms|s)?\s*$`)
+	tapPlanRegex      = regexp.MustCompile(`^1\.\.(\d+)`)
+	tapYAMLTimeRegex  = regexp.MustCompile(`^\s*duration_ms:\s*([0-9.]+)`)
+	tapYAMLMsgRegex   = regexp.MustCompile(`^\s*message:\s*(.+)$`)
+)
+
+// parseTAPResults parses a Test Anything Protocol stream. Diagnostics after a
+// test point belong to that test, and indented subtest output belongs to the
+// parent test point that follows it.
+func parseTAPResults(r io.Reader) ([]reportTestCase, error) {
+	var (
+		tests          []reportTestCase
+		pending        []string
+		cur            = -1
+		afterTestPoint bool
+		inYAML         bool
+		planned        = -1
+		bailedOut      bool
+	)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		trimmed := strings.TrimSpace(line)
+		indented := trimmed != "" && line != strings.TrimLeft(line, " \t")
+
+		if inYAML {
+			tests[cur].logLines = append(tests[cur].logLines, line)
+			if trimmed == "..." {
+				inYAML = false
+			} else if match := tapYAMLTimeRegex.FindStringSubmatch(line); match != nil {
+				if ms, err := strconv.ParseFloat(match[1], 64); err == nil {
+					tests[cur].duration = time.Duration(ms * float64(time.Millisecond))
+				}
+			} else if match := tapYAMLMsgRegex.FindStringSubmatch(line); match != nil {
+				tests[cur].failureMessage = strings.Trim(strings.TrimSpace(match[1]), `"'`)
+			}
+			continue
+		}
+
+		wasAfterTestPoint := afterTestPoint
+		afterTestPoint = false
+		switch {
+		case indented && trimmed == "---" && wasAfterTestPoint:
+			inYAML = true
+			tests[cur].logLines = append(tests[cur].logLines, line)
+		case indented:
+			pending = append(pending, line)
+		case tapTestPointRegex.MatchString(trimmed):
+			tests = append(tests, parseTAPTestPoint(trimmed, pending))
+			pending = nil
+			cur = len(tests) - 1
+			afterTestPoint = true
+		case strings.HasPrefix(trimmed, "Bail out!"):
+			tests = append(tests, reportTestCase{
+				name:     "Bail out!",
+				status:   evergreen.TestFailedStatus,
+				logLines: append(pending, line),
+			})
+			pending = nil
+			cur = len(tests) - 1
+			bailedOut = true
+		case strings.HasPrefix(trimmed, "# Subtest"):
+			pending = append(pending, line)
+		case tapPlanRegex.MatchString(trimmed):
+			planned, _ = strconv.Atoi(tapPlanRegex.FindStringSubmatch(trimmed)[1])
+		case strings.HasPrefix(trimmed, "TAP version"), trimmed == "":
+		default:
+			// Comments and other output after a test point are that test's
+			// diagnostics.
+			if cur >= 0 {
+				tests[cur].logLines = append(tests[cur].logLines, line)
+				// The first diagnostic of a failing test usually says why it
+				// failed.
+				if tests[cur].status == evergreen.TestFailedStatus && tests[cur].failureMessage == "" {
+					tests[cur].failureMessage = strings.TrimSpace(strings.TrimPrefix(trimmed, "#"))
+				}
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "reading TAP stream")
+	}
+
+	if planned > len(tests) && !bailedOut {
+		tests = append(tests, reportTestCase{
+			name:     "TAP plan",
+			status:   evergreen.TestFailedStatus,
+			logLines: []string{fmt.Sprintf("Planned %d tests but only %d ran.", planned, len(tests))},
+		})
+	}
+
+	return tests, nil
+}
+
+func parseTAPTestPoint(line string, output []string) reportTestCase {
+	match := tapTestPointRegex.FindStringSubmatch(line)
+	ok := match[1] == "ok"
+	number := match[2]
+	description := match[3]
+
+	tc := reportTestCase{logLines: output}
+	if timeMatch := tapTimeRegex.FindStringSubmatch(description); timeMatch != nil {
+		if val, err := strconv.ParseFloat(timeMatch[1], 64); err == nil {
+			unit := time.Millisecond
+			if timeMatch[2] == "s" {
+				unit = time.Second
+			}
+			tc.duration = time.Duration(val * float64(unit))
+		}
+		description = strings.TrimSpace(description[:len(description)-len(timeMatch[0])])
+	}
+
+	var directive string
+	if directiveMatch := tapDirectiveRegex.FindStringSubmatchIndex(description); directiveMatch != nil {
+		directive = strings.ToLower(description[directiveMatch[2]:directiveMatch[3]])
+		description = strings.TrimSpace(description[:directiveMatch[0]])
+	}
+	description = strings.ReplaceAll(description, `\#`, "#")
+
+	tc.name = description
+	if tc.name == "" {
+		tc.name = fmt.Sprintf("test %s", number)
+	}
+
+	switch {
+	case directive == "skip":
+		tc.status = evergreen.TestSkippedStatus
+	case directive == "todo" && !ok:
+		// Failing TODO tests are expected to fail, so they shouldn't fail
+		// the task.
+		tc.status = evergreen.TestSilentlyFailedStatus
+	case ok:
+		tc.status = evergreen.TestSucceededStatus
+	default:
+		tc.status = evergreen.TestFailedStatus
+	}
+
+	return tc
+}
@@ -0,0 +1,84 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTAPResults(t *testing.T) {
+	cwd := testutil.GetDirectoryOfFile()
+
+	t.Run("Perl", func(t *testing.T) {
+		f, err := os.Open(filepath.Join(cwd, "testdata", "tap", "perl.tap"))
+		require.NoError(t, err)
+		defer f.Close()
+
+		tests, err := parseTAPResults(f)
+		require.NoError(t, err)
+		require.Len(t, tests, 6)
+
+		assert.Equal(t, "loads the module", tests[0].name)
+		assert.Equal(t, evergreen.TestSucceededStatus, tests[0].status)
+		assert.Empty(t, tests[0].logLines)
+
+		assert.Equal(t, "parses the config", tests[1].name)
+		assert.Equal(t, evergreen.TestFailedStatus, tests[1].status)
+		require.Len(t, tests[1].logLines, 4)
+		assert.Contains(t, tests[1].logLines[0], "Failed test 'parses the config'")
+		assert.Equal(t, "Failed test 'parses the config'", tests[1].failureMessage)
+
+		assert.Equal(t, "handles unicode # comments", tests[2].name)
+		assert.Equal(t, evergreen.TestSucceededStatus, tests[2].status)
+
+		assert.Equal(t, "test 4", tests[3].name)
+		assert.Equal(t, evergreen.TestSkippedStatus, tests[3].status)
+
+		assert.Equal(t, "future feature", tests[4].name)
+		assert.Equal(t, evergreen.TestSilentlyFailedStatus, tests[4].status)
+
+		assert.Equal(t, "test 6", tests[5].name)
+	})
+	t.Run("NodeWithSubtests", func(t *testing.T) {
+		f, err := os.Open(filepath.Join(cwd, "testdata", "tap", "node.tap"))
+		require.NoError(t, err)
+		defer f.Close()
+
+		tests, err := parseTAPResults(f)
+		require.NoError(t, err)
+		require.Len(t, tests, 3)
+
+		assert.Equal(t, "math", tests[0].name)
+		assert.Equal(t, evergreen.TestFailedStatus, tests[0].status)
+		assert.Equal(t, 12500*time.Microsecond, tests[0].duration)
+		assert.Contains(t, tests[0].logLines, "    not ok 2 - divides")
+		assert.Contains(t, tests[0].logLines, "  duration_ms: 12.5")
+
+		assert.Equal(t, "strings", tests[1].name)
+		assert.Equal(t, 1500*time.Millisecond, tests[1].duration)
+		assert.Empty(t, tests[1].logLines)
+
+		assert.Equal(t, "TAP plan", tests[2].name)
+		assert.Equal(t, evergreen.TestFailedStatus, tests[2].status)
+	})
+	t.Run("YAMLMessage", func(t *testing.T) {
+		tests, err := parseTAPResults(strings.NewReader("not ok 1 - compares\n  ---\n  message: 'should be equal'\n  ...\n"))
+		require.NoError(t, err)
+		require.Len(t, tests, 1)
+		assert.Equal(t, "should be equal", tests[0].failureMessage)
+	})
+	t.Run("BailOut", func(t *testing.T) {
+		tests, err := parseTAPResults(strings.NewReader("1..3\nok 1 - first\nBail out! database unavailable\n"))
+		require.NoError(t, err)
+		require.Len(t, tests, 2)
+		assert.Equal(t, "Bail out!", tests[1].name)
+		assert.Equal(t, evergreen.TestFailedStatus, tests[1].status)
+	})
+}
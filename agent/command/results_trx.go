@@ -0,0 +1,162 @@
+package command
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/pkg/errors"
+)
+
+func trxResultsFactory() Command {
+	return &reportResults{name: "attach.trx_results", parser: parseTRXResults}
+}
+
+// trxTestRun is the root of a Visual Studio test results (.trx) file.
+type trxTestRun struct {
+	Results     []trxUnitTestResult `xml:"Results>UnitTestResult"`
+	Definitions []trxUnitTest       `xml:"TestDefinitions>UnitTest"`
+}
+
+type trxUnitTestResult struct {
+	TestID       string              `xml:"testId,attr"`
+	TestName     string              `xml:"testName,attr"`
+	Outcome      string              `xml:"outcome,attr"`
+	Duration     string              `xml:"duration,attr"`
+	StartTime    string              `xml:"startTime,attr"`
+	StdOut       string              `xml:"Output>StdOut"`
+	StdErr       string              `xml:"Output>StdErr"`
+	ErrorMessage string              `xml:"Output>ErrorInfo>Message"`
+	StackTrace   string              `xml:"Output>ErrorInfo>StackTrace"`
+	InnerResults []trxUnitTestResult `xml:"InnerResults>UnitTestResult"`
+}
+
+type trxUnitTest struct {
+	ID         string        `xml:"id,attr"`
+	Method     trxTestMethod `xml:"TestMethod"`
+	Categories []trxCategory `xml:"TestCategory>TestCategoryItem"`
+	Owners     []trxOwner    `xml:"Owners>Owner"`
+}
+
+type trxCategory struct {
+	Name string `xml:"TestCategory,attr"`
+}
+
+type trxOwner struct {
+	Name string `xml:"name,attr"`
+}
+
+type trxTestMethod struct {
+	ClassName string `xml:"className,attr"`
+}
+
+// parseTRXResults parses a .NET TRX test results file. Data-driven tests are
+// reported as their individual inner results.
+func parseTRXResults(r io.Reader) ([]reportTestCase, error) {
+	run := trxTestRun{}
+	if err := xml.NewDecoder(r).Decode(&run); err != nil {
+		return nil, errors.Wrap(err, "decoding TRX file")
+	}
+
+	definitions := map[string]trxUnitTest{}
+	for _, def := range run.Definitions {
+		definitions[def.ID] = def
+	}
+
+	var tests []reportTestCase
+	var addResults func([]trxUnitTestResult)
+	addResults = func(results []trxUnitTestResult) {
+		for _, res := range results {
+			if len(res.InnerResults) > 0 {
+				addResults(res.InnerResults)
+				continue
+			}
+			tests = append(tests, res.toReportTestCase(definitions[res.TestID]))
+		}
+	}
+	addResults(run.Results)
+
+	return tests, nil
+}
+
+func (r trxUnitTestResult) toReportTestCase(def trxUnitTest) reportTestCase {
+	tc := reportTestCase{
+		name:           r.TestName,
+		status:         trxOutcomeToStatus(r.Outcome),
+		duration:       parseTRXDuration(r.Duration),
+		failureMessage: strings.TrimSpace(r.ErrorMessage),
+		stackTrace:     strings.TrimSpace(r.StackTrace),
+	}
+	for _, category := range def.Categories {
+		if category.Name != "" {
+			tc.tags = append(tc.tags, category.Name)
+		}
+	}
+	for _, owner := range def.Owners {
+		if owner.Name != "" {
+			tc.owners = append(tc.owners, owner.Name)
+		}
+	}
+
+	// The class name may include the assembly, e.g.
+	// "Namespace.Class, Assembly, Version=1.0.0.0".
+	className := strings.TrimSpace(strings.SplitN(def.Method.ClassName, ",", 2)[0])
+	if className != "" && !strings.HasPrefix(tc.name, className+".") {
+		tc.name = fmt.Sprintf("%s.%s", className, tc.name)
+	}
+	if start, err := time.Parse(time.RFC3339Nano, r.StartTime); err == nil {
+		tc.start = start
+	}
+
+	if r.ErrorMessage != "" || r.StackTrace != "" {
+		tc.logLines = append(tc.logLines, fmt.Sprintf("%s: %s", strings.ToUpper(r.Outcome), strings.TrimSpace(r.ErrorMessage)))
+		tc.logLines = append(tc.logLines, splitReportOutput(r.StackTrace)...)
+	}
+	if out := splitReportOutput(r.StdOut); len(out) > 0 {
+		tc.logLines = append(tc.logLines, "stdout:")
+		tc.logLines = append(tc.logLines, out...)
+	}
+	if out := splitReportOutput(r.StdErr); len(out) > 0 {
+		tc.logLines = append(tc.logLines, "stderr:")
+		tc.logLines = append(tc.logLines, out...)
+	}
+
+	return tc
+}
+
+func trxOutcomeToStatus(outcome string) string {
+	switch strings.ToLower(outcome) {
+	case "passed", "passedbutrunaborted", "warning", "completed":
+		return evergreen.TestSucceededStatus
+	case "notexecuted", "notrunnable", "inconclusive", "pending", "inprogress":
+		return evergreen.TestSkippedStatus
+	default:
+		return evergreen.TestFailedStatus
+	}
+}
+
+// parseTRXDuration parses a TRX duration, which is formatted as
+// "hh:mm:ss.fffffff".
+func parseTRXDuration(duration string) time.Duration {
+	parts := strings.Split(duration, ":")
+	if len(parts) != 3 {
+		return 0
+	}
+	hours, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0
+	}
+	minutes, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0
+	}
+	seconds, err := strconv.ParseFloat(parts[2], 64)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute + time.Duration(seconds*float64(time.Second))
+}
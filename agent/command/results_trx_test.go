@@ -0,0 +1,60 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTRXResults(t *testing.T) {
+	f, err := os.Open(filepath.Join(testutil.GetDirectoryOfFile(), "testdata", "trx", "results.trx"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	tests, err := parseTRXResults(f)
+	require.NoError(t, err)
+	require.Len(t, tests, 5)
+
+	assert.Equal(t, "Calc.Tests.CalculatorTests.AddsNumbers", tests[0].name)
+	assert.Equal(t, evergreen.TestSucceededStatus, tests[0].status)
+	assert.Equal(t, 125*time.Millisecond, tests[0].duration)
+	assert.Equal(t, time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC), tests[0].start.UTC())
+	assert.Empty(t, tests[0].logLines)
+	assert.Empty(t, tests[0].failureMessage)
+	assert.Empty(t, tests[0].tags)
+
+	assert.Equal(t, "Calc.Tests.CalculatorTests.DividesByZero", tests[1].name)
+	assert.Equal(t, evergreen.TestFailedStatus, tests[1].status)
+	assert.Equal(t, 1500*time.Millisecond, tests[1].duration)
+	assert.Equal(t, []string{
+		"FAILED: Assert.Throws() Failure",
+		"at Calc.Tests.CalculatorTests.DividesByZero() in CalculatorTests.cs:line 20",
+		"   at Calc.Tests.Runner.Run()",
+		"stdout:",
+		"dividing 1 by 0",
+	}, tests[1].logLines)
+	assert.Equal(t, "Assert.Throws() Failure", tests[1].failureMessage)
+	assert.Equal(t, "at Calc.Tests.CalculatorTests.DividesByZero() in CalculatorTests.cs:line 20\n   at Calc.Tests.Runner.Run()", tests[1].stackTrace)
+	assert.Equal(t, []string{"Math", "Smoke"}, tests[1].tags)
+	assert.Equal(t, []string{"calc-team"}, tests[1].owners)
+
+	assert.Equal(t, "Calc.Tests.CalculatorTests.Skipped", tests[2].name)
+	assert.Equal(t, evergreen.TestSkippedStatus, tests[2].status)
+
+	assert.Equal(t, "Calc.Tests.CalculatorTests.Multiplies (2, 3)", tests[3].name)
+	assert.Equal(t, evergreen.TestSucceededStatus, tests[3].status)
+	assert.Equal(t, "Calc.Tests.CalculatorTests.Multiplies (0, 3)", tests[4].name)
+	assert.Equal(t, evergreen.TestFailedStatus, tests[4].status)
+}
+
+func TestParseTRXDuration(t *testing.T) {
+	assert.Equal(t, time.Hour+2*time.Minute+3500*time.Millisecond, parseTRXDuration("01:02:03.5000000"))
+	assert.Zero(t, parseTRXDuration("00:00:00"))
+	assert.Zero(t, parseTRXDuration("not a duration"))
+}
@@ -106,7 +106,7 @@ func sendTestResultsToCedar(ctx context.Context, conf *internal.TaskConfig, td c
 		}
 	}
 
-	cedarResults, failed := makeCedarTestResults(conf.CedarTestResultsID, conf.Task, results)
+	cedarResults, metadata, failed := makeCedarTestResults(conf.CedarTestResultsID, conf.Task, results)
 	if err = client.AddResults(ctx, cedarResults); err != nil {
 		return errors.Wrap(err, "adding test results")
 	}
@@ -115,7 +115,7 @@ func sendTestResultsToCedar(ctx context.Context, conf *internal.TaskConfig, td c
 		return errors.Wrap(err, "closing test results record")
 	}
 
-	if err = comm.SetHasCedarResults(ctx, td, failed); err != nil {
+	if err = comm.SetHasCedarResults(ctx, td, failed, metadata); err != nil {
 		return errors.Wrap(err, "problem setting HasCedarResults flag in task")
 	}
 
@@ -171,8 +171,11 @@ func makeCedarTestResultsRecord(conf *internal.TaskConfig, displayTaskInfo *apim
 	}
 }
 
-func makeCedarTestResults(id string, t *task.Task, results *task.LocalTestResults) (testresults.Results, bool) {
+// makeCedarTestResults returns the results to send to Cedar, along with the
+// metadata of the results that Cedar does not store.
+func makeCedarTestResults(id string, t *task.Task, results *task.LocalTestResults) (testresults.Results, []apimodels.CedarTestResultMetadata, bool) {
 	rs := testresults.Results{ID: id}
+	var metadata []apimodels.CedarTestResultMetadata
 	failed := false
 	for _, r := range results.Results {
 		if r.DisplayTestName == "" {
@@ -181,8 +184,19 @@ func makeCedarTestResults(id string, t *task.Task, results *task.LocalTestResult
 		if r.LogTestName == "" {
 			r.LogTestName = r.TestFile
 		}
+		testName := utility.RandomString()
+		if r.FailureMessage != "" || r.StackTrace != "" || len(r.Tags) > 0 || len(r.Owners) > 0 || r.Retries > 0 {
+			metadata = append(metadata, apimodels.CedarTestResultMetadata{
+				TestName:       testName,
+				FailureMessage: r.FailureMessage,
+				StackTrace:     r.StackTrace,
+				Tags:           r.Tags,
+				Owners:         r.Owners,
+				Retries:        r.Retries,
+			})
+		}
 		rs.Results = append(rs.Results, testresults.Result{
-			TestName:        utility.RandomString(),
+			TestName:        testName,
 			DisplayTestName: r.DisplayTestName,
 			GroupID:         r.GroupID,
 			Status:          r.Status,
@@ -200,5 +214,5 @@ func makeCedarTestResults(id string, t *task.Task, results *task.LocalTestResult
 		}
 	}
 
-	return rs, failed
+	return rs, metadata, failed
 }
@@ -158,6 +158,33 @@ func TestSendTestResults(t *testing.T) {
 				assert.False(t, comm.CedarResultsFailed)
 				results.Results[0].LogTestName = logTestName
 			},
+			"SendsMetadataCedarDoesNotStore": func(ctx context.Context, t *testing.T, srv *timberutil.MockTestResultsServer, comm *client.Mock) {
+				results.Results[0].FailureMessage = "expected 1 but was 2"
+				results.Results[0].Tags = []string{"slow"}
+				results.Results[0].Owners = []string{"team"}
+				results.Results[0].Retries = 2
+				defer func() {
+					results.Results[0].FailureMessage = ""
+					results.Results[0].Tags = nil
+					results.Results[0].Owners = nil
+					results.Results[0].Retries = 0
+				}()
+				require.NoError(t, sendTestResults(ctx, comm, logger, conf, results))
+
+				checkResults(t, srv)
+				require.Len(t, comm.CedarMetadata, 1)
+				assert.Equal(t, srv.Results[conf.CedarTestResultsID][0].Results[0].TestName, comm.CedarMetadata[0].TestName)
+				assert.Equal(t, results.Results[0].FailureMessage, comm.CedarMetadata[0].FailureMessage)
+				assert.Equal(t, results.Results[0].Tags, comm.CedarMetadata[0].Tags)
+				assert.Equal(t, results.Results[0].Owners, comm.CedarMetadata[0].Owners)
+				assert.Equal(t, results.Results[0].Retries, comm.CedarMetadata[0].Retries)
+			},
+			"SendsNoMetadataWithoutAny": func(ctx context.Context, t *testing.T, srv *timberutil.MockTestResultsServer, comm *client.Mock) {
+				require.NoError(t, sendTestResults(ctx, comm, logger, conf, results))
+
+				checkResults(t, srv)
+				assert.Empty(t, comm.CedarMetadata)
+			},
 			"FailsIfCreatingRecordFails": func(ctx context.Context, t *testing.T, srv *timberutil.MockTestResultsServer, comm *client.Mock) {
 				srv.CreateErr = true
 
@@ -187,6 +214,7 @@ func TestSendTestResults(t *testing.T) {
 				srv := setupCedarServer(ctx, t, comm)
 				comm.HasCedarResults = false
 				comm.CedarResultsFailed = false
+				comm.CedarMetadata = nil
 				testCase(ctx, t, srv.TestResults, comm)
 			})
 		}
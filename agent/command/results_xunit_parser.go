@@ -61,6 +61,18 @@ type testCase struct {
 	Failure   *failureDetails `xml:"failure"`
 	Error     *failureDetails `xml:"error"`
 	Skipped   *failureDetails `xml:"skipped"`
+	// Properties may record the test's tags and owners.
+	Properties []testProperty `xml:"properties>property"`
+	// Maven Surefire records each failed attempt of a test that was rerun.
+	FlakyFailures []failureDetails `xml:"flakyFailure"`
+	FlakyErrors   []failureDetails `xml:"flakyError"`
+	RerunFailures []failureDetails `xml:"rerunFailure"`
+	RerunErrors   []failureDetails `xml:"rerunError"`
+}
+
+type testProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
 }
 
 type failureDetails struct {
@@ -108,6 +120,15 @@ func (tc testCase) toModelTestResultAndLog(conf *internal.TaskConfig) (task.Test
 
 	res.StartTime = float64(time.Now().Unix())
 	res.EndTime = res.StartTime + float64(tc.Time)
+	res.Retries = len(tc.FlakyFailures) + len(tc.FlakyErrors) + len(tc.RerunFailures) + len(tc.RerunErrors)
+	for _, prop := range tc.Properties {
+		switch strings.ToLower(prop.Name) {
+		case "tag", "tags", "category", "categories":
+			res.Tags = append(res.Tags, splitReportList(prop.Value)...)
+		case "owner", "owners":
+			res.Owners = append(res.Owners, splitReportList(prop.Value)...)
+		}
+	}
 
 	// the presence of the Failure, Error, or Skipped fields
 	// is used to indicate an unsuccessful test case. Logs
@@ -116,9 +137,11 @@ func (tc testCase) toModelTestResultAndLog(conf *internal.TaskConfig) (task.Test
 	switch {
 	case tc.Failure != nil:
 		res.Status = evergreen.TestFailedStatus
+		res.FailureMessage, res.StackTrace = tc.Failure.messageAndStackTrace()
 		log = tc.Failure.toBasicTestLog("FAILURE")
 	case tc.Error != nil:
 		res.Status = evergreen.TestFailedStatus
+		res.FailureMessage, res.StackTrace = tc.Error.messageAndStackTrace()
 		log = tc.Error.toBasicTestLog("ERROR")
 	case tc.Skipped != nil:
 		res.Status = evergreen.TestSkippedStatus
@@ -145,6 +168,16 @@ func (tc testCase) toModelTestResultAndLog(conf *internal.TaskConfig) (task.Test
 	return res, log
 }
 
+// messageAndStackTrace returns the failure's message, falling back to the first
+// line of its content, and the content as the stack trace.
+func (fd failureDetails) messageAndStackTrace() (string, string) {
+	stackTrace := strings.TrimSpace(fd.Content)
+	if message := strings.TrimSpace(fd.Message); message != "" {
+		return message, stackTrace
+	}
+	return splitFailureOutput(stackTrace)
+}
+
 func (fd failureDetails) toBasicTestLog(fdType string) *model.TestLog {
 	log := model.TestLog{
 		Lines: []string{fmt.Sprintf("%v: %v (%v)", fdType, fd.Message, fd.Type)},
@@ -11,6 +11,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/testutil"
 	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -257,3 +258,34 @@ func TestXMLToModelConversion(t *testing.T) {
 		})
 	})
 }
+
+func TestXMLToModelConversionMetadata(t *testing.T) {
+	file, err := os.Open(filepath.Join(testutil.GetDirectoryOfFile(), "testdata", "surefire", "TEST-com.example.AccountTest.xml"))
+	require.NoError(t, err)
+	defer file.Close()
+	res, err := parseXMLResults(file)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Len(t, res[0].TestCases, 3)
+	conf := &internal.TaskConfig{
+		ProjectRef: &model.ProjectRef{},
+		Task:       &task.Task{Id: "TEST", Execution: 5},
+	}
+
+	tagged, _ := res[0].TestCases[0].toModelTestResultAndLog(conf)
+	assert.Equal(t, evergreen.TestSucceededStatus, tagged.Status)
+	assert.Equal(t, []string{"smoke", "accounts"}, tagged.Tags)
+	assert.Equal(t, []string{"payments-team"}, tagged.Owners)
+	assert.Empty(t, tagged.FailureMessage)
+	assert.Zero(t, tagged.Retries)
+
+	failed, log := res[0].TestCases[1].toModelTestResultAndLog(conf)
+	require.NotNil(t, log)
+	assert.Equal(t, evergreen.TestFailedStatus, failed.Status)
+	assert.Equal(t, "expected:<10> but was:<5>", failed.FailureMessage)
+	assert.Contains(t, failed.StackTrace, "at com.example.AccountTest.withdraws(AccountTest.java:42)")
+
+	flaky, _ := res[0].TestCases[2].toModelTestResultAndLog(conf)
+	assert.Equal(t, evergreen.TestSucceededStatus, flaky.Status)
+	assert.Equal(t, 2, flaky.Retries)
+}
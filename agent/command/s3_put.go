@@ -410,23 +410,22 @@ func (s3pc *s3put) attachFiles(ctx context.Context, comm client.Communicator, lo
 		} else if s3pc.isMulti() {
 			displayName = fmt.Sprintf("%s %s", s3pc.ResourceDisplayName, filepath.Base(fn))
 		}
-		var key, secret, bucket, fileKey string
-		if s3pc.Visibility == artifact.Signed {
-			key = s3pc.AwsKey
-			secret = s3pc.AwsSecret
-			bucket = s3pc.Bucket
-			fileKey = remoteFileName
-		}
-
-		files = append(files, &artifact.File{
+		// The file's location is always recorded so that the project's
+		// artifact retention policy can delete it, but the credentials it
+		// was uploaded with are only needed to presign links to signed files.
+		file := &artifact.File{
 			Name:       displayName,
 			Link:       fileLink,
 			Visibility: s3pc.Visibility,
-			AwsKey:     key,
-			AwsSecret:  secret,
-			Bucket:     bucket,
-			FileKey:    fileKey,
-		})
+			Bucket:     s3pc.Bucket,
+			FileKey:    remoteFileName,
+			Region:     s3pc.Region,
+		}
+		if s3pc.Visibility == artifact.Signed {
+			file.AwsKey = s3pc.AwsKey
+			file.AwsSecret = s3pc.AwsSecret
+		}
+		files = append(files, file)
 	}
 
 	err := comm.AttachFiles(ctx, s3pc.taskdata, files)
@@ -318,6 +318,7 @@ func TestSignedUrlVisibility(t *testing.T) {
 			AwsKey:        "key",
 			AwsSecret:     "secret",
 			Bucket:        "bucket",
+			Region:        "us-east-2",
 			BuildVariants: []string{},
 			ContentType:   "content-type",
 			Permissions:   s3.BucketCannedACLPublicRead,
@@ -345,13 +346,14 @@ func TestSignedUrlVisibility(t *testing.T) {
 			for _, file := range v {
 				assert.NotEqual(t, " ", string(file.Name[0]))
 				if file.Visibility == artifact.Signed {
-					assert.Equal(t, file.AwsKey, s.AwsKey)
-					assert.Equal(t, file.AwsSecret, s.AwsSecret)
-
+					assert.Equal(t, s.AwsKey, file.AwsKey)
+					assert.Equal(t, s.AwsSecret, file.AwsSecret)
 				} else {
-					assert.Equal(t, file.AwsKey, "")
-					assert.Equal(t, file.AwsSecret, "")
+					assert.Empty(t, file.AwsKey)
+					assert.Empty(t, file.AwsSecret)
 				}
+				assert.Equal(t, s.Bucket, file.Bucket)
+				assert.Equal(t, s.Region, file.Region)
 			}
 		}
 	}
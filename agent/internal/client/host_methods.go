@@ -16,8 +16,10 @@ import (
 	"github.com/evergreen-ci/evergreen/cloud"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/coverage"
 	"github.com/evergreen-ci/evergreen/model/manifest"
 	patchmodel "github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/perf"
 	"github.com/evergreen-ci/evergreen/model/task"
 	restmodel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/evergreen/util"
@@ -715,15 +717,16 @@ func (c *hostCommunicator) SendTestResults(ctx context.Context, taskData TaskDat
 }
 
 // SetHasCedarResults sets the HasCedarResults flag to true in the given task
-// in the database.
-func (c *hostCommunicator) SetHasCedarResults(ctx context.Context, taskData TaskData, failed bool) error {
+// in the database and saves the metadata of its results that Cedar does not
+// store.
+func (c *hostCommunicator) SetHasCedarResults(ctx context.Context, taskData TaskData, failed bool, metadata []apimodels.CedarTestResultMetadata) error {
 	info := requestInfo{
 		method:   http.MethodPost,
 		taskData: &taskData,
 		version:  apiVersion2,
 	}
 	info.path = fmt.Sprintf("tasks/%s/set_has_cedar_results", taskData.ID)
-	resp, err := c.retryRequest(ctx, info, &apimodels.CedarTestResultsTaskInfo{Failed: failed})
+	resp, err := c.retryRequest(ctx, info, &apimodels.CedarTestResultsTaskInfo{Failed: failed, Metadata: metadata})
 	if err != nil {
 		return utility.RespErrorf(resp, "failed to set HasCedarResults for task %s: %s", taskData.ID, err.Error())
 	}
@@ -752,6 +755,48 @@ func (c *hostCommunicator) AttachFiles(ctx context.Context, taskData TaskData, t
 	return nil
 }
 
+// SendCoverage sends the coverage of the task's source files.
+func (c *hostCommunicator) SendCoverage(ctx context.Context, taskData TaskData, files []coverage.FileCoverage) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	info := requestInfo{
+		method:   http.MethodPost,
+		taskData: &taskData,
+		version:  apiVersion2,
+	}
+	info.path = fmt.Sprintf("tasks/%s/coverage", taskData.ID)
+	resp, err := c.retryRequest(ctx, info, files)
+	if err != nil {
+		return utility.RespErrorf(resp, "failed to send coverage for task %s: %s", taskData.ID, err.Error())
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// SendPerfResults sends the metrics of the task's performance tests.
+func (c *hostCommunicator) SendPerfResults(ctx context.Context, taskData TaskData, results []perf.TestResult) error {
+	if len(results) == 0 {
+		return nil
+	}
+
+	info := requestInfo{
+		method:   http.MethodPost,
+		taskData: &taskData,
+		version:  apiVersion2,
+	}
+	info.path = fmt.Sprintf("tasks/%s/perf_results", taskData.ID)
+	resp, err := c.retryRequest(ctx, info, results)
+	if err != nil {
+		return utility.RespErrorf(resp, "failed to send perf results for task %s: %s", taskData.ID, err.Error())
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
 func (c *hostCommunicator) SetDownstreamParams(ctx context.Context, downstreamParams []patchmodel.Parameter, taskData TaskData) error {
 	info := requestInfo{
 		method:   http.MethodPost,
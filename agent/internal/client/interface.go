@@ -9,8 +9,10 @@ import (
 	"github.com/evergreen-ci/evergreen/cloud"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/coverage"
 	"github.com/evergreen-ci/evergreen/model/manifest"
 	patchmodel "github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/perf"
 	"github.com/evergreen-ci/evergreen/model/task"
 	restmodel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/evergreen/util"
@@ -61,8 +63,10 @@ type Communicator interface {
 	// creates it if it doesn't exist.
 	GetCedarGRPCConn(context.Context) (*grpc.ClientConn, error)
 	// SetHasCedarResults sets the HasCedarResults flag to true in the
-	// task and sets CedarResultsFailed if there are failed results.
-	SetHasCedarResults(context.Context, TaskData, bool) error
+	// task and sets CedarResultsFailed if there are failed results. The
+	// metadata of the results that Cedar does not store is kept by
+	// Evergreen.
+	SetHasCedarResults(context.Context, TaskData, bool, []apimodels.CedarTestResultMetadata) error
 
 	// DisableHost signals to the app server that the host should be disabled.
 	DisableHost(context.Context, string, apimodels.DisableInfo) error
@@ -87,6 +91,10 @@ type Communicator interface {
 
 	// The following operations are used by
 	AttachFiles(context.Context, TaskData, []*artifact.File) error
+	// SendCoverage sends the coverage of the task's source files.
+	SendCoverage(context.Context, TaskData, []coverage.FileCoverage) error
+	// SendPerfResults sends the metrics of the task's performance tests.
+	SendPerfResults(context.Context, TaskData, []perf.TestResult) error
 	GetManifest(context.Context, TaskData) (*manifest.Manifest, error)
 	S3Copy(context.Context, TaskData, *apimodels.S3CopyRequest) (string, error)
 	KeyValInc(context.Context, TaskData, *model.KeyVal) error
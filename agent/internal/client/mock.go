@@ -15,8 +15,10 @@ import (
 	"github.com/evergreen-ci/evergreen/cloud"
 	serviceModel "github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/coverage"
 	"github.com/evergreen-ci/evergreen/model/manifest"
 	patchmodel "github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/perf"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/evergreen/util"
@@ -58,10 +60,13 @@ type Mock struct {
 	CedarGRPCConn *grpc.ClientConn
 
 	AttachedFiles      map[string][]*artifact.File
+	Coverage           map[string][]coverage.FileCoverage
+	PerfResults        map[string][]perf.TestResult
 	LogID              string
 	LocalTestResults   *task.LocalTestResults
 	HasCedarResults    bool
 	CedarResultsFailed bool
+	CedarMetadata      []apimodels.CedarTestResultMetadata
 	TestLogs           []*serviceModel.TestLog
 	TestLogCount       int
 
@@ -90,6 +95,8 @@ func NewMock(serverURL string) *Mock {
 		PatchFiles:    make(map[string]string),
 		keyVal:        make(map[string]*serviceModel.KeyVal),
 		AttachedFiles: make(map[string][]*artifact.File),
+		Coverage:      make(map[string][]coverage.FileCoverage),
+		PerfResults:   make(map[string][]perf.TestResult),
 		serverURL:     serverURL,
 	}
 }
@@ -382,11 +389,12 @@ func (c *Mock) SendTestResults(ctx context.Context, td TaskData, results *task.L
 }
 
 // SetHasCedarResults sets the HasCedarResults flag in the task.
-func (c *Mock) SetHasCedarResults(ctx context.Context, td TaskData, failed bool) error {
+func (c *Mock) SetHasCedarResults(ctx context.Context, td TaskData, failed bool, metadata []apimodels.CedarTestResultMetadata) error {
 	c.HasCedarResults = true
 	if failed {
 		c.CedarResultsFailed = true
 	}
+	c.CedarMetadata = append(c.CedarMetadata, metadata...)
 	return nil
 }
 
@@ -406,6 +414,26 @@ func (c *Mock) AttachFiles(ctx context.Context, td TaskData, taskFiles []*artifa
 	return nil
 }
 
+// SendCoverage stores the coverage of the task's source files.
+func (c *Mock) SendCoverage(ctx context.Context, td TaskData, files []coverage.FileCoverage) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.Coverage[td.ID] = append(c.Coverage[td.ID], files...)
+
+	return nil
+}
+
+// SendPerfResults stores the metrics of the task's performance tests.
+func (c *Mock) SendPerfResults(ctx context.Context, td TaskData, results []perf.TestResult) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.PerfResults[td.ID] = append(c.PerfResults[td.ID], results...)
+
+	return nil
+}
+
 func (c *Mock) SetDownstreamParams(ctx context.Context, downstreamParams []patchmodel.Parameter, taskData TaskData) error {
 	c.DownstreamParams = downstreamParams
 	return nil
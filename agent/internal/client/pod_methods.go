@@ -11,8 +11,10 @@ import (
 	"github.com/evergreen-ci/evergreen/cloud"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/coverage"
 	"github.com/evergreen-ci/evergreen/model/manifest"
 	patchmodel "github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/perf"
 	"github.com/evergreen-ci/evergreen/model/task"
 	restmodel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/evergreen/util"
@@ -198,7 +200,7 @@ func (c *podCommunicator) SendTestResults(ctx context.Context, taskData TaskData
 
 // SetHasCedarResults sets the HasCedarResults flag to true in the given task
 // in the database.
-func (c *podCommunicator) SetHasCedarResults(ctx context.Context, taskData TaskData, failed bool) error {
+func (c *podCommunicator) SetHasCedarResults(ctx context.Context, taskData TaskData, failed bool, metadata []apimodels.CedarTestResultMetadata) error {
 	return errors.New("TODO: implement")
 }
 
@@ -207,6 +209,16 @@ func (c *podCommunicator) AttachFiles(ctx context.Context, taskData TaskData, ta
 	return errors.New("TODO: implement")
 }
 
+// SendCoverage sends the coverage of the task's source files.
+func (c *podCommunicator) SendCoverage(ctx context.Context, taskData TaskData, files []coverage.FileCoverage) error {
+	return errors.New("TODO: implement")
+}
+
+// SendPerfResults sends the metrics of the task's performance tests.
+func (c *podCommunicator) SendPerfResults(ctx context.Context, taskData TaskData, results []perf.TestResult) error {
+	return errors.New("TODO: implement")
+}
+
 func (c *podCommunicator) SetDownstreamParams(ctx context.Context, downstreamParams []patchmodel.Parameter, taskData TaskData) error {
 	return errors.New("TODO: implement")
 }
@@ -0,0 +1,286 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/command"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/recovery"
+	"github.com/mongodb/grip/send"
+	"github.com/mongodb/jasper"
+	"github.com/pkg/errors"
+)
+
+// LocalTaskOptions describe a single task to run on the developer's machine
+// instead of on an Evergreen host.
+type LocalTaskOptions struct {
+	// Project is the parsed project configuration containing the task.
+	Project *model.Project
+	// ProjectRef identifies the project the configuration belongs to. It
+	// only needs the fields that are used to populate expansions.
+	ProjectRef *model.ProjectRef
+	TaskName   string
+	Variant    string
+	// WorkDir is the directory the task's commands run in. It must already
+	// exist.
+	WorkDir string
+	// Expansions are the project variables and user-supplied values to run
+	// the task with. They take precedence over the variant's expansions.
+	Expansions util.Expansions
+	// SkipSetup skips the pre/post commands, or the setup and teardown
+	// commands if the task is part of a task group.
+	SkipSetup bool
+	// Output is where the task logs are streamed. Defaults to standard
+	// output.
+	Output io.Writer
+}
+
+func (o *LocalTaskOptions) validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(o.Project == nil, "must specify a project")
+	catcher.NewWhen(o.ProjectRef == nil, "must specify a project ref")
+	catcher.NewWhen(o.TaskName == "", "must specify a task name")
+	catcher.NewWhen(o.Variant == "", "must specify a build variant")
+	catcher.NewWhen(o.WorkDir == "", "must specify a working directory")
+	if catcher.HasErrors() {
+		return catcher.Resolve()
+	}
+
+	if o.Project.FindTaskForVariant(o.TaskName, o.Variant) == nil {
+		return errors.Errorf("task '%s' does not run on build variant '%s'", o.TaskName, o.Variant)
+	}
+	if o.Project.FindProjectTask(o.TaskName) == nil {
+		return errors.Errorf("task '%s' is not defined in the project", o.TaskName)
+	}
+
+	workDir, err := filepath.Abs(o.WorkDir)
+	if err != nil {
+		return errors.Wrapf(err, "resolving working directory '%s'", o.WorkDir)
+	}
+	info, err := os.Stat(workDir)
+	if err != nil {
+		return errors.Wrapf(err, "checking working directory '%s'", workDir)
+	}
+	if !info.IsDir() {
+		return errors.Errorf("working directory '%s' is not a directory", workDir)
+	}
+	o.WorkDir = workDir
+
+	if o.Output == nil {
+		o.Output = os.Stdout
+	}
+
+	return nil
+}
+
+// localTaskRunner runs a task's commands in-process without an Evergreen
+// service. Commands that cannot run locally are rendered as stubs, so the
+// communicator is never used.
+type localTaskRunner struct {
+	conf   *internal.TaskConfig
+	logger client.LoggerProducer
+	jasper jasper.Manager
+}
+
+// RunLocalTask runs the pre, task and post commands of a single task in a
+// local working directory, streaming the task logs to the configured output.
+// Commands that need the Evergreen service or remote resources (e.g. s3.put or
+// host.create) are skipped. It returns an error if the task fails.
+func RunLocalTask(ctx context.Context, opts LocalTaskOptions) error {
+	if err := opts.validate(); err != nil {
+		return errors.Wrap(err, "invalid local task options")
+	}
+
+	r, err := newLocalTaskRunner(opts)
+	if err != nil {
+		return errors.Wrap(err, "setting up local task")
+	}
+	defer func() {
+		grip.Warning(r.jasper.Close(ctx))
+		grip.Warning(r.logger.Close())
+	}()
+
+	return r.run(ctx, opts.SkipSetup)
+}
+
+func newLocalTaskRunner(opts LocalTaskOptions) (*localTaskRunner, error) {
+	t := &task.Task{
+		Id:           fmt.Sprintf("local_%s_%s", opts.Variant, opts.TaskName),
+		DisplayName:  opts.TaskName,
+		BuildVariant: opts.Variant,
+		Project:      opts.ProjectRef.Id,
+		Version:      "local",
+		Requester:    evergreen.PatchVersionRequester,
+	}
+	unit := opts.Project.FindTaskForVariant(opts.TaskName, opts.Variant)
+	if unit.Name != opts.TaskName && opts.Project.FindTaskGroup(unit.Name) != nil {
+		t.TaskGroup = unit.Name
+	}
+
+	expansions := util.Expansions{}
+	if bv := opts.Project.FindBuildVariant(opts.Variant); bv != nil {
+		expansions.Update(bv.Expansions)
+	}
+	expansions.Update(opts.Expansions.Map())
+	expansions.Put("execution", "0")
+	expansions.Put("version_id", t.Version)
+	expansions.Put("task_id", t.Id)
+	expansions.Put("task_name", t.DisplayName)
+	expansions.Put("build_variant", t.BuildVariant)
+	expansions.Put("distro_id", "local")
+	expansions.Put("project", opts.ProjectRef.Identifier)
+	expansions.Put("project_identifier", opts.ProjectRef.Identifier)
+	expansions.Put("project_id", opts.ProjectRef.Id)
+	expansions.Put("branch_name", opts.ProjectRef.Branch)
+	expansions.Put("is_patch", "true")
+	expansions.Put("workdir", opts.WorkDir)
+
+	distro := &apimodels.DistroView{WorkDir: opts.WorkDir}
+	conf, err := internal.NewTaskConfig(distro, opts.Project, t, opts.ProjectRef, nil, expansions)
+	if err != nil {
+		return nil, errors.Wrap(err, "making task config")
+	}
+	conf.Redacted = map[string]bool{}
+
+	sender, err := send.NewWrappedWriterLogger("local", opts.Output, send.LevelInfo{Default: level.Info, Threshold: level.Info})
+	if err != nil {
+		return nil, errors.Wrap(err, "making output logger")
+	}
+	if err = sender.SetFormatter(send.MakePlainFormatter()); err != nil {
+		return nil, errors.Wrap(err, "setting output log format")
+	}
+
+	jpm, err := jasper.NewSynchronizedManager(false)
+	if err != nil {
+		return nil, errors.Wrap(err, "making process manager")
+	}
+
+	return &localTaskRunner{
+		conf:   conf,
+		logger: client.NewSingleChannelLogHarness("local", sender),
+		jasper: jpm,
+	}, nil
+}
+
+func (r *localTaskRunner) run(ctx context.Context, skipSetup bool) error {
+	taskGroup, err := r.conf.GetTaskGroup(r.conf.Task.TaskGroup)
+	if err != nil {
+		return errors.Wrap(err, "getting task group")
+	}
+	projectTask := r.conf.Project.FindProjectTask(r.conf.Task.DisplayName)
+
+	if !skipSetup {
+		if taskGroup.SetupGroup != nil {
+			r.logger.Task().Infof("Running setup_group for '%s'.", taskGroup.Name)
+			if err = r.runCommands(ctx, taskGroup.SetupGroup.List()); err != nil && taskGroup.SetupGroupFailTask {
+				return errors.Wrap(err, "running setup group")
+			}
+		}
+		if taskGroup.SetupTask != nil {
+			r.logger.Task().Info("Running pre-task commands.")
+			if err = r.runCommands(ctx, taskGroup.SetupTask.List()); err != nil && taskGroup.SetupGroupFailTask {
+				return errors.Wrap(err, "running pre-task commands")
+			}
+		}
+	}
+
+	r.logger.Task().Infof("Running task commands for '%s' on variant '%s'.", r.conf.Task.DisplayName, r.conf.Task.BuildVariant)
+	start := time.Now()
+	taskErr := r.runCommands(ctx, projectTask.Commands)
+	r.logger.Task().Infof("Finished running task commands in %s.", time.Since(start).String())
+
+	catcher := grip.NewBasicCatcher()
+	catcher.Wrap(taskErr, "running task commands")
+	if !skipSetup {
+		if taskGroup.TeardownTask != nil {
+			r.logger.Task().Info("Running post-task commands.")
+			if err = r.runCommands(ctx, taskGroup.TeardownTask.List()); err != nil && taskGroup.TeardownTaskCanFailTask {
+				catcher.Wrap(err, "running post-task commands")
+			}
+		}
+		if taskGroup.TeardownGroup != nil {
+			r.logger.Task().Infof("Running teardown_group for '%s'.", taskGroup.Name)
+			grip.Warning(errors.Wrap(r.runCommands(ctx, taskGroup.TeardownGroup.List()), "running teardown group"))
+		}
+	}
+
+	if catcher.HasErrors() {
+		r.logger.Task().Errorf("Task failed: %s", catcher.Resolve())
+	} else {
+		r.logger.Task().Info("Task succeeded.")
+	}
+	return catcher.Resolve()
+}
+
+func (r *localTaskRunner) runCommands(ctx context.Context, commands []model.PluginCommandConf) error {
+	for i, commandInfo := range commands {
+		if ctx.Err() != nil {
+			return errors.Wrap(ctx.Err(), "running commands canceled")
+		}
+		cmds, err := command.RenderLocal(commandInfo, r.conf.Project.Functions)
+		if err != nil {
+			r.logger.Task().Errorf("Couldn't parse command '%s': %s", commandInfo.Command, err)
+			return errors.Wrapf(err, "rendering command '%s'", getFunctionName(commandInfo))
+		}
+		for idx, cmd := range cmds {
+			if err = r.runCommand(ctx, commandInfo, cmd, i+1, idx+1, len(cmds), len(commands)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *localTaskRunner) runCommand(ctx context.Context, commandInfo model.PluginCommandConf, cmd command.Command, index, subIndex, numSubCommands, total int) (err error) {
+	defer func() {
+		err = recovery.HandlePanicWithError(recover(), err, fmt.Sprintf("running command '%s'", cmd.Name()))
+	}()
+
+	cmd.SetType(r.conf.Project.CommandType)
+	cmd.SetJasperManager(r.jasper)
+	fullCommandName := getCommandName(commandInfo, cmd)
+
+	if !commandInfo.RunOnVariant(r.conf.BuildVariant.Name) {
+		r.logger.Task().Infof("Skipping command %s on variant %s (step %d of %d)",
+			fullCommandName, r.conf.BuildVariant.Name, index, total)
+		return nil
+	}
+	if numSubCommands == 1 {
+		r.logger.Task().Infof("Running command %s (step %d of %d)", fullCommandName, index, total)
+	} else {
+		r.logger.Task().Infof("Running command %s (step %d.%d of %d)", fullCommandName, index, subIndex, total)
+	}
+
+	for key, val := range commandInfo.Vars {
+		var newVal string
+		newVal, err = r.conf.Expansions.ExpandString(val)
+		if err != nil {
+			return errors.Wrapf(err, "expanding '%s'", val)
+		}
+		r.conf.Expansions.Put(key, newVal)
+	}
+
+	start := time.Now()
+	// The communicator is nil because every command that needs one is
+	// replaced by a local stub.
+	if err = cmd.Execute(ctx, nil, r.logger, r.conf); err != nil {
+		r.logger.Task().Errorf("Command failed: %s", err)
+		return errors.Wrapf(err, "command %s failed", fullCommandName)
+	}
+	r.logger.Task().Infof("Finished %s in %s", fullCommandName, time.Since(start).String())
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunLocalTask(t *testing.T) {
+	const projYAML = `
+pre:
+  - command: shell.exec
+    params:
+      script: echo pre > pre.txt
+functions:
+  upload:
+    - command: s3.put
+      params:
+        remote_file: ${bucket_path}/out.txt
+tasks:
+  - name: compile
+    commands:
+      - command: shell.exec
+        params:
+          script: echo ${greeting} ${build_variant} > out.txt
+      - func: upload
+  - name: broken
+    commands:
+      - command: shell.exec
+        params:
+          script: exit 1
+      - command: shell.exec
+        params:
+          script: echo unreachable > out.txt
+buildvariants:
+  - name: linux
+    expansions:
+      greeting: hello
+    tasks:
+      - name: compile
+      - name: broken
+`
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	project := &model.Project{}
+	_, _, err := model.LoadProjectInto(ctx, []byte(projYAML), nil, "", project)
+	require.NoError(t, err)
+
+	makeOpts := func(t *testing.T, taskName string) LocalTaskOptions {
+		return LocalTaskOptions{
+			Project:    project,
+			ProjectRef: &model.ProjectRef{Id: "proj", Identifier: "proj"},
+			TaskName:   taskName,
+			Variant:    "linux",
+			WorkDir:    t.TempDir(),
+			Output:     &bytes.Buffer{},
+		}
+	}
+
+	t.Run("RunsTaskAndStubsRemoteCommands", func(t *testing.T) {
+		opts := makeOpts(t, "compile")
+		require.NoError(t, RunLocalTask(ctx, opts))
+
+		out, err := ioutil.ReadFile(filepath.Join(opts.WorkDir, "out.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "hello linux\n", string(out))
+		_, err = ioutil.ReadFile(filepath.Join(opts.WorkDir, "pre.txt"))
+		assert.NoError(t, err)
+
+		logs := opts.Output.(*bytes.Buffer).String()
+		assert.Contains(t, logs, "Skipping command 's3.put'")
+		assert.Contains(t, logs, "Task succeeded.")
+	})
+	t.Run("ExpansionsOverrideVariant", func(t *testing.T) {
+		opts := makeOpts(t, "compile")
+		opts.Expansions = *util.NewExpansions(map[string]string{"greeting": "bonjour"})
+		require.NoError(t, RunLocalTask(ctx, opts))
+
+		out, err := ioutil.ReadFile(filepath.Join(opts.WorkDir, "out.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "bonjour linux\n", string(out))
+	})
+	t.Run("SkipSetup", func(t *testing.T) {
+		opts := makeOpts(t, "compile")
+		opts.SkipSetup = true
+		require.NoError(t, RunLocalTask(ctx, opts))
+
+		_, err = ioutil.ReadFile(filepath.Join(opts.WorkDir, "pre.txt"))
+		assert.Error(t, err)
+	})
+	t.Run("FailingCommandFailsTask", func(t *testing.T) {
+		opts := makeOpts(t, "broken")
+		assert.Error(t, RunLocalTask(ctx, opts))
+
+		_, err = ioutil.ReadFile(filepath.Join(opts.WorkDir, "out.txt"))
+		assert.Error(t, err)
+		assert.Contains(t, opts.Output.(*bytes.Buffer).String(), "Task failed")
+	})
+	t.Run("InvalidOptions", func(t *testing.T) {
+		opts := makeOpts(t, "nonexistent")
+		assert.Error(t, RunLocalTask(ctx, opts))
+
+		opts = makeOpts(t, "compile")
+		opts.Variant = "windows"
+		assert.Error(t, RunLocalTask(ctx, opts))
+
+		opts = makeOpts(t, "compile")
+		opts.WorkDir = filepath.Join(opts.WorkDir, "nonexistent")
+		assert.Error(t, RunLocalTask(ctx, opts))
+	})
+}
@@ -9,4 +9,19 @@ type CedarConfig struct {
 
 type CedarTestResultsTaskInfo struct {
 	Failed bool `json:"failed"`
+	// Metadata is the information about the task's test results that Cedar
+	// does not store, which is kept by Evergreen instead.
+	Metadata []CedarTestResultMetadata `json:"metadata,omitempty"`
+}
+
+// CedarTestResultMetadata is the information about a test result sent to
+// Cedar that Cedar does not store. TestName is the name the test result was
+// sent to Cedar with.
+type CedarTestResultMetadata struct {
+	TestName       string   `json:"test_name"`
+	FailureMessage string   `json:"failure_message,omitempty"`
+	StackTrace     string   `json:"stack_trace,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+	Owners         []string `json:"owners,omitempty"`
+	Retries        int      `json:"retries,omitempty"`
 }
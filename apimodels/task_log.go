@@ -80,6 +80,9 @@ type GetBuildloggerLogsOptions struct {
 	PrintPriority bool   `json:"-"`
 	Tail          int    `json:"-"`
 	LogType       string `json:"-"`
+	// Start is the earliest time to read lines from. If it's not set, lines
+	// are read from the start of the logs.
+	Start time.Time `json:"-"`
 }
 
 // GetBuildloggerLogs makes request to Cedar for a specifc log and returns an
@@ -102,6 +105,7 @@ func GetBuildloggerLogs(ctx context.Context, opts GetBuildloggerLogsOptions) (io
 		PrintTime:     true,
 		PrintPriority: opts.PrintPriority,
 		Tail:          opts.Tail,
+		Start:         opts.Start,
 	}
 
 	switch opts.LogType {
@@ -53,6 +53,14 @@ type CedarTestResult struct {
 	LineNum         int       `json:"line_num"`
 	Start           time.Time `json:"test_start_time"`
 	End             time.Time `json:"test_end_time"`
+
+	// Cedar does not store the following fields, they are filled in from the
+	// metadata kept by Evergreen.
+	FailureMessage string   `json:"failure_message,omitempty"`
+	StackTrace     string   `json:"stack_trace,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+	Owners         []string `json:"owners,omitempty"`
+	Retries        int      `json:"retries,omitempty"`
 }
 
 // GetCedarTestResultsOptions represents the arguments for fetching test
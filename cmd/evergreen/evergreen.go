@@ -49,6 +49,7 @@ func buildApp() *cli.App {
 		operations.Volume(),
 		operations.Notification(),
 		operations.Buildlogger(),
+		operations.Task(),
 		operations.Generate(),
 
 		// Top-level commands.
@@ -59,10 +60,12 @@ func buildApp() *cli.App {
 		operations.Validate(),
 		operations.List(),
 		operations.LastGreen(),
+		operations.TestHistory(),
 		operations.Subscriptions(),
 		operations.CommitQueue(),
 		operations.Export(),
 		operations.Scheduler(),
+		operations.Local(),
 
 		// Patch creation and management commands (top-level)
 		operations.Patch(),
@@ -1,6 +1,9 @@
 package evergreen
 
 import (
+	"time"
+
+	"github.com/mongodb/grip"
 	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -11,14 +14,77 @@ const (
 	DefaultEventProcessingLimit    = 1000
 	DefaultBufferIntervalSeconds   = 60
 	DefaultBufferTargetPerInterval = 20
+
+	DefaultNotificationRateLimitIntervalSeconds = 300
 )
 
 // NotifyConfig hold logging and email settings for the notify package.
 type NotifyConfig struct {
-	BufferTargetPerInterval int        `bson:"buffer_target_per_interval" json:"buffer_target_per_interval" yaml:"buffer_target_per_interval"`
-	BufferIntervalSeconds   int        `bson:"buffer_interval_seconds" json:"buffer_interval_seconds" yaml:"buffer_interval_seconds"`
-	EventProcessingLimit    int        `bson:"event_processing_limit" json:"event_processing_limit" yaml:"event_processing_limit"`
-	SMTP                    SMTPConfig `bson:"smtp" json:"smtp" yaml:"smtp"`
+	BufferTargetPerInterval int                          `bson:"buffer_target_per_interval" json:"buffer_target_per_interval" yaml:"buffer_target_per_interval"`
+	BufferIntervalSeconds   int                          `bson:"buffer_interval_seconds" json:"buffer_interval_seconds" yaml:"buffer_interval_seconds"`
+	EventProcessingLimit    int                          `bson:"event_processing_limit" json:"event_processing_limit" yaml:"event_processing_limit"`
+	RateLimits              NotificationRateLimitsConfig `bson:"rate_limits" json:"rate_limits" yaml:"rate_limits"`
+	SMTP                    SMTPConfig                   `bson:"smtp" json:"smtp" yaml:"smtp"`
+}
+
+// NotificationRateLimitsConfig limits how many notifications are sent in a
+// period of time, so that a single breaking change doesn't flood subscribers
+// (or get Evergreen rate limited by services like Slack). Notifications over
+// a limit are suppressed rather than delayed. A limit of zero is unlimited.
+type NotificationRateLimitsConfig struct {
+	// IntervalSeconds is the length of the window that limits apply to.
+	IntervalSeconds int `bson:"interval_seconds" json:"interval_seconds" yaml:"interval_seconds"`
+	// SubscriberLimit is the maximum number of notifications sent to any
+	// one subscriber (e.g. a Slack channel) per interval.
+	SubscriberLimit int `bson:"subscriber_limit" json:"subscriber_limit" yaml:"subscriber_limit"`
+	// SubscriberTypeLimits are the maximum number of notifications sent to
+	// all subscribers of a type (e.g. all of Slack) per interval.
+	SubscriberTypeLimits []SubscriberTypeRateLimit `bson:"subscriber_type_limits" json:"subscriber_type_limits" yaml:"subscriber_type_limits"`
+	// CollapseIdentical merges identical notifications to the same
+	// subscriber, such as the same task failing on several build variants,
+	// into a single notification with a count. Identical notifications that
+	// arrive after one was sent are held until the interval since it was
+	// sent ends and are then sent as one follow-up.
+	CollapseIdentical bool `bson:"collapse_identical" json:"collapse_identical" yaml:"collapse_identical"`
+}
+
+type SubscriberTypeRateLimit struct {
+	SubscriberType string `bson:"subscriber_type" json:"subscriber_type" yaml:"subscriber_type"`
+	Limit          int    `bson:"limit" json:"limit" yaml:"limit"`
+}
+
+// Interval returns the window that the rate limits apply to.
+func (c *NotificationRateLimitsConfig) Interval() time.Duration {
+	return time.Duration(c.IntervalSeconds) * time.Second
+}
+
+// SubscriberTypeLimit returns the limit for the given subscriber type, or zero
+// if the subscriber type is unlimited.
+func (c *NotificationRateLimitsConfig) SubscriberTypeLimit(subscriberType string) int {
+	for _, l := range c.SubscriberTypeLimits {
+		if l.SubscriberType == subscriberType {
+			return l.Limit
+		}
+	}
+	return 0
+}
+
+func (c *NotificationRateLimitsConfig) ValidateAndDefault() error {
+	catcher := grip.NewBasicCatcher()
+	if c.IntervalSeconds <= 0 {
+		c.IntervalSeconds = DefaultNotificationRateLimitIntervalSeconds
+	}
+	catcher.NewWhen(c.SubscriberLimit < 0, "subscriber rate limit cannot be negative")
+
+	seen := map[string]bool{}
+	for _, l := range c.SubscriberTypeLimits {
+		catcher.NewWhen(l.SubscriberType == "", "subscriber type rate limit must specify a subscriber type")
+		catcher.ErrorfWhen(l.Limit < 0, "rate limit for subscriber type '%s' cannot be negative", l.SubscriberType)
+		catcher.ErrorfWhen(seen[l.SubscriberType], "duplicate rate limit for subscriber type '%s'", l.SubscriberType)
+		seen[l.SubscriberType] = true
+	}
+
+	return catcher.Resolve()
 }
 
 func (c *NotifyConfig) SectionId() string { return "notify" }
@@ -74,7 +140,7 @@ func (c *NotifyConfig) ValidateAndDefault() error {
 		c.EventProcessingLimit = DefaultEventProcessingLimit
 	}
 
-	return nil
+	return errors.Wrap(c.RateLimits.ValidateAndDefault(), "invalid notification rate limits")
 }
 
 type AlertsConfig struct {
@@ -90,6 +90,9 @@ var (
 	hostAllocatorDisabledKey        = bsonutil.MustHaveTag(ServiceFlags{}, "HostAllocatorDisabled")
 	backgroundReauthDisabledKey     = bsonutil.MustHaveTag(ServiceFlags{}, "BackgroundReauthDisabled")
 	backgroundCleanupDisabledKey    = bsonutil.MustHaveTag(ServiceFlags{}, "BackgroundCleanupDisabled")
+	failureClusteringDisabledKey    = bsonutil.MustHaveTag(ServiceFlags{}, "FailureClusteringDisabled")
+	artifactRetentionDisabledKey    = bsonutil.MustHaveTag(ServiceFlags{}, "ArtifactRetentionDisabled")
+	perfAnalysisDisabledKey         = bsonutil.MustHaveTag(ServiceFlags{}, "PerfAnalysisDisabled")
 
 	// ContainerPoolsConfig keys
 	poolsKey = bsonutil.MustHaveTag(ContainerPoolsConfig{}, "Pools")
@@ -31,6 +31,9 @@ type ServiceFlags struct {
 	HostAllocatorDisabled      bool `bson:"host_allocator_disabled" json:"host_allocator_disabled"`
 	BackgroundReauthDisabled   bool `bson:"background_reauth_disabled" json:"background_reauth_disabled"`
 	BackgroundCleanupDisabled  bool `bson:"background_cleanup_disabled" json:"background_cleanup_disabled"`
+	FailureClusteringDisabled  bool `bson:"failure_clustering_disabled" json:"failure_clustering_disabled"`
+	ArtifactRetentionDisabled  bool `bson:"artifact_retention_disabled" json:"artifact_retention_disabled"`
+	PerfAnalysisDisabled       bool `bson:"perf_analysis_disabled" json:"perf_analysis_disabled"`
 
 	// Notification Flags
 	EventProcessingDisabled      bool `bson:"event_processing_disabled" json:"event_processing_disabled"`
@@ -99,6 +102,9 @@ func (c *ServiceFlags) Set() error {
 			hostAllocatorDisabledKey:        c.HostAllocatorDisabled,
 			backgroundCleanupDisabledKey:    c.BackgroundCleanupDisabled,
 			backgroundReauthDisabledKey:     c.BackgroundReauthDisabled,
+			failureClusteringDisabledKey:    c.FailureClusteringDisabled,
+			artifactRetentionDisabledKey:    c.ArtifactRetentionDisabled,
+			perfAnalysisDisabledKey:         c.PerfAnalysisDisabled,
 		},
 	}, options.Update().SetUpsert(true))
 
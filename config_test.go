@@ -585,6 +585,28 @@ func (s *AdminSuite) TestNotifyConfig() {
 	s.Equal(config, settings.Notify)
 }
 
+func (s *AdminSuite) TestNotificationRateLimitsConfig() {
+	config := NotificationRateLimitsConfig{}
+	s.NoError(config.ValidateAndDefault())
+	s.Equal(DefaultNotificationRateLimitIntervalSeconds, config.IntervalSeconds)
+	s.Zero(config.SubscriberTypeLimit("slack"))
+
+	config.SubscriberTypeLimits = []SubscriberTypeRateLimit{{SubscriberType: "slack", Limit: 5}}
+	s.NoError(config.ValidateAndDefault())
+	s.Equal(5, config.SubscriberTypeLimit("slack"))
+	s.Zero(config.SubscriberTypeLimit("email"))
+
+	config.SubscriberTypeLimits = append(config.SubscriberTypeLimits, SubscriberTypeRateLimit{SubscriberType: "slack", Limit: 10})
+	s.Error(config.ValidateAndDefault())
+
+	config.SubscriberTypeLimits = []SubscriberTypeRateLimit{{SubscriberType: "slack", Limit: -1}}
+	s.Error(config.ValidateAndDefault())
+
+	config.SubscriberTypeLimits = nil
+	config.SubscriberLimit = -1
+	s.Error(config.ValidateAndDefault())
+}
+
 func (s *AdminSuite) TestContainerPoolsConfig() {
 
 	invalidConfig := ContainerPoolsConfig{
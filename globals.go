@@ -134,6 +134,8 @@ const (
 	MergeTestStarted   = "started"
 	MergeTestSucceeded = "succeeded"
 	MergeTestFailed    = "failed"
+	MergeTestRestarted = "restarted"
+	MergeTestBisected  = "bisected"
 	EnqueueFailed      = "failed to enqueue"
 
 	// maximum task (zero based) execution number
@@ -463,6 +465,7 @@ const (
 	RepotrackerVersionRequester = "gitter_request"
 	TriggerRequester            = "trigger_request"
 	MergeTestRequester          = "merge_test" // commit queue
+	GithubMergeRequester        = "github_merge_request"
 	AdHocRequester              = "ad_hoc"
 )
 
@@ -484,12 +487,13 @@ const (
 	SenderJIRAComment
 	SenderEmail
 	SenderGeneric
+	SenderChatWebhook
 )
 
 func (k SenderKey) Validate() error {
 	switch k {
 	case SenderGithubStatus, SenderEvergreenWebhook, SenderSlack, SenderJIRAComment, SenderJIRAIssue,
-		SenderEmail, SenderGeneric:
+		SenderEmail, SenderGeneric, SenderChatWebhook:
 		return nil
 	default:
 		return errors.New("invalid sender defined")
@@ -512,6 +516,8 @@ func (k SenderKey) String() string {
 		return "jira-issue"
 	case SenderGeneric:
 		return "generic"
+	case SenderChatWebhook:
+		return "chat-webhook"
 	default:
 		return "<error:unknown>"
 	}
@@ -673,6 +679,18 @@ var (
 
 	ValidCommandTypes = []string{CommandTypeSetup, CommandTypeSystem, CommandTypeTest}
 
+	// AttachCommandNames are the commands that attach results or files to
+	// the task that's running.
+	AttachCommandNames = []string{
+		"attach.results",
+		"attach.xunit_results",
+		"attach.tap_results",
+		"attach.trx_results",
+		"attach.cucumber_results",
+		"attach.artifacts",
+		"attach.coverage",
+	}
+
 	// Map from valid architectures to display names
 	ValidArchDisplayNames = map[string]string{
 		ArchWindowsAmd64: "Windows 64-bit",
@@ -716,8 +734,15 @@ func IsGitTagRequester(requester string) bool {
 	return requester == GitTagRequester
 }
 
+// IsGithubMergeRequester returns true for versions created by GitHub's merge
+// queue.
+func IsGithubMergeRequester(requester string) bool {
+	return requester == GithubMergeRequester
+}
+
 func ShouldConsiderBatchtime(requester string) bool {
-	return !IsPatchRequester(requester) && requester != AdHocRequester && requester != GitTagRequester
+	return !IsPatchRequester(requester) && requester != AdHocRequester && requester != GitTagRequester &&
+		requester != GithubMergeRequester
 }
 
 // Permissions-related constants
@@ -37,6 +37,7 @@ type BuildBaron struct {
 	SearchReturnInfo        *thirdparty.SearchReturnInfo `json:"searchReturnInfo"`
 	BuildBaronConfigured    bool                         `json:"buildBaronConfigured"`
 	BbTicketCreationDefined bool                         `json:"bbTicketCreationDefined"`
+	FailureClusters         []*FailureCluster            `json:"failureClusters"`
 }
 
 type BuildVariantOptions struct {
@@ -73,6 +74,21 @@ type EditSpawnHostInput struct {
 	SavePublicKey       *bool           `json:"savePublicKey"`
 }
 
+type FailureCluster struct {
+	ID              string                    `json:"id"`
+	Signature       string                    `json:"signature"`
+	Size            int                       `json:"size"`
+	FirstOccurrence *FailureClusterOccurrence `json:"firstOccurrence"`
+	LastOccurrence  *FailureClusterOccurrence `json:"lastOccurrence"`
+}
+
+type FailureClusterOccurrence struct {
+	TaskID    string     `json:"taskId"`
+	Execution int        `json:"execution"`
+	VersionID string     `json:"versionId"`
+	Time      *time.Time `json:"time"`
+}
+
 type GroupedBuildVariant struct {
 	Variant     string           `json:"variant"`
 	DisplayName string           `json:"displayName"`
@@ -184,6 +200,28 @@ type PatchesInput struct {
 	OnlyCommitQueue    *bool    `json:"onlyCommitQueue"`
 }
 
+type PerfRegression struct {
+	ID            string     `json:"id"`
+	ProjectID     string     `json:"projectId"`
+	BuildVariant  string     `json:"buildVariant"`
+	TaskName      string     `json:"taskName"`
+	TestName      string     `json:"testName"`
+	Metric        string     `json:"metric"`
+	VersionID     string     `json:"versionId"`
+	Revision      string     `json:"revision"`
+	Order         int        `json:"order"`
+	TaskID        string     `json:"taskId"`
+	PreviousMean  float64    `json:"previousMean"`
+	NewMean       float64    `json:"newMean"`
+	PercentChange float64    `json:"percentChange"`
+	Statistic     *float64   `json:"statistic"`
+	Status        string     `json:"status"`
+	TriagedBy     *string    `json:"triagedBy"`
+	TriagedAt     *time.Time `json:"triagedAt"`
+	Note          *string    `json:"note"`
+	CreateTime    *time.Time `json:"createTime"`
+}
+
 type ProjectBuildVariant struct {
 	Name        string   `json:"name"`
 	DisplayName string   `json:"displayName"`
@@ -246,6 +284,8 @@ type Subscriber struct {
 	JiraCommentSubscriber *string                         `json:"jiraCommentSubscriber"`
 	EmailSubscriber       *string                         `json:"emailSubscriber"`
 	SlackSubscriber       *string                         `json:"slackSubscriber"`
+	MsTeamsSubscriber     *string                         `json:"msTeamsSubscriber"`
+	ChatWebhookSubscriber *string                         `json:"chatWebhookSubscriber"`
 }
 
 type TaskFiles struct {
@@ -253,6 +293,18 @@ type TaskFiles struct {
 	GroupedFiles []*GroupedFiles `json:"groupedFiles"`
 }
 
+type TaskLogSearchMatch struct {
+	LineNumber int                     `json:"lineNumber"`
+	Line       *apimodels.LogMessage   `json:"line"`
+	Before     []*apimodels.LogMessage `json:"before"`
+	After      []*apimodels.LogMessage `json:"after"`
+}
+
+type TaskLogSearchResult struct {
+	Matches      []*TaskLogSearchMatch `json:"matches"`
+	TotalMatches int                   `json:"totalMatches"`
+}
+
 type TaskLogs struct {
 	TaskID        string                        `json:"taskId"`
 	Execution     int                           `json:"execution"`
@@ -261,6 +313,7 @@ type TaskLogs struct {
 	TaskLogs      []*apimodels.LogMessage       `json:"taskLogs"`
 	SystemLogs    []*apimodels.LogMessage       `json:"systemLogs"`
 	AgentLogs     []*apimodels.LogMessage       `json:"agentLogs"`
+	Search        *TaskLogSearchResult          `json:"search"`
 }
 
 type TaskQueueDistro struct {
@@ -303,6 +356,27 @@ type TestFilter struct {
 	TestStatus string `json:"testStatus"`
 }
 
+type TestHistory struct {
+	Runs         []*TestHistoryRun `json:"runs"`
+	FailingSince *TestHistoryRun   `json:"failingSince"`
+}
+
+type TestHistoryRun struct {
+	TestFile       string     `json:"testFile"`
+	Status         string     `json:"status"`
+	StartTime      *time.Time `json:"startTime"`
+	Duration       float64    `json:"duration"`
+	FailureMessage *string    `json:"failureMessage"`
+	URL            *string    `json:"url"`
+	TaskID         string     `json:"taskId"`
+	Execution      int        `json:"execution"`
+	TaskName       string     `json:"taskName"`
+	BuildVariant   string     `json:"buildVariant"`
+	VersionID      string     `json:"versionId"`
+	Revision       string     `json:"revision"`
+	Order          int        `json:"order"`
+}
+
 type UpdateVolumeInput struct {
 	Expiration   *time.Time `json:"expiration"`
 	NoExpiration *bool      `json:"noExpiration"`
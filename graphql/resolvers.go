@@ -24,6 +24,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/host"
 	"github.com/evergreen-ci/evergreen/model/manifest"
 	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/perf"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/evergreen/model/user"
@@ -532,6 +533,10 @@ func (r *projectSubscriberResolver) Subscriber(ctx context.Context, obj *restMod
 		res.EmailSubscriber = obj.Target.(*string)
 	case event.SlackSubscriberType:
 		res.SlackSubscriber = obj.Target.(*string)
+	case event.MSTeamsSubscriberType:
+		res.MsTeamsSubscriber = obj.Target.(*string)
+	case event.ChatWebhookSubscriberType:
+		res.ChatWebhookSubscriber = obj.Target.(*string)
 	case event.EnqueuePatchSubscriberType:
 		// We don't store information in target for this case, so do nothing.
 	default:
@@ -1691,7 +1696,7 @@ func (r *queryResolver) PatchTasks(ctx context.Context, patchID string, sorts []
 	return &patchTasks, nil
 }
 
-func (r *queryResolver) TaskTests(ctx context.Context, taskID string, execution *int, sortCategory *TestSortCategory, sortDirection *SortDirection, page *int, limit *int, testName *string, statuses []string, groupID *string) (*TaskTestResult, error) {
+func (r *queryResolver) TaskTests(ctx context.Context, taskID string, execution *int, sortCategory *TestSortCategory, sortDirection *SortDirection, page *int, limit *int, testName *string, statuses []string, groupID *string, tags []string, owners []string) (*TaskTestResult, error) {
 	dbTask, err := task.FindByIdExecution(taskID, execution)
 	if dbTask == nil || err != nil {
 		return nil, ResourceNotFound.Send(ctx, fmt.Sprintf("finding task with id %s", taskID))
@@ -1739,13 +1744,31 @@ func (r *queryResolver) TaskTests(ctx context.Context, taskID string, execution
 		if baseTask != nil && baseTask.HasCedarResults {
 			opts.BaseTaskID = baseTask.Id
 		}
+		// Cedar does not store test tags or owners, so all of the results
+		// are fetched and filtered by the metadata Evergreen keeps for them.
+		filterByMetadata := len(tags) > 0 || len(owners) > 0
+		if filterByMetadata {
+			opts.Limit = 0
+			opts.Page = 0
+		}
 		cedarTestResults, err := apimodels.GetCedarTestResultsWithStatusError(ctx, opts)
 		if err != nil {
 			return nil, InternalServerError.Send(ctx, fmt.Sprintf("finding test results for task %s: %s", taskID, err))
 		}
+		if err = task.AddCedarTestResultMetadata(cedarTestResults.Results); err != nil {
+			return nil, InternalServerError.Send(ctx, fmt.Sprintf("getting test result metadata for task %s: %s", taskID, err))
+		}
+
+		results := cedarTestResults.Results
+		filteredCount := utility.FromIntPtr(cedarTestResults.Stats.FilteredCount)
+		if filterByMetadata {
+			results = task.FilterCedarTestResultsByMetadata(results, tags, owners)
+			filteredCount = len(results)
+			results = task.PageCedarTestResults(results, utility.FromIntPtr(limit), utility.FromIntPtr(page))
+		}
 
-		apiTestResults := make([]*restModel.APITest, len(cedarTestResults.Results))
-		for i, t := range cedarTestResults.Results {
+		apiTestResults := make([]*restModel.APITest, len(results))
+		for i, t := range results {
 			apiTest := &restModel.APITest{}
 			if err = apiTest.BuildFromService(t.TaskID); err != nil {
 				return nil, InternalServerError.Send(ctx, err.Error())
@@ -1760,7 +1783,7 @@ func (r *queryResolver) TaskTests(ctx context.Context, taskID string, execution
 		return &TaskTestResult{
 			TestResults:       apiTestResults,
 			TotalTestCount:    cedarTestResults.Stats.TotalCount,
-			FilteredTestCount: utility.FromIntPtr(cedarTestResults.Stats.FilteredCount),
+			FilteredTestCount: filteredCount,
 		}, nil
 	}
 
@@ -1783,6 +1806,8 @@ func (r *queryResolver) TaskTests(ctx context.Context, taskID string, execution
 		SortBy:    sortBy,
 		SortDir:   sortDir,
 		GroupID:   utility.FromStringPtr(groupID),
+		Tags:      tags,
+		Owners:    owners,
 		Limit:     utility.FromIntPtr(limit),
 		Page:      utility.FromIntPtr(page),
 	})
@@ -1803,11 +1828,11 @@ func (r *queryResolver) TaskTests(ctx context.Context, taskID string, execution
 
 		apiTestResults[i] = apiTest
 	}
-	totalTestCount, err := r.sc.GetTestCountByTaskIdAndFilters(taskID, "", []string{}, dbTask.Execution)
+	totalTestCount, err := r.sc.GetTestCountByTaskIdAndFilters(taskID, "", []string{}, nil, nil, dbTask.Execution)
 	if err != nil {
 		return nil, InternalServerError.Send(ctx, fmt.Sprintf("getting total test count: %s", err))
 	}
-	filteredTestCount, err := r.sc.GetTestCountByTaskIdAndFilters(taskID, utility.FromStringPtr(testName), statuses, dbTask.Execution)
+	filteredTestCount, err := r.sc.GetTestCountByTaskIdAndFilters(taskID, utility.FromStringPtr(testName), statuses, tags, owners, dbTask.Execution)
 	if err != nil {
 		return nil, InternalServerError.Send(ctx, fmt.Sprintf("getting filtered test count: %s", err))
 	}
@@ -1870,7 +1895,7 @@ func (r *queryResolver) TaskTestSample(ctx context.Context, tasks []string, test
 			if err != nil {
 				return nil, InternalServerError.Send(ctx, fmt.Sprintf("getting test results sample: %s", err))
 			}
-			failedTestCount, err := r.sc.GetTestCountByTaskIdAndFilters(t.Id, "", []string{evergreen.TestFailedStatus}, t.Execution)
+			failedTestCount, err := r.sc.GetTestCountByTaskIdAndFilters(t.Id, "", []string{evergreen.TestFailedStatus}, nil, nil, t.Execution)
 			if err != nil {
 				return nil, InternalServerError.Send(ctx, fmt.Sprintf("getting failed test count: %s", err))
 			}
@@ -1891,6 +1916,89 @@ func (r *queryResolver) TaskTestSample(ctx context.Context, tasks []string, test
 	return testResultsToReturn, nil
 }
 
+func (r *queryResolver) TestHistory(ctx context.Context, projectID string, testName string, variants []string, tasks []string, statuses []string, minDuration float64, maxDuration float64, revisions *int) (*TestHistory, error) {
+	pid, err := model.GetIdForProject(projectID)
+	if err != nil {
+		return nil, ResourceNotFound.Send(ctx, fmt.Sprintf("Could not find project with id: %s", projectID))
+	}
+	opts := model.TestHistoryOptions{
+		ProjectID:     pid,
+		TestName:      testName,
+		BuildVariants: variants,
+		TaskNames:     tasks,
+		NumRevisions:  utility.FromIntPtr(revisions),
+		Statuses:      statuses,
+		MinDuration:   time.Duration(minDuration * float64(time.Second)),
+		MaxDuration:   time.Duration(maxDuration * float64(time.Second)),
+	}
+	if err = opts.SetDefaultsAndValidate(); err != nil {
+		return nil, InputValidationError.Send(ctx, fmt.Sprintf("invalid test history options: %s", err))
+	}
+
+	history, err := r.sc.GetTestHistory(opts)
+	if err != nil {
+		apiErr, ok := err.(gimlet.ErrorResponse)
+		if ok && apiErr.StatusCode == http.StatusBadRequest {
+			return nil, InputValidationError.Send(ctx, apiErr.Message)
+		}
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("getting history for test '%s': %s", testName, err))
+	}
+	apiHistory := restModel.APITestHistory{}
+	if err = apiHistory.BuildFromService(history); err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("building test history from service: %s", err))
+	}
+
+	testHistory := &TestHistory{Runs: []*TestHistoryRun{}}
+	for _, run := range apiHistory.Runs {
+		testHistory.Runs = append(testHistory.Runs, makeTestHistoryRun(run))
+	}
+	if apiHistory.FailingSince != nil {
+		testHistory.FailingSince = makeTestHistoryRun(*apiHistory.FailingSince)
+	}
+	return testHistory, nil
+}
+
+func (r *queryResolver) PerfRegressions(ctx context.Context, projectID string, status *string, variant *string, taskName *string, testName *string, metric *string, limit *int) ([]*PerfRegression, error) {
+	pid, err := model.GetIdForProject(projectID)
+	if err != nil {
+		return nil, ResourceNotFound.Send(ctx, fmt.Sprintf("Could not find project with id: %s", projectID))
+	}
+	opts := perf.RegressionOptions{
+		Project:  pid,
+		Status:   utility.FromStringPtr(status),
+		Variant:  utility.FromStringPtr(variant),
+		TaskName: utility.FromStringPtr(taskName),
+		TestName: utility.FromStringPtr(testName),
+		Metric:   utility.FromStringPtr(metric),
+		Limit:    100,
+	}
+	if opts.Status != "" {
+		if err = perf.ValidateStatus(opts.Status); err != nil {
+			return nil, InputValidationError.Send(ctx, err.Error())
+		}
+	}
+	if limit != nil {
+		if *limit <= 0 || *limit > 1000 {
+			return nil, InputValidationError.Send(ctx, "limit must be between 1 and 1000")
+		}
+		opts.Limit = *limit
+	}
+
+	regressions, err := r.sc.FindPerfRegressions(opts)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("finding perf regressions for project '%s': %s", projectID, err))
+	}
+	res := []*PerfRegression{}
+	for _, regression := range regressions {
+		apiRegression := restModel.APIPerfRegression{}
+		if err = apiRegression.BuildFromService(regression); err != nil {
+			return nil, InternalServerError.Send(ctx, fmt.Sprintf("building perf regression from service: %s", err))
+		}
+		res = append(res, makePerfRegression(apiRegression))
+	}
+	return res, nil
+}
+
 func (r *queryResolver) TaskFiles(ctx context.Context, taskID string, execution *int) (*TaskFiles, error) {
 	emptyTaskFiles := TaskFiles{
 		FileCount:    0,
@@ -2119,6 +2227,41 @@ func (r *taskLogsResolver) TaskLogs(ctx context.Context, obj *TaskLogs) ([]*apim
 	return taskLogPointers, nil
 }
 
+func (r *taskLogsResolver) Search(ctx context.Context, obj *TaskLogs, pattern string, severities []string, logType *string, contextLines *int, page *int, limit *int) (*TaskLogSearchResult, error) {
+	opts := model.TaskLogSearchOptions{
+		TaskID:        obj.TaskID,
+		Execution:     obj.Execution,
+		DefaultLogger: obj.DefaultLogger,
+		Pattern:       pattern,
+		Severities:    severities,
+		LogType:       utility.FromStringPtr(logType),
+		ContextLines:  utility.FromIntPtr(contextLines),
+		Page:          utility.FromIntPtr(page),
+		Limit:         utility.FromIntPtr(limit),
+	}
+	if err := opts.SetDefaultsAndValidate(); err != nil {
+		return nil, InputValidationError.Send(ctx, err.Error())
+	}
+
+	result, err := r.sc.SearchTaskLogs(ctx, opts)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("Error searching logs for task %s: %s", obj.TaskID, err.Error()))
+	}
+
+	matches := []*TaskLogSearchMatch{}
+	for i := range result.Matches {
+		match := result.Matches[i]
+		matches = append(matches, &TaskLogSearchMatch{
+			LineNumber: match.LineNumber,
+			Line:       &match.Line,
+			Before:     makeLogMessagePointers(match.Before),
+			After:      makeLogMessagePointers(match.After),
+		})
+	}
+
+	return &TaskLogSearchResult{Matches: matches, TotalMatches: result.TotalMatches}, nil
+}
+
 func (r *queryResolver) PatchBuildVariants(ctx context.Context, patchID string) ([]*GroupedBuildVariant, error) {
 	patch, err := r.sc.FindPatchById(patchID)
 	if err != nil {
@@ -2404,6 +2547,36 @@ func (r *mutationResolver) ForceRepotrackerRun(ctx context.Context, projectID st
 	return true, nil
 }
 
+func (r *mutationResolver) TriagePerfRegression(ctx context.Context, regressionID string, status string, note *string) (*PerfRegression, error) {
+	if err := perf.ValidateStatus(status); err != nil {
+		return nil, InputValidationError.Send(ctx, err.Error())
+	}
+	regression, err := r.sc.FindPerfRegressionByID(regressionID)
+	if err != nil {
+		return nil, ResourceNotFound.Send(ctx, fmt.Sprintf("finding perf regression '%s': %s", regressionID, err))
+	}
+	authUser := gimlet.GetUser(ctx)
+	canTriage := authUser.HasPermission(gimlet.PermissionOpts{
+		Resource:      regression.Project,
+		ResourceType:  evergreen.ProjectResourceType,
+		Permission:    evergreen.PermissionTasks,
+		RequiredLevel: evergreen.TasksBasic.Value,
+	})
+	if !canTriage {
+		return nil, Forbidden.Send(ctx, fmt.Sprintf("user '%s' does not have permission to triage perf regressions in project '%s'", authUser.Username(), regression.Project))
+	}
+
+	regression, err = r.sc.TriagePerfRegression(regressionID, status, authUser.Username(), utility.FromStringPtr(note))
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("triaging perf regression '%s': %s", regressionID, err))
+	}
+	apiRegression := restModel.APIPerfRegression{}
+	if err = apiRegression.BuildFromService(regression); err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("building perf regression from service: %s", err))
+	}
+	return makePerfRegression(apiRegression), nil
+}
+
 func (r *mutationResolver) SetTaskPriority(ctx context.Context, taskID string, priority int) (*restModel.APITask, error) {
 	t, err := r.sc.FindTaskById(taskID)
 	if err != nil {
@@ -3109,7 +3282,7 @@ func (r *taskResolver) TotalTestCount(ctx context.Context, obj *restModel.APITas
 		return stats.TotalCount, nil
 	}
 
-	testCount, err := r.sc.GetTestCountByTaskIdAndFilters(*obj.Id, "", nil, obj.Execution)
+	testCount, err := r.sc.GetTestCountByTaskIdAndFilters(*obj.Id, "", nil, nil, nil, obj.Execution)
 	if err != nil {
 		return 0, InternalServerError.Send(ctx, fmt.Sprintf("getting test count: %s", err))
 	}
@@ -3133,7 +3306,7 @@ func (r *taskResolver) FailedTestCount(ctx context.Context, obj *restModel.APITa
 		return stats.FailedCount, nil
 	}
 
-	failedTestCount, err := r.sc.GetTestCountByTaskIdAndFilters(*obj.Id, "", []string{evergreen.TestFailedStatus}, obj.Execution)
+	failedTestCount, err := r.sc.GetTestCountByTaskIdAndFilters(*obj.Id, "", []string{evergreen.TestFailedStatus}, nil, nil, obj.Execution)
 	if err != nil {
 		return 0, InternalServerError.Send(ctx, fmt.Sprintf("getting failed test count: %s", err))
 	}
@@ -3410,11 +3583,16 @@ func (r *queryResolver) BuildBaron(ctx context.Context, taskID string, exec int)
 	if err != nil {
 		return nil, InternalServerError.Send(ctx, err.Error())
 	}
+	failureClusters, err := getFailureClusters(taskID, exec)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("getting failure clusters: %s", err))
+	}
 
 	return &BuildBaron{
 		SearchReturnInfo:        searchReturnInfo,
 		BuildBaronConfigured:    bbConfig.ProjectFound && bbConfig.SearchConfigured,
 		BbTicketCreationDefined: bbConfig.ticketCreationDefined,
+		FailureClusters:         failureClusters,
 	}, nil
 }
 
@@ -7,12 +7,14 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/annotations"
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/notification"
 	"github.com/evergreen-ci/evergreen/model/task"
@@ -233,6 +235,43 @@ func GetSearchReturnInfo(taskId string, exec string) (*thirdparty.SearchReturnIn
 	return &thirdparty.SearchReturnInfo{Issues: tickets, Search: jql, Source: source, FeaturesURL: featuresURL}, bbConfig, nil
 }
 
+// getFailureClusters returns the failure clusters that the task execution's
+// failure was added to, largest first.
+func getFailureClusters(taskId string, execution int) ([]*FailureCluster, error) {
+	clusters := []*FailureCluster{}
+	annotation, err := annotations.FindOneByTaskIdAndExecution(taskId, execution)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding annotation for task '%s'", taskId)
+	}
+	if annotation == nil {
+		return clusters, nil
+	}
+	dbClusters, err := annotations.FindFailureClustersByIds(annotation.FailureClusterIds())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	sort.SliceStable(dbClusters, func(i, j int) bool { return dbClusters[i].Size > dbClusters[j].Size })
+	for _, c := range dbClusters {
+		clusters = append(clusters, &FailureCluster{
+			ID:              c.Id,
+			Signature:       c.Signature,
+			Size:            c.Size,
+			FirstOccurrence: makeFailureClusterOccurrence(c.FirstOccurrence),
+			LastOccurrence:  makeFailureClusterOccurrence(c.LastOccurrence),
+		})
+	}
+	return clusters, nil
+}
+
+func makeFailureClusterOccurrence(o annotations.FailureOccurrence) *FailureClusterOccurrence {
+	return &FailureClusterOccurrence{
+		TaskID:    o.TaskId,
+		Execution: o.TaskExecution,
+		VersionID: o.VersionId,
+		Time:      &o.Time,
+	}
+}
+
 func BbGetTask(taskId string, executionString string) (*task.Task, error) {
 	execution, err := strconv.Atoi(executionString)
 	if err != nil {
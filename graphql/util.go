@@ -1389,3 +1389,53 @@ func GroupProjects(projects []model.ProjectRef, onlyDefaultedToRepo bool) ([]*Gr
 	})
 	return groupsArr, nil
 }
+
+func makeTestHistoryRun(run restModel.APITestHistoryRun) *TestHistoryRun {
+	return &TestHistoryRun{
+		TestFile:       utility.FromStringPtr(run.TestFile),
+		Status:         utility.FromStringPtr(run.Status),
+		StartTime:      run.StartTime,
+		Duration:       run.Duration,
+		FailureMessage: run.FailureMessage,
+		URL:            run.URL,
+		TaskID:         utility.FromStringPtr(run.TaskID),
+		Execution:      run.Execution,
+		TaskName:       utility.FromStringPtr(run.TaskName),
+		BuildVariant:   utility.FromStringPtr(run.BuildVariant),
+		VersionID:      utility.FromStringPtr(run.VersionID),
+		Revision:       utility.FromStringPtr(run.Revision),
+		Order:          run.Order,
+	}
+}
+
+func makeLogMessagePointers(msgs []apimodels.LogMessage) []*apimodels.LogMessage {
+	pointers := []*apimodels.LogMessage{}
+	for i := range msgs {
+		pointers = append(pointers, &msgs[i])
+	}
+	return pointers
+}
+
+func makePerfRegression(r restModel.APIPerfRegression) *PerfRegression {
+	return &PerfRegression{
+		ID:            utility.FromStringPtr(r.ID),
+		ProjectID:     utility.FromStringPtr(r.Project),
+		BuildVariant:  utility.FromStringPtr(r.Variant),
+		TaskName:      utility.FromStringPtr(r.TaskName),
+		TestName:      utility.FromStringPtr(r.TestName),
+		Metric:        utility.FromStringPtr(r.Metric),
+		VersionID:     utility.FromStringPtr(r.Version),
+		Revision:      utility.FromStringPtr(r.Revision),
+		Order:         r.Order,
+		TaskID:        utility.FromStringPtr(r.TaskID),
+		PreviousMean:  r.PreviousMean,
+		NewMean:       r.NewMean,
+		PercentChange: r.PercentChange,
+		Statistic:     r.Statistic,
+		Status:        utility.FromStringPtr(r.Status),
+		TriagedBy:     r.TriagedBy,
+		TriagedAt:     r.TriagedAt,
+		Note:          r.Note,
+		CreateTime:    r.CreateTime,
+	}
+}
@@ -25,6 +25,9 @@ const (
 	FirstRegressionInVersion = "first_regression_in_version"
 	LastRevisionNotFound     = "last_revision_not_found"
 	taskRegressionByTest     = "task-regression-by-test"
+
+	PersistentFailureEscalationId = "persistent_failure_escalation"
+	PersistentFailureResolvedId   = "persistent_failure_resolved"
 )
 
 // Host triggers
@@ -50,6 +53,8 @@ type AlertRecord struct {
 	TestName            string           `bson:"test_name,omitempty"`
 	RevisionOrderNumber int              `bson:"order,omitempty"`
 	AlertTime           time.Time        `bson:"alert_time,omitempty"`
+	// EscalationTier is the tier that a persistent failure was escalated to.
+	EscalationTier string `bson:"escalation_tier,omitempty"`
 }
 
 func (ar *AlertRecord) MarshalBSON() ([]byte, error)  { return mgobson.Marshal(ar) }
@@ -184,6 +189,33 @@ func FindByTaskRegressionTestAndOrderNumber(subscriptionID, testName, taskDispla
 	return FindOne(db.Query(q))
 }
 
+// FindByPersistentFailureEscalation finds the latest escalation alert sent
+// for a run of consecutive failures of a task/variant within a project that
+// began at or after the given revision order number, which is the one for the
+// highest tier escalated to.
+func FindByPersistentFailureEscalation(subscriptionID, taskDisplayName, variant, projectID string, firstFailureOrder int) (*AlertRecord, error) {
+	q := subscriptionIDQuery(subscriptionID)
+	q[TypeKey] = PersistentFailureEscalationId
+	q[TaskNameKey] = taskDisplayName
+	q[VariantKey] = variant
+	q[ProjectIdKey] = projectID
+	q[RevisionOrderNumberKey] = bson.M{"$gte": firstFailureOrder}
+	return FindOne(db.Query(q).Sort([]string{"-" + RevisionOrderNumberKey, "-" + AlertTimeKey}))
+}
+
+// FindByPersistentFailureResolution finds the resolution alert sent for a
+// task/variant within a project after the escalation at the given revision
+// order number.
+func FindByPersistentFailureResolution(subscriptionID, taskDisplayName, variant, projectID string, escalationOrder int) (*AlertRecord, error) {
+	q := subscriptionIDQuery(subscriptionID)
+	q[TypeKey] = PersistentFailureResolvedId
+	q[TaskNameKey] = taskDisplayName
+	q[VariantKey] = variant
+	q[ProjectIdKey] = projectID
+	q[RevisionOrderNumberKey] = bson.M{"$gt": escalationOrder}
+	return FindOne(db.Query(q).Limit(1))
+}
+
 func FindBySpawnHostExpirationWithHours(hostID string, hours int) (*AlertRecord, error) {
 	alerttype := fmt.Sprintf(spawnHostWarningTemplate, hours)
 	q := subscriptionIDQuery(legacyAlertsSubscription)
@@ -301,3 +301,58 @@ func (s *alertRecordSuite) TestFindByTaskRegressionTestAndOrderNumber() {
 	s.NoError(err)
 	s.Equal("t1", alert.TaskId)
 }
+
+func (s *alertRecordSuite) TestFindByPersistentFailureEscalationAndResolution() {
+	const (
+		sub             = "test-sub"
+		taskDisplayName = "task"
+		variant         = "variant"
+		projectID       = "project"
+	)
+	escalation := AlertRecord{
+		Id:                  mgobson.NewObjectId(),
+		SubscriptionID:      sub,
+		Type:                PersistentFailureEscalationId,
+		TaskName:            taskDisplayName,
+		Variant:             variant,
+		ProjectId:           projectID,
+		RevisionOrderNumber: 5,
+		AlertTime:           time.Now(),
+	}
+	s.NoError(escalation.Insert())
+
+	record, err := FindByPersistentFailureEscalation(sub, taskDisplayName, variant, projectID, 3)
+	s.NoError(err)
+	s.Require().NotNil(record)
+	s.Equal(escalation.Id, record.Id)
+
+	// escalations from an earlier run of failures aren't found
+	record, err = FindByPersistentFailureEscalation(sub, taskDisplayName, variant, projectID, 6)
+	s.NoError(err)
+	s.Nil(record)
+
+	record, err = FindByPersistentFailureResolution(sub, taskDisplayName, variant, projectID, 5)
+	s.NoError(err)
+	s.Nil(record)
+
+	resolution := AlertRecord{
+		Id:                  mgobson.NewObjectId(),
+		SubscriptionID:      sub,
+		Type:                PersistentFailureResolvedId,
+		TaskName:            taskDisplayName,
+		Variant:             variant,
+		ProjectId:           projectID,
+		RevisionOrderNumber: 7,
+		AlertTime:           time.Now(),
+	}
+	s.NoError(resolution.Insert())
+
+	record, err = FindByPersistentFailureResolution(sub, taskDisplayName, variant, projectID, 5)
+	s.NoError(err)
+	s.Require().NotNil(record)
+	s.Equal(resolution.Id, record.Id)
+
+	record, err = FindByPersistentFailureResolution(sub, taskDisplayName, variant, projectID, 7)
+	s.NoError(err)
+	s.Nil(record)
+}
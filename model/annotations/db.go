@@ -19,6 +19,8 @@ var (
 	SuspectedIssuesKey = bsonutil.MustHaveTag(TaskAnnotation{}, "SuspectedIssues")
 	CreatedIssuesKey   = bsonutil.MustHaveTag(TaskAnnotation{}, "CreatedIssues")
 	IssueLinkIssueKey  = bsonutil.MustHaveTag(IssueLink{}, "IssueKey")
+	IssueLinkSourceKey = bsonutil.MustHaveTag(IssueLink{}, "Source")
+	SourceRequesterKey = bsonutil.MustHaveTag(Source{}, "Requester")
 )
 
 const (
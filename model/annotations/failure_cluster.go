@@ -0,0 +1,163 @@
+package annotations
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	FailureClusterCollection = "failure_clusters"
+	// FailureClusterRequester is the requester of suspected issues that link
+	// a task to the failure cluster its failure belongs to.
+	FailureClusterRequester = "failure_clustering"
+)
+
+var (
+	FailureClusterIdKey              = bsonutil.MustHaveTag(FailureCluster{}, "Id")
+	FailureClusterProjectIdKey       = bsonutil.MustHaveTag(FailureCluster{}, "ProjectId")
+	FailureClusterSignatureKey       = bsonutil.MustHaveTag(FailureCluster{}, "Signature")
+	FailureClusterTaskIdsKey         = bsonutil.MustHaveTag(FailureCluster{}, "TaskIds")
+	FailureClusterFirstOccurrenceKey = bsonutil.MustHaveTag(FailureCluster{}, "FirstOccurrence")
+	FailureClusterLastOccurrenceKey  = bsonutil.MustHaveTag(FailureCluster{}, "LastOccurrence")
+)
+
+// FailureCluster is a group of task failures in a project that share the same
+// normalized failure signature.
+type FailureCluster struct {
+	// Id is derived from the project and signature.
+	Id        string `bson:"_id" json:"id"`
+	ProjectId string `bson:"project_id" json:"project_id"`
+	Signature string `bson:"signature" json:"signature"`
+	// TaskIds are the tasks that have failed with the signature. A task is
+	// only counted once however many times it fails with the signature.
+	TaskIds []string `bson:"task_ids" json:"-"`
+	// Size is the number of tasks that have failed with the signature.
+	Size            int               `bson:"-" json:"size"`
+	FirstOccurrence FailureOccurrence `bson:"first_occurrence" json:"first_occurrence"`
+	LastOccurrence  FailureOccurrence `bson:"last_occurrence" json:"last_occurrence"`
+}
+
+// FailureOccurrence is a task execution whose failure belongs to a cluster.
+type FailureOccurrence struct {
+	TaskId        string    `bson:"task_id" json:"task_id"`
+	TaskExecution int       `bson:"task_execution" json:"task_execution"`
+	VersionId     string    `bson:"version_id" json:"version_id"`
+	Time          time.Time `bson:"time" json:"time"`
+}
+
+// FailureClusterId returns the ID of the cluster for the given project and
+// failure signature.
+func FailureClusterId(projectId, signature string) string {
+	sum := sha256.Sum256([]byte(projectId + "\x00" + signature))
+	return fmt.Sprintf("%x", sum[:12])
+}
+
+// AddFailureOccurrence records a task execution that failed with the given
+// signature, creating its cluster if this is the first such failure. Adding
+// an occurrence for a task that's already in the cluster doesn't change the
+// cluster's size, so clustering the same failure again is harmless.
+func AddFailureOccurrence(projectId, signature string, occurrence FailureOccurrence) (*FailureCluster, error) {
+	change := adb.Change{
+		Update: bson.M{
+			"$setOnInsert": bson.M{
+				FailureClusterProjectIdKey:       projectId,
+				FailureClusterSignatureKey:       signature,
+				FailureClusterFirstOccurrenceKey: occurrence,
+			},
+			"$set":      bson.M{FailureClusterLastOccurrenceKey: occurrence},
+			"$addToSet": bson.M{FailureClusterTaskIdsKey: occurrence.TaskId},
+		},
+		Upsert:    true,
+		ReturnNew: true,
+	}
+
+	cluster := &FailureCluster{}
+	if _, err := db.FindAndModify(FailureClusterCollection, bson.M{FailureClusterIdKey: FailureClusterId(projectId, signature)}, nil, change, cluster); err != nil {
+		return nil, errors.Wrapf(err, "adding failure occurrence to cluster for task '%s'", occurrence.TaskId)
+	}
+	cluster.Size = len(cluster.TaskIds)
+	return cluster, nil
+}
+
+// FindFailureClustersByIds returns the failure clusters with the given IDs.
+func FindFailureClustersByIds(ids []string) ([]FailureCluster, error) {
+	clusters := []FailureCluster{}
+	if len(ids) == 0 {
+		return clusters, nil
+	}
+	err := db.FindAllQ(FailureClusterCollection, db.Query(bson.M{FailureClusterIdKey: bson.M{"$in": ids}}), &clusters)
+	if err != nil && !adb.ResultsNotFound(err) {
+		return nil, errors.Wrap(err, "finding failure clusters")
+	}
+	for i := range clusters {
+		clusters[i].Size = len(clusters[i].TaskIds)
+	}
+	return clusters, nil
+}
+
+// FailureClusterIds returns the IDs of the failure clusters that the
+// annotation links to as suspected issues.
+func (a *TaskAnnotation) FailureClusterIds() []string {
+	var ids []string
+	for _, issue := range a.SuspectedIssues {
+		if issue.Source != nil && issue.Source.Requester == FailureClusterRequester {
+			ids = append(ids, issue.IssueKey)
+		}
+	}
+	return ids
+}
+
+// AddFailureClusterToAnnotation links the task execution's annotation to the
+// failure cluster as a suspected issue, unless it already links to it.
+func AddFailureClusterToAnnotation(taskId string, execution int, cluster FailureCluster, url string) error {
+	// Create the annotation if it doesn't exist yet so that the link can be
+	// added with a single conditional update, which can't add it twice.
+	_, err := db.Upsert(
+		Collection,
+		ByTaskIdAndExecution(taskId, execution),
+		bson.M{
+			"$setOnInsert": bson.M{SuspectedIssuesKey: []IssueLink{}},
+		},
+	)
+	if err != nil {
+		return errors.Wrapf(err, "creating annotation for task '%s'", taskId)
+	}
+
+	issue := IssueLink{
+		URL:      url,
+		IssueKey: cluster.Id,
+		Source: &Source{
+			Author:    FailureClusterRequester,
+			Time:      time.Now(),
+			Requester: FailureClusterRequester,
+		},
+	}
+	query := ByTaskIdAndExecution(taskId, execution)
+	query[SuspectedIssuesKey] = bson.M{
+		"$not": bson.M{
+			"$elemMatch": bson.M{
+				IssueLinkIssueKey: cluster.Id,
+				bsonutil.GetDottedKeyName(IssueLinkSourceKey, SourceRequesterKey): FailureClusterRequester,
+			},
+		},
+	}
+	err = db.Update(
+		Collection,
+		query,
+		bson.M{
+			"$push": bson.M{SuspectedIssuesKey: issue},
+		},
+	)
+	if adb.ResultsNotFound(err) {
+		// The annotation already links to the cluster.
+		return nil
+	}
+	return errors.Wrapf(err, "adding failure cluster to annotation for task '%s'", taskId)
+}
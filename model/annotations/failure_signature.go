@@ -0,0 +1,49 @@
+package annotations
+
+import (
+	"path"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// maxFailureSignatureLength is the longest a normalized failure signature can
+// be, so that very long log lines don't produce unwieldy clusters.
+const maxFailureSignatureLength = 512
+
+var (
+	timestampPattern   = regexp.MustCompile(`\d{4}[-/]\d{2}[-/]\d{2}[T ]\d{2}:\d{2}:\d{2}(?:[.,]\d+)?(?:Z|[+-]\d{2}:?\d{2})?|\b\d{2}:\d{2}:\d{2}(?:[.,]\d+)?\b`)
+	uuidPattern        = regexp.MustCompile(`\b[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}\b`)
+	hexLiteralPattern  = regexp.MustCompile(`\b0[xX][0-9a-fA-F]+\b`)
+	hexIdPattern       = regexp.MustCompile(`\b[0-9a-fA-F]{8,}\b`)
+	unixPathPattern    = regexp.MustCompile(`(?:/[\w.@+-]+){2,}`)
+	windowsPathPattern = regexp.MustCompile(`[A-Za-z]:\\(?:[\w.@+ -]+\\)+`)
+	whitespacePattern  = regexp.MustCompile(`\s+`)
+)
+
+// NormalizeFailureSignature strips the parts of a failure message or log line
+// that change between otherwise identical failures, so that the same failure
+// in different tasks and versions has the same signature. Timestamps, UUIDs
+// and hex IDs are replaced with placeholders, and file paths are reduced to
+// their base names.
+func NormalizeFailureSignature(line string) string {
+	line = timestampPattern.ReplaceAllString(line, "<time>")
+	line = uuidPattern.ReplaceAllString(line, "<uuid>")
+	line = unixPathPattern.ReplaceAllStringFunc(line, path.Base)
+	line = windowsPathPattern.ReplaceAllString(line, "")
+	line = hexLiteralPattern.ReplaceAllString(line, "<hex>")
+	line = hexIdPattern.ReplaceAllStringFunc(line, func(id string) string {
+		// Don't mistake long words that happen to only use the letters a-f
+		// for IDs.
+		if strings.IndexFunc(id, unicode.IsDigit) == -1 {
+			return id
+		}
+		return "<hex>"
+	})
+	line = strings.TrimSpace(whitespacePattern.ReplaceAllString(line, " "))
+
+	if len(line) > maxFailureSignatureLength {
+		line = strings.ToValidUTF8(line[:maxFailureSignatureLength], "")
+	}
+	return line
+}
@@ -0,0 +1,33 @@
+package annotations
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeFailureSignature(t *testing.T) {
+	for input, expected := range map[string]string{
+		"[2021/10/01 12:00:00.123] connection refused":             "[<time>] connection refused",
+		"2021-10-01T12:00:00.123Z failed after 12:30:01":           "<time> failed after <time>",
+		"could not find host 9f2c1e4a-3b7d-4c8e-a1f0-5d6e7f8a9b0c": "could not find host <uuid>",
+		"segfault at 0x7ffd5e8c3a10 in process":                    "segfault at <hex> in process",
+		"document 5f3e2d1c0b9a8f7e6d5c4b3a not found":              "document <hex> not found",
+		"decoded feedface successfully":                            "decoded feedface successfully",
+		"open /data/mci/abc123/src/config.yml: no such file":       "open config.yml: no such file",
+		`cannot read C:\data\mci\src\config.yml`:                   "cannot read config.yml",
+		"  assertion   failed:\texpected 1\n":                      "assertion failed: expected 1",
+		"exited with exit code 1 after 30 seconds":                 "exited with exit code 1 after 30 seconds",
+	} {
+		assert.Equal(t, expected, NormalizeFailureSignature(input), input)
+	}
+
+	assert.Len(t, NormalizeFailureSignature(strings.Repeat("x", 2*maxFailureSignatureLength)), maxFailureSignatureLength)
+}
+
+func TestFailureClusterId(t *testing.T) {
+	assert.Equal(t, FailureClusterId("project", "TestFoo: failed"), FailureClusterId("project", "TestFoo: failed"))
+	assert.NotEqual(t, FailureClusterId("project", "TestFoo: failed"), FailureClusterId("other", "TestFoo: failed"))
+	assert.Len(t, FailureClusterId("project", "TestFoo: failed"), 24)
+}
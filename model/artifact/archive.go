@@ -0,0 +1,212 @@
+package artifact
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// Formats of archives that can be browsed, such as the ones created by
+	// archive.targz_pack and archive.zip_pack.
+	ArchiveFormatTarGz = "tar.gz"
+	ArchiveFormatTar   = "tar"
+	ArchiveFormatZip   = "zip"
+)
+
+// ErrArchiveFileNotFound is returned when a file is not in an archive.
+var ErrArchiveFileNotFound = errors.New("file not found in archive")
+
+// ErrArchiveTooLarge is returned when an archive that must be buffered to be
+// read is larger than maxBufferedArchiveSize.
+var ErrArchiveTooLarge = errors.New("archive is too large to browse")
+
+// maxBufferedArchiveSize is the largest zip archive that is buffered to disk
+// to be read.
+var maxBufferedArchiveSize int64 = 1024 * 1024 * 1024
+
+// ArchiveEntry describes a file or directory in an archive.
+type ArchiveEntry struct {
+	Path    string
+	Size    int64
+	IsDir   bool
+	ModTime time.Time
+}
+
+// ArchiveFormat returns the format of the archive based on the extension of
+// the file's key or link, or an empty string if the file is not a browsable
+// archive.
+func (f *File) ArchiveFormat() string {
+	name := f.FileKey
+	if name == "" {
+		if u, err := url.Parse(f.Link); err == nil {
+			name = u.Path
+		}
+	}
+	name = strings.ToLower(name)
+
+	switch {
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		return ArchiveFormatTarGz
+	case strings.HasSuffix(name, ".tar"):
+		return ArchiveFormatTar
+	case strings.HasSuffix(name, ".zip"):
+		return ArchiveFormatZip
+	default:
+		return ""
+	}
+}
+
+// ListArchive returns the entries of the archive read from r, sorted by path.
+func ListArchive(r io.Reader, format string) ([]ArchiveEntry, error) {
+	var entries []ArchiveEntry
+	err := walkArchive(r, format, func(entry ArchiveEntry, _ func() (io.Reader, error)) (bool, error) {
+		entries = append(entries, entry)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Path < entries[j].Path })
+	return entries, nil
+}
+
+// ReadArchiveFile calls fn with the content of the file at the given path in
+// the archive read from r. It returns ErrArchiveFileNotFound if the archive
+// does not contain a regular file at the path.
+func ReadArchiveFile(r io.Reader, format, filePath string, fn func(ArchiveEntry, io.Reader) error) error {
+	filePath = cleanArchivePath(filePath)
+	found := false
+	err := walkArchive(r, format, func(entry ArchiveEntry, open func() (io.Reader, error)) (bool, error) {
+		if entry.IsDir || entry.Path != filePath {
+			return false, nil
+		}
+		found = true
+
+		content, err := open()
+		if err != nil {
+			return true, errors.Wrapf(err, "opening file '%s' in archive", filePath)
+		}
+		return true, fn(entry, content)
+	})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.Wrap(ErrArchiveFileNotFound, filePath)
+	}
+	return nil
+}
+
+// walkArchive calls fn for each entry in the archive until it returns true or
+// an error. The function to open an entry's content is only valid until fn
+// returns.
+func walkArchive(r io.Reader, format string, fn func(ArchiveEntry, func() (io.Reader, error)) (bool, error)) error {
+	switch format {
+	case ArchiveFormatTarGz:
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return errors.Wrap(err, "reading gzip stream")
+		}
+		defer gz.Close()
+		return walkTar(gz, fn)
+	case ArchiveFormatTar:
+		return walkTar(r, fn)
+	case ArchiveFormatZip:
+		return walkZip(r, fn)
+	default:
+		return errors.Errorf("unsupported archive format '%s'", format)
+	}
+}
+
+func walkTar(r io.Reader, fn func(ArchiveEntry, func() (io.Reader, error)) (bool, error)) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "reading tar archive")
+		}
+		if hdr.Typeflag != tar.TypeReg && hdr.Typeflag != tar.TypeDir {
+			continue
+		}
+
+		entry := ArchiveEntry{
+			Path:    cleanArchivePath(hdr.Name),
+			Size:    hdr.Size,
+			IsDir:   hdr.Typeflag == tar.TypeDir,
+			ModTime: hdr.ModTime,
+		}
+		done, err := fn(entry, func() (io.Reader, error) { return tr, nil })
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+// walkZip buffers the archive in a temporary file, since the zip directory is
+// at the end of the archive. Archives larger than maxBufferedArchiveSize are
+// not buffered.
+func walkZip(r io.Reader, fn func(ArchiveEntry, func() (io.Reader, error)) (bool, error)) error {
+	tmp, err := ioutil.TempFile("", "artifact-*.zip")
+	if err != nil {
+		return errors.Wrap(err, "creating temporary file for zip archive")
+	}
+	defer func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+	size, err := io.Copy(tmp, io.LimitReader(r, maxBufferedArchiveSize+1))
+	if err != nil {
+		return errors.Wrap(err, "buffering zip archive")
+	}
+	if size > maxBufferedArchiveSize {
+		return errors.Wrapf(ErrArchiveTooLarge, "zip archives larger than %d bytes cannot be browsed", maxBufferedArchiveSize)
+	}
+
+	zr, err := zip.NewReader(tmp, size)
+	if err != nil {
+		return errors.Wrap(err, "reading zip archive")
+	}
+	for _, f := range zr.File {
+		f := f
+		entry := ArchiveEntry{
+			Path:    cleanArchivePath(f.Name),
+			Size:    int64(f.UncompressedSize64),
+			IsDir:   f.FileInfo().IsDir(),
+			ModTime: f.Modified,
+		}
+		var rc io.ReadCloser
+		done, err := fn(entry, func() (io.Reader, error) {
+			var err error
+			rc, err = f.Open()
+			return rc, err
+		})
+		if rc != nil {
+			rc.Close()
+		}
+		if err != nil || done {
+			return err
+		}
+	}
+	return nil
+}
+
+// cleanArchivePath normalizes a path in an archive so that "./dir/file" and
+// "dir/file/" refer to the same entry.
+func cleanArchivePath(p string) string {
+	return strings.TrimPrefix(path.Clean("/"+p), "/")
+}
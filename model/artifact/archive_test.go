@@ -0,0 +1,119 @@
+package artifact
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveFormat(t *testing.T) {
+	for format, file := range map[string]File{
+		ArchiveFormatTarGz: {FileKey: "build/dist.tgz"},
+		ArchiveFormatTar:   {Link: "https://bucket.s3.amazonaws.com/build/dist.tar?versionId=1"},
+		ArchiveFormatZip:   {FileKey: "build/Coverage.ZIP"},
+		"":                 {FileKey: "build/coverage.html"},
+	} {
+		assert.Equal(t, format, file.ArchiveFormat(), file)
+	}
+	assert.Equal(t, ArchiveFormatTarGz, (&File{Link: "https://example.com/dist.tar.gz"}).ArchiveFormat())
+}
+
+func TestBrowseArchive(t *testing.T) {
+	files := map[string]string{
+		"report/index.html": "<html></html>",
+		"report/data.json":  `{"coverage": 0.8}`,
+	}
+	makeTar := func(t *testing.T, w io.Writer) {
+		tw := tar.NewWriter(w)
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: "./report/", Typeflag: tar.TypeDir, Mode: 0755}))
+		for name, content := range files {
+			require.NoError(t, tw.WriteHeader(&tar.Header{Name: "./" + name, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(content))}))
+			_, err := tw.Write([]byte(content))
+			require.NoError(t, err)
+		}
+		require.NoError(t, tw.Close())
+	}
+	archives := map[string]func(t *testing.T) []byte{
+		ArchiveFormatTar: func(t *testing.T) []byte {
+			buf := &bytes.Buffer{}
+			makeTar(t, buf)
+			return buf.Bytes()
+		},
+		ArchiveFormatTarGz: func(t *testing.T) []byte {
+			buf := &bytes.Buffer{}
+			gz := gzip.NewWriter(buf)
+			makeTar(t, gz)
+			require.NoError(t, gz.Close())
+			return buf.Bytes()
+		},
+		ArchiveFormatZip: func(t *testing.T) []byte {
+			buf := &bytes.Buffer{}
+			zw := zip.NewWriter(buf)
+			_, err := zw.Create("report/")
+			require.NoError(t, err)
+			for name, content := range files {
+				w, err := zw.Create(name)
+				require.NoError(t, err)
+				_, err = w.Write([]byte(content))
+				require.NoError(t, err)
+			}
+			require.NoError(t, zw.Close())
+			return buf.Bytes()
+		},
+	}
+
+	for format, makeArchive := range archives {
+		t.Run(format, func(t *testing.T) {
+			archive := makeArchive(t)
+
+			entries, err := ListArchive(bytes.NewReader(archive), format)
+			require.NoError(t, err)
+			require.Len(t, entries, 3)
+			assert.Equal(t, "report", entries[0].Path)
+			assert.True(t, entries[0].IsDir)
+			assert.Equal(t, "report/data.json", entries[1].Path)
+			assert.EqualValues(t, len(files["report/data.json"]), entries[1].Size)
+			assert.Equal(t, "report/index.html", entries[2].Path)
+
+			var content []byte
+			require.NoError(t, ReadArchiveFile(bytes.NewReader(archive), format, "/report/index.html", func(entry ArchiveEntry, r io.Reader) error {
+				content, err = ioutil.ReadAll(r)
+				return err
+			}))
+			assert.Equal(t, files["report/index.html"], string(content))
+
+			err = ReadArchiveFile(bytes.NewReader(archive), format, "report", func(ArchiveEntry, io.Reader) error { return nil })
+			assert.Equal(t, ErrArchiveFileNotFound, errors.Cause(err))
+		})
+	}
+}
+
+func TestBrowseZipArchiveTooLarge(t *testing.T) {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	w, err := zw.Create("report/index.html")
+	require.NoError(t, err)
+	_, err = w.Write([]byte("<html></html>"))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	originalSize := maxBufferedArchiveSize
+	defer func() {
+		maxBufferedArchiveSize = originalSize
+	}()
+	maxBufferedArchiveSize = int64(buf.Len())
+	_, err = ListArchive(bytes.NewReader(buf.Bytes()), ArchiveFormatZip)
+	assert.NoError(t, err)
+
+	maxBufferedArchiveSize = int64(buf.Len()) - 1
+	_, err = ListArchive(bytes.NewReader(buf.Bytes()), ArchiveFormatZip)
+	assert.Equal(t, ErrArchiveTooLarge, errors.Cause(err))
+}
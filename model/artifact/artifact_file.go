@@ -1,15 +1,22 @@
 package artifact
 
 import (
+	"net/url"
+	"regexp"
 	"time"
 
 	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/evergreen-ci/utility"
 	"github.com/pkg/errors"
 )
 
 const Collection = "artifact_files"
 const PresignExpireTime = 24 * time.Hour
 
+// MaxExpirationFailures is the number of times a project's artifact retention
+// policy tries to delete a file from its bucket before giving up.
+const MaxExpirationFailures = 3
+
 const (
 	// strings for setting visibility
 	Public  = "public"
@@ -31,6 +38,13 @@ type Entry struct {
 	Files           []File    `json:"files" bson:"files"`
 	Execution       int       `json:"execution" bson:"execution"`
 	CreateTime      time.Time `json:"create_time" bson:"create_time"`
+	// ProjectId, Requester and VersionId describe the task the files are
+	// attached to so that retention policies can be applied without
+	// looking up the task. Entries created before these were recorded do
+	// not have them and are never expired.
+	ProjectId string `json:"project,omitempty" bson:"project,omitempty"`
+	Requester string `json:"requester,omitempty" bson:"requester,omitempty"`
+	VersionId string `json:"version,omitempty" bson:"version,omitempty"`
 }
 
 // Params stores file entries as key-value pairs, for easy parameter parsing.
@@ -48,14 +62,23 @@ type File struct {
 	Visibility string `json:"visibility" bson:"visibility"`
 	// When true, these artifacts are excluded from reproduction
 	IgnoreForFetch bool `bson:"fetch_ignore,omitempty" json:"ignore_for_fetch"`
-	//AwsKey is the key with which the file was uploaded to s3
-	AwsKey string `json:"aws_key,omitempty" bson:"aws_key,omitempty"`
-	//AwsSercret is the secret with which the file was uploaded to s3
+	// AwsKey and AwsSecret are the credentials with which a signed file was
+	// uploaded to s3. They are only stored for signed files and must be
+	// removed before the file is shown to users.
+	AwsKey    string `json:"aws_key,omitempty" bson:"aws_key,omitempty"`
 	AwsSecret string `json:"aws_secret,omitempty" bson:"aws_secret,omitempty"`
 	//Bucket is the aws bucket in which the file is stored
 	Bucket string `json:"bucket,omitempty" bson:"bucket,omitempty"`
 	//FileKey is the path to the file in the bucket
 	FileKey string `json:"filekey,omitempty" bson:"filekey,omitempty"`
+	// Region is the AWS region the bucket is in.
+	Region string `json:"region,omitempty" bson:"region,omitempty"`
+	// Expired is true if the file has been deleted from its bucket by the
+	// project's artifact retention policy.
+	Expired bool `json:"expired,omitempty" bson:"expired,omitempty"`
+	// ExpirationFailures is the number of times the project's artifact
+	// retention policy failed to delete the file from its bucket.
+	ExpirationFailures int `json:"expiration_failures,omitempty" bson:"expiration_failures,omitempty"`
 }
 
 // StripHiddenFiles is a helper for only showing users the files they are allowed to see.
@@ -82,20 +105,74 @@ func StripHiddenFiles(files []File, hasUser bool) ([]File, error) {
 				return nil, errors.Wrap(err, "problem presigning url")
 			}
 			file.Link = urlStr
-			publicFiles = append(publicFiles, file)
+			publicFiles = append(publicFiles, file.withoutCredentials())
 		default:
-			publicFiles = append(publicFiles, file)
+			publicFiles = append(publicFiles, file.withoutCredentials())
 		}
 	}
 	return publicFiles, nil
 }
 
+// ExpirableFiles returns the entry's files that are stored in one of the
+// given buckets and have not been expired yet. Files that repeatedly failed to
+// be deleted are no longer expirable.
+func (e *Entry) ExpirableFiles(buckets []string) []File {
+	var files []File
+	for _, file := range e.Files {
+		if !file.Expired && file.FileKey != "" && utility.StringSliceContains(buckets, file.Bucket) && file.ExpirationFailures < MaxExpirationFailures {
+			files = append(files, file)
+		}
+	}
+	return files
+}
+
+// withoutCredentials returns a copy of the file without the credentials it was
+// uploaded with, so that they are never shown to users.
+func (f File) withoutCredentials() File {
+	f.AwsKey = ""
+	f.AwsSecret = ""
+	return f
+}
+
 //ContainsSigningParams returns true if all the params needed for
 //presigning a url are present
 func (f *File) ContainsSigningParams() bool {
 	return !(f.AwsSecret == "" || f.AwsKey == "" || f.Bucket == "" || f.FileKey == "")
 }
 
+// ContentURL returns the URL to download the file's content from. Only the
+// content of files stored in S3 can be downloaded, since other links are
+// arbitrary URLs provided by the task.
+func (f *File) ContentURL() (string, error) {
+	if f.Visibility == Signed {
+		if !f.ContainsSigningParams() {
+			return "", errors.Errorf("cannot presign the URL for file '%s' because its credentials, bucket, or key are missing", f.Name)
+		}
+		return thirdparty.PreSign(thirdparty.RequestParams{
+			Bucket:    f.Bucket,
+			FileKey:   f.FileKey,
+			AwsKey:    f.AwsKey,
+			AwsSecret: f.AwsSecret,
+		})
+	}
+
+	u, err := url.Parse(f.Link)
+	if err != nil {
+		return "", errors.Wrapf(err, "parsing link for file '%s'", f.Name)
+	}
+	if u.Scheme != "https" || u.Port() != "" || !s3HostRegexp.MatchString(u.Hostname()) {
+		return "", errors.Errorf("file '%s' is not stored in S3", f.Name)
+	}
+	return f.Link, nil
+}
+
+// s3HostRegexp matches the hosts of S3's path-style endpoints, such as
+// "s3.amazonaws.com" and "s3.us-east-1.amazonaws.com", and virtual-hosted
+// style endpoints, such as "bucket.s3.us-east-1.amazonaws.com" and
+// "bucket.s3-us-east-1.amazonaws.com". Other AWS hosts, such as EC2 and ELB
+// hosts, can resolve to private addresses, so they are not matched.
+var s3HostRegexp = regexp.MustCompile(`^([a-z0-9][a-z0-9.-]*\.)?s3([.-][a-z]{2}(-[a-z]+)+-[0-9])?\.amazonaws\.com$`)
+
 func GetAllArtifacts(tasks []TaskIDAndExecution) ([]File, error) {
 	artifacts, err := FindAll(ByTaskIdsAndExecutions(tasks))
 	if err != nil {
@@ -2,9 +2,13 @@ package artifact
 
 import (
 	"testing"
+	"time"
 
+	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/db"
 	_ "github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.mongodb.org/mongo-driver/bson"
 )
@@ -203,3 +207,158 @@ func (s *TestArtifactFileSuite) TestFindByIds() {
 	s.NoError(err)
 	s.Len(entries, 3)
 }
+
+func (s *TestArtifactFileSuite) TestExpirableAndMarkFileExpired() {
+	now := time.Now()
+	entries := []Entry{
+		{
+			TaskId:     "mainline_task",
+			ProjectId:  "project",
+			Requester:  evergreen.RepotrackerVersionRequester,
+			VersionId:  "v1",
+			CreateTime: now.Add(-48 * time.Hour),
+			Files: []File{
+				{Name: "stored", Bucket: "bucket", FileKey: "mainline/stored"},
+				{Name: "other_bucket", Bucket: "other_bucket", FileKey: "mainline/other_bucket"},
+				{Name: "linked", Link: "https://example.com/linked"},
+			},
+		},
+		{
+			TaskId:     "pinned_task",
+			ProjectId:  "project",
+			Requester:  evergreen.RepotrackerVersionRequester,
+			VersionId:  "pinned",
+			CreateTime: now.Add(-48 * time.Hour),
+			Files:      []File{{Name: "stored", Bucket: "bucket", FileKey: "pinned/stored"}},
+		},
+		{
+			TaskId:     "patch_task",
+			ProjectId:  "project",
+			Requester:  evergreen.PatchVersionRequester,
+			VersionId:  "v2",
+			CreateTime: now.Add(-48 * time.Hour),
+			Files:      []File{{Name: "stored", Bucket: "bucket", FileKey: "patch/stored"}},
+		},
+		{
+			TaskId:     "recent_task",
+			ProjectId:  "project",
+			Requester:  evergreen.RepotrackerVersionRequester,
+			VersionId:  "v3",
+			CreateTime: now,
+			Files:      []File{{Name: "stored", Bucket: "bucket", FileKey: "recent/stored"}},
+		},
+	}
+	for _, entry := range entries {
+		s.NoError(entry.Upsert())
+	}
+
+	opts := ExpirableOptions{
+		ProjectID:        "project",
+		CreatedBefore:    now.Add(-24 * time.Hour),
+		ExcludedVersions: []string{"pinned"},
+		Buckets:          []string{"bucket"},
+		Limit:            10,
+	}
+	found, err := FindAll(ByExpirable(opts))
+	s.NoError(err)
+	s.Require().Len(found, 1)
+	s.Equal("mainline_task", found[0].TaskId)
+	expirable := found[0].ExpirableFiles(opts.Buckets)
+	s.Require().Len(expirable, 1)
+	s.Equal("mainline/stored", expirable[0].FileKey)
+
+	opts.Patches = true
+	found, err = FindAll(ByExpirable(opts))
+	s.NoError(err)
+	s.Require().Len(found, 1)
+	s.Equal("patch_task", found[0].TaskId)
+
+	s.NoError(found[0].MarkFileExpired("bucket", "patch/stored"))
+	s.True(found[0].Files[0].Expired)
+	s.Empty(found[0].ExpirableFiles(opts.Buckets))
+	found, err = FindAll(ByExpirable(opts))
+	s.NoError(err)
+	s.Empty(found)
+
+	entryFromDb, err := FindOne(ByTaskId("patch_task"))
+	s.NoError(err)
+	s.Require().NotNil(entryFromDb)
+	s.Require().Len(entryFromDb.Files, 1)
+	s.True(entryFromDb.Files[0].Expired)
+	s.Equal("v2", entryFromDb.VersionId)
+}
+
+func (s *TestArtifactFileSuite) TestMarkFileExpirationFailed() {
+	entry := Entry{
+		TaskId:     "task",
+		ProjectId:  "project",
+		Requester:  evergreen.RepotrackerVersionRequester,
+		CreateTime: time.Now().Add(-48 * time.Hour),
+		Files:      []File{{Name: "stored", Bucket: "bucket", FileKey: "task/stored"}},
+	}
+	s.NoError(entry.Upsert())
+	opts := ExpirableOptions{
+		ProjectID:     "project",
+		CreatedBefore: time.Now().Add(-24 * time.Hour),
+		Buckets:       []string{"bucket"},
+		Limit:         10,
+	}
+
+	for i := 0; i < MaxExpirationFailures; i++ {
+		found, err := FindAll(ByExpirable(opts))
+		s.NoError(err)
+		s.Require().Len(found, 1)
+		s.Require().Len(found[0].ExpirableFiles(opts.Buckets), 1)
+		s.NoError(found[0].MarkFileExpirationFailed("bucket", "task/stored"))
+		s.Equal(i+1, found[0].Files[0].ExpirationFailures)
+	}
+
+	found, err := FindAll(ByExpirable(opts))
+	s.NoError(err)
+	s.Empty(found)
+}
+
+func TestStripHiddenFilesRemovesCredentials(t *testing.T) {
+	files := []File{
+		{Name: "public", Visibility: Public, Link: "https://example.com/public"},
+		{Name: "private", Visibility: Private, Link: "https://example.com/private", AwsKey: "key", AwsSecret: "secret"},
+		{Name: "signed", Visibility: Signed, Bucket: "bucket", FileKey: "signed", AwsKey: "key", AwsSecret: "secret"},
+	}
+	stripped, err := StripHiddenFiles(files, true)
+	require.NoError(t, err)
+	require.Len(t, stripped, 3)
+	for _, file := range stripped {
+		assert.Empty(t, file.AwsKey, file.Name)
+		assert.Empty(t, file.AwsSecret, file.Name)
+	}
+	assert.NotEqual(t, "", stripped[2].Link)
+	assert.Equal(t, "key", files[2].AwsKey, "original files should not be modified")
+}
+
+func TestContentURL(t *testing.T) {
+	for _, link := range []string{
+		"https://s3.amazonaws.com/bucket/file.txt",
+		"https://s3.us-east-1.amazonaws.com/bucket/file.txt",
+		"https://s3-us-west-2.amazonaws.com/bucket/file.txt",
+		"https://bucket.s3.amazonaws.com/file.txt",
+		"https://my.bucket.s3.us-gov-west-1.amazonaws.com/file.txt",
+		"https://bucket.s3-eu-west-1.amazonaws.com/file.txt",
+	} {
+		contentURL, err := (&File{Name: "file", Link: link}).ContentURL()
+		assert.NoError(t, err, link)
+		assert.Equal(t, link, contentURL)
+	}
+
+	for _, link := range []string{
+		"http://bucket.s3.amazonaws.com/file.txt",
+		"https://bucket.s3.amazonaws.com:8080/file.txt",
+		"https://ec2-54-1-2-3.compute-1.amazonaws.com/file.txt",
+		"https://internal-lb-123.us-east-1.elb.amazonaws.com/file.txt",
+		"https://abc123.execute-api.us-east-1.amazonaws.com/file.txt",
+		"https://s3.amazonaws.com.example.com/file.txt",
+		"https://example.com/s3.amazonaws.com/file.txt",
+	} {
+		_, err := (&File{Name: "file", Link: link}).ContentURL()
+		assert.Error(t, err, link)
+	}
+}
@@ -1,6 +1,9 @@
 package artifact
 
 import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/mongodb/anser/bsonutil"
 	adb "github.com/mongodb/anser/db"
@@ -15,8 +18,16 @@ var (
 	FilesKey      = bsonutil.MustHaveTag(Entry{}, "Files")
 	ExecutionKey  = bsonutil.MustHaveTag(Entry{}, "Execution")
 	CreateTimeKey = bsonutil.MustHaveTag(Entry{}, "CreateTime")
+	ProjectIdKey  = bsonutil.MustHaveTag(Entry{}, "ProjectId")
+	RequesterKey  = bsonutil.MustHaveTag(Entry{}, "Requester")
+	VersionIdKey  = bsonutil.MustHaveTag(Entry{}, "VersionId")
 	NameKey       = bsonutil.MustHaveTag(File{}, "Name")
 	LinkKey       = bsonutil.MustHaveTag(File{}, "Link")
+	BucketKey     = bsonutil.MustHaveTag(File{}, "Bucket")
+	FileKeyKey    = bsonutil.MustHaveTag(File{}, "FileKey")
+	ExpiredKey    = bsonutil.MustHaveTag(File{}, "Expired")
+
+	ExpirationFailuresKey = bsonutil.MustHaveTag(File{}, "ExpirationFailures")
 )
 
 type TaskIDAndExecution struct {
@@ -77,6 +88,48 @@ func ByBuildId(id string) db.Q {
 	return db.Query(bson.M{BuildIdKey: id}).Sort([]string{TaskNameKey})
 }
 
+// ExpirableOptions describe which entries have files that can be expired by
+// a project's artifact retention policy.
+type ExpirableOptions struct {
+	ProjectID string
+	// Patches selects entries of patch tasks if true and entries of
+	// mainline tasks otherwise.
+	Patches bool
+	// CreatedBefore is the time entries must be created before.
+	CreatedBefore time.Time
+	// ExcludedVersions are versions whose entries are kept regardless of
+	// their age.
+	ExcludedVersions []string
+	// Buckets are the buckets that files can be expired from.
+	Buckets []string
+	Limit   int
+}
+
+// ByExpirable returns a query for the oldest entries that match the options
+// and still have files stored in one of the options' buckets that have not
+// been expired. Files that repeatedly failed to be deleted are skipped.
+func ByExpirable(opts ExpirableOptions) db.Q {
+	requesterOp := "$nin"
+	if opts.Patches {
+		requesterOp = "$in"
+	}
+	query := bson.M{
+		ProjectIdKey:  opts.ProjectID,
+		RequesterKey:  bson.M{requesterOp: evergreen.PatchRequesters},
+		CreateTimeKey: bson.M{"$lt": opts.CreatedBefore},
+		FilesKey: bson.M{"$elemMatch": bson.M{
+			ExpiredKey:            bson.M{"$ne": true},
+			BucketKey:             bson.M{"$in": opts.Buckets},
+			FileKeyKey:            bson.M{"$nin": []interface{}{"", nil}},
+			ExpirationFailuresKey: bson.M{"$not": bson.M{"$gte": MaxExpirationFailures}},
+		}},
+	}
+	if len(opts.ExcludedVersions) > 0 {
+		query[VersionIdKey] = bson.M{"$nin": opts.ExcludedVersions}
+	}
+	return db.Query(query).Sort([]string{CreateTimeKey}).Limit(opts.Limit)
+}
+
 // === DB Logic ===
 
 // Upsert updates the files entry in the db if an entry already exists,
@@ -98,6 +151,9 @@ func (e Entry) Upsert() error {
 			},
 			"$setOnInsert": bson.M{
 				ExecutionKey: e.Execution,
+				ProjectIdKey: e.ProjectId,
+				RequesterKey: e.Requester,
+				VersionIdKey: e.VersionId,
 			},
 		},
 	)
@@ -120,3 +176,61 @@ func FindAll(query db.Q) ([]Entry, error) {
 	err := db.FindAllQ(Collection, query, &entries)
 	return entries, err
 }
+
+// MarkFileExpired marks the entry's file stored at the given bucket and key as
+// expired.
+func (e *Entry) MarkFileExpired(bucket, fileKey string) error {
+	err := db.Update(
+		Collection,
+		bson.M{
+			TaskIdKey:    e.TaskId,
+			ExecutionKey: e.Execution,
+			FilesKey: bson.M{"$elemMatch": bson.M{
+				BucketKey:  bucket,
+				FileKeyKey: fileKey,
+			}},
+		},
+		bson.M{
+			"$set": bson.M{bsonutil.GetDottedKeyName(FilesKey, "$", ExpiredKey): true},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for i := range e.Files {
+		if e.Files[i].Bucket == bucket && e.Files[i].FileKey == fileKey {
+			e.Files[i].Expired = true
+		}
+	}
+	return nil
+}
+
+// MarkFileExpirationFailed records that the entry's file stored at the given
+// bucket and key failed to be deleted from its bucket.
+func (e *Entry) MarkFileExpirationFailed(bucket, fileKey string) error {
+	err := db.Update(
+		Collection,
+		bson.M{
+			TaskIdKey:    e.TaskId,
+			ExecutionKey: e.Execution,
+			FilesKey: bson.M{"$elemMatch": bson.M{
+				BucketKey:  bucket,
+				FileKeyKey: fileKey,
+			}},
+		},
+		bson.M{
+			"$inc": bson.M{bsonutil.GetDottedKeyName(FilesKey, "$", ExpirationFailuresKey): 1},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for i := range e.Files {
+		if e.Files[i].Bucket == bucket && e.Files[i].FileKey == fileKey {
+			e.Files[i].ExpirationFailures++
+		}
+	}
+	return nil
+}
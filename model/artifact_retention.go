@@ -0,0 +1,53 @@
+package model
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/pkg/errors"
+)
+
+// ArtifactRetentionBatchSize is the maximum number of mainline and patch
+// entries whose files are expired at once.
+const ArtifactRetentionBatchSize = 500
+
+// FindExpiredArtifacts returns the oldest entries with files that the
+// project's artifact retention policy has expired as of the given time, up to
+// the limit for both mainline and patch entries. Each entry's files are
+// limited to the ones that still need to be deleted.
+func FindExpiredArtifacts(projectRef *ProjectRef, now time.Time, limit int) ([]artifact.Entry, error) {
+	policy := projectRef.ArtifactRetention
+	if len(policy.Buckets) == 0 {
+		return nil, nil
+	}
+	var entries []artifact.Entry
+	for _, retention := range []struct {
+		days    int
+		patches bool
+	}{
+		{days: policy.MainlineDays},
+		{days: policy.PatchDays, patches: true},
+	} {
+		if retention.days <= 0 {
+			continue
+		}
+		found, err := artifact.FindAll(artifact.ByExpirable(artifact.ExpirableOptions{
+			ProjectID:        projectRef.Id,
+			Patches:          retention.patches,
+			CreatedBefore:    now.Add(-time.Duration(retention.days) * 24 * time.Hour),
+			ExcludedVersions: policy.PinnedVersions,
+			Buckets:          policy.Buckets,
+			Limit:            limit,
+		}))
+		if err != nil {
+			return nil, errors.Wrapf(err, "finding expired artifacts for project '%s'", projectRef.Id)
+		}
+		entries = append(entries, found...)
+	}
+
+	for i := range entries {
+		entries[i].Files = entries[i].ExpirableFiles(policy.Buckets)
+	}
+
+	return entries, nil
+}
@@ -5,8 +5,11 @@ import (
 
 	"github.com/evergreen-ci/evergreen/model/commitqueue"
 	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/thirdparty"
 	"github.com/google/go-github/v34/github"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
 	"github.com/pkg/errors"
 )
 
@@ -41,3 +44,38 @@ func GetModulesFromPR(ctx context.Context, githubToken string, prNum int, module
 
 	return modulePRs, modulePatches, nil
 }
+
+// RemoveStackedCommitQueueItems removes the items stacked on the given patch
+// from the commit queue, along with everything stacked on them, since they
+// can't be merged without the changes they're stacked on.
+func RemoveStackedCommitQueueItems(cq *commitqueue.CommitQueue, patchID, caller string) error {
+	stacked, err := patch.FindStackedOn(patchID)
+	if err != nil {
+		return errors.Wrapf(err, "can't find patches stacked on '%s'", patchID)
+	}
+
+	catcher := grip.NewBasicCatcher()
+	for i := range stacked {
+		p := &stacked[i]
+		removed, err := cq.RemoveItemAndPreventMerge(p.Id.Hex(), p.Version != "", caller)
+		if err != nil {
+			catcher.Wrapf(err, "can't remove stacked item '%s'", p.Id.Hex())
+			continue
+		}
+		if removed == nil {
+			continue
+		}
+		grip.Info(message.Fields{
+			"message":    "removed commit queue item stacked on a removed item",
+			"project_id": cq.ProjectID,
+			"item":       p.Id.Hex(),
+			"stacked_on": patchID,
+			"caller":     caller,
+		})
+		if p.Version != "" {
+			catcher.Wrapf(CancelPatch(p, task.AbortInfo{User: caller}), "can't abort stacked patch '%s'", p.Id.Hex())
+		}
+		catcher.Add(RemoveStackedCommitQueueItems(cq, p.Id.Hex(), caller))
+	}
+	return catcher.Resolve()
+}
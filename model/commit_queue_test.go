@@ -0,0 +1,47 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/db"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/model/commitqueue"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveStackedCommitQueueItems(t *testing.T) {
+	require.NoError(t, db.ClearCollections(patch.Collection, commitqueue.Collection))
+
+	parent := patch.Patch{Id: mgobson.NewObjectId(), Project: "mci"}
+	child := patch.Patch{Id: mgobson.NewObjectId(), Project: "mci", StackedOn: parent.Id.Hex()}
+	grandchild := patch.Patch{Id: mgobson.NewObjectId(), Project: "mci", StackedOn: child.Id.Hex()}
+	unrelated := patch.Patch{Id: mgobson.NewObjectId(), Project: "mci"}
+	for _, p := range []patch.Patch{parent, child, grandchild, unrelated} {
+		require.NoError(t, p.Insert())
+	}
+	cq := &commitqueue.CommitQueue{
+		ProjectID: "mci",
+		Queue: []commitqueue.CommitQueueItem{
+			{Issue: child.Id.Hex(), Source: commitqueue.SourceDiff},
+			{Issue: unrelated.Id.Hex(), Source: commitqueue.SourceDiff},
+			{Issue: grandchild.Id.Hex(), Source: commitqueue.SourceDiff},
+		},
+	}
+	require.NoError(t, commitqueue.InsertQueue(cq))
+
+	assert.NoError(t, RemoveStackedCommitQueueItems(cq, parent.Id.Hex(), "user"))
+
+	dbQueue, err := commitqueue.FindOneId(cq.ProjectID)
+	require.NoError(t, err)
+	require.Len(t, dbQueue.Queue, 1)
+	assert.Equal(t, unrelated.Id.Hex(), dbQueue.Queue[0].Issue)
+
+	// removing the items stacked on a patch with nothing stacked on it is a
+	// no-op
+	assert.NoError(t, RemoveStackedCommitQueueItems(dbQueue, unrelated.Id.Hex(), "user"))
+	dbQueue, err = commitqueue.FindOneId(cq.ProjectID)
+	require.NoError(t, err)
+	assert.Len(t, dbQueue.Queue, 1)
+}
@@ -17,6 +17,7 @@ const (
 	SourcePullRequest = "PR"
 	SourceDiff        = "diff"
 	GithubContext     = "evergreen/commitqueue"
+	DefaultLane       = "normal"
 )
 
 type Module struct {
@@ -37,6 +38,71 @@ type CommitQueueItem struct {
 	Modules             []Module  `bson:"modules"`
 	MessageOverride     string    `bson:"message_override"`
 	Source              string    `bson:"source"`
+	// BisectBatchSize is the size of the batch this item should be retested
+	// in after a batch it was part of failed and was split.
+	BisectBatchSize int `bson:"bisect_batch_size,omitempty"`
+	// Lane is the name of the lane the item was enqueued in. An empty lane is
+	// the default lane.
+	Lane string `bson:"lane,omitempty"`
+	// Author is the user who enqueued the item.
+	Author string `bson:"author,omitempty"`
+}
+
+// Lane is a named group of items in the queue. Items in lanes listed earlier
+// in a project's commit queue settings are ordered ahead of items in lanes
+// listed later.
+type Lane struct {
+	Name string `bson:"name" json:"name" yaml:"name"`
+	// MaxConcurrent is the number of items in the lane that can be tested at
+	// once. If it's not set, there's no limit.
+	MaxConcurrent int `bson:"max_concurrent,omitempty" json:"max_concurrent,omitempty" yaml:"max_concurrent"`
+}
+
+// laneRank returns the position of the given lane in the list of lanes. The
+// default lane is ranked after all the listed lanes unless it's listed
+// explicitly.
+func laneRank(lanes []Lane, name string) int {
+	if name == "" {
+		name = DefaultLane
+	}
+	for i, lane := range lanes {
+		if lane.Name == name {
+			return i
+		}
+	}
+	return len(lanes)
+}
+
+// ValidateLanes checks that the given lanes have unique names that don't
+// contain whitespace and that their concurrency limits are not negative.
+func ValidateLanes(lanes []Lane) error {
+	catcher := grip.NewBasicCatcher()
+	names := map[string]bool{}
+	for i, lane := range lanes {
+		if lane.Name == "" {
+			catcher.Errorf("lane %d must have a name", i+1)
+		} else if strings.ContainsAny(lane.Name, " \t\n") {
+			catcher.Errorf("lane name '%s' can't contain whitespace", lane.Name)
+		}
+		catcher.ErrorfWhen(names[lane.Name] && lane.Name != "", "lane '%s' is listed more than once", lane.Name)
+		catcher.ErrorfWhen(lane.MaxConcurrent < 0, "lane '%s' can't have a negative concurrency limit", lane.Name)
+		names[lane.Name] = true
+	}
+	return catcher.Resolve()
+}
+
+// ValidateLane checks that the given lane is either the default lane or one
+// of the given lanes.
+func ValidateLane(lanes []Lane, name string) error {
+	if name == "" || name == DefaultLane {
+		return nil
+	}
+	for _, lane := range lanes {
+		if lane.Name == name {
+			return nil
+		}
+	}
+	return errors.Errorf("lane '%s' is not configured for the commit queue", name)
 }
 
 func (i *CommitQueueItem) MarshalBSON() ([]byte, error)  { return mgobson.Marshal(i) }
@@ -76,6 +142,56 @@ func (q *CommitQueue) Enqueue(item CommitQueueItem) (int, error) {
 	return len(q.Queue) - 1, nil
 }
 
+// EnqueueInLane adds a given item behind all the unprocessed items in its own
+// lane or in lanes ahead of it, and in front of the unprocessed items in
+// lanes behind it.
+func (q *CommitQueue) EnqueueInLane(item CommitQueueItem, lanes []Lane) (int, error) {
+	return q.enqueueInLaneFrom(item, lanes, 0)
+}
+
+// EnqueueBehind adds a given item to its lane like EnqueueInLane, but never
+// ahead of the item with the given issue, so that a stacked change is merged
+// after the change it's stacked on.
+func (q *CommitQueue) EnqueueBehind(item CommitQueueItem, lanes []Lane, issue string) (int, error) {
+	return q.enqueueInLaneFrom(item, lanes, q.FindItem(issue)+1)
+}
+
+func (q *CommitQueue) enqueueInLaneFrom(item CommitQueueItem, lanes []Lane, start int) (int, error) {
+	position := q.FindItem(item.Issue)
+	if position >= 0 {
+		return position, errors.New("item already in queue")
+	}
+
+	rank := laneRank(lanes, item.Lane)
+	newPos := len(q.Queue)
+	for i := start; i < len(q.Queue); i++ {
+		if q.Queue[i].Version == "" && laneRank(lanes, q.Queue[i].Lane) > rank {
+			newPos = i
+			break
+		}
+	}
+	if newPos == len(q.Queue) {
+		return q.Enqueue(item)
+	}
+
+	item.EnqueueTime = time.Now()
+	if err := addAtPosition(q.ProjectID, q.Queue, item, newPos); err != nil {
+		return 0, errors.Wrapf(err, "can't add '%s' to lane '%s' of queue '%s'", item.Issue, item.Lane, q.ProjectID)
+	}
+	grip.Info(message.Fields{
+		"source":       "commit queue",
+		"item_id":      item.Issue,
+		"project_id":   q.ProjectID,
+		"lane":         item.Lane,
+		"queue_length": len(q.Queue) + 1,
+		"position":     newPos,
+		"message":      "enqueued commit queue item in lane",
+	})
+
+	q.Queue = append(q.Queue[:newPos], append([]CommitQueueItem{item}, q.Queue[newPos:]...)...)
+	return newPos, nil
+}
+
 // EnqueueAtFront adds a given item to the front of the _unprocessed_ items in the queue
 func (q *CommitQueue) EnqueueAtFront(item CommitQueueItem) (int, error) {
 	position := q.FindItem(item.Issue)
@@ -135,6 +251,136 @@ func (q *CommitQueue) NextUnprocessed(n int) []CommitQueueItem {
 	return items
 }
 
+// NextBisectBatch returns the CLI patch items at the front of the queue that
+// should be tested together as one version. If the front item was part of a
+// batch that failed, it's retested with the half of the batch it was split
+// into; otherwise up to n items are returned.
+func (q *CommitQueue) NextBisectBatch(n int) []CommitQueueItem {
+	items := []CommitQueueItem{}
+	for _, item := range q.Queue {
+		if item.Version != "" || item.Source != SourceDiff {
+			break
+		}
+		if len(items) == 0 && item.BisectBatchSize > 0 {
+			n = item.BisectBatchSize
+		}
+		if len(items) >= n {
+			break
+		}
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// ItemsForVersion returns the items in the queue being tested by the given
+// version. There can be more than one if the items are tested as a batch.
+func (q *CommitQueue) ItemsForVersion(version string) []CommitQueueItem {
+	items := []CommitQueueItem{}
+	for _, item := range q.Queue {
+		if item.Version == version {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// SplitBatch splits the items tested by the given failed batch version in
+// half, returning the items to the queue to be retested in the two halves.
+func (q *CommitQueue) SplitBatch(version, user string) ([]CommitQueueItem, []CommitQueueItem, error) {
+	items := q.ItemsForVersion(version)
+	if len(items) < 2 {
+		return nil, nil, errors.Errorf("version '%s' is not testing a batch of items", version)
+	}
+	if err := preventMergeForItem(items[0], user); err != nil {
+		return nil, nil, errors.Wrapf(err, "can't prevent merge for batch '%s'", version)
+	}
+
+	firstSize := (len(items) + 1) / 2
+	for i, item := range items {
+		size := firstSize
+		if i >= firstSize {
+			size = len(items) - firstSize
+		}
+		if err := resetForBisection(q.ProjectID, item.Issue, size); err != nil {
+			return nil, nil, errors.Wrapf(err, "can't reset item '%s'", item.Issue)
+		}
+		for j := range q.Queue {
+			if q.Queue[j].Issue == item.Issue {
+				q.Queue[j].Version = ""
+				q.Queue[j].BisectBatchSize = size
+			}
+		}
+		items[i].Version = ""
+		items[i].BisectBatchSize = size
+	}
+
+	return items[:firstSize], items[firstSize:], nil
+}
+
+// LimitByLane returns the leading items of the given items that can start
+// without exceeding the concurrency limits of their lanes, taking the items
+// already being tested into account.
+func (q *CommitQueue) LimitByLane(items []CommitQueueItem, lanes []Lane) []CommitQueueItem {
+	limits := map[string]int{}
+	for _, lane := range lanes {
+		if lane.MaxConcurrent > 0 {
+			limits[lane.Name] = lane.MaxConcurrent
+		}
+	}
+	if len(limits) == 0 {
+		return items
+	}
+
+	laneName := func(item CommitQueueItem) string {
+		if item.Lane == "" {
+			return DefaultLane
+		}
+		return item.Lane
+	}
+	processing := map[string]int{}
+	for _, item := range q.Queue {
+		if item.Version != "" {
+			processing[laneName(item)]++
+		}
+	}
+	for i, item := range items {
+		lane := laneName(item)
+		if limit, ok := limits[lane]; ok && processing[lane] >= limit {
+			return items[:i]
+		}
+		processing[lane]++
+	}
+
+	return items
+}
+
+// CheckAuthorLimit returns an error if the given user already has the
+// maximum number of items in the queue. A maximum that is not set means there
+// is no limit.
+func (q *CommitQueue) CheckAuthorLimit(author string, maxItems int) error {
+	if maxItems <= 0 || author == "" {
+		return nil
+	}
+	if q.CountItemsForAuthor(author) >= maxItems {
+		return errors.Errorf("user '%s' already has the maximum of %d items in the commit queue", author, maxItems)
+	}
+	return nil
+}
+
+// CountItemsForAuthor returns the number of items in the queue that were
+// enqueued by the given user.
+func (q *CommitQueue) CountItemsForAuthor(author string) int {
+	count := 0
+	for _, item := range q.Queue {
+		if item.Author == author {
+			count++
+		}
+	}
+	return count
+}
+
 func (q *CommitQueue) Processing() bool {
 	for _, item := range q.Queue {
 		if item.Version != "" {
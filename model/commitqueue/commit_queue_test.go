@@ -361,3 +361,212 @@ func TestProcessing(t *testing.T) {
 	}
 	assert.False(t, q.Processing())
 }
+
+func TestNextBisectBatch(t *testing.T) {
+	q := CommitQueue{
+		Queue: []CommitQueueItem{},
+	}
+	assert.Empty(t, q.NextBisectBatch(2))
+
+	q.Queue = []CommitQueueItem{
+		{Issue: "1", Source: SourceDiff},
+		{Issue: "2", Source: SourceDiff},
+		{Issue: "3", Source: SourceDiff},
+		{Issue: "4", Source: SourcePullRequest},
+		{Issue: "5", Source: SourceDiff},
+	}
+	batch := q.NextBisectBatch(2)
+	require.Len(t, batch, 2)
+	assert.Equal(t, "1", batch[0].Issue)
+	assert.Equal(t, "2", batch[1].Issue)
+
+	// PR items can't be batched
+	assert.Len(t, q.NextBisectBatch(5), 3)
+
+	// the front item's bisection size takes precedence
+	q.Queue[0].BisectBatchSize = 1
+	batch = q.NextBisectBatch(4)
+	require.Len(t, batch, 1)
+	assert.Equal(t, "1", batch[0].Issue)
+
+	q.Queue[0].Version = "1"
+	assert.Empty(t, q.NextBisectBatch(4))
+}
+
+func TestSplitBatch(t *testing.T) {
+	require.NoError(t, db.ClearCollections(Collection, task.Collection))
+
+	q := &CommitQueue{
+		ProjectID: "mci",
+		Queue: []CommitQueueItem{
+			{Issue: "1", Source: SourceDiff, Version: "v"},
+			{Issue: "2", Source: SourceDiff, Version: "v"},
+			{Issue: "3", Source: SourceDiff, Version: "v"},
+			{Issue: "4", Source: SourceDiff},
+		},
+	}
+	require.NoError(t, InsertQueue(q))
+	mergeTask := &task.Task{Id: "t1", CommitQueueMerge: true, Version: "v"}
+	require.NoError(t, mergeTask.Insert())
+
+	_, _, err := q.SplitBatch("v2", "user")
+	assert.Error(t, err)
+
+	first, second, err := q.SplitBatch("v", "user")
+	require.NoError(t, err)
+	require.Len(t, first, 2)
+	require.Len(t, second, 1)
+	assert.Equal(t, "3", second[0].Issue)
+	assert.False(t, q.Processing())
+
+	dbQueue, err := FindOneId(q.ProjectID)
+	require.NoError(t, err)
+	require.Len(t, dbQueue.Queue, 4)
+	for i, expected := range []int{2, 2, 1, 0} {
+		assert.Empty(t, dbQueue.Queue[i].Version)
+		assert.Equal(t, expected, dbQueue.Queue[i].BisectBatchSize)
+	}
+	batch := dbQueue.NextBisectBatch(3)
+	require.Len(t, batch, 2)
+
+	mergeTask, err = task.FindOneId("t1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), mergeTask.Priority)
+}
+
+func TestEnqueueInLane(t *testing.T) {
+	require.NoError(t, db.ClearCollections(Collection))
+	lanes := []Lane{{Name: "hotfix"}, {Name: DefaultLane}, {Name: "bulk"}}
+
+	q := &CommitQueue{
+		ProjectID: "mci",
+		Queue: []CommitQueueItem{
+			{Issue: "processing", Lane: "bulk", Version: "processing"},
+		},
+	}
+	require.NoError(t, InsertQueue(q))
+
+	pos, err := q.EnqueueInLane(CommitQueueItem{Issue: "bulk1", Lane: "bulk"}, lanes)
+	require.NoError(t, err)
+	assert.Equal(t, 1, pos)
+	pos, err = q.EnqueueInLane(CommitQueueItem{Issue: "normal1"}, lanes)
+	require.NoError(t, err)
+	assert.Equal(t, 1, pos)
+	pos, err = q.EnqueueInLane(CommitQueueItem{Issue: "hotfix1", Lane: "hotfix"}, lanes)
+	require.NoError(t, err)
+	assert.Equal(t, 1, pos)
+	pos, err = q.EnqueueInLane(CommitQueueItem{Issue: "normal2", Lane: DefaultLane}, lanes)
+	require.NoError(t, err)
+	assert.Equal(t, 3, pos)
+	_, err = q.EnqueueInLane(CommitQueueItem{Issue: "normal2"}, lanes)
+	assert.Error(t, err)
+
+	dbQueue, err := FindOneId(q.ProjectID)
+	require.NoError(t, err)
+	expected := []string{"processing", "hotfix1", "normal1", "normal2", "bulk1"}
+	require.Len(t, dbQueue.Queue, len(expected))
+	for i, issue := range expected {
+		assert.Equal(t, issue, dbQueue.Queue[i].Issue)
+		assert.Equal(t, issue, q.Queue[i].Issue)
+	}
+}
+
+func TestEnqueueBehind(t *testing.T) {
+	require.NoError(t, db.ClearCollections(Collection))
+	lanes := []Lane{{Name: "hotfix"}, {Name: DefaultLane}}
+
+	q := &CommitQueue{
+		ProjectID: "mci",
+		Queue: []CommitQueueItem{
+			{Issue: "processing", Version: "processing"},
+			{Issue: "normal1"},
+			{Issue: "parent"},
+			{Issue: "normal2"},
+		},
+	}
+	require.NoError(t, InsertQueue(q))
+
+	pos, err := q.EnqueueBehind(CommitQueueItem{Issue: "child", Lane: "hotfix"}, lanes, "parent")
+	require.NoError(t, err)
+	assert.Equal(t, 3, pos)
+	pos, err = q.EnqueueBehind(CommitQueueItem{Issue: "grandchild"}, lanes, "child")
+	require.NoError(t, err)
+	assert.Equal(t, 5, pos)
+	pos, err = q.EnqueueBehind(CommitQueueItem{Issue: "hotfix1", Lane: "hotfix"}, lanes, "nonexistent")
+	require.NoError(t, err)
+	assert.Equal(t, 1, pos)
+
+	dbQueue, err := FindOneId(q.ProjectID)
+	require.NoError(t, err)
+	expected := []string{"processing", "hotfix1", "normal1", "parent", "child", "normal2", "grandchild"}
+	require.Len(t, dbQueue.Queue, len(expected))
+	for i, issue := range expected {
+		assert.Equal(t, issue, dbQueue.Queue[i].Issue)
+		assert.Equal(t, issue, q.Queue[i].Issue)
+	}
+}
+
+func TestLimitByLane(t *testing.T) {
+	q := CommitQueue{
+		Queue: []CommitQueueItem{
+			{Issue: "1", Lane: "hotfix", Version: "1"},
+			{Issue: "2", Version: "2"},
+			{Issue: "3", Lane: "hotfix"},
+			{Issue: "4"},
+			{Issue: "5"},
+		},
+	}
+	items := q.NextUnprocessed(5)
+	require.Len(t, items, 3)
+
+	assert.Len(t, q.LimitByLane(items, nil), 3)
+	assert.Len(t, q.LimitByLane(items, []Lane{{Name: "hotfix"}}), 3)
+	assert.Len(t, q.LimitByLane(items, []Lane{{Name: "hotfix", MaxConcurrent: 2}, {Name: DefaultLane, MaxConcurrent: 2}}), 2)
+	assert.Empty(t, q.LimitByLane(items, []Lane{{Name: "hotfix", MaxConcurrent: 1}}))
+}
+
+func TestValidateLane(t *testing.T) {
+	lanes := []Lane{{Name: "hotfix"}}
+	assert.NoError(t, ValidateLane(lanes, ""))
+	assert.NoError(t, ValidateLane(lanes, DefaultLane))
+	assert.NoError(t, ValidateLane(lanes, "hotfix"))
+	assert.Error(t, ValidateLane(lanes, "bulk"))
+	assert.Error(t, ValidateLane(nil, "hotfix"))
+}
+
+func TestCountItemsForAuthor(t *testing.T) {
+	q := CommitQueue{
+		Queue: []CommitQueueItem{
+			{Issue: "1", Author: "me"},
+			{Issue: "2", Author: "you"},
+			{Issue: "3", Author: "me"},
+		},
+	}
+	assert.Equal(t, 2, q.CountItemsForAuthor("me"))
+	assert.Equal(t, 1, q.CountItemsForAuthor("you"))
+	assert.Equal(t, 0, q.CountItemsForAuthor("them"))
+}
+
+func TestValidateLanes(t *testing.T) {
+	assert.NoError(t, ValidateLanes(nil))
+	assert.NoError(t, ValidateLanes([]Lane{{Name: "hotfix", MaxConcurrent: 1}, {Name: DefaultLane}, {Name: "bulk"}}))
+	assert.Error(t, ValidateLanes([]Lane{{Name: ""}}))
+	assert.Error(t, ValidateLanes([]Lane{{Name: "hot fix"}}))
+	assert.Error(t, ValidateLanes([]Lane{{Name: "hotfix"}, {Name: "hotfix"}}))
+	assert.Error(t, ValidateLanes([]Lane{{Name: "hotfix", MaxConcurrent: -1}}))
+}
+
+func TestCheckAuthorLimit(t *testing.T) {
+	q := CommitQueue{
+		Queue: []CommitQueueItem{
+			{Issue: "1", Author: "me"},
+			{Issue: "2", Author: "you"},
+			{Issue: "3", Author: "me"},
+		},
+	}
+	assert.NoError(t, q.CheckAuthorLimit("me", 0))
+	assert.NoError(t, q.CheckAuthorLimit("me", 3))
+	assert.Error(t, q.CheckAuthorLimit("me", 2))
+	assert.NoError(t, q.CheckAuthorLimit("you", 2))
+	assert.NoError(t, q.CheckAuthorLimit("", 1))
+}
@@ -21,6 +21,7 @@ var (
 	VersionKey             = bsonutil.MustHaveTag(CommitQueueItem{}, "Version")
 	EnqueueTimeKey         = bsonutil.MustHaveTag(CommitQueueItem{}, "EnqueueTime")
 	ProcessingStartTimeKey = bsonutil.MustHaveTag(CommitQueueItem{}, "ProcessingStartTime")
+	BisectBatchSizeKey     = bsonutil.MustHaveTag(CommitQueueItem{}, "BisectBatchSize")
 )
 
 func updateOne(query interface{}, update interface{}) error {
@@ -108,6 +109,24 @@ func addVersionID(id string, item CommitQueueItem) error {
 		})
 }
 
+// resetForBisection marks an item as unprocessed so that it's retested in a
+// batch of the given size.
+func resetForBisection(id, issue string, size int) error {
+	return updateOne(
+		bson.M{
+			IdKey: id,
+			bsonutil.GetDottedKeyName(QueueKey, IssueKey): issue,
+		},
+		bson.M{
+			"$set": bson.M{
+				bsonutil.GetDottedKeyName(QueueKey, "$", BisectBatchSizeKey): size,
+			},
+			"$unset": bson.M{
+				bsonutil.GetDottedKeyName(QueueKey, "$", VersionKey): 1,
+			},
+		})
+}
+
 // remove removes a given item from a project's commit queue. Make sure to pass the actual
 // issue identifier and not the patch or version
 func remove(project, issue string) error {
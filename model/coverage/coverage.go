@@ -0,0 +1,172 @@
+package coverage
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/task"
+)
+
+// Collection is the name of the task coverage collection in the database.
+const Collection = "task_coverage"
+
+// Summary is the number of lines covered out of the number of lines that can
+// be covered. Coverage from Go cover profiles counts statements rather than
+// lines, so that it matches "go tool cover".
+type Summary struct {
+	LinesCovered int `bson:"lines_covered" json:"lines_covered"`
+	LinesTotal   int `bson:"lines_total" json:"lines_total"`
+}
+
+// Percent returns the percentage of lines that are covered.
+func (s Summary) Percent() float64 {
+	if s.LinesTotal == 0 {
+		return 0
+	}
+	return 100 * float64(s.LinesCovered) / float64(s.LinesTotal)
+}
+
+func (s *Summary) add(other Summary) {
+	s.LinesCovered += other.LinesCovered
+	s.LinesTotal += other.LinesTotal
+}
+
+// FileCoverage is the coverage of a single source file.
+type FileCoverage struct {
+	Path    string `bson:"path" json:"path"`
+	Summary `bson:",inline"`
+}
+
+// TaskCoverage is the coverage reported by a task execution. Fields from the
+// task are denormalized so that coverage can be aggregated by version and
+// build variant without joining on tasks.
+type TaskCoverage struct {
+	ID                  string    `bson:"_id" json:"id"`
+	TaskID              string    `bson:"task_id" json:"task_id"`
+	Execution           int       `bson:"execution" json:"execution"`
+	TaskName            string    `bson:"task_name" json:"task_name"`
+	Project             string    `bson:"project" json:"project"`
+	Version             string    `bson:"version" json:"version"`
+	BuildVariant        string    `bson:"build_variant" json:"build_variant"`
+	Requester           string    `bson:"requester" json:"requester"`
+	Revision            string    `bson:"revision" json:"revision"`
+	RevisionOrderNumber int       `bson:"order" json:"order"`
+	CreateTime          time.Time `bson:"create_time" json:"create_time"`
+	Summary             `bson:",inline"`
+	Files               []FileCoverage `bson:"files" json:"files"`
+}
+
+// NewTaskCoverage returns the coverage of the task's files.
+func NewTaskCoverage(t *task.Task, files []FileCoverage) *TaskCoverage {
+	c := &TaskCoverage{
+		ID:                  taskCoverageID(t.Id, t.Execution),
+		TaskID:              t.Id,
+		Execution:           t.Execution,
+		TaskName:            t.DisplayName,
+		Project:             t.Project,
+		Version:             t.Version,
+		BuildVariant:        t.BuildVariant,
+		Requester:           t.Requester,
+		Revision:            t.Revision,
+		RevisionOrderNumber: t.RevisionOrderNumber,
+		CreateTime:          t.CreateTime,
+	}
+	c.setFiles(files)
+	return c
+}
+
+func taskCoverageID(taskID string, execution int) string {
+	return fmt.Sprintf("%s_%d", taskID, execution)
+}
+
+// setFiles sets the files sorted by path and the summary of their coverage.
+func (c *TaskCoverage) setFiles(files []FileCoverage) {
+	sortFiles(files)
+	c.Files = files
+	c.Summary = Summary{}
+	for _, f := range files {
+		c.Summary.add(f.Summary)
+	}
+}
+
+func sortFiles(files []FileCoverage) {
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+}
+
+// mergeFiles returns the files in existing updated with the coverage of the
+// files in update, which replaces the coverage of files with the same path.
+func mergeFiles(existing, update []FileCoverage) []FileCoverage {
+	byPath := make(map[string]FileCoverage, len(existing)+len(update))
+	for _, f := range existing {
+		byPath[f.Path] = f
+	}
+	for _, f := range update {
+		byPath[f.Path] = f
+	}
+
+	merged := make([]FileCoverage, 0, len(byPath))
+	for _, f := range byPath {
+		merged = append(merged, f)
+	}
+	return merged
+}
+
+// VersionCoverage is the coverage of a build variant in a version, aggregated
+// across the tasks in the build variant that reported coverage.
+type VersionCoverage struct {
+	Version             string    `bson:"version" json:"version"`
+	BuildVariant        string    `bson:"build_variant" json:"build_variant"`
+	Revision            string    `bson:"revision" json:"revision"`
+	RevisionOrderNumber int       `bson:"order" json:"order"`
+	CreateTime          time.Time `bson:"create_time" json:"create_time"`
+	NumFiles            int       `bson:"num_files" json:"num_files"`
+	Summary             `bson:",inline"`
+}
+
+// Delta compares the coverage of a build variant in a version with its
+// coverage in a base version. Either may be nil if the build variant did not
+// report coverage in that version.
+type Delta struct {
+	BuildVariant string
+	Coverage     *VersionCoverage
+	Base         *VersionCoverage
+}
+
+// PercentChange returns the change in the percentage of lines covered from the
+// base version, and false if there is nothing to compare.
+func (d Delta) PercentChange() (float64, bool) {
+	if d.Coverage == nil || d.Base == nil {
+		return 0, false
+	}
+	return d.Coverage.Percent() - d.Base.Percent(), true
+}
+
+// CompareVersions returns the deltas between the coverage of each build
+// variant in a version and in the base version, sorted by build variant.
+// Build variants that only reported coverage in the base version are
+// included so that coverage that was dropped is visible.
+func CompareVersions(coverage, base []VersionCoverage) []Delta {
+	byVariant := map[string]*Delta{}
+	getDelta := func(variant string) *Delta {
+		if d, ok := byVariant[variant]; ok {
+			return d
+		}
+		d := &Delta{BuildVariant: variant}
+		byVariant[variant] = d
+		return d
+	}
+	for i := range coverage {
+		getDelta(coverage[i].BuildVariant).Coverage = &coverage[i]
+	}
+	for i := range base {
+		getDelta(base[i].BuildVariant).Base = &base[i]
+	}
+
+	deltas := make([]Delta, 0, len(byVariant))
+	for _, d := range byVariant {
+		deltas = append(deltas, *d)
+	}
+	sort.Slice(deltas, func(i, j int) bool { return deltas[i].BuildVariant < deltas[j].BuildVariant })
+	return deltas
+}
@@ -0,0 +1,121 @@
+package coverage
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/task"
+	_ "github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareVersions(t *testing.T) {
+	coverage := []VersionCoverage{
+		{BuildVariant: "ubuntu", Summary: Summary{LinesCovered: 80, LinesTotal: 100}},
+		{BuildVariant: "windows", Summary: Summary{LinesCovered: 10, LinesTotal: 20}},
+	}
+	base := []VersionCoverage{
+		{BuildVariant: "ubuntu", Summary: Summary{LinesCovered: 75, LinesTotal: 100}},
+		{BuildVariant: "macos", Summary: Summary{LinesCovered: 1, LinesTotal: 2}},
+	}
+
+	deltas := CompareVersions(coverage, base)
+	require.Len(t, deltas, 3)
+
+	assert.Equal(t, "macos", deltas[0].BuildVariant)
+	assert.Nil(t, deltas[0].Coverage)
+	_, ok := deltas[0].PercentChange()
+	assert.False(t, ok)
+
+	assert.Equal(t, "ubuntu", deltas[1].BuildVariant)
+	change, ok := deltas[1].PercentChange()
+	assert.True(t, ok)
+	assert.InDelta(t, 5.0, change, 0.001)
+
+	assert.Equal(t, "windows", deltas[2].BuildVariant)
+	assert.Nil(t, deltas[2].Base)
+}
+
+func TestAttachAndAggregate(t *testing.T) {
+	require.NoError(t, db.Clear(Collection))
+	defer func() {
+		assert.NoError(t, db.Clear(Collection))
+	}()
+
+	makeTask := func(id, version, variant string, execution, order int) *task.Task {
+		return &task.Task{
+			Id:                  id,
+			Execution:           execution,
+			Project:             "project",
+			Version:             version,
+			BuildVariant:        variant,
+			Requester:           evergreen.RepotrackerVersionRequester,
+			RevisionOrderNumber: order,
+		}
+	}
+
+	// Reporting coverage twice merges the files.
+	_, err := Attach(makeTask("t1", "v1", "ubuntu", 0, 1), []FileCoverage{
+		{Path: "a.go", Summary: Summary{LinesCovered: 1, LinesTotal: 10}},
+	})
+	require.NoError(t, err)
+	c, err := Attach(makeTask("t1", "v1", "ubuntu", 0, 1), []FileCoverage{
+		{Path: "b.go", Summary: Summary{LinesCovered: 5, LinesTotal: 5}},
+	})
+	require.NoError(t, err)
+	assert.Len(t, c.Files, 2)
+	assert.Equal(t, Summary{LinesCovered: 6, LinesTotal: 15}, c.Summary)
+
+	dbCoverage, err := FindOneByTaskIDAndExecution("t1", 0)
+	require.NoError(t, err)
+	require.NotNil(t, dbCoverage)
+	assert.Equal(t, c.Summary, dbCoverage.Summary)
+
+	// A task covering the same file counts it once, with the best coverage.
+	_, err = Attach(makeTask("t2", "v1", "ubuntu", 0, 1), []FileCoverage{
+		{Path: "a.go", Summary: Summary{LinesCovered: 8, LinesTotal: 10}},
+	})
+	require.NoError(t, err)
+
+	// Only the latest execution of a task counts.
+	_, err = Attach(makeTask("t3", "v2", "ubuntu", 0, 2), []FileCoverage{
+		{Path: "a.go", Summary: Summary{LinesCovered: 1, LinesTotal: 10}},
+	})
+	require.NoError(t, err)
+	_, err = Attach(makeTask("t3", "v2", "ubuntu", 1, 2), []FileCoverage{
+		{Path: "a.go", Summary: Summary{LinesCovered: 9, LinesTotal: 10}},
+	})
+	require.NoError(t, err)
+
+	_, err = Attach(makeTask("t4", "v2", "windows", 0, 2), []FileCoverage{
+		{Path: "a.go", Summary: Summary{LinesCovered: 2, LinesTotal: 10}},
+	})
+	require.NoError(t, err)
+
+	versionCoverage, err := FindVersionCoverage("v1")
+	require.NoError(t, err)
+	require.Len(t, versionCoverage, 1)
+	assert.Equal(t, "ubuntu", versionCoverage[0].BuildVariant)
+	assert.Equal(t, 2, versionCoverage[0].NumFiles)
+	assert.Equal(t, Summary{LinesCovered: 13, LinesTotal: 15}, versionCoverage[0].Summary)
+
+	trend, err := FindCoverageTrend(TrendOptions{Project: "project", BuildVariants: []string{"ubuntu"}})
+	require.NoError(t, err)
+	require.Len(t, trend, 2)
+	assert.Equal(t, "v2", trend[0].Version)
+	assert.Equal(t, Summary{LinesCovered: 9, LinesTotal: 10}, trend[0].Summary)
+	assert.Equal(t, "v1", trend[1].Version)
+
+	trend, err = FindCoverageTrend(TrendOptions{Project: "project", BeforeOrder: 2})
+	require.NoError(t, err)
+	require.Len(t, trend, 1)
+	assert.Equal(t, "v1", trend[0].Version)
+
+	trend, err = FindCoverageTrend(TrendOptions{Project: "project", Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, trend, 1)
+	assert.Equal(t, "v2", trend[0].Version)
+	assert.Equal(t, "ubuntu", trend[0].BuildVariant)
+}
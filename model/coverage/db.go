@@ -0,0 +1,172 @@
+package coverage
+
+import (
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	// BSON fields for the task coverage struct
+	IDKey                  = bsonutil.MustHaveTag(TaskCoverage{}, "ID")
+	TaskIDKey              = bsonutil.MustHaveTag(TaskCoverage{}, "TaskID")
+	ExecutionKey           = bsonutil.MustHaveTag(TaskCoverage{}, "Execution")
+	ProjectKey             = bsonutil.MustHaveTag(TaskCoverage{}, "Project")
+	VersionKey             = bsonutil.MustHaveTag(TaskCoverage{}, "Version")
+	BuildVariantKey        = bsonutil.MustHaveTag(TaskCoverage{}, "BuildVariant")
+	RequesterKey           = bsonutil.MustHaveTag(TaskCoverage{}, "Requester")
+	RevisionKey            = bsonutil.MustHaveTag(TaskCoverage{}, "Revision")
+	RevisionOrderNumberKey = bsonutil.MustHaveTag(TaskCoverage{}, "RevisionOrderNumber")
+	CreateTimeKey          = bsonutil.MustHaveTag(TaskCoverage{}, "CreateTime")
+	FilesKey               = bsonutil.MustHaveTag(TaskCoverage{}, "Files")
+	PathKey                = bsonutil.MustHaveTag(FileCoverage{}, "Path")
+	LinesCoveredKey        = bsonutil.MustHaveTag(Summary{}, "LinesCovered")
+	LinesTotalKey          = bsonutil.MustHaveTag(Summary{}, "LinesTotal")
+
+	numFilesKey = bsonutil.MustHaveTag(VersionCoverage{}, "NumFiles")
+)
+
+// ByTaskIDAndExecution returns a query for the coverage reported by the
+// task execution.
+func ByTaskIDAndExecution(taskID string, execution int) db.Q {
+	return db.Query(bson.M{IDKey: taskCoverageID(taskID, execution)})
+}
+
+// FindOne returns the task coverage that matches the query, or nil if there
+// is none.
+func FindOne(query db.Q) (*TaskCoverage, error) {
+	c := &TaskCoverage{}
+	err := db.FindOneQ(Collection, query, c)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	return c, err
+}
+
+// FindOneByTaskIDAndExecution returns the coverage reported by the task
+// execution, or nil if it has not reported any.
+func FindOneByTaskIDAndExecution(taskID string, execution int) (*TaskCoverage, error) {
+	return FindOne(ByTaskIDAndExecution(taskID, execution))
+}
+
+// Attach stores the coverage of the files reported by the task. Coverage the
+// task execution already reported for other files is kept, so tasks can
+// report coverage more than once.
+func Attach(t *task.Task, files []FileCoverage) (*TaskCoverage, error) {
+	existing, err := FindOneByTaskIDAndExecution(t.Id, t.Execution)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding existing coverage for task '%s'", t.Id)
+	}
+	c := NewTaskCoverage(t, files)
+	if existing != nil {
+		c.setFiles(mergeFiles(existing.Files, files))
+	}
+
+	if _, err = db.Upsert(Collection, bson.M{IDKey: c.ID}, c); err != nil {
+		return nil, errors.Wrapf(err, "storing coverage for task '%s'", t.Id)
+	}
+	return c, nil
+}
+
+// TrendOptions filters the coverage of build variants in a project's
+// versions.
+type TrendOptions struct {
+	Project       string
+	BuildVariants []string
+	Requesters    []string
+	// BeforeOrder, if set, only includes versions with a lower revision
+	// order number.
+	BeforeOrder int
+	Limit       int
+}
+
+// FindVersionCoverage returns the coverage of each build variant in the
+// version.
+func FindVersionCoverage(versionID string) ([]VersionCoverage, error) {
+	return aggregateVersionCoverage(bson.M{VersionKey: versionID}, 0)
+}
+
+// FindCoverageTrend returns the coverage of build variants in the project's
+// versions, most recent first.
+func FindCoverageTrend(opts TrendOptions) ([]VersionCoverage, error) {
+	match := bson.M{ProjectKey: opts.Project}
+	if len(opts.BuildVariants) > 0 {
+		match[BuildVariantKey] = bson.M{"$in": opts.BuildVariants}
+	}
+	requesters := opts.Requesters
+	if len(requesters) == 0 {
+		requesters = []string{evergreen.RepotrackerVersionRequester}
+	}
+	match[RequesterKey] = bson.M{"$in": requesters}
+	if opts.BeforeOrder > 0 {
+		match[RevisionOrderNumberKey] = bson.M{"$lt": opts.BeforeOrder}
+	}
+	return aggregateVersionCoverage(match, opts.Limit)
+}
+
+// aggregateVersionCoverage aggregates the coverage of the tasks matching the
+// filter by version and build variant. Only the latest execution of each task
+// is counted. Tasks in a build variant often cover the same files, so each
+// file counts once with the coverage of the task that covered the most of it
+// rather than being summed across tasks.
+func aggregateVersionCoverage(match bson.M, limit int) ([]VersionCoverage, error) {
+	pipeline := []bson.M{
+		{"$match": match},
+		{"$sort": bson.D{{Key: TaskIDKey, Value: 1}, {Key: ExecutionKey, Value: -1}}},
+		{"$group": bson.M{
+			"_id": "$" + TaskIDKey,
+			"doc": bson.M{"$first": "$$ROOT"},
+		}},
+		{"$replaceRoot": bson.M{"newRoot": "$doc"}},
+		{"$unwind": "$" + FilesKey},
+		{"$group": bson.M{
+			"_id": bson.M{
+				VersionKey:      "$" + VersionKey,
+				BuildVariantKey: "$" + BuildVariantKey,
+				PathKey:         "$" + bsonutil.GetDottedKeyName(FilesKey, PathKey),
+			},
+			LinesCoveredKey:        bson.M{"$max": "$" + bsonutil.GetDottedKeyName(FilesKey, LinesCoveredKey)},
+			LinesTotalKey:          bson.M{"$max": "$" + bsonutil.GetDottedKeyName(FilesKey, LinesTotalKey)},
+			RevisionKey:            bson.M{"$first": "$" + RevisionKey},
+			RevisionOrderNumberKey: bson.M{"$first": "$" + RevisionOrderNumberKey},
+			CreateTimeKey:          bson.M{"$min": "$" + CreateTimeKey},
+		}},
+		{"$group": bson.M{
+			"_id": bson.M{
+				VersionKey:      "$" + bsonutil.GetDottedKeyName("_id", VersionKey),
+				BuildVariantKey: "$" + bsonutil.GetDottedKeyName("_id", BuildVariantKey),
+			},
+			LinesCoveredKey:        bson.M{"$sum": "$" + LinesCoveredKey},
+			LinesTotalKey:          bson.M{"$sum": "$" + LinesTotalKey},
+			numFilesKey:            bson.M{"$sum": 1},
+			RevisionKey:            bson.M{"$first": "$" + RevisionKey},
+			RevisionOrderNumberKey: bson.M{"$first": "$" + RevisionOrderNumberKey},
+			CreateTimeKey:          bson.M{"$min": "$" + CreateTimeKey},
+		}},
+		{"$project": bson.M{
+			"_id":                  0,
+			VersionKey:             "$" + bsonutil.GetDottedKeyName("_id", VersionKey),
+			BuildVariantKey:        "$" + bsonutil.GetDottedKeyName("_id", BuildVariantKey),
+			LinesCoveredKey:        1,
+			LinesTotalKey:          1,
+			numFilesKey:            1,
+			RevisionKey:            1,
+			RevisionOrderNumberKey: 1,
+			CreateTimeKey:          1,
+		}},
+		{"$sort": bson.D{{Key: RevisionOrderNumberKey, Value: -1}, {Key: BuildVariantKey, Value: 1}}},
+	}
+	if limit > 0 {
+		pipeline = append(pipeline, bson.M{"$limit": limit})
+	}
+
+	var results []VersionCoverage
+	if err := db.Aggregate(Collection, pipeline, &results); err != nil {
+		return nil, errors.Wrap(err, "aggregating coverage by version and build variant")
+	}
+	return results, nil
+}
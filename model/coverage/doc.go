@@ -0,0 +1,3 @@
+// Package coverage models the code coverage reported by tasks and aggregates
+// it by version and build variant.
+package coverage
@@ -0,0 +1,294 @@
+package coverage
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// Formats of coverage reports that can be parsed.
+	FormatGo        = "go"
+	FormatLCOV      = "lcov"
+	FormatCobertura = "cobertura"
+)
+
+// ValidFormats are the formats of coverage reports that can be parsed.
+var ValidFormats = []string{FormatGo, FormatLCOV, FormatCobertura}
+
+// maxReportLineSize is the longest line that can be read from a line-based
+// coverage report.
+const maxReportLineSize = 1024 * 1024
+
+// Report accumulates the coverage of source files from one or more coverage
+// reports. Reports in line-based formats count lines, and a line is covered
+// if any report covers it. Go cover profiles count statements, as "go tool
+// cover" does, and a block of statements is covered if any profile covers it.
+type Report struct {
+	lines  map[string]map[int]bool
+	blocks map[string]map[string]goBlock
+}
+
+// goBlock is a block of statements in a Go cover profile.
+type goBlock struct {
+	statements int
+	covered    bool
+}
+
+// NewReport returns an empty coverage report.
+func NewReport() *Report {
+	return &Report{
+		lines:  map[string]map[int]bool{},
+		blocks: map[string]map[string]goBlock{},
+	}
+}
+
+// Add parses the coverage report in the given format and adds its coverage to
+// the report. If the format is empty, it is detected from the content.
+func (r *Report) Add(in io.Reader, format string) error {
+	br := bufio.NewReader(in)
+	if format == "" {
+		// The error is ignored because a short report can still be detected.
+		head, _ := br.Peek(512)
+		var err error
+		if format, err = DetectFormat(head); err != nil {
+			return err
+		}
+	}
+
+	switch format {
+	case FormatGo:
+		return errors.Wrap(r.parseGo(br), "parsing Go cover profile")
+	case FormatLCOV:
+		return errors.Wrap(r.parseLCOV(br), "parsing LCOV report")
+	case FormatCobertura:
+		return errors.Wrap(r.parseCobertura(br), "parsing Cobertura report")
+	default:
+		return errors.Errorf("unsupported coverage format '%s'", format)
+	}
+}
+
+// DetectFormat returns the format of the coverage report that starts with
+// head.
+func DetectFormat(head []byte) (string, error) {
+	head = bytes.TrimPrefix(head, []byte("\xef\xbb\xbf"))
+	trimmed := bytes.TrimSpace(head)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("mode:")):
+		return FormatGo, nil
+	case bytes.HasPrefix(trimmed, []byte("<")):
+		return FormatCobertura, nil
+	}
+	for _, line := range bytes.Split(trimmed, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if bytes.HasPrefix(line, []byte("TN:")) || bytes.HasPrefix(line, []byte("SF:")) {
+			return FormatLCOV, nil
+		}
+	}
+	return "", errors.New("could not detect the format of the coverage report")
+}
+
+// Files returns the coverage of each file in the report.
+func (r *Report) Files() []FileCoverage {
+	summaries := map[string]*Summary{}
+	summaryFor := func(path string) *Summary {
+		if _, ok := summaries[path]; !ok {
+			summaries[path] = &Summary{}
+		}
+		return summaries[path]
+	}
+	for path, lines := range r.lines {
+		summary := summaryFor(path)
+		for _, covered := range lines {
+			summary.LinesTotal++
+			if covered {
+				summary.LinesCovered++
+			}
+		}
+	}
+	for path, blocks := range r.blocks {
+		summary := summaryFor(path)
+		for _, block := range blocks {
+			summary.LinesTotal += block.statements
+			if block.covered {
+				summary.LinesCovered += block.statements
+			}
+		}
+	}
+
+	files := make([]FileCoverage, 0, len(summaries))
+	for path, summary := range summaries {
+		files = append(files, FileCoverage{Path: path, Summary: *summary})
+	}
+	sortFiles(files)
+	return files
+}
+
+func (r *Report) addLine(path string, line int, covered bool) {
+	lines, ok := r.lines[path]
+	if !ok {
+		lines = map[int]bool{}
+		r.lines[path] = lines
+	}
+	lines[line] = lines[line] || covered
+}
+
+func (r *Report) addGoBlock(path, blockRange string, statements int, covered bool) {
+	blocks, ok := r.blocks[path]
+	if !ok {
+		blocks = map[string]goBlock{}
+		r.blocks[path] = blocks
+	}
+	block := blocks[blockRange]
+	block.statements = statements
+	block.covered = block.covered || covered
+	blocks[blockRange] = block
+}
+
+func newReportScanner(in io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxReportLineSize)
+	return scanner
+}
+
+// parseGo parses a profile written by "go test -coverprofile", where each line
+// after the mode is a block of the form
+// "file.go:startLine.startCol,endLine.endCol numStatements count". Blocks
+// with the same range, such as those from profiles of different test
+// binaries, are the same statements.
+func (r *Report) parseGo(in io.Reader) error {
+	scanner := newReportScanner(in)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "mode:") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return errors.Errorf("line %d: malformed block '%s'", lineNum, line)
+		}
+		sep := strings.LastIndex(fields[0], ":")
+		if sep < 0 {
+			return errors.Errorf("line %d: missing file name in block '%s'", lineNum, line)
+		}
+		path, blockRange := fields[0][:sep], fields[0][sep+1:]
+
+		if _, _, err := parseGoBlockRange(blockRange); err != nil {
+			return errors.Wrapf(err, "line %d", lineNum)
+		}
+		statements, err := strconv.Atoi(fields[1])
+		if err != nil || statements < 0 {
+			return errors.Errorf("line %d: invalid number of statements '%s'", lineNum, fields[1])
+		}
+		count, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return errors.Wrapf(err, "line %d: parsing count", lineNum)
+		}
+		r.addGoBlock(path, blockRange, statements, count > 0)
+	}
+	return scanner.Err()
+}
+
+// parseGoBlockRange returns the first and last lines of a range of the form
+// "startLine.startCol,endLine.endCol".
+func parseGoBlockRange(blockRange string) (int, int, error) {
+	parts := strings.Split(blockRange, ",")
+	if len(parts) != 2 {
+		return 0, 0, errors.Errorf("malformed block range '%s'", blockRange)
+	}
+	var lines [2]int
+	for i, part := range parts {
+		line, err := strconv.Atoi(strings.Split(part, ".")[0])
+		if err != nil {
+			return 0, 0, errors.Wrapf(err, "parsing block range '%s'", blockRange)
+		}
+		lines[i] = line
+	}
+	if lines[1] < lines[0] {
+		return 0, 0, errors.Errorf("block range '%s' ends before it starts", blockRange)
+	}
+	return lines[0], lines[1], nil
+}
+
+// parseLCOV parses the line records of an LCOV tracefile, where each source
+// file's records start with "SF:path", have a "DA:line,hits" record per
+// line, and end with "end_of_record".
+func (r *Report) parseLCOV(in io.Reader) error {
+	scanner := newReportScanner(in)
+	lineNum := 0
+	path := ""
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "SF:"):
+			path = strings.TrimPrefix(line, "SF:")
+		case line == "end_of_record":
+			path = ""
+		case strings.HasPrefix(line, "DA:"):
+			if path == "" {
+				return errors.Errorf("line %d: line record outside of a source file", lineNum)
+			}
+			parts := strings.Split(strings.TrimPrefix(line, "DA:"), ",")
+			if len(parts) < 2 {
+				return errors.Errorf("line %d: malformed line record '%s'", lineNum, line)
+			}
+			sourceLine, err := strconv.Atoi(parts[0])
+			if err != nil {
+				return errors.Wrapf(err, "line %d: parsing line number", lineNum)
+			}
+			// Some tools write hit counts that overflow as negative numbers,
+			// so only the sign matters.
+			hits, err := strconv.ParseFloat(parts[1], 64)
+			if err != nil {
+				return errors.Wrapf(err, "line %d: parsing hit count", lineNum)
+			}
+			r.addLine(path, sourceLine, hits != 0)
+		}
+	}
+	return scanner.Err()
+}
+
+type coberturaReport struct {
+	Packages []struct {
+		Classes []struct {
+			Filename string `xml:"filename,attr"`
+			Lines    []struct {
+				Number int    `xml:"number,attr"`
+				Hits   string `xml:"hits,attr"`
+			} `xml:"lines>line"`
+		} `xml:"classes>class"`
+	} `xml:"packages>package"`
+}
+
+// parseCobertura parses the per-line hits of each class in a Cobertura XML
+// report. Classes in the same file, such as inner classes, are combined.
+func (r *Report) parseCobertura(in io.Reader) error {
+	report := coberturaReport{}
+	if err := xml.NewDecoder(in).Decode(&report); err != nil {
+		return errors.Wrap(err, "decoding XML")
+	}
+	for _, pkg := range report.Packages {
+		for _, class := range pkg.Classes {
+			if class.Filename == "" {
+				return errors.New("class is missing a file name")
+			}
+			for _, line := range class.Lines {
+				hits, err := strconv.ParseFloat(line.Hits, 64)
+				if err != nil {
+					return errors.Wrapf(err, "parsing hits of line %d in '%s'", line.Number, class.Filename)
+				}
+				r.addLine(class.Filename, line.Number, hits != 0)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package coverage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGoProfile = `mode: set
+github.com/evergreen-ci/evergreen/util/math.go:10.30,12.2 2 1
+github.com/evergreen-ci/evergreen/util/math.go:14.30,15.10 1 0
+github.com/evergreen-ci/evergreen/util/math.go:15.10,17.3 1 1
+github.com/evergreen-ci/evergreen/util/strings.go:5.20,6.2 1 0
+`
+
+const testLCOVReport = `TN:
+SF:src/math.js
+FN:1,add
+DA:1,4
+DA:2,4
+DA:3,0
+LF:3
+LH:2
+end_of_record
+SF:src/strings.js
+DA:1,0
+end_of_record
+`
+
+const testCoberturaReport = `<?xml version="1.0" ?>
+<!DOCTYPE coverage SYSTEM "http://cobertura.sourceforge.net/xml/coverage-04.dtd">
+<coverage line-rate="0.5" version="1.9">
+	<sources><source>/src</source></sources>
+	<packages>
+		<package name="app">
+			<classes>
+				<class filename="app/math.py" name="math.py">
+					<lines>
+						<line hits="3" number="1"/>
+						<line hits="0" number="2"/>
+					</lines>
+				</class>
+				<class filename="app/math.py" name="math.py$Inner">
+					<lines>
+						<line hits="1" number="2"/>
+						<line hits="0" number="5"/>
+					</lines>
+				</class>
+			</classes>
+		</package>
+	</packages>
+</coverage>
+`
+
+func TestDetectFormat(t *testing.T) {
+	for format, report := range map[string]string{
+		FormatGo:        testGoProfile,
+		FormatLCOV:      testLCOVReport,
+		FormatCobertura: testCoberturaReport,
+	} {
+		detected, err := DetectFormat([]byte(report))
+		require.NoError(t, err)
+		assert.Equal(t, format, detected)
+	}
+
+	_, err := DetectFormat([]byte("PASS\nok  \tgithub.com/evergreen-ci/evergreen\t0.1s\n"))
+	assert.Error(t, err)
+}
+
+func TestReport(t *testing.T) {
+	t.Run("GoProfile", func(t *testing.T) {
+		r := NewReport()
+		require.NoError(t, r.Add(strings.NewReader(testGoProfile), FormatGo))
+		// Statements are counted rather than lines, as "go tool cover"
+		// does.
+		assert.Equal(t, []FileCoverage{
+			{Path: "github.com/evergreen-ci/evergreen/util/math.go", Summary: Summary{LinesCovered: 3, LinesTotal: 4}},
+			{Path: "github.com/evergreen-ci/evergreen/util/strings.go", Summary: Summary{LinesCovered: 0, LinesTotal: 1}},
+		}, r.Files())
+	})
+	t.Run("CombinesGoProfiles", func(t *testing.T) {
+		r := NewReport()
+		require.NoError(t, r.Add(strings.NewReader(testGoProfile), FormatGo))
+		require.NoError(t, r.Add(strings.NewReader("mode: set\ngithub.com/evergreen-ci/evergreen/util/math.go:14.30,15.10 1 1\n"), FormatGo))
+		assert.Equal(t, []FileCoverage{
+			{Path: "github.com/evergreen-ci/evergreen/util/math.go", Summary: Summary{LinesCovered: 4, LinesTotal: 4}},
+			{Path: "github.com/evergreen-ci/evergreen/util/strings.go", Summary: Summary{LinesCovered: 0, LinesTotal: 1}},
+		}, r.Files())
+	})
+	t.Run("LCOV", func(t *testing.T) {
+		r := NewReport()
+		require.NoError(t, r.Add(strings.NewReader(testLCOVReport), FormatLCOV))
+		assert.Equal(t, []FileCoverage{
+			{Path: "src/math.js", Summary: Summary{LinesCovered: 2, LinesTotal: 3}},
+			{Path: "src/strings.js", Summary: Summary{LinesCovered: 0, LinesTotal: 1}},
+		}, r.Files())
+	})
+	t.Run("Cobertura", func(t *testing.T) {
+		r := NewReport()
+		require.NoError(t, r.Add(strings.NewReader(testCoberturaReport), FormatCobertura))
+		assert.Equal(t, []FileCoverage{
+			{Path: "app/math.py", Summary: Summary{LinesCovered: 2, LinesTotal: 3}},
+		}, r.Files())
+	})
+	t.Run("DetectsFormat", func(t *testing.T) {
+		r := NewReport()
+		require.NoError(t, r.Add(strings.NewReader(testLCOVReport), ""))
+		assert.Len(t, r.Files(), 2)
+	})
+	t.Run("CombinesReports", func(t *testing.T) {
+		r := NewReport()
+		require.NoError(t, r.Add(strings.NewReader(testLCOVReport), FormatLCOV))
+		require.NoError(t, r.Add(strings.NewReader("SF:src/math.js\nDA:3,1\nDA:4,0\nend_of_record\n"), FormatLCOV))
+		assert.Equal(t, []FileCoverage{
+			{Path: "src/math.js", Summary: Summary{LinesCovered: 3, LinesTotal: 4}},
+			{Path: "src/strings.js", Summary: Summary{LinesCovered: 0, LinesTotal: 1}},
+		}, r.Files())
+	})
+	t.Run("MalformedGoProfile", func(t *testing.T) {
+		r := NewReport()
+		assert.Error(t, r.Add(strings.NewReader("mode: set\nmath.go:10.30 2 1\n"), FormatGo))
+		assert.Error(t, r.Add(strings.NewReader("mode: set\nmath.go:10.30,12.2 x 1\n"), FormatGo))
+	})
+	t.Run("LCOVLineOutsideOfFile", func(t *testing.T) {
+		r := NewReport()
+		assert.Error(t, r.Add(strings.NewReader("DA:1,1\n"), FormatLCOV))
+	})
+	t.Run("InvalidFormat", func(t *testing.T) {
+		r := NewReport()
+		assert.Error(t, r.Add(strings.NewReader(testGoProfile), "jacoco"))
+	})
+}
@@ -17,6 +17,8 @@ const (
 	CommitQueueEnqueueFailed = "ENQUEUE_FAILED"
 	CommitQueueStartTest     = "START_TEST"
 	CommitQueueConcludeTest  = "CONCLUDE_TEST"
+	CommitQueueRestartTest   = "RESTART_TEST"
+	CommitQueueBisectBatch   = "BISECT_BATCH"
 )
 
 type PRInfo struct {
@@ -50,6 +52,7 @@ func logCommitQueueEvent(patchID, eventType string, data *CommitQueueEventData)
 type CommitQueueEventData struct {
 	Status string `bson:"status,omitempty" json:"status,omitempty"`
 	Error  string `bson:"error,omitempty" json:"error,omitempty"`
+	Reason string `bson:"reason,omitempty" json:"reason,omitempty"`
 }
 
 func LogCommitQueueStartTestEvent(patchID string) {
@@ -60,12 +63,40 @@ func LogCommitQueueStartTestEvent(patchID string) {
 }
 
 func LogCommitQueueConcludeTest(patchID, status string) {
+	LogCommitQueueConcludeTestWithReason(patchID, status, "")
+}
+
+// LogCommitQueueConcludeTestWithReason logs that the item's test concluded
+// and why, so that the reason is included in the notifications sent to the
+// item's author.
+func LogCommitQueueConcludeTestWithReason(patchID, status, reason string) {
 	data := &CommitQueueEventData{
 		Status: status,
+		Reason: reason,
 	}
 	logCommitQueueEvent(patchID, CommitQueueConcludeTest, data)
 }
 
+// LogCommitQueueRestartTest logs that the item's version was restarted
+// because the items it was being tested with changed.
+func LogCommitQueueRestartTest(patchID, reason string) {
+	data := &CommitQueueEventData{
+		Status: evergreen.MergeTestRestarted,
+		Reason: reason,
+	}
+	logCommitQueueEvent(patchID, CommitQueueRestartTest, data)
+}
+
+// LogCommitQueueBisectBatch logs that the item was part of a batch that
+// failed and is being bisected to find the item that caused the failure.
+func LogCommitQueueBisectBatch(patchID, reason string) {
+	data := &CommitQueueEventData{
+		Status: evergreen.MergeTestBisected,
+		Reason: reason,
+	}
+	logCommitQueueEvent(patchID, CommitQueueBisectBatch, data)
+}
+
 func LogCommitQueueEnqueueFailed(patchID string, err error) {
 	data := &CommitQueueEventData{
 		Status: evergreen.EnqueueFailed,
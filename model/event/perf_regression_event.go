@@ -0,0 +1,54 @@
+package event
+
+import (
+	"time"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+)
+
+func init() {
+	registry.AddType(ResourceTypePerfRegression, perfRegressionEventDataFactory)
+	registry.AllowSubscription(ResourceTypePerfRegression, PerfRegressionDetected)
+}
+
+func perfRegressionEventDataFactory() interface{} {
+	return &PerfRegressionEventData{}
+}
+
+const (
+	ResourceTypePerfRegression = "PERF_REGRESSION"
+	PerfRegressionDetected     = "DETECTED"
+)
+
+// PerfRegressionEventData describes a regression detected in a performance
+// series.
+type PerfRegressionEventData struct {
+	Project       string  `bson:"project" json:"project"`
+	Variant       string  `bson:"variant" json:"variant"`
+	TaskName      string  `bson:"task_name" json:"task_name"`
+	TestName      string  `bson:"test_name" json:"test_name"`
+	Metric        string  `bson:"metric" json:"metric"`
+	Version       string  `bson:"version" json:"version"`
+	PercentChange float64 `bson:"percent_change" json:"percent_change"`
+}
+
+// LogPerfRegressionDetected logs an event for a newly detected regression.
+func LogPerfRegressionDetected(id string, data PerfRegressionEventData) {
+	event := EventLogEntry{
+		Timestamp:    time.Now().Truncate(0).Round(time.Millisecond),
+		ResourceId:   id,
+		ResourceType: ResourceTypePerfRegression,
+		EventType:    PerfRegressionDetected,
+		Data:         &data,
+	}
+
+	logger := NewDBEventLogger(AllLogCollection)
+	if err := logger.LogEvent(&event); err != nil {
+		grip.Error(message.WrapError(err, message.Fields{
+			"resource_type": ResourceTypePerfRegression,
+			"message":       "error logging event",
+			"source":        "event-log-fail",
+		}))
+	}
+}
@@ -2,6 +2,7 @@ package event
 
 import (
 	"fmt"
+	"net/url"
 
 	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
 	"github.com/evergreen-ci/utility"
@@ -18,6 +19,8 @@ const (
 	EvergreenWebhookSubscriberType  = "evergreen-webhook"
 	EmailSubscriberType             = "email"
 	SlackSubscriberType             = "slack"
+	MSTeamsSubscriberType           = "ms-teams"
+	ChatWebhookSubscriberType       = "chat-webhook"
 	EnqueuePatchSubscriberType      = "enqueue-patch"
 	SubscriberTypeNone              = "none"
 	RunChildPatchSubscriberType     = "run-child-patch"
@@ -31,6 +34,8 @@ var SubscriberTypes = []string{
 	EvergreenWebhookSubscriberType,
 	EmailSubscriberType,
 	SlackSubscriberType,
+	MSTeamsSubscriberType,
+	ChatWebhookSubscriberType,
 	EnqueuePatchSubscriberType,
 	RunChildPatchSubscriberType,
 }
@@ -74,7 +79,8 @@ func (s *Subscriber) SetBSON(raw mgobson.Raw) error {
 		s.Target = &WebhookSubscriber{}
 	case JIRAIssueSubscriberType:
 		s.Target = &JIRAIssueSubscriber{}
-	case JIRACommentSubscriberType, EmailSubscriberType, SlackSubscriberType:
+	case JIRACommentSubscriberType, EmailSubscriberType, SlackSubscriberType,
+		MSTeamsSubscriberType, ChatWebhookSubscriberType:
 		str := ""
 		s.Target = &str
 	case RunChildPatchSubscriberType:
@@ -117,10 +123,41 @@ func (s *Subscriber) Validate() error {
 	}
 	if s.Target == nil {
 		catcher.Add(errors.New("target is required for subscriber"))
+	} else if s.Type == MSTeamsSubscriberType || s.Type == ChatWebhookSubscriberType {
+		catcher.Add(validateChatWebhookURL(s.Target))
 	}
 	return catcher.Resolve()
 }
 
+// validateChatWebhookURL checks that the target of a chat webhook subscriber
+// (e.g. a Microsoft Teams incoming webhook) is an absolute HTTP(S) URL.
+func validateChatWebhookURL(target interface{}) error {
+	var rawURL string
+	switch v := target.(type) {
+	case string:
+		rawURL = v
+	case *string:
+		if v != nil {
+			rawURL = *v
+		}
+	default:
+		return errors.Errorf("chat webhook target must be a URL, not '%T'", target)
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.Wrapf(err, "invalid chat webhook URL '%s'", rawURL)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.Errorf("chat webhook URL '%s' must use http or https", rawURL)
+	}
+	if u.Host == "" {
+		return errors.Errorf("chat webhook URL '%s' must have a host", rawURL)
+	}
+
+	return nil
+}
+
 type WebhookSubscriber struct {
 	URL     string          `bson:"url"`
 	Secret  []byte          `bson:"secret"`
@@ -139,6 +176,64 @@ func (s *WebhookSubscriber) String() string {
 	return s.URL
 }
 
+// RedactedWebhookSecret replaces webhook secrets in subscriptions that are
+// exported. Saving a webhook subscriber with this secret keeps the secret of
+// the existing subscription.
+const RedactedWebhookSecret = "{REDACTED}"
+
+// webhookTarget returns a copy of the subscriber's webhook target, or nil if
+// the subscriber is not a webhook.
+func (s *Subscriber) webhookTarget() *WebhookSubscriber {
+	if s.Type != EvergreenWebhookSubscriberType {
+		return nil
+	}
+	switch v := s.Target.(type) {
+	case *WebhookSubscriber:
+		if v == nil {
+			return nil
+		}
+		target := *v
+		return &target
+	case WebhookSubscriber:
+		return &v
+	}
+	return nil
+}
+
+// RedactSecrets replaces the secret of a webhook subscriber with
+// RedactedWebhookSecret.
+func (s *Subscriber) RedactSecrets() {
+	target := s.webhookTarget()
+	if target == nil {
+		return
+	}
+	target.Secret = []byte(RedactedWebhookSecret)
+	s.Target = target
+}
+
+// HasRedactedSecret returns true if the subscriber is a webhook whose secret
+// has been redacted.
+func (s *Subscriber) HasRedactedSecret() bool {
+	target := s.webhookTarget()
+	return target != nil && string(target.Secret) == RedactedWebhookSecret
+}
+
+// RestoreRedactedSecret replaces a redacted webhook secret with the secret of
+// the existing subscriber.
+func (s *Subscriber) RestoreRedactedSecret(existing Subscriber) error {
+	if !s.HasRedactedSecret() {
+		return nil
+	}
+	existingTarget := existing.webhookTarget()
+	if existingTarget == nil || len(existingTarget.Secret) == 0 {
+		return errors.New("webhook secret is redacted but the existing subscriber has no secret")
+	}
+	target := s.webhookTarget()
+	target.Secret = existingTarget.Secret
+	s.Target = target
+	return nil
+}
+
 type JIRAIssueSubscriber struct {
 	Project   string `bson:"project"`
 	IssueType string `bson:"issue_type"`
@@ -223,3 +318,17 @@ func NewSlackSubscriber(t string) Subscriber {
 		Target: t,
 	}
 }
+
+func NewMSTeamsSubscriber(webhookURL string) Subscriber {
+	return Subscriber{
+		Type:   MSTeamsSubscriberType,
+		Target: webhookURL,
+	}
+}
+
+func NewChatWebhookSubscriber(webhookURL string) Subscriber {
+	return Subscriber{
+		Type:   ChatWebhookSubscriberType,
+		Target: webhookURL,
+	}
+}
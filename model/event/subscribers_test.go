@@ -117,3 +117,54 @@ func TestSubscribersStringerWithMissingAttributes(t *testing.T) {
 
 	assert.True(strings.HasSuffix(webhookSub.String(), "NIL_URL"))
 }
+
+func TestChatWebhookSubscriberValidate(t *testing.T) {
+	for _, subscriberType := range []string{MSTeamsSubscriberType, ChatWebhookSubscriberType} {
+		t.Run(subscriberType, func(t *testing.T) {
+			webhookURL := "https://example.webhook.office.com/webhookb2/abc"
+			sub := Subscriber{Type: subscriberType, Target: &webhookURL}
+			assert.NoError(t, sub.Validate())
+
+			sub.Target = "https://chat.example.com/hooks/abc"
+			assert.NoError(t, sub.Validate())
+
+			sub.Target = "#general"
+			assert.Error(t, sub.Validate())
+
+			sub.Target = "ftp://example.com/hooks/abc"
+			assert.Error(t, sub.Validate())
+
+			sub.Target = &WebhookSubscriber{URL: webhookURL}
+			assert.Error(t, sub.Validate())
+		})
+	}
+}
+
+func TestWebhookSubscriberRedaction(t *testing.T) {
+	existing := Subscriber{
+		Type: EvergreenWebhookSubscriberType,
+		Target: &WebhookSubscriber{
+			URL:    "https://example.com/hook",
+			Secret: []byte("shh"),
+		},
+	}
+
+	sub := existing
+	sub.RedactSecrets()
+	assert.True(t, sub.HasRedactedSecret())
+	assert.Equal(t, []byte(RedactedWebhookSecret), sub.Target.(*WebhookSubscriber).Secret)
+	assert.Equal(t, []byte("shh"), existing.Target.(*WebhookSubscriber).Secret)
+	assert.False(t, existing.HasRedactedSecret())
+
+	require.NoError(t, sub.RestoreRedactedSecret(existing))
+	assert.False(t, sub.HasRedactedSecret())
+	assert.Equal(t, []byte("shh"), sub.Target.(*WebhookSubscriber).Secret)
+
+	sub.RedactSecrets()
+	assert.Error(t, sub.RestoreRedactedSecret(Subscriber{Type: EmailSubscriberType, Target: "a@example.com"}))
+
+	email := Subscriber{Type: EmailSubscriberType, Target: "a@example.com"}
+	email.RedactSecrets()
+	assert.Equal(t, "a@example.com", email.Target)
+	assert.False(t, email.HasRedactedSecret())
+}
@@ -49,6 +49,9 @@ const (
 	VersionPercentChangeKey                           = "version-percent-change"
 	TestRegexKey                                      = "test-regex"
 	RenotifyIntervalKey                               = "renotify-interval"
+	PersistentFailureVersionsKey                      = "persistent-failure-versions"
+	PersistentFailureHoursKey                         = "persistent-failure-hours"
+	EscalationTierKey                                 = "escalation-tier"
 	ImplicitSubscriptionPatchOutcome                  = "patch-outcome"
 	ImplicitSubscriptionPatchFirstFailure             = "patch-first-failure"
 	ImplicitSubscriptionBuildBreak                    = "build-break"
@@ -61,6 +64,7 @@ const (
 	ObjectBuild                     = "build"
 	ObjectHost                      = "host"
 	ObjectPatch                     = "patch"
+	ObjectPerfRegression            = "perf-regression"
 
 	TriggerOutcome                   = "outcome"
 	TriggerGithubCheckOutcome        = "github-check-outcome"
@@ -73,8 +77,23 @@ const (
 	TriggerPatchStarted              = "started"
 	TriggerTaskFirstFailureInVersion = "first-failure-in-version"
 	TriggerTaskStarted               = "task-started"
+	TriggerPersistentFailure         = "persistent-failure"
 )
 
+// Escalation tiers for persistent failure subscriptions, in the order that
+// they're expected to be notified.
+const (
+	EscalationTierCommitter     = "committer"
+	EscalationTierProjectAdmins = "project-admins"
+	EscalationTierPaging        = "paging"
+)
+
+var EscalationTiers = []string{
+	EscalationTierCommitter,
+	EscalationTierProjectAdmins,
+	EscalationTierPaging,
+}
+
 type Subscription struct {
 	ID             string            `bson:"_id"`
 	ResourceType   string            `bson:"type"`
@@ -361,6 +380,37 @@ func (s *Subscription) runCustomValidation() error {
 	if renotifyInterval, ok := s.TriggerData[RenotifyIntervalKey]; ok {
 		catcher.Add(validatePositiveInt(renotifyInterval))
 	}
+	if s.Trigger == TriggerPersistentFailure {
+		catcher.Add(s.validatePersistentFailure())
+	}
+	return catcher.Resolve()
+}
+
+func (s *Subscription) validatePersistentFailure() error {
+	catcher := grip.NewBasicCatcher()
+	hasThreshold := false
+	for _, key := range []string{PersistentFailureVersionsKey, PersistentFailureHoursKey} {
+		val, ok := s.TriggerData[key]
+		if !ok {
+			continue
+		}
+		catcher.Add(validatePositiveInt(val))
+		if n, err := strconv.Atoi(val); err == nil && n > 0 {
+			hasThreshold = true
+		}
+	}
+	if !hasThreshold {
+		catcher.Errorf("%s subscriptions must specify a nonzero %s or %s", TriggerPersistentFailure, PersistentFailureVersionsKey, PersistentFailureHoursKey)
+	}
+
+	tier := s.TriggerData[EscalationTierKey]
+	if tier != "" && !utility.StringSliceContains(EscalationTiers, tier) {
+		catcher.Errorf("'%s' is not a valid escalation tier", tier)
+	}
+	if tier == EscalationTierPaging && s.Subscriber.Type != EvergreenWebhookSubscriberType && s.Subscriber.Type != ChatWebhookSubscriberType {
+		catcher.Errorf("the %s escalation tier requires a webhook subscriber", EscalationTierPaging)
+	}
+
 	return catcher.Resolve()
 }
 
@@ -441,6 +491,46 @@ func FindSubscriptionsByOwner(owner string, ownerType OwnerType) ([]Subscription
 	return subscriptions, errors.Wrapf(err, "error retrieving subscriptions for owner %s", owner)
 }
 
+// FindTimedPersistentFailureSubscriptions returns the persistent failure
+// subscriptions that escalate after a number of hours.
+func FindTimedPersistentFailureSubscriptions() ([]Subscription, error) {
+	query := db.Query(bson.M{
+		subscriptionTriggerKey: TriggerPersistentFailure,
+		bsonutil.GetDottedKeyName(subscriptionTriggerDataKey, PersistentFailureHoursKey): bson.M{"$nin": []interface{}{nil, ""}},
+	})
+	subscriptions := []Subscription{}
+	err := db.FindAllQ(SubscriptionsCollection, query, &subscriptions)
+	return subscriptions, errors.Wrap(err, "error retrieving timed persistent failure subscriptions")
+}
+
+// ProjectConfigSubscriptionID returns the ID of a subscription that is
+// declared in the given project's configuration file. The key identifies the
+// declaration within the project.
+func ProjectConfigSubscriptionID(projectID, key string) string {
+	return projectConfigSubscriptionIDPrefix(projectID) + key
+}
+
+func projectConfigSubscriptionIDPrefix(projectID string) string {
+	return fmt.Sprintf("project-config-%s-", projectID)
+}
+
+// FindProjectConfigSubscriptions returns the subscriptions owned by the
+// project that were created from the notifications section of its
+// configuration file.
+func FindProjectConfigSubscriptions(projectID string) ([]Subscription, error) {
+	if len(projectID) == 0 {
+		return nil, nil
+	}
+	query := db.Query(bson.M{
+		subscriptionIDKey:        bson.M{"$regex": "^" + regexp.QuoteMeta(projectConfigSubscriptionIDPrefix(projectID))},
+		subscriptionOwnerKey:     projectID,
+		subscriptionOwnerTypeKey: OwnerTypeProject,
+	})
+	subscriptions := []Subscription{}
+	err := db.FindAllQ(SubscriptionsCollection, query, &subscriptions)
+	return subscriptions, errors.Wrapf(err, "error retrieving config subscriptions for project %s", projectID)
+}
+
 func IsValidOwnerType(in string) bool {
 	switch in {
 	case string(OwnerTypePerson):
@@ -6,6 +6,7 @@ import (
 
 	"github.com/evergreen-ci/evergreen/db"
 	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -320,3 +321,40 @@ func (s *subscriptionsSuite) TestCreateOrUpdateImplicitSubscription() {
 	s.Require().Len(subscriptions, 1)
 	s.Equal(subscriptions[0].ID, subscription.ID)
 }
+
+func TestPersistentFailureSubscriptionValidate(t *testing.T) {
+	sub := Subscription{
+		ResourceType: ResourceTypeTask,
+		Trigger:      TriggerPersistentFailure,
+		Selectors: []Selector{
+			{
+				Type: SelectorProject,
+				Data: "project",
+			},
+		},
+		Subscriber: Subscriber{
+			Type:   EmailSubscriberType,
+			Target: "committer@example.com",
+		},
+		OwnerType: OwnerTypeProject,
+	}
+	assert.Error(t, sub.Validate(), "thresholds are required")
+
+	sub.TriggerData = map[string]string{PersistentFailureVersionsKey: "0"}
+	assert.Error(t, sub.Validate(), "thresholds must be nonzero")
+
+	sub.TriggerData = map[string]string{PersistentFailureVersionsKey: "3"}
+	assert.NoError(t, sub.Validate())
+
+	sub.TriggerData = map[string]string{PersistentFailureHoursKey: "12", EscalationTierKey: "nobody"}
+	assert.Error(t, sub.Validate(), "tier must be valid")
+
+	sub.TriggerData[EscalationTierKey] = EscalationTierPaging
+	assert.Error(t, sub.Validate(), "paging tier requires a webhook")
+
+	sub.Subscriber = Subscriber{
+		Type:   ChatWebhookSubscriberType,
+		Target: "https://pager.example.com/hook",
+	}
+	assert.NoError(t, sub.Validate())
+}
@@ -13,6 +13,7 @@ func init() {
 	registry.AllowSubscription(ResourceTypeTask, TaskStarted)
 	registry.AllowSubscription(ResourceTypeTask, TaskFinished)
 	registry.AllowSubscription(ResourceTypeTask, TaskBlocked)
+	registry.AllowSubscription(ResourceTypeTask, TaskStillFailing)
 }
 
 const (
@@ -26,6 +27,7 @@ const (
 	TaskStarted                = "TASK_STARTED"
 	TaskFinished               = "TASK_FINISHED"
 	TaskBlocked                = "TASK_BLOCKED"
+	TaskStillFailing           = "TASK_STILL_FAILING"
 	TaskRestarted              = "TASK_RESTARTED"
 	TaskActivated              = "TASK_ACTIVATED"
 	TaskDeactivated            = "TASK_DEACTIVATED"
@@ -134,6 +136,13 @@ func LogTaskBlocked(taskId string, execution int) {
 	logTaskEvent(taskId, TaskBlocked, TaskEventData{Execution: execution})
 }
 
+// LogTaskStillFailing logs that a mainline task is still failing, so that
+// persistent failures can escalate once they've been failing for long enough
+// even if the task doesn't run again.
+func LogTaskStillFailing(taskId string, execution int) {
+	logTaskEvent(taskId, TaskStillFailing, TaskEventData{Execution: execution})
+}
+
 func LogTaskActivated(taskId string, execution int, userId string) {
 	logTaskEvent(taskId, TaskActivated, TaskEventData{Execution: execution, UserId: userId})
 }
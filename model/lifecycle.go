@@ -665,6 +665,8 @@ func CreateBuildFromVersionNoInsert(args BuildCreateArgs) (*build.Build, task.Ta
 		rev = args.Version.Id
 	} else if args.Version.Requester == evergreen.GitTagRequester {
 		rev = fmt.Sprintf("%s_%s", args.SourceRev, args.Version.TriggeredByGitTag.Tag)
+	} else if args.Version.Requester == evergreen.GithubMergeRequester {
+		rev = fmt.Sprintf("merge_group_%s", args.Version.Revision)
 	}
 
 	// create a new build id
@@ -28,16 +28,27 @@ var (
 	payloadKey    = bsonutil.MustHaveTag(Notification{}, "Payload")
 	sentAtKey     = bsonutil.MustHaveTag(Notification{}, "SentAt")
 	errorKey      = bsonutil.MustHaveTag(Notification{}, "Error")
+
+	subscriberKeyKey    = bsonutil.MustHaveTag(Notification{}, "SubscriberKey")
+	metadataKey         = bsonutil.MustHaveTag(Notification{}, "Metadata")
+	suppressedReasonKey = bsonutil.MustHaveTag(Notification{}, "SuppressedReason")
+	collapsedCountKey   = bsonutil.MustHaveTag(Notification{}, "CollapsedCount")
+
+	collapseKeyKey = bsonutil.MustHaveTag(NotificationMetadata{}, "CollapseKey")
 )
 
 type unmarshalNotification struct {
-	ID         string           `bson:"_id"`
-	Subscriber event.Subscriber `bson:"subscriber"`
-	Payload    mgobson.Raw      `bson:"payload"`
+	ID            string           `bson:"_id"`
+	Subscriber    event.Subscriber `bson:"subscriber"`
+	SubscriberKey string           `bson:"subscriber_key,omitempty"`
+	Payload       mgobson.Raw      `bson:"payload"`
 
 	SentAt   time.Time            `bson:"sent_at,omitempty"`
 	Error    string               `bson:"error,omitempty"`
 	Metadata NotificationMetadata `bson:"metadata,omitempty"`
+
+	SuppressedReason string `bson:"suppressed_reason,omitempty"`
+	CollapsedCount   int    `bson:"collapsed_count,omitempty"`
 }
 
 func (d *Notification) UnmarshalBSON(in []byte) error {
@@ -67,6 +78,9 @@ func (n *Notification) SetBSON(raw mgobson.Raw) error {
 	case event.SlackSubscriberType:
 		n.Payload = &SlackPayload{}
 
+	case event.MSTeamsSubscriberType, event.ChatWebhookSubscriberType:
+		n.Payload = &util.ChatWebhook{}
+
 	case event.GithubPullRequestSubscriberType, event.GithubCheckSubscriberType:
 		n.Payload = &message.GithubStatus{}
 
@@ -83,9 +97,12 @@ func (n *Notification) SetBSON(raw mgobson.Raw) error {
 
 	n.ID = temp.ID
 	n.Subscriber = temp.Subscriber
+	n.SubscriberKey = temp.SubscriberKey
 	n.SentAt = temp.SentAt
 	n.Error = temp.Error
 	n.Metadata = temp.Metadata
+	n.SuppressedReason = temp.SuppressedReason
+	n.CollapsedCount = temp.CollapsedCount
 
 	return nil
 }
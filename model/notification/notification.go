@@ -38,25 +38,40 @@ func New(eventID, trigger string, subscriber *event.Subscriber, payload interfac
 	}
 
 	return &Notification{
-		ID:         makeNotificationID(eventID, trigger, subscriber),
-		Subscriber: *subscriber,
-		Payload:    payload,
+		ID:            makeNotificationID(eventID, trigger, subscriber),
+		Subscriber:    *subscriber,
+		SubscriberKey: subscriber.String(),
+		Payload:       payload,
 	}, nil
 }
 
 type Notification struct {
-	ID         string           `bson:"_id"`
-	Subscriber event.Subscriber `bson:"subscriber"`
-	Payload    interface{}      `bson:"payload"`
+	ID            string           `bson:"_id"`
+	Subscriber    event.Subscriber `bson:"subscriber"`
+	SubscriberKey string           `bson:"subscriber_key,omitempty"`
+	Payload       interface{}      `bson:"payload"`
 
 	SentAt   time.Time            `bson:"sent_at,omitempty"`
 	Error    string               `bson:"error,omitempty"`
 	Metadata NotificationMetadata `bson:"metadata,omitempty"`
+
+	// SuppressedReason is set if the notification was processed but
+	// deliberately not sent, e.g. because of a rate limit.
+	SuppressedReason string `bson:"suppressed_reason,omitempty"`
+	// CollapsedCount is the number of identical notifications that were
+	// suppressed and summarized by this one.
+	CollapsedCount int `bson:"collapsed_count,omitempty"`
 }
 
 type NotificationMetadata struct {
 	TaskID        string `bson:"task_id,omitempty"`
 	TaskExecution int    `bson:"task_execution,omitempty"`
+	// CollapseKey identifies notifications that are identical for the
+	// purposes of collapsing them into one, such as the same task failing
+	// in several build variants of a version.
+	CollapseKey string `bson:"collapse_key,omitempty"`
+	// PatchID is set on the notifications of a finished patch's outcome.
+	PatchID string `bson:"patch_id,omitempty"`
 }
 
 // SenderKey returns an evergreen.SenderKey to get a grip sender for this
@@ -78,6 +93,9 @@ func (n *Notification) SenderKey() (evergreen.SenderKey, error) {
 	case event.SlackSubscriberType:
 		return evergreen.SenderSlack, nil
 
+	case event.MSTeamsSubscriberType, event.ChatWebhookSubscriberType:
+		return evergreen.SenderChatWebhook, nil
+
 	case event.GithubPullRequestSubscriberType, event.GithubCheckSubscriberType:
 		return evergreen.SenderGithubStatus, nil
 
@@ -171,6 +189,20 @@ func (n *Notification) Composer(env evergreen.Environment) (message.Composer, er
 
 		return message.NewSlackMessage(level.Notice, *sub, payload.Body, payload.Attachments), nil
 
+	case event.MSTeamsSubscriberType, event.ChatWebhookSubscriberType:
+		sub, ok := n.Subscriber.Target.(*string)
+		if !ok {
+			return nil, errors.Errorf("%s subscriber is invalid", n.Subscriber.Type)
+		}
+
+		payload, ok := n.Payload.(*util.ChatWebhook)
+		if !ok || payload == nil {
+			return nil, errors.Errorf("%s payload is invalid", n.Subscriber.Type)
+		}
+
+		payload.URL = *sub
+		return util.NewChatWebhookMessage(*payload), nil
+
 	case event.GithubPullRequestSubscriberType:
 		sub := n.Subscriber.Target.(*event.GithubPullRequestSubscriber)
 		payload, ok := n.Payload.(*message.GithubStatus)
@@ -260,6 +292,10 @@ func (n *Notification) SetTaskMetadata(ID string, execution int) {
 	n.Metadata.TaskExecution = execution
 }
 
+func (n *Notification) SetCollapseKey(key string) {
+	n.Metadata.CollapseKey = key
+}
+
 type NotificationStats struct {
 	GithubPullRequest int `json:"github_pull_request" bson:"github_pull_request" yaml:"github_pull_request"`
 	JIRAIssue         int `json:"jira_issue" bson:"jira_issue" yaml:"jira_issue"`
@@ -267,6 +303,8 @@ type NotificationStats struct {
 	EvergreenWebhook  int `json:"evergreen_webhook" bson:"evergreen_webhook" yaml:"evergreen_webhook"`
 	Email             int `json:"email" bson:"email" yaml:"email"`
 	Slack             int `json:"slack" bson:"slack" yaml:"slack"`
+	MSTeams           int `json:"ms_teams" bson:"ms_teams" yaml:"ms_teams"`
+	ChatWebhook       int `json:"chat_webhook" bson:"chat_webhook" yaml:"chat_webhook"`
 	GithubCheck       int `json:"github_check" bson:"github_check" yaml:"github_check"`
 	EnqueuePatch      int `json:"enqueue_patch" bson:"enqueue_patch" yaml:"enqueue_patch"`
 }
@@ -325,6 +363,12 @@ func CollectUnsentNotificationStats() (*NotificationStats, error) {
 		case event.SlackSubscriberType:
 			nStats.Slack = data.Count
 
+		case event.MSTeamsSubscriberType:
+			nStats.MSTeams = data.Count
+
+		case event.ChatWebhookSubscriberType:
+			nStats.ChatWebhook = data.Count
+
 		case event.EnqueuePatchSubscriberType:
 			nStats.EnqueuePatch = data.Count
 
@@ -356,6 +356,39 @@ func (s *notificationSuite) TestSlackPayload() {
 	s.True(c.Loggable())
 }
 
+func (s *notificationSuite) TestChatWebhookPayload() {
+	for _, subscriberType := range []string{event.MSTeamsSubscriberType, event.ChatWebhookSubscriberType} {
+		s.Require().NoError(db.ClearCollections(Collection))
+		s.n.ID = "1"
+		s.n.Subscriber.Type = subscriberType
+		webhookURL := "https://example.com/webhook"
+		s.n.Subscriber.Target = &webhookURL
+		s.n.Payload = &util.ChatWebhook{
+			Body: []byte(`{"text": "hi"}`),
+		}
+
+		s.NoError(InsertMany(s.n))
+
+		n, err := Find(s.n.ID)
+		s.NoError(err)
+		s.NotNil(n)
+
+		s.Equal(s.n, *n)
+
+		key, err := n.SenderKey()
+		s.NoError(err)
+		s.Equal(evergreen.SenderChatWebhook, key)
+
+		c, err := n.Composer(s.env)
+		s.NoError(err)
+		s.Require().NotNil(c)
+		s.True(c.Loggable())
+		raw, ok := c.Raw().(*util.ChatWebhook)
+		s.Require().True(ok)
+		s.Equal(webhookURL, raw.URL)
+	}
+}
+
 func (s *notificationSuite) TestGithubPayload() {
 	s.n.ID = "1"
 	s.n.Subscriber.Type = event.GithubPullRequestSubscriberType
@@ -396,7 +429,8 @@ func (s *notificationSuite) TestCollectUnsentNotificationStats() {
 	types := []string{event.GithubPullRequestSubscriberType, event.EmailSubscriberType,
 		event.SlackSubscriberType, event.EvergreenWebhookSubscriberType,
 		event.JIRACommentSubscriberType, event.JIRAIssueSubscriberType,
-		event.EnqueuePatchSubscriberType, event.GithubCheckSubscriberType}
+		event.EnqueuePatchSubscriberType, event.GithubCheckSubscriberType,
+		event.MSTeamsSubscriberType, event.ChatWebhookSubscriberType}
 
 	n := []Notification{}
 	// add one of every notification, unsent
@@ -0,0 +1,318 @@
+package notification
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// SuppressedStatsWindow is how far back suppressed notifications are counted
+// in notification stats.
+const SuppressedStatsWindow = time.Hour
+
+// Reasons that a notification was suppressed instead of sent.
+const (
+	SuppressedSubscriberRateLimit     = "subscriber-rate-limit"
+	SuppressedSubscriberTypeRateLimit = "subscriber-type-rate-limit"
+	SuppressedCollapsed               = "collapsed"
+)
+
+// IsRateLimitable returns whether notifications for the subscriber type may be
+// suppressed by rate limits. Notifications that drive other workflows, such as
+// GitHub statuses and commit queue enqueues, are never rate limited.
+func IsRateLimitable(subscriberType string) bool {
+	switch subscriberType {
+	case event.EmailSubscriberType, event.SlackSubscriberType, event.MSTeamsSubscriberType,
+		event.ChatWebhookSubscriberType, event.JIRACommentSubscriberType, event.JIRAIssueSubscriberType,
+		event.EvergreenWebhookSubscriberType:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsCollapsible returns whether identical notifications for the subscriber
+// type can be merged into a single message with a count.
+func IsCollapsible(subscriberType string) bool {
+	switch subscriberType {
+	case event.EmailSubscriberType, event.SlackSubscriberType, event.MSTeamsSubscriberType,
+		event.ChatWebhookSubscriberType, event.JIRACommentSubscriberType:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarkSuppressed marks the notification as processed without being sent. It
+// returns false if the notification had already been processed.
+func (n *Notification) MarkSuppressed(reason string) (bool, error) {
+	sentAt := time.Now().Truncate(time.Millisecond)
+	ok, err := n.markProcessedIfUnsent(bson.M{
+		sentAtKey:           sentAt,
+		suppressedReasonKey: reason,
+	})
+	if err != nil || !ok {
+		return false, errors.Wrap(err, "failed to mark notification as suppressed")
+	}
+
+	n.SentAt = sentAt
+	n.SuppressedReason = reason
+
+	return true, nil
+}
+
+// Claim marks the notification as sent before it is actually sent, so that
+// identical notifications processed concurrently are collapsed into it. It
+// returns false if the notification had already been processed.
+func (n *Notification) Claim() (bool, error) {
+	sentAt := time.Now().Truncate(time.Millisecond)
+	ok, err := n.markProcessedIfUnsent(bson.M{
+		sentAtKey: sentAt,
+	})
+	if err != nil || !ok {
+		return false, errors.Wrap(err, "failed to claim notification")
+	}
+
+	n.SentAt = sentAt
+
+	return true, nil
+}
+
+func (n *Notification) markProcessedIfUnsent(set bson.M) (bool, error) {
+	if len(n.ID) == 0 {
+		return false, errors.New("notification has no ID")
+	}
+
+	err := db.Update(
+		Collection,
+		bson.M{
+			idKey:     n.ID,
+			sentAtKey: bson.M{"$exists": false},
+		},
+		bson.M{"$set": set},
+	)
+	if adb.ResultsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// sentSince returns a query for notifications that were actually sent (i.e.
+// not suppressed) since the given time.
+func sentSince(since time.Time) bson.M {
+	return bson.M{
+		sentAtKey:           bson.M{"$gte": since},
+		suppressedReasonKey: bson.M{"$exists": false},
+	}
+}
+
+// CountSentToSubscriber counts the notifications sent to the subscriber since
+// the given time.
+func CountSentToSubscriber(subscriberKey string, since time.Time) (int, error) {
+	query := sentSince(since)
+	query[subscriberKeyKey] = subscriberKey
+
+	return db.Count(Collection, query)
+}
+
+// CountSentToSubscriberType counts the notifications sent to any subscriber of
+// the given type since the given time.
+func CountSentToSubscriberType(subscriberType string, since time.Time) (int, error) {
+	const subscriberTypeKey = "type"
+	query := sentSince(since)
+	query[bsonutil.GetDottedKeyName(subscriberKey, subscriberTypeKey)] = subscriberType
+
+	return db.Count(Collection, query)
+}
+
+func byDuplicate(n *Notification) bson.M {
+	return bson.M{
+		idKey:            bson.M{"$ne": n.ID},
+		subscriberKeyKey: n.SubscriberKey,
+		bsonutil.GetDottedKeyName(metadataKey, collapseKeyKey): n.Metadata.CollapseKey,
+	}
+}
+
+// FindSentDuplicate returns a notification identical to the given one that was
+// sent to the same subscriber since the given time, if one exists.
+func FindSentDuplicate(n *Notification, since time.Time) (*Notification, error) {
+	query := byDuplicate(n)
+	for k, v := range sentSince(since) {
+		query[k] = v
+	}
+
+	out := Notification{}
+	err := db.FindOneQ(Collection, db.Query(query), &out)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find duplicate notification")
+	}
+
+	return &out, nil
+}
+
+// SuppressUnsentDuplicates suppresses all unprocessed notifications identical
+// to the given one, and returns how many were suppressed.
+func SuppressUnsentDuplicates(n *Notification) (int, error) {
+	query := byDuplicate(n)
+	query[sentAtKey] = bson.M{"$exists": false}
+
+	info, err := db.UpdateAll(Collection, query, bson.M{
+		"$set": bson.M{
+			sentAtKey:           time.Now().Truncate(time.Millisecond),
+			suppressedReasonKey: SuppressedCollapsed,
+		},
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to suppress duplicate notifications")
+	}
+
+	return info.Updated, nil
+}
+
+// IncCollapsedCount records that other notifications were collapsed into the
+// notification with the given ID.
+func IncCollapsedCount(id string, count int) error {
+	return errors.Wrap(db.UpdateId(Collection, id, bson.M{
+		"$inc": bson.M{collapsedCountKey: count},
+	}), "failed to update collapsed notification count")
+}
+
+// AddCollapsedSummary adds a line to the notification's payload noting how
+// many identical notifications it summarizes.
+func (n *Notification) AddCollapsedSummary() error {
+	if n.CollapsedCount <= 0 {
+		return nil
+	}
+	summary := fmt.Sprintf("This notification also covers %d identical notification(s), such as the same failure in other build variants.", n.CollapsedCount)
+
+	switch payload := n.Payload.(type) {
+	case *SlackPayload:
+		payload.Body = fmt.Sprintf("%s\n_%s_", payload.Body, summary)
+	case *message.Email:
+		payload.Subject = fmt.Sprintf("%s (+%d similar)", payload.Subject, n.CollapsedCount)
+		if i := strings.LastIndex(payload.Body, "</body>"); i >= 0 {
+			payload.Body = fmt.Sprintf("%s<p>%s</p>\n%s", payload.Body[:i], summary, payload.Body[i:])
+		} else {
+			payload.Body = fmt.Sprintf("%s\n%s", payload.Body, summary)
+		}
+	case *string:
+		*payload = fmt.Sprintf("%s\n%s", *payload, summary)
+	case *util.ChatWebhook:
+		body, err := addChatWebhookSummary(payload.Body, summary)
+		if err != nil {
+			return errors.Wrap(err, "failed to add summary to chat webhook")
+		}
+		payload.Body = body
+	default:
+		return errors.Errorf("cannot summarize collapsed notifications for payload type '%T'", n.Payload)
+	}
+
+	return nil
+}
+
+// addChatWebhookSummary appends the summary to either a plain text chat
+// message or the first adaptive card in a Microsoft Teams message.
+func addChatWebhookSummary(body []byte, summary string) ([]byte, error) {
+	msg := map[string]interface{}{}
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return nil, errors.Wrap(err, "invalid chat webhook body")
+	}
+
+	if text, ok := msg["text"].(string); ok {
+		msg["text"] = fmt.Sprintf("%s\n%s", text, summary)
+		return json.Marshal(msg)
+	}
+
+	attachments, _ := msg["attachments"].([]interface{})
+	if len(attachments) == 0 {
+		return nil, errors.New("chat webhook body has neither text nor attachments")
+	}
+	attachment, _ := attachments[0].(map[string]interface{})
+	content, _ := attachment["content"].(map[string]interface{})
+	cardBody, ok := content["body"].([]interface{})
+	if !ok {
+		return nil, errors.New("chat webhook attachment is not an adaptive card")
+	}
+	content["body"] = append(cardBody, map[string]interface{}{
+		"type":     "TextBlock",
+		"text":     summary,
+		"isSubtle": true,
+		"wrap":     true,
+	})
+
+	return json.Marshal(msg)
+}
+
+// SuppressedNotificationStats counts the notifications suppressed for each
+// reason.
+type SuppressedNotificationStats struct {
+	SubscriberRateLimit     int `json:"subscriber_rate_limit" bson:"subscriber_rate_limit" yaml:"subscriber_rate_limit"`
+	SubscriberTypeRateLimit int `json:"subscriber_type_rate_limit" bson:"subscriber_type_rate_limit" yaml:"subscriber_type_rate_limit"`
+	Collapsed               int `json:"collapsed" bson:"collapsed" yaml:"collapsed"`
+}
+
+// CollectSuppressedNotificationStats counts the notifications suppressed since
+// the given time by reason.
+func CollectSuppressedNotificationStats(since time.Time) (*SuppressedNotificationStats, error) {
+	pipeline := []bson.M{
+		{
+			"$match": bson.M{
+				sentAtKey:           bson.M{"$gte": since},
+				suppressedReasonKey: bson.M{"$exists": true},
+			},
+		},
+		{
+			"$group": bson.M{
+				"_id": "$" + suppressedReasonKey,
+				"n": bson.M{
+					"$sum": 1,
+				},
+			},
+		},
+	}
+
+	stats := []struct {
+		Key   string `bson:"_id"`
+		Count int    `bson:"n"`
+	}{}
+
+	if err := db.Aggregate(Collection, pipeline, &stats); err != nil {
+		return nil, errors.Wrap(err, "failed to count suppressed notifications")
+	}
+
+	sStats := SuppressedNotificationStats{}
+	for _, data := range stats {
+		switch data.Key {
+		case SuppressedSubscriberRateLimit:
+			sStats.SubscriberRateLimit = data.Count
+		case SuppressedSubscriberTypeRateLimit:
+			sStats.SubscriberTypeRateLimit = data.Count
+		case SuppressedCollapsed:
+			sStats.Collapsed = data.Count
+		default:
+			grip.Error(message.Fields{
+				"message": fmt.Sprintf("unknown suppression reason %s", data.Key),
+			})
+		}
+	}
+
+	return &sStats, nil
+}
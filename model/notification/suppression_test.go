@@ -0,0 +1,73 @@
+package notification
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mongodb/grip/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddCollapsedSummary(t *testing.T) {
+	const summary = "also covers 2 identical notification(s)"
+
+	t.Run("NoCollapsedNotifications", func(t *testing.T) {
+		n := Notification{Payload: &SlackPayload{Body: "hi"}}
+		assert.NoError(t, n.AddCollapsedSummary())
+		assert.Equal(t, "hi", n.Payload.(*SlackPayload).Body)
+	})
+	t.Run("Slack", func(t *testing.T) {
+		n := Notification{CollapsedCount: 2, Payload: &SlackPayload{Body: "hi"}}
+		assert.NoError(t, n.AddCollapsedSummary())
+		assert.Contains(t, n.Payload.(*SlackPayload).Body, summary)
+	})
+	t.Run("Email", func(t *testing.T) {
+		n := Notification{CollapsedCount: 2, Payload: &message.Email{Subject: "failed", Body: "<html><body>hi</body></html>"}}
+		assert.NoError(t, n.AddCollapsedSummary())
+		email := n.Payload.(*message.Email)
+		assert.Equal(t, "failed (+2 similar)", email.Subject)
+		assert.Contains(t, email.Body, summary)
+		assert.Contains(t, email.Body, "</p>\n</body>")
+	})
+	t.Run("JIRAComment", func(t *testing.T) {
+		comment := "hi"
+		n := Notification{CollapsedCount: 2, Payload: &comment}
+		assert.NoError(t, n.AddCollapsedSummary())
+		assert.Contains(t, comment, summary)
+	})
+	t.Run("ChatWebhook", func(t *testing.T) {
+		n := Notification{CollapsedCount: 2, Payload: &util.ChatWebhook{Body: []byte(`{"text": "hi"}`)}}
+		assert.NoError(t, n.AddCollapsedSummary())
+		msg := struct {
+			Text string `json:"text"`
+		}{}
+		require.NoError(t, json.Unmarshal(n.Payload.(*util.ChatWebhook).Body, &msg))
+		assert.Contains(t, msg.Text, "hi\n")
+		assert.Contains(t, msg.Text, summary)
+	})
+	t.Run("MSTeams", func(t *testing.T) {
+		body := `{"type": "message", "attachments": [{"contentType": "application/vnd.microsoft.card.adaptive", "content": {"type": "AdaptiveCard", "body": [{"type": "TextBlock", "text": "hi"}]}}]}`
+		n := Notification{CollapsedCount: 2, Payload: &util.ChatWebhook{Body: []byte(body)}}
+		assert.NoError(t, n.AddCollapsedSummary())
+		msg := struct {
+			Attachments []struct {
+				Content struct {
+					Body []struct {
+						Text string `json:"text"`
+					} `json:"body"`
+				} `json:"content"`
+			} `json:"attachments"`
+		}{}
+		require.NoError(t, json.Unmarshal(n.Payload.(*util.ChatWebhook).Body, &msg))
+		require.Len(t, msg.Attachments, 1)
+		require.Len(t, msg.Attachments[0].Content.Body, 2)
+		assert.Equal(t, "hi", msg.Attachments[0].Content.Body[0].Text)
+		assert.Contains(t, msg.Attachments[0].Content.Body[1].Text, summary)
+	})
+	t.Run("UnsupportedPayload", func(t *testing.T) {
+		n := Notification{CollapsedCount: 2, Payload: &message.GithubStatus{}}
+		assert.Error(t, n.AddCollapsedSummary())
+	})
+}
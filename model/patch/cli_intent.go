@@ -86,6 +86,14 @@ type cliIntent struct {
 	GitInfo *GitMetadata `bson:"git_info,omitempty"`
 
 	ReuseDefinition bool `bson:"reuse_definition"`
+
+	// StackedOn is the ID of the patch that this patch's changes are made on
+	// top of.
+	StackedOn string `bson:"stacked_on,omitempty"`
+
+	// WaitForStackedOn indicates that the patch should only be finalized
+	// once the patch it is stacked on succeeds.
+	WaitForStackedOn bool `bson:"wait_for_stacked_on,omitempty"`
 }
 
 // BSON fields for the patches
@@ -161,6 +169,10 @@ func (c *cliIntent) ShouldFinalizePatch() bool {
 	return c.Finalize
 }
 
+func (c *cliIntent) ShouldWaitForStackedOn() bool {
+	return c.WaitForStackedOn
+}
+
 func (c *cliIntent) ReusePreviousPatchDefinition() bool {
 	return c.ReuseDefinition
 }
@@ -187,6 +199,7 @@ func (c *cliIntent) NewPatch() *Patch {
 		BackportOf:    c.BackportOf,
 		Patches:       []ModulePatch{},
 		GitInfo:       c.GitInfo,
+		StackedOn:     c.StackedOn,
 	}
 	if len(c.PatchFileID) > 0 {
 		p.Patches = append(p.Patches,
@@ -219,6 +232,9 @@ type CLIIntentParams struct {
 	TriggerAliases  []string
 	ReuseDefinition bool
 	SyncParams      SyncAtEndOptions
+	// StackedOn is the ID of the patch to stack the new patch on.
+	StackedOn        string
+	WaitForStackedOn bool
 }
 
 func NewCliIntent(params CLIIntentParams) (Intent, error) {
@@ -241,6 +257,12 @@ func NewCliIntent(params CLIIntentParams) (Intent, error) {
 			}
 		}
 	}
+	if params.WaitForStackedOn && params.StackedOn == "" {
+		return nil, errors.New("can't wait for the patch this patch is stacked on without a patch to stack on")
+	}
+	if params.StackedOn != "" && !IsValidId(params.StackedOn) {
+		return nil, errors.Errorf("'%s' is not a valid patch ID to stack on", params.StackedOn)
+	}
 	if len(params.SyncParams.BuildVariants) != 0 && len(params.SyncParams.Tasks) == 0 {
 		return nil, errors.New("build variants provided for task sync but task names missing")
 	}
@@ -258,25 +280,27 @@ func NewCliIntent(params CLIIntentParams) (Intent, error) {
 	}
 
 	return &cliIntent{
-		DocumentID:      mgobson.NewObjectId().Hex(),
-		IntentType:      CliIntentType,
-		PatchContent:    params.PatchContent,
-		Path:            params.Path,
-		Description:     params.Description,
-		BuildVariants:   params.Variants,
-		Tasks:           params.Tasks,
-		Parameters:      params.Parameters,
-		SyncAtEndOpts:   params.SyncParams,
-		User:            params.User,
-		ProjectID:       params.Project,
-		BaseHash:        params.BaseGitHash,
-		Finalize:        params.Finalize,
-		Module:          params.Module,
-		Alias:           params.Alias,
-		TriggerAliases:  params.TriggerAliases,
-		BackportOf:      params.BackportOf,
-		GitInfo:         params.GitInfo,
-		ReuseDefinition: params.ReuseDefinition,
+		DocumentID:       mgobson.NewObjectId().Hex(),
+		IntentType:       CliIntentType,
+		PatchContent:     params.PatchContent,
+		Path:             params.Path,
+		Description:      params.Description,
+		BuildVariants:    params.Variants,
+		Tasks:            params.Tasks,
+		Parameters:       params.Parameters,
+		SyncAtEndOpts:    params.SyncParams,
+		User:             params.User,
+		ProjectID:        params.Project,
+		BaseHash:         params.BaseGitHash,
+		Finalize:         params.Finalize,
+		Module:           params.Module,
+		Alias:            params.Alias,
+		TriggerAliases:   params.TriggerAliases,
+		BackportOf:       params.BackportOf,
+		GitInfo:          params.GitInfo,
+		ReuseDefinition:  params.ReuseDefinition,
+		StackedOn:        params.StackedOn,
+		WaitForStackedOn: params.WaitForStackedOn,
 	}, nil
 }
 
@@ -241,6 +241,26 @@ func (s *CliIntentSuite) TestNewCliIntentRejectsInvalidIntents() {
 	})
 	s.Nil(intent)
 	s.Error(err)
+
+	intent, err = NewCliIntent(CLIIntentParams{
+		User:             s.user,
+		Project:          s.projectID,
+		BaseGitHash:      s.hash,
+		PatchContent:     s.patchContent,
+		WaitForStackedOn: true,
+	})
+	s.Nil(intent)
+	s.Error(err)
+
+	intent, err = NewCliIntent(CLIIntentParams{
+		User:         s.user,
+		Project:      s.projectID,
+		BaseGitHash:  s.hash,
+		PatchContent: s.patchContent,
+		StackedOn:    "not-a-patch-id",
+	})
+	s.Nil(intent)
+	s.Error(err)
 }
 
 func (s *CliIntentSuite) TestFindIntentSpecifically() {
@@ -45,6 +45,8 @@ var (
 	githubPatchDataKey  = bsonutil.MustHaveTag(Patch{}, "GithubPatchData")
 	MergePatchKey       = bsonutil.MustHaveTag(Patch{}, "MergePatch")
 	TriggersKey         = bsonutil.MustHaveTag(Patch{}, "Triggers")
+	StackedOnKey        = bsonutil.MustHaveTag(Patch{}, "StackedOn")
+	CommitQueueLaneKey  = bsonutil.MustHaveTag(Patch{}, "CommitQueueLane")
 
 	// BSON fields for sync at end struct
 	SyncAtEndOptionsBuildVariantsKey = bsonutil.MustHaveTag(SyncAtEndOptions{}, "BuildVariants")
@@ -337,6 +339,39 @@ func ByGithubPRAndCreatedBefore(t time.Time, owner, repo string, prNumber int) d
 	})
 }
 
+// FindPreviousGithubPRPatch returns the most recent finalized patch for the
+// same pull request that was created before the given patch, if any.
+func FindPreviousGithubPRPatch(p *Patch) (*Patch, error) {
+	q := db.Query(bson.M{
+		CreateTimeKey: bson.M{
+			"$lt": p.CreateTime,
+		},
+		bsonutil.GetDottedKeyName(githubPatchDataKey, thirdparty.GithubPatchBaseOwnerKey): p.GithubPatchData.BaseOwner,
+		bsonutil.GetDottedKeyName(githubPatchDataKey, thirdparty.GithubPatchBaseRepoKey):  p.GithubPatchData.BaseRepo,
+		bsonutil.GetDottedKeyName(githubPatchDataKey, thirdparty.GithubPatchPRNumberKey):  p.GithubPatchData.PRNumber,
+		ProjectKey: p.Project,
+		VersionKey: bson.M{"$exists": true, "$ne": ""},
+	}).Sort([]string{"-" + CreateTimeKey})
+	return FindOne(q)
+}
+
+// FindStackedOn returns the patches that are stacked directly on the given
+// patch.
+func FindStackedOn(patchID string) ([]Patch, error) {
+	return Find(db.Query(bson.M{StackedOnKey: patchID}))
+}
+
+// FindLatestGithubPRPatch returns the most recently created patch for the
+// given pull request, if any.
+func FindLatestGithubPRPatch(owner, repo string, prNumber int) (*Patch, error) {
+	q := db.Query(bson.M{
+		bsonutil.GetDottedKeyName(githubPatchDataKey, thirdparty.GithubPatchBaseOwnerKey): owner,
+		bsonutil.GetDottedKeyName(githubPatchDataKey, thirdparty.GithubPatchBaseRepoKey):  repo,
+		bsonutil.GetDottedKeyName(githubPatchDataKey, thirdparty.GithubPatchPRNumberKey):  prNumber,
+	}).Sort([]string{"-" + CreateTimeKey})
+	return FindOne(q)
+}
+
 func FindProjectForPatch(patchID mgobson.ObjectId) (string, error) {
 	p, err := FindOne(ById(patchID).Project(bson.M{ProjectKey: 1}))
 	if err != nil {
@@ -2,6 +2,8 @@ package patch
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -77,6 +79,28 @@ type githubIntent struct {
 
 	// IntentType indicates the type of the patch intent, e.g. GithubIntentType
 	IntentType string `bson:"intent_type"`
+
+	// StackedOnPR is the number of the pull request that this pull request
+	// is stacked on, as declared by a marker in its description.
+	StackedOnPR int `bson:"stacked_on_pr,omitempty"`
+}
+
+// stackedOnMarker matches a line in a pull request description declaring the
+// pull request it is stacked on, e.g. "Stacked on: #123".
+var stackedOnMarker = regexp.MustCompile(`(?im)^\s*stacked[ -]on:?\s+#(\d+)\s*$`)
+
+// ParseStackedOnMarker returns the number of the pull request that a pull
+// request description declares it is stacked on, or 0 if there is none.
+func ParseStackedOnMarker(body string) int {
+	matches := stackedOnMarker.FindStringSubmatch(body)
+	if len(matches) < 2 {
+		return 0
+	}
+	prNumber, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return 0
+	}
+	return prNumber
 }
 
 // BSON fields for the patches
@@ -150,6 +174,7 @@ func NewGithubIntent(msgDeliveryID, patchOwner string, pr *github.PullRequest) (
 		Title:        pr.GetTitle(),
 		IntentType:   GithubIntentType,
 		PushedAt:     pr.Head.Repo.PushedAt.Time.UTC(),
+		StackedOnPR:  ParseStackedOnMarker(pr.GetBody()),
 	}, nil
 }
 
@@ -209,6 +234,10 @@ func (g *githubIntent) ReusePreviousPatchDefinition() bool {
 	return false
 }
 
+func (g *githubIntent) ShouldWaitForStackedOn() bool {
+	return false
+}
+
 func (g *githubIntent) RequesterIdentity() string {
 	return evergreen.GithubPRRequester
 }
@@ -235,15 +264,16 @@ func (g *githubIntent) NewPatch() *Patch {
 		Status:      evergreen.PatchCreated,
 		CreateTime:  g.PushedAt,
 		GithubPatchData: thirdparty.GithubPatch{
-			PRNumber:   g.PRNumber,
-			BaseOwner:  baseRepo[0],
-			BaseRepo:   baseRepo[1],
-			BaseBranch: g.BaseBranch,
-			HeadOwner:  headRepo[0],
-			HeadRepo:   headRepo[1],
-			HeadHash:   g.HeadHash,
-			Author:     g.User,
-			AuthorUID:  g.UID,
+			PRNumber:    g.PRNumber,
+			BaseOwner:   baseRepo[0],
+			BaseRepo:    baseRepo[1],
+			BaseBranch:  g.BaseBranch,
+			HeadOwner:   headRepo[0],
+			HeadRepo:    headRepo[1],
+			HeadHash:    g.HeadHash,
+			Author:      g.User,
+			AuthorUID:   g.UID,
+			StackedOnPR: g.StackedOnPR,
 		},
 	}
 	return patchDoc
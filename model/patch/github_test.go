@@ -10,6 +10,7 @@ import (
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/testutil"
 	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.mongodb.org/mongo-driver/bson"
 )
@@ -245,3 +246,16 @@ func (s *GithubSuite) TestNewPatch() {
 	s.Equal("octocat", patchDoc.GithubPatchData.Author)
 	s.Equal(1234, patchDoc.GithubPatchData.AuthorUID)
 }
+
+func TestParseStackedOnMarker(t *testing.T) {
+	for body, expected := range map[string]int{
+		"":                                  0,
+		"Stacked on #123":                   123,
+		"Fixes a bug.\n\nStacked-on: #45\n": 45,
+		"stacked on: #7":                    7,
+		"This is not stacked on #12 at all": 0,
+		"Stacked on #abc":                   0,
+	} {
+		assert.Equal(t, expected, ParseStackedOnMarker(body), body)
+	}
+}
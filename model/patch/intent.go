@@ -35,6 +35,11 @@ type Intent interface {
 	// as the previous patch submitted for this project by the user.
 	ReusePreviousPatchDefinition() bool
 
+	// ShouldWaitForStackedOn indicates whether the patch created from this
+	// intent should only be finalized once the patch it is stacked on
+	// succeeds.
+	ShouldWaitForStackedOn() bool
+
 	// GetAlias defines the variants and tasks this intent should run on.
 	GetAlias() string
 
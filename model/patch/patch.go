@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"sort"
 	"strings"
 	"text/template"
 	"time"
@@ -30,6 +31,9 @@ import (
 const SizeLimit = 1024 * 1024 * 100
 const backportFmtString = "Backport: %s"
 
+// MaxStackDepth is the maximum number of patches a patch can be stacked on.
+const MaxStackDepth = 10
+
 // VariantTasks contains the variant ID and  the set of tasks to be scheduled for that variant
 type VariantTasks struct {
 	Variant      string
@@ -164,6 +168,13 @@ type Patch struct {
 	MergePatch      string                 `bson:"merge_patch"`
 	GithubPatchData thirdparty.GithubPatch `bson:"github_patch_data,omitempty"`
 	GitInfo         *GitMetadata           `bson:"git_info,omitempty"`
+	// StackedOn is the ID of the patch that this patch's changes are made on
+	// top of. The changes of every patch in the stack are applied, in order,
+	// before this patch's own changes.
+	StackedOn string `bson:"stacked_on,omitempty"`
+	// CommitQueueLane is the commit queue lane that this patch was last
+	// enqueued in.
+	CommitQueueLane string `bson:"commit_queue_lane,omitempty"`
 	// DisplayNewUI is only used when roundtripping the patch via the CLI
 	DisplayNewUI bool `bson:"display_new_ui,omitempty"`
 	// MergeStatus is only used in gitServePatch to send the status of this
@@ -237,6 +248,20 @@ func (p *Patch) SetMergePatch(newPatchID string) error {
 	)
 }
 
+// SetCommitQueueLane records the commit queue lane that the patch is
+// enqueued in.
+func (p *Patch) SetCommitQueueLane(lane string) error {
+	p.CommitQueueLane = lane
+	return UpdateOne(
+		bson.M{IdKey: p.Id},
+		bson.M{
+			"$set": bson.M{
+				CommitQueueLaneKey: lane,
+			},
+		},
+	)
+}
+
 func (p *Patch) GetCommitQueueURL(uiHost string) string {
 	return uiHost + "/commit-queue/" + p.Project
 }
@@ -566,6 +591,113 @@ func (p *Patch) ConfigChanged(remotePath string) bool {
 	return false
 }
 
+// ChangedFiles returns the names of the files the patch changes in the
+// project's own repository. It returns nil if the patch also changes modules,
+// since their paths aren't relative to the project's repository.
+func (p *Patch) ChangedFiles() []string {
+	var files []string
+	for _, patchPart := range p.Patches {
+		if patchPart.ModuleName != "" {
+			if len(patchPart.PatchSet.Summary) > 0 {
+				return nil
+			}
+			continue
+		}
+		for _, summary := range patchPart.PatchSet.Summary {
+			files = append(files, summary.Name)
+		}
+	}
+	return files
+}
+
+// ChangedFilesSince returns the files whose changes differ between the
+// previous patch's diff and this patch's diff, i.e. the files touched by
+// whatever changed between the two patches. Both patches must have their diffs
+// loaded. It returns false if the patches can't be compared because they are
+// against different base revisions or their module changes differ.
+func (p *Patch) ChangedFilesSince(prev *Patch) ([]string, bool) {
+	if p.Githash != prev.Githash || len(p.Patches) != len(prev.Patches) {
+		return nil, false
+	}
+
+	changed := []string{}
+	for _, part := range p.Patches {
+		var prevPart *ModulePatch
+		for i := range prev.Patches {
+			if prev.Patches[i].ModuleName == part.ModuleName {
+				prevPart = &prev.Patches[i]
+				break
+			}
+		}
+		if prevPart == nil || prevPart.Githash != part.Githash {
+			return nil, false
+		}
+		files := DiffChangedFiles(prevPart.PatchSet.Patch, part.PatchSet.Patch)
+		if part.ModuleName != "" {
+			// Module files aren't in the project's repository, so there's
+			// no way to tell which tasks they affect.
+			if len(files) > 0 {
+				return nil, false
+			}
+			continue
+		}
+		changed = append(changed, files...)
+	}
+
+	return changed, true
+}
+
+// DiffChangedFiles compares two git diffs against the same base revision and
+// returns the files whose changes are not identical in both.
+func DiffChangedFiles(oldDiff, newDiff string) []string {
+	oldFiles := splitDiffByFile(oldDiff)
+	newFiles := splitDiffByFile(newDiff)
+
+	changed := []string{}
+	for name, fileDiff := range newFiles {
+		if oldFiles[name] != fileDiff {
+			changed = append(changed, name)
+		}
+	}
+	for name := range oldFiles {
+		if _, ok := newFiles[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+
+	return changed
+}
+
+// splitDiffByFile splits a git diff into the changes for each file, keyed by
+// file path. Renamed files are keyed by both their old and new paths.
+func splitDiffByFile(diff string) map[string]string {
+	const header = "diff --git a/"
+	files := map[string]string{}
+	var names []string
+	var section strings.Builder
+	flush := func() {
+		for _, name := range names {
+			files[name] = section.String()
+		}
+		section.Reset()
+	}
+	for _, line := range strings.SplitAfter(diff, "\n") {
+		if strings.HasPrefix(line, header) {
+			flush()
+			names = nil
+			paths := strings.TrimSpace(strings.TrimPrefix(line, header))
+			if idx := strings.LastIndex(paths, " b/"); idx >= 0 {
+				names = utility.UniqueStrings([]string{paths[:idx], paths[idx+len(" b/"):]})
+			}
+		}
+		section.WriteString(line)
+	}
+	flush()
+
+	return files
+}
+
 // SetActivated sets the patch to activated in the db
 func (p *Patch) SetActivated(ctx context.Context, versionId string) error {
 	p.Version = versionId
@@ -704,6 +836,10 @@ func (p *Patch) IsBackport() bool {
 	return len(p.BackportOf.PatchID) != 0 || len(p.BackportOf.SHA) != 0
 }
 
+func (p *Patch) IsStacked() bool {
+	return p.StackedOn != ""
+}
+
 func (p *Patch) IsChild() bool {
 	return p.Triggers.ParentPatch != ""
 }
@@ -750,6 +886,34 @@ func (p *Patch) GetPatchFamily() ([]string, *Patch, error) {
 	return childrenOrSiblings, parentPatch, nil
 }
 
+// GetStackAncestors returns the patches this patch is stacked on, starting
+// with the patch at the bottom of the stack and ending with the patch this
+// patch is stacked on directly.
+func (p *Patch) GetStackAncestors() ([]Patch, error) {
+	ancestors := []Patch{}
+	seen := map[string]bool{p.Id.Hex(): true}
+	for parentID := p.StackedOn; parentID != ""; {
+		if seen[parentID] {
+			return nil, errors.Errorf("patch '%s' is stacked on itself", parentID)
+		}
+		if len(ancestors) >= MaxStackDepth {
+			return nil, errors.Errorf("patch stack is deeper than the maximum of %d patches", MaxStackDepth)
+		}
+		seen[parentID] = true
+
+		parent, err := FindOneId(parentID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't get stacked on patch '%s'", parentID)
+		}
+		if parent == nil {
+			return nil, errors.Errorf("stacked on patch '%s' does not exist", parentID)
+		}
+		ancestors = append([]Patch{*parent}, ancestors...)
+		parentID = parent.StackedOn
+	}
+	return ancestors, nil
+}
+
 func (p *Patch) SetParametersFromParent() (*Patch, error) {
 	parentPatchId := p.Triggers.ParentPatch
 	parentPatch, err := FindOneId(parentPatchId)
@@ -993,6 +1157,51 @@ func MakeNewMergePatch(pr *github.PullRequest, projectID, alias, commitTitle, co
 	return patchDoc, nil
 }
 
+// MakeNewBatchPatch creates a patch that applies the changes of all the given
+// commit queue patches in order, so that they can be tested and merged
+// together. The first patch's author is used as the batch's author.
+func MakeNewBatchPatch(patches []Patch) (*Patch, error) {
+	if len(patches) == 0 {
+		return nil, errors.New("can't make a batch with no patches")
+	}
+	first := patches[0]
+	u, err := user.FindOneById(first.Author)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't find user for patch author '%s'", first.Author)
+	}
+	if u == nil {
+		return nil, errors.Errorf("patch author '%s' not found", first.Author)
+	}
+	patchNumber, err := u.IncPatchNumber()
+	if err != nil {
+		return nil, errors.Wrap(err, "error computing patch num")
+	}
+
+	ids := make([]string, 0, len(patches))
+	modulePatches := []ModulePatch{}
+	for _, p := range patches {
+		ids = append(ids, p.Id.Hex())
+		modulePatches = append(modulePatches, p.Patches...)
+	}
+
+	return &Patch{
+		Id:            mgobson.NewObjectId(),
+		Project:       first.Project,
+		Author:        u.Id,
+		Githash:       first.Githash,
+		Description:   fmt.Sprintf("Commit Queue Batch: %s", strings.Join(ids, ", ")),
+		CreateTime:    time.Now(),
+		Status:        evergreen.PatchCreated,
+		Alias:         evergreen.CommitQueueAlias,
+		PatchNumber:   patchNumber,
+		Patches:       modulePatches,
+		BuildVariants: first.BuildVariants,
+		Tasks:         first.Tasks,
+		VariantsTasks: first.VariantsTasks,
+		PatchedConfig: first.PatchedConfig,
+	}, nil
+}
+
 type PatchesByCreateTime []Patch
 
 func (p PatchesByCreateTime) Len() int {
@@ -10,6 +10,7 @@ import (
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/model/user"
 	"github.com/evergreen-ci/evergreen/testutil"
 	"github.com/evergreen-ci/evergreen/thirdparty"
 	"github.com/evergreen-ci/utility"
@@ -40,6 +41,23 @@ func TestConfigChanged(t *testing.T) {
 	assert.False(p.ConfigChanged(remoteConfigPath))
 }
 
+func TestChangedFiles(t *testing.T) {
+	p := &Patch{
+		Patches: []ModulePatch{{
+			PatchSet: PatchSet{
+				Summary: []thirdparty.Summary{{Name: "src/main.go"}, {Name: "README.md"}},
+			},
+		}},
+	}
+	assert.Equal(t, []string{"src/main.go", "README.md"}, p.ChangedFiles())
+
+	p.Patches = append(p.Patches, ModulePatch{ModuleName: "module"})
+	assert.Equal(t, []string{"src/main.go", "README.md"}, p.ChangedFiles())
+
+	p.Patches[1].PatchSet.Summary = []thirdparty.Summary{{Name: "lib.go"}}
+	assert.Nil(t, p.ChangedFiles())
+}
+
 type patchSuite struct {
 	suite.Suite
 	testConfig *evergreen.Settings
@@ -172,6 +190,48 @@ func (s *patchSuite) TestMakeMergePatch() {
 	s.Equal("message", p.GithubPatchData.CommitMessage)
 }
 
+func (s *patchSuite) TestMakeNewBatchPatch() {
+	s.NoError(db.ClearCollections(user.Collection))
+	s.NoError((&user.DBUser{Id: "octocat"}).Insert())
+
+	_, err := MakeNewBatchPatch(nil)
+	s.Error(err)
+
+	patches := []Patch{
+		{
+			Id:            bson.NewObjectId(),
+			Project:       "mci",
+			Author:        "octocat",
+			Githash:       "abcdef",
+			Patches:       []ModulePatch{{ModuleName: "", PatchSet: PatchSet{PatchFileId: "p1"}}},
+			BuildVariants: []string{"bv1"},
+			Tasks:         []string{"t1"},
+		},
+		{
+			Id:      bson.NewObjectId(),
+			Project: "mci",
+			Author:  "someone",
+			Githash: "abcdef",
+			Patches: []ModulePatch{
+				{ModuleName: "m1", PatchSet: PatchSet{PatchFileId: "p2"}},
+				{ModuleName: "", PatchSet: PatchSet{PatchFileId: "p3"}},
+			},
+		},
+	}
+	p, err := MakeNewBatchPatch(patches)
+	s.Require().NoError(err)
+	s.Equal("mci", p.Project)
+	s.Equal("octocat", p.Author)
+	s.Equal(1, p.PatchNumber)
+	s.Equal(evergreen.CommitQueueAlias, p.Alias)
+	s.True(p.IsCommitQueuePatch())
+	s.Equal([]string{"bv1"}, p.BuildVariants)
+	s.Require().Len(p.Patches, 3)
+	for i, fileID := range []string{"p1", "p2", "p3"} {
+		s.Equal(fileID, p.Patches[i].PatchSet.PatchFileId)
+	}
+}
+
 func (s *patchSuite) TestUpdateGithashProjectAndTasks() {
 	patch, err := FindOne(ByUserAndCommitQueue("octocat", false))
 	s.NoError(err)
@@ -946,3 +1006,117 @@ func TestSetChildPatches(t *testing.T) {
 	assert.Equal(dbPatch.Triggers.ChildPatches[1], "id_1")
 	assert.Equal(dbPatch.Triggers.ChildPatches[2], "id_2")
 }
+
+func TestDiffChangedFiles(t *testing.T) {
+	oldDiff := `diff --git a/main.go b/main.go
+index 1111111..2222222 100644
+--- a/main.go
++++ b/main.go
+@@ -1 +1 @@
+-package main
++package app
+diff --git a/docs/README.md b/docs/README.md
+index 3333333..4444444 100644
+--- a/docs/README.md
++++ b/docs/README.md
+@@ -1 +1 @@
+-hello
++hi
+`
+	newDiff := `diff --git a/main.go b/main.go
+index 1111111..2222222 100644
+--- a/main.go
++++ b/main.go
+@@ -1 +1 @@
+-package main
++package app
+diff --git a/docs/README.md b/docs/README.md
+index 3333333..5555555 100644
+--- a/docs/README.md
++++ b/docs/README.md
+@@ -1 +1 @@
+-hello
++hello there
+diff --git a/old.go b/new.go
+similarity index 100%
+rename from old.go
+rename to new.go
+`
+	assert.Equal(t, []string{"docs/README.md", "new.go", "old.go"}, DiffChangedFiles(oldDiff, newDiff))
+	assert.Equal(t, []string{"docs/README.md", "new.go", "old.go"}, DiffChangedFiles(newDiff, oldDiff))
+	assert.Empty(t, DiffChangedFiles(oldDiff, oldDiff))
+	assert.Equal(t, []string{"docs/README.md", "main.go"}, DiffChangedFiles("", oldDiff))
+}
+
+func TestChangedFilesSince(t *testing.T) {
+	mainDiff := "diff --git a/main.go b/main.go\n--- a/main.go\n+++ b/main.go\n@@ -1 +1 @@\n-a\n+b\n"
+	otherDiff := "diff --git a/other.go b/other.go\n--- a/other.go\n+++ b/other.go\n@@ -1 +1 @@\n-a\n+b\n"
+	prev := &Patch{
+		Githash: "base",
+		Patches: []ModulePatch{{Githash: "base", PatchSet: PatchSet{Patch: mainDiff}}},
+	}
+	p := &Patch{
+		Githash: "base",
+		Patches: []ModulePatch{{Githash: "base", PatchSet: PatchSet{Patch: mainDiff + otherDiff}}},
+	}
+
+	files, ok := p.ChangedFilesSince(prev)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"other.go"}, files)
+
+	p.Githash = "new-base"
+	_, ok = p.ChangedFilesSince(prev)
+	assert.False(t, ok, "patches against different bases are not comparable")
+	p.Githash = "base"
+
+	prev.Patches = append(prev.Patches, ModulePatch{ModuleName: "mod", Githash: "mod-base"})
+	p.Patches = append(p.Patches, ModulePatch{ModuleName: "mod", Githash: "mod-base", PatchSet: PatchSet{Patch: mainDiff}})
+	_, ok = p.ChangedFilesSince(prev)
+	assert.False(t, ok, "changed module diffs are not comparable")
+
+	p.Patches[1].PatchSet.Patch = ""
+	files, ok = p.ChangedFilesSince(prev)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"other.go"}, files)
+}
+
+func TestGetStackAncestors(t *testing.T) {
+	require.NoError(t, db.ClearCollections(Collection))
+
+	bottom := Patch{Id: bson.NewObjectId()}
+	middle := Patch{Id: bson.NewObjectId(), StackedOn: bottom.Id.Hex()}
+	top := Patch{Id: bson.NewObjectId(), StackedOn: middle.Id.Hex()}
+	for _, p := range []Patch{bottom, middle, top} {
+		require.NoError(t, p.Insert())
+	}
+
+	ancestors, err := top.GetStackAncestors()
+	require.NoError(t, err)
+	require.Len(t, ancestors, 2)
+	assert.Equal(t, bottom.Id, ancestors[0].Id)
+	assert.Equal(t, middle.Id, ancestors[1].Id)
+
+	ancestors, err = bottom.GetStackAncestors()
+	require.NoError(t, err)
+	assert.Empty(t, ancestors)
+
+	stacked, err := FindStackedOn(bottom.Id.Hex())
+	require.NoError(t, err)
+	require.Len(t, stacked, 1)
+	assert.Equal(t, middle.Id, stacked[0].Id)
+
+	t.Run("MissingParent", func(t *testing.T) {
+		orphan := Patch{Id: bson.NewObjectId(), StackedOn: bson.NewObjectId().Hex()}
+		_, err := orphan.GetStackAncestors()
+		assert.Error(t, err)
+	})
+	t.Run("Cycle", func(t *testing.T) {
+		first := Patch{Id: bson.NewObjectId()}
+		second := Patch{Id: bson.NewObjectId(), StackedOn: first.Id.Hex()}
+		first.StackedOn = second.Id.Hex()
+		require.NoError(t, first.Insert())
+		require.NoError(t, second.Insert())
+		_, err := first.GetStackAncestors()
+		assert.Error(t, err)
+	})
+}
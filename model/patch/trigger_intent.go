@@ -78,6 +78,10 @@ func (t *TriggerIntent) ReusePreviousPatchDefinition() bool {
 	return false
 }
 
+func (t *TriggerIntent) ShouldWaitForStackedOn() bool {
+	return false
+}
+
 func (t *TriggerIntent) GetAlias() string {
 	// triggers have no alias
 	return ""
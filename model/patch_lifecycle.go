@@ -154,6 +154,26 @@ func GetPatchedProject(ctx context.Context, p *patch.Patch, githubOauthToken str
 		return nil, "", errors.Wrapf(err, "could not fetch remote configuration file")
 	}
 
+	// apply the configuration changes of the patches this patch is stacked on
+	// first. Commit queue patches get those changes from the items ahead of
+	// them in the queue instead.
+	if p.IsStacked() && !p.IsGithubPRPatch() && !p.IsCommitQueuePatch() {
+		ancestors, err := p.GetStackAncestors()
+		if err != nil {
+			return nil, "", errors.Wrap(err, "can't get stacked on patches")
+		}
+		for i := range ancestors {
+			if !ancestors[i].ConfigChanged(path) {
+				continue
+			}
+			opts.ReadFileFrom = ReadFromPatchDiff
+			projectFileBytes, err = MakePatchedConfig(ctx, env, &ancestors[i], path, string(projectFileBytes))
+			if err != nil {
+				return nil, "", errors.Wrapf(err, "Could not apply configuration changes from stacked on patch '%s'", ancestors[i].Id.Hex())
+			}
+		}
+	}
+
 	// apply remote configuration patch if needed
 	if !(p.IsGithubPRPatch() || p.IsPRMergePatch()) && p.ConfigChanged(path) {
 		opts.ReadFileFrom = ReadFromPatchDiff
@@ -295,7 +315,7 @@ func FinalizePatch(ctx context.Context, p *patch.Patch, requester string, github
 	githubCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	_, err = thirdparty.GetCommitEvent(githubCtx, githubOauthToken, projectRef.Owner, projectRef.Repo, p.Githash)
+	_, err = projectRef.GetCodeHost(githubOauthToken).GetCommit(githubCtx, p.Githash)
 	if err != nil {
 		return nil, errors.Wrap(err, "Couldn't fetch commit information")
 	}
@@ -349,6 +369,16 @@ func FinalizePatch(ctx context.Context, p *patch.Patch, requester string, github
 		}).TVPairsToVariantTasks()
 	}
 
+	filteredPairs, err := pathFilteredTVPairs(project, p, requester, tasks)
+	grip.Warning(message.WrapError(err, message.Fields{
+		"message": "problem selecting tasks for changed files",
+		"patch":   p.Id.Hex(),
+		"project": p.Project,
+	}))
+	if len(filteredPairs) > 0 && len(filteredPairs) == len(tasks.ExecTasks) {
+		return nil, errors.Errorf("no tasks in PR patch '%s' are affected by its changed files", p.Id.Hex())
+	}
+
 	// if variant tasks is still empty, then the patch is empty and we shouldn't add to commit queue
 	if p.IsCommitQueuePatch() && len(p.VariantsTasks) == 0 {
 		return nil, errors.Errorf("No builds or tasks for commit queue version in projects '%s', githash '%s'", p.Project, p.Githash)
@@ -414,6 +444,27 @@ func FinalizePatch(ctx context.Context, p *patch.Patch, requester string, github
 			},
 		)
 	}
+	if len(filteredPairs) > 0 {
+		deactivatePathFilteredTasks(filteredPairs, patchVersion, buildsToInsert, tasksToInsert)
+		grip.Info(message.Fields{
+			"message":        "not activating tasks whose inputs didn't change",
+			"patch":          p.Id.Hex(),
+			"project":        p.Project,
+			"num_tasks":      len(tasks.ExecTasks),
+			"num_unaffected": len(filteredPairs),
+		})
+	}
+	if p.IsGithubPRPatch() && projectRef.IsPRTaskReuseEnabled() {
+		if err = reusePreviousPRPatchResults(p, project, projectRef.RemotePath, patchVersion, buildsToInsert, tasksToInsert); err != nil {
+			// Reusing results is an optimization, so run everything instead.
+			grip.Error(message.WrapError(err, message.Fields{
+				"message": "could not reuse task results from previous PR patch",
+				"patch":   p.Id.Hex(),
+				"project": p.Project,
+			}))
+		}
+	}
+
 	mongoClient := evergreen.GetEnvironment().Client()
 	session, err := mongoClient.StartSession()
 	if err != nil {
@@ -562,6 +613,265 @@ func SubscribeOnParentOutcome(parentStatus string, childPatchId string, parentPa
 	return nil
 }
 
+// FailStackedPatch marks an unfinalized patch failed because the patch it is
+// stacked on failed, so that it is not left waiting to be finalized. Patches
+// stacked on it are failed in turn by the logged state change.
+func FailStackedPatch(p *patch.Patch) error {
+	if p.Status != evergreen.PatchCreated {
+		return nil
+	}
+	if err := p.MarkFinished(evergreen.PatchFailed, time.Now()); err != nil {
+		return errors.Wrapf(err, "marking patch '%s' failed", p.Id.Hex())
+	}
+	event.LogPatchStateChangeEvent(p.Id.Hex(), p.Status)
+	return nil
+}
+
+// reusePreviousPRPatchResults skips the tasks of a new PR patch whose inputs
+// are unchanged since the previous patch for the same pull request and that
+// succeeded there. Skipped tasks are left unscheduled and point to the task
+// whose result they reuse. Builds left with nothing to run are deactivated so
+// that they don't hold up the version.
+func reusePreviousPRPatchResults(p *patch.Patch, project *Project, remotePath string, v *Version, builds build.Builds, tasks task.Tasks) error {
+	prev, err := patch.FindPreviousGithubPRPatch(p)
+	if err != nil {
+		return errors.Wrap(err, "finding previous patch for pull request")
+	}
+	if prev == nil {
+		return nil
+	}
+
+	current := *p
+	current.Patches = append([]patch.ModulePatch{}, p.Patches...)
+	if err = current.FetchPatchFiles(false); err != nil {
+		return errors.Wrap(err, "fetching patch diff")
+	}
+	if err = prev.FetchPatchFiles(false); err != nil {
+		return errors.Wrapf(err, "fetching diff for previous patch '%s'", prev.Id.Hex())
+	}
+	changedFiles, ok := current.ChangedFilesSince(prev)
+	if !ok || utility.StringSliceContains(changedFiles, remotePath) {
+		return nil
+	}
+
+	prevTasks, err := task.FindAll(task.ByVersion(prev.Version).WithFields(task.IdKey, task.DisplayNameKey,
+		task.BuildVariantKey, task.StatusKey, task.ActivatedKey, task.ReusedFromTaskKey, task.ReusedFromPatchKey))
+	if err != nil {
+		return errors.Wrapf(err, "finding tasks for previous patch '%s'", prev.Id.Hex())
+	}
+
+	reused := selectReusableTasks(project, changedFiles, prev.Id.Hex(), prevTasks, tasks)
+	if len(reused) == 0 {
+		return nil
+	}
+
+	for _, t := range tasks {
+		if origin, ok := reused[t.Id]; ok {
+			t.Activated = false
+			t.ActivatedTime = utility.ZeroTime
+			t.ReusedFromTask = origin.ReusedFromTask
+			t.ReusedFromPatch = origin.ReusedFromPatch
+		}
+	}
+	deactivateBuildsWithoutActiveTasks(v, builds, tasks)
+
+	grip.Info(message.Fields{
+		"message":        "reusing task results from previous PR patch",
+		"patch":          p.Id.Hex(),
+		"previous_patch": prev.Id.Hex(),
+		"project":        p.Project,
+		"changed_files":  len(changedFiles),
+		"reused_tasks":   len(reused),
+		"total_tasks":    len(tasks),
+	})
+
+	return nil
+}
+
+// pathFilteredTVPairs returns the patch's execution tasks that don't need to
+// run because none of their inputs changed. Only GitHub PR patches are
+// filtered, since their tasks are selected by the project's alias rather than
+// requested by a user. As with IncludeDependencies, a returned error is only
+// informational.
+func pathFilteredTVPairs(project *Project, p *patch.Patch, requester string, pairs TaskVariantPairs) (map[TVPair]bool, error) {
+	if !p.IsGithubPRPatch() || !project.HasInputs() {
+		return nil, nil
+	}
+	selected, err := project.SelectTVPairsForChangedFiles(pairs, requester, p.ChangedFiles())
+	isSelected := map[TVPair]bool{}
+	for _, pair := range selected.ExecTasks {
+		isSelected[pair] = true
+	}
+	filtered := map[TVPair]bool{}
+	for _, pair := range pairs.ExecTasks {
+		if !isSelected[pair] {
+			filtered[pair] = true
+		}
+	}
+	return filtered, err
+}
+
+// HasTasksAffectedByChangedFiles returns whether any of the patch's tasks
+// would be activated given the files it changes. It is always true for patches
+// that aren't GitHub PR patches.
+func HasTasksAffectedByChangedFiles(project *Project, p *patch.Patch) bool {
+	pairs := VariantTasksToTVPairs(p.VariantsTasks)
+	filtered, _ := pathFilteredTVPairs(project, p, p.GetRequester(), pairs)
+	return len(filtered) == 0 || len(filtered) < len(pairs.ExecTasks)
+}
+
+// deactivatePathFilteredTasks deactivates the tasks that don't need to run
+// because none of their inputs changed, along with the display tasks and
+// builds left without any active tasks. They are still created, so they can
+// be scheduled manually.
+func deactivatePathFilteredTasks(filtered map[TVPair]bool, v *Version, builds build.Builds, tasks task.Tasks) {
+	deactivated := map[string]bool{}
+	for _, t := range tasks {
+		if !t.DisplayOnly && filtered[TVPair{Variant: t.BuildVariant, TaskName: t.DisplayName}] {
+			t.Activated = false
+			t.ActivatedTime = utility.ZeroTime
+			deactivated[t.Id] = true
+		}
+	}
+	for _, t := range tasks {
+		if !t.DisplayOnly || len(t.ExecutionTasks) == 0 {
+			continue
+		}
+		allDeactivated := true
+		for _, execTask := range t.ExecutionTasks {
+			allDeactivated = allDeactivated && deactivated[execTask]
+		}
+		if allDeactivated {
+			t.Activated = false
+			t.ActivatedTime = utility.ZeroTime
+		}
+	}
+	deactivateBuildsWithoutActiveTasks(v, builds, tasks)
+}
+
+// deactivateBuildsWithoutActiveTasks deactivates the builds, and their status
+// in the version, that have no active tasks.
+func deactivateBuildsWithoutActiveTasks(v *Version, builds build.Builds, tasks task.Tasks) {
+	buildHasActiveTasks := map[string]bool{}
+	for _, t := range tasks {
+		if t.Activated {
+			buildHasActiveTasks[t.BuildId] = true
+		}
+	}
+	for _, b := range builds {
+		if buildHasActiveTasks[b.Id] {
+			continue
+		}
+		b.Activated = false
+		for i := range v.BuildVariants {
+			if v.BuildVariants[i].BuildId == b.Id {
+				v.BuildVariants[i].Activated = false
+			}
+		}
+	}
+}
+
+// selectReusableTasks returns the new tasks that can reuse a previous
+// result, keyed by task ID, along with the task and patch holding that
+// result. A task can reuse a result if the same task succeeded in the
+// previous patch, or itself reused a result there, and none of its declared
+// inputs changed. A display task is reused when all of its execution tasks
+// are. Tasks that other scheduled tasks depend on must still run, and if
+// nothing would be left to run no results are reused at all.
+func selectReusableTasks(project *Project, changedFiles []string, prevPatchID string, prevTasks []task.Task, tasks task.Tasks) map[string]task.Task {
+	type variantTask struct {
+		variant string
+		name    string
+	}
+	prevByName := map[variantTask]task.Task{}
+	for _, t := range prevTasks {
+		prevByName[variantTask{variant: t.BuildVariant, name: t.DisplayName}] = t
+	}
+	originFor := func(t *task.Task) (task.Task, bool) {
+		prevTask, ok := prevByName[variantTask{variant: t.BuildVariant, name: t.DisplayName}]
+		if !ok {
+			return task.Task{}, false
+		}
+		if prevTask.ReusedFromTask != "" && !prevTask.Activated {
+			return task.Task{ReusedFromTask: prevTask.ReusedFromTask, ReusedFromPatch: prevTask.ReusedFromPatch}, true
+		}
+		return task.Task{ReusedFromTask: prevTask.Id, ReusedFromPatch: prevPatchID}, prevTask.Status == evergreen.TaskSucceeded
+	}
+
+	reused := map[string]task.Task{}
+	for _, t := range tasks {
+		if t.DisplayOnly || !t.Activated {
+			continue
+		}
+		origin, ok := originFor(t)
+		if !ok {
+			continue
+		}
+		projectTask := project.FindProjectTask(t.DisplayName)
+		if projectTask == nil || projectTask.InputsChanged(changedFiles) {
+			continue
+		}
+		reused[t.Id] = origin
+	}
+
+	execToDisplay := map[string]string{}
+	for _, t := range tasks {
+		if !t.DisplayOnly || len(t.ExecutionTasks) == 0 {
+			continue
+		}
+		allReused := true
+		for _, execTask := range t.ExecutionTasks {
+			execToDisplay[execTask] = t.Id
+			if _, ok := reused[execTask]; !ok {
+				allReused = false
+			}
+		}
+		if !allReused {
+			continue
+		}
+		origin, ok := originFor(t)
+		if !ok {
+			origin = task.Task{ReusedFromPatch: prevPatchID}
+		}
+		reused[t.Id] = origin
+	}
+	unreuse := func(id string) {
+		delete(reused, id)
+		delete(reused, execToDisplay[id])
+		for _, t := range tasks {
+			if t.Id == id && t.DisplayOnly {
+				for _, execTask := range t.ExecutionTasks {
+					delete(reused, execTask)
+				}
+			}
+		}
+	}
+
+	// Tasks that will run need their dependencies to run too.
+	for removed := true; removed; {
+		removed = false
+		for _, t := range tasks {
+			if _, ok := reused[t.Id]; ok || t.DisplayOnly || !t.Activated {
+				continue
+			}
+			for _, dep := range t.DependsOn {
+				if _, ok := reused[dep.TaskId]; ok {
+					unreuse(dep.TaskId)
+					removed = true
+				}
+			}
+		}
+	}
+
+	for _, t := range tasks {
+		if _, ok := reused[t.Id]; !ok && !t.DisplayOnly && t.Activated {
+			return reused
+		}
+	}
+	// The version would never finish if none of its tasks ran.
+	return map[string]task.Task{}
+}
+
 func CancelPatch(p *patch.Patch, reason task.AbortInfo) error {
 	if p.Version != "" {
 		if err := SetVersionActivation(p.Version, false, reason.User); err != nil {
@@ -684,13 +994,31 @@ func (e *EnqueuePatch) Send() error {
 		return errors.Errorf("no commit queue for project '%s'", existingPatch.Project)
 	}
 
+	projectRef, err := FindMergedProjectRef(existingPatch.Project, "", false)
+	if err != nil {
+		return errors.Wrapf(err, "can't find project '%s'", existingPatch.Project)
+	}
+	if projectRef == nil {
+		return errors.Errorf("project '%s' not found", existingPatch.Project)
+	}
+	if err = cq.CheckAuthorLimit(existingPatch.Author, projectRef.CommitQueue.MaxItemsPerAuthor); err != nil {
+		return errors.Wrap(err, "can't enqueue patch")
+	}
+
 	ctx := context.Background()
 	mergePatch, err := MakeMergePatchFromExisting(ctx, existingPatch, "")
 	if err != nil {
 		return errors.Wrap(err, "problem making merge patch")
 	}
 
-	_, err = cq.Enqueue(commitqueue.CommitQueueItem{Issue: mergePatch.Id.Hex(), PatchId: mergePatch.Id.Hex(), Source: commitqueue.SourceDiff})
+	item := commitqueue.CommitQueueItem{
+		Issue:   mergePatch.Id.Hex(),
+		PatchId: mergePatch.Id.Hex(),
+		Source:  commitqueue.SourceDiff,
+		Author:  mergePatch.Author,
+		Lane:    commitQueueLane(mergePatch, projectRef.CommitQueue.Lanes),
+	}
+	_, err = cq.EnqueueInLane(item, projectRef.CommitQueue.Lanes)
 
 	return errors.Wrap(err, "can't enqueue item")
 }
@@ -716,20 +1044,40 @@ func MakeMergePatchFromExisting(ctx context.Context, existingPatch *patch.Patch,
 		return nil, errors.WithStack(err)
 	}
 
+	// a stacked patch is merged after the patch it's stacked on, so that one
+	// has to be enqueued first
+	stackedOn := ""
+	if existingPatch.IsStacked() {
+		parent, err := patch.FindOneId(existingPatch.StackedOn)
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't get stacked on patch '%s'", existingPatch.StackedOn)
+		}
+		if parent == nil {
+			return nil, errors.Errorf("stacked on patch '%s' doesn't exist", existingPatch.StackedOn)
+		}
+		if parent.MergePatch == "" {
+			return nil, errors.Errorf("patch '%s' is stacked on patch '%s', which must be added to the commit queue first",
+				existingPatch.Id.Hex(), parent.Id.Hex())
+		}
+		stackedOn = parent.MergePatch
+	}
+
 	project := &Project{}
 	if _, _, err = LoadProjectInto(ctx, []byte(existingPatch.PatchedConfig), nil, existingPatch.Project, project); err != nil {
 		return nil, errors.Wrap(err, "problem loading project")
 	}
 
 	patchDoc := &patch.Patch{
-		Id:            mgobson.NewObjectId(),
-		Author:        existingPatch.Author,
-		Project:       existingPatch.Project,
-		Githash:       existingPatch.Githash,
-		Status:        evergreen.PatchCreated,
-		Alias:         evergreen.CommitQueueAlias,
-		PatchedConfig: existingPatch.PatchedConfig,
-		CreateTime:    time.Now(),
+		Id:              mgobson.NewObjectId(),
+		Author:          existingPatch.Author,
+		Project:         existingPatch.Project,
+		Githash:         existingPatch.Githash,
+		Status:          evergreen.PatchCreated,
+		Alias:           evergreen.CommitQueueAlias,
+		PatchedConfig:   existingPatch.PatchedConfig,
+		CreateTime:      time.Now(),
+		StackedOn:       stackedOn,
+		CommitQueueLane: existingPatch.CommitQueueLane,
 	}
 
 	if patchDoc.Patches, err = patch.MakeMergePatchPatches(existingPatch, commitMessage); err != nil {
@@ -783,6 +1131,14 @@ func RetryCommitQueueItems(projectID string, opts RestartOptions) ([]string, []s
 	if cq == nil {
 		return nil, nil, errors.Errorf("commit queue '%s' not found", projectID)
 	}
+	projectRef, err := FindMergedProjectRef(projectID, "", false)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error finding project '%s'", projectID)
+	}
+	if projectRef == nil {
+		return nil, nil, errors.Errorf("project '%s' not found", projectID)
+	}
+	lanes := projectRef.CommitQueue.Lanes
 
 	// don't requeue items, just return what would be requeued
 	if opts.DryRun {
@@ -799,9 +1155,9 @@ func RetryCommitQueueItems(projectID string, opts RestartOptions) ([]string, []s
 		// is not a reliable field that is set for diff patches
 		var err error
 		if p.GithubPatchData.PRNumber > 0 {
-			err = restartPRItem(p, cq)
+			err = restartPRItem(p, cq, lanes)
 		} else {
-			err = restartDiffItem(p, cq)
+			err = restartDiffItem(p, cq, lanes)
 		}
 		if err != nil {
 			grip.Error(message.WrapError(err, message.Fields{
@@ -819,7 +1175,7 @@ func RetryCommitQueueItems(projectID string, opts RestartOptions) ([]string, []s
 	return patchesRestarted, patchesFailed, nil
 }
 
-func restartPRItem(p patch.Patch, cq *commitqueue.CommitQueue) error {
+func restartPRItem(p patch.Patch, cq *commitqueue.CommitQueue, lanes []commitqueue.Lane) error {
 	// reconstruct commit queue item from patch
 	modules := []commitqueue.Module{}
 	for _, modulePatch := range p.Patches {
@@ -835,15 +1191,17 @@ func restartPRItem(p patch.Patch, cq *commitqueue.CommitQueue) error {
 		Issue:   strconv.Itoa(p.GithubPatchData.PRNumber),
 		Modules: modules,
 		Source:  commitqueue.SourcePullRequest,
+		Author:  p.Author,
+		Lane:    commitQueueLane(&p, lanes),
 	}
-	if _, err := cq.Enqueue(item); err != nil {
+	if _, err := cq.EnqueueInLane(item, lanes); err != nil {
 		return errors.Wrap(err, "error enqueuing item")
 	}
 
 	return nil
 }
 
-func restartDiffItem(p patch.Patch, cq *commitqueue.CommitQueue) error {
+func restartDiffItem(p patch.Patch, cq *commitqueue.CommitQueue, lanes []commitqueue.Lane) error {
 	u, err := user.FindOne(user.ById(p.Author))
 	if err != nil {
 		return errors.Wrapf(err, "error finding user %s", p.Author)
@@ -870,17 +1228,34 @@ func restartDiffItem(p patch.Patch, cq *commitqueue.CommitQueue) error {
 		Alias:           p.Alias,
 		Patches:         p.Patches,
 		PatchNumber:     patchNumber,
+		CommitQueueLane: commitQueueLane(&p, lanes),
 	}
 
 	if err = newPatch.Insert(); err != nil {
 		return errors.Wrap(err, "error inserting patch")
 	}
-	if _, err = cq.Enqueue(commitqueue.CommitQueueItem{Issue: newPatch.Id.Hex(), PatchId: newPatch.Id.Hex(), Source: commitqueue.SourceDiff}); err != nil {
+	item := commitqueue.CommitQueueItem{
+		Issue:   newPatch.Id.Hex(),
+		PatchId: newPatch.Id.Hex(),
+		Source:  commitqueue.SourceDiff,
+		Author:  newPatch.Author,
+		Lane:    newPatch.CommitQueueLane,
+	}
+	if _, err = cq.EnqueueInLane(item, lanes); err != nil {
 		return errors.Wrap(err, "error enqueuing item")
 	}
 	return nil
 }
 
+// commitQueueLane returns the lane that the patch was last enqueued in, or the
+// default lane if that lane is no longer configured for the commit queue.
+func commitQueueLane(p *patch.Patch, lanes []commitqueue.Lane) string {
+	if commitqueue.ValidateLane(lanes, p.CommitQueueLane) != nil {
+		return ""
+	}
+	return p.CommitQueueLane
+}
+
 func SendCommitQueueResult(p *patch.Patch, status message.GithubState, description string) error {
 	if p.GithubPatchData.PRNumber == 0 {
 		return nil
@@ -917,3 +1292,31 @@ func SendCommitQueueResult(p *patch.Patch, status message.GithubState, descripti
 
 	return nil
 }
+
+// SendCommitQueueComment comments on the pull request of a commit queue
+// patch. Patches that aren't associated with a pull request are not commented
+// on.
+func SendCommitQueueComment(ctx context.Context, p *patch.Patch, comment string) error {
+	if p.GithubPatchData.PRNumber == 0 {
+		return nil
+	}
+	projectRef, err := FindMergedProjectRef(p.Project, p.Version, true)
+	if err != nil {
+		return errors.Wrap(err, "unable to find project")
+	}
+	if projectRef == nil {
+		return errors.New("no project found for patch")
+	}
+	settings, err := evergreen.GetConfig()
+	if err != nil {
+		return errors.Wrap(err, "unable to get settings")
+	}
+	githubToken, err := settings.GetGithubOauthToken()
+	if err != nil {
+		return errors.Wrap(err, "unable to get github token")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	return errors.Wrap(thirdparty.PostCommentToPullRequest(ctx, githubToken, projectRef.Owner, projectRef.Repo, p.GithubPatchData.PRNumber, comment), "call to Github API failed")
+}
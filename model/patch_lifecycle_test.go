@@ -876,6 +876,46 @@ func TestRetryCommitQueueItems(t *testing.T) {
 			assert.Len(t, restarted, 2)
 			assert.Len(t, notRestarted, 0)
 		},
+		"KeepsLane": func(*testing.T) {
+			laneProjectRef := *projectRef
+			laneProjectRef.CommitQueue.Lanes = []commitqueue.Lane{{Name: "hotfix", MaxConcurrent: 1}}
+			assert.NoError(t, laneProjectRef.Insert())
+			u := user.DBUser{Id: "me", PatchNumber: 12}
+			assert.NoError(t, u.Insert())
+
+			p := patch.Patch{
+				Id:              mgobson.NewObjectId(),
+				Project:         projectRef.Id,
+				Githash:         patchedRevision,
+				StartTime:       startTime.Add(30 * time.Minute),
+				FinishTime:      startTime.Add(time.Hour),
+				Status:          evergreen.PatchFailed,
+				Alias:           evergreen.CommitQueueAlias,
+				Author:          "me",
+				CommitQueueLane: "hotfix",
+			}
+			assert.NoError(t, p.Insert())
+			restarted, notRestarted, err := RetryCommitQueueItems(projectRef.Id, opts)
+			assert.NoError(t, err)
+			assert.Len(t, restarted, 2)
+			assert.Len(t, notRestarted, 0)
+
+			cq, err := commitqueue.FindOneId(projectRef.Id)
+			assert.NoError(t, err)
+			require.NotNil(t, cq)
+			require.Len(t, cq.Queue, 2)
+			for _, item := range cq.Queue {
+				if item.Source == commitqueue.SourcePullRequest {
+					assert.Empty(t, item.Lane)
+					continue
+				}
+				assert.Equal(t, "hotfix", item.Lane)
+				newPatch, err := patch.FindOneId(item.PatchId)
+				assert.NoError(t, err)
+				require.NotNil(t, newPatch)
+				assert.Equal(t, "hotfix", newPatch.CommitQueueLane)
+			}
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			assert.NoError(t, db.ClearCollections(ProjectRefCollection, commitqueue.Collection, patch.Collection, user.Collection))
@@ -947,3 +987,135 @@ func TestRetryCommitQueueItems(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectReusableTasks(t *testing.T) {
+	project := &Project{
+		Tasks: []ProjectTask{
+			{Name: "compile", Inputs: []string{"src/**"}},
+			{Name: "lint", Inputs: []string{"*.go"}},
+			{Name: "docs", Inputs: []string{"docs/**"}},
+			{Name: "test"},
+		},
+	}
+	prevTasks := []task.Task{
+		{Id: "prev_compile", BuildVariant: "bv", DisplayName: "compile", Status: evergreen.TaskSucceeded},
+		{Id: "prev_lint", BuildVariant: "bv", DisplayName: "lint", Status: evergreen.TaskFailed},
+		{Id: "prev_docs", BuildVariant: "bv", DisplayName: "docs", ReusedFromTask: "older_docs", ReusedFromPatch: "older_patch"},
+		{Id: "prev_test", BuildVariant: "bv", DisplayName: "test", Status: evergreen.TaskSucceeded},
+	}
+	newTasks := func() task.Tasks {
+		return task.Tasks{
+			{Id: "compile", BuildVariant: "bv", DisplayName: "compile", Activated: true},
+			{Id: "lint", BuildVariant: "bv", DisplayName: "lint", Activated: true},
+			{Id: "docs", BuildVariant: "bv", DisplayName: "docs", Activated: true},
+			{Id: "test", BuildVariant: "bv", DisplayName: "test", Activated: true},
+		}
+	}
+
+	t.Run("ReusesSucceededTasksWithUnchangedInputs", func(t *testing.T) {
+		reused := selectReusableTasks(project, []string{"README.md"}, "prev_patch", prevTasks, newTasks())
+		require.Len(t, reused, 2)
+		assert.Equal(t, "prev_compile", reused["compile"].ReusedFromTask)
+		assert.Equal(t, "prev_patch", reused["compile"].ReusedFromPatch)
+		assert.Equal(t, "older_docs", reused["docs"].ReusedFromTask)
+		assert.Equal(t, "older_patch", reused["docs"].ReusedFromPatch)
+	})
+	t.Run("RunsTasksWithChangedInputs", func(t *testing.T) {
+		reused := selectReusableTasks(project, []string{"src/main.c"}, "prev_patch", prevTasks, newTasks())
+		require.Len(t, reused, 1)
+		assert.Contains(t, reused, "docs")
+	})
+	t.Run("RunsDependenciesOfScheduledTasks", func(t *testing.T) {
+		tasks := newTasks()
+		tasks[3].DependsOn = []task.Dependency{{TaskId: "compile"}}
+		reused := selectReusableTasks(project, nil, "prev_patch", prevTasks, tasks)
+		require.Len(t, reused, 1)
+		assert.Contains(t, reused, "docs")
+	})
+	t.Run("ReusesDisplayTaskWhenAllExecutionTasksAreReused", func(t *testing.T) {
+		tasks := append(newTasks(), &task.Task{Id: "dt", BuildVariant: "bv", DisplayName: "dt", DisplayOnly: true, Activated: true, ExecutionTasks: []string{"compile", "docs"}})
+		reused := selectReusableTasks(project, nil, "prev_patch", prevTasks, tasks)
+		require.Len(t, reused, 3)
+		assert.Equal(t, "prev_patch", reused["dt"].ReusedFromPatch)
+	})
+	t.Run("ReusesNothingIfNoTasksWouldRun", func(t *testing.T) {
+		tasks := newTasks()[:1]
+		reused := selectReusableTasks(project, nil, "prev_patch", prevTasks, tasks)
+		assert.Empty(t, reused)
+	})
+}
+
+func TestPathFilteredTasks(t *testing.T) {
+	project := &Project{
+		Tasks: []ProjectTask{
+			{Name: "compile"},
+			{Name: "docs", Inputs: []string{"docs/**"}},
+		},
+		BuildVariants: []BuildVariant{
+			{Name: "bv", Tasks: []BuildVariantTaskUnit{{Name: "compile"}, {Name: "docs"}}},
+		},
+	}
+	newPatch := func(files ...string) *patch.Patch {
+		summaries := []thirdparty.Summary{}
+		for _, f := range files {
+			summaries = append(summaries, thirdparty.Summary{Name: f})
+		}
+		return &patch.Patch{
+			GithubPatchData: thirdparty.GithubPatch{HeadOwner: "octocat"},
+			Patches:         []patch.ModulePatch{{PatchSet: patch.PatchSet{Summary: summaries}}},
+			VariantsTasks:   []patch.VariantTasks{{Variant: "bv", Tasks: []string{"compile", "docs"}}},
+		}
+	}
+
+	t.Run("FiltersPRPatchTasksWithUnchangedInputs", func(t *testing.T) {
+		p := newPatch("src/main.go")
+		filtered, err := pathFilteredTVPairs(project, p, evergreen.GithubPRRequester, VariantTasksToTVPairs(p.VariantsTasks))
+		require.NoError(t, err)
+		assert.Equal(t, map[TVPair]bool{{Variant: "bv", TaskName: "docs"}: true}, filtered)
+		assert.True(t, HasTasksAffectedByChangedFiles(project, p))
+	})
+	t.Run("NeverFiltersCLIPatchTasks", func(t *testing.T) {
+		p := newPatch("src/main.go")
+		p.GithubPatchData = thirdparty.GithubPatch{}
+		filtered, err := pathFilteredTVPairs(project, p, evergreen.PatchVersionRequester, VariantTasksToTVPairs(p.VariantsTasks))
+		require.NoError(t, err)
+		assert.Empty(t, filtered)
+		assert.True(t, HasTasksAffectedByChangedFiles(project, p))
+	})
+	t.Run("DetectsPRPatchesWithoutAffectedTasks", func(t *testing.T) {
+		p := newPatch("README.md")
+		p.VariantsTasks = []patch.VariantTasks{{Variant: "bv", Tasks: []string{"docs"}}}
+		assert.False(t, HasTasksAffectedByChangedFiles(project, p))
+
+		p = newPatch("docs/index.md")
+		p.VariantsTasks = []patch.VariantTasks{{Variant: "bv", Tasks: []string{"docs"}}}
+		assert.True(t, HasTasksAffectedByChangedFiles(project, p))
+	})
+	t.Run("DeactivatesFilteredTasksAndEmptyBuilds", func(t *testing.T) {
+		v := &Version{BuildVariants: []VersionBuildStatus{
+			{BuildVariant: "bv", BuildId: "b1", ActivationStatus: ActivationStatus{Activated: true}},
+			{BuildVariant: "docs_bv", BuildId: "b2", ActivationStatus: ActivationStatus{Activated: true}},
+		}}
+		builds := build.Builds{{Id: "b1", Activated: true}, {Id: "b2", Activated: true}}
+		tasks := task.Tasks{
+			{Id: "compile", BuildId: "b1", BuildVariant: "bv", DisplayName: "compile", Activated: true},
+			{Id: "docs", BuildId: "b1", BuildVariant: "bv", DisplayName: "docs", Activated: true},
+			{Id: "docs_dt", BuildId: "b1", BuildVariant: "bv", DisplayName: "docs_dt", DisplayOnly: true, ExecutionTasks: []string{"docs"}, Activated: true},
+			{Id: "other_docs", BuildId: "b2", BuildVariant: "docs_bv", DisplayName: "docs", Activated: true},
+		}
+		filtered := map[TVPair]bool{
+			{Variant: "bv", TaskName: "docs"}:      true,
+			{Variant: "docs_bv", TaskName: "docs"}: true,
+		}
+
+		deactivatePathFilteredTasks(filtered, v, builds, tasks)
+		assert.True(t, tasks[0].Activated)
+		assert.False(t, tasks[1].Activated)
+		assert.False(t, tasks[2].Activated)
+		assert.False(t, tasks[3].Activated)
+		assert.True(t, builds[0].Activated)
+		assert.False(t, builds[1].Activated)
+		assert.True(t, v.BuildVariants[0].Activated)
+		assert.False(t, v.BuildVariants[1].Activated)
+	})
+}
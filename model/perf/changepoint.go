@@ -0,0 +1,136 @@
+package perf
+
+import (
+	"math"
+	"sort"
+)
+
+// DetectionOptions configure change-point detection.
+type DetectionOptions struct {
+	// Threshold is the minimum Welch's t-statistic between the values before
+	// and after a change point for it to be significant.
+	Threshold float64
+	// MinSegment is the minimum number of values on each side of a change
+	// point.
+	MinSegment int
+	// MinChange is the minimum absolute percent change between the means
+	// before and after a change point for it to be reported, which filters
+	// out changes that are statistically significant but too small to
+	// matter.
+	MinChange float64
+}
+
+// DefaultDetectionOptions are the options used to detect change points in a
+// project's performance series.
+var DefaultDetectionOptions = DetectionOptions{
+	Threshold:  5,
+	MinSegment: 3,
+	MinChange:  5,
+}
+
+// ChangePoint is an index in a series where the values after it differ
+// significantly from the values before it.
+type ChangePoint struct {
+	Index        int
+	PreviousMean float64
+	NewMean      float64
+	Statistic    float64
+}
+
+// PercentChange returns the percent change from the previous mean to the new
+// mean, or 0 if the previous mean is 0.
+func (c ChangePoint) PercentChange() float64 {
+	return percentChange(c.PreviousMean, c.NewMean)
+}
+
+// DetectChangePoints finds the change points in the values using binary
+// segmentation: the series is split where the t-statistic between the two
+// sides is largest, and each side is searched again until no split is
+// significant. The change points are sorted by index.
+func DetectChangePoints(values []float64, opts DetectionOptions) []ChangePoint {
+	if opts.MinSegment < 1 {
+		opts.MinSegment = 1
+	}
+	changePoints := detectChangePoints(values, 0, opts)
+	sort.Slice(changePoints, func(i, j int) bool {
+		return changePoints[i].Index < changePoints[j].Index
+	})
+	return changePoints
+}
+
+func detectChangePoints(values []float64, offset int, opts DetectionOptions) []ChangePoint {
+	if len(values) < 2*opts.MinSegment {
+		return nil
+	}
+
+	best := ChangePoint{Index: -1}
+	for i := opts.MinSegment; i <= len(values)-opts.MinSegment; i++ {
+		before, after := values[:i], values[i:]
+		statistic := welchStatistic(before, after)
+		if statistic > best.Statistic {
+			best = ChangePoint{
+				Index:        i,
+				PreviousMean: mean(before),
+				NewMean:      mean(after),
+				Statistic:    statistic,
+			}
+		}
+	}
+	if best.Index < 0 || best.Statistic < opts.Threshold {
+		return nil
+	}
+	if best.PreviousMean != 0 && math.Abs(best.PercentChange()) < opts.MinChange {
+		return nil
+	}
+
+	changePoints := detectChangePoints(values[:best.Index], offset, opts)
+	changePoints = append(changePoints, detectChangePoints(values[best.Index:], offset+best.Index, opts)...)
+	best.Index += offset
+	return append(changePoints, best)
+}
+
+// welchStatistic returns the absolute value of Welch's t-statistic for the
+// difference between the means of the two samples. Samples without variance
+// that have different means are infinitely far apart.
+func welchStatistic(a, b []float64) float64 {
+	diff := math.Abs(mean(a) - mean(b))
+	if diff == 0 {
+		return 0
+	}
+	stderr := math.Sqrt(variance(a)/float64(len(a)) + variance(b)/float64(len(b)))
+	if stderr == 0 {
+		return math.Inf(1)
+	}
+	return diff / stderr
+}
+
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// variance returns the sample variance of the values.
+func variance(values []float64) float64 {
+	if len(values) < 2 {
+		return 0
+	}
+	m := mean(values)
+	var sum float64
+	for _, v := range values {
+		sum += (v - m) * (v - m)
+	}
+	return sum / float64(len(values)-1)
+}
+
+func percentChange(previous, current float64) float64 {
+	if previous == 0 {
+		return 0
+	}
+	return 100 * (current - previous) / math.Abs(previous)
+}
@@ -0,0 +1,53 @@
+package perf
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectChangePoints(t *testing.T) {
+	opts := DetectionOptions{Threshold: 5, MinSegment: 3, MinChange: 5}
+
+	t.Run("FlatSeries", func(t *testing.T) {
+		assert.Empty(t, DetectChangePoints([]float64{10, 10, 10, 10, 10, 10, 10}, opts))
+	})
+	t.Run("NoisySeries", func(t *testing.T) {
+		values := []float64{100, 102, 98, 101, 99, 100, 103, 97, 100, 101}
+		assert.Empty(t, DetectChangePoints(values, opts))
+	})
+	t.Run("TooShort", func(t *testing.T) {
+		assert.Empty(t, DetectChangePoints([]float64{10, 10, 50, 50}, opts))
+	})
+	t.Run("SingleStep", func(t *testing.T) {
+		values := []float64{100, 101, 99, 100, 101, 80, 81, 79, 80}
+		changePoints := DetectChangePoints(values, opts)
+		require.Len(t, changePoints, 1)
+		assert.Equal(t, 5, changePoints[0].Index)
+		assert.InDelta(t, 100.2, changePoints[0].PreviousMean, 0.001)
+		assert.InDelta(t, 80, changePoints[0].NewMean, 0.001)
+		assert.InDelta(t, -20.16, changePoints[0].PercentChange(), 0.01)
+	})
+	t.Run("MultipleSteps", func(t *testing.T) {
+		values := []float64{10, 10, 10, 10, 20, 20, 20, 20, 5, 5, 5, 5}
+		changePoints := DetectChangePoints(values, opts)
+		require.Len(t, changePoints, 2)
+		assert.Equal(t, 4, changePoints[0].Index)
+		assert.Equal(t, 8, changePoints[1].Index)
+		assert.True(t, math.IsInf(changePoints[0].Statistic, 1))
+	})
+	t.Run("SmallChange", func(t *testing.T) {
+		values := []float64{100, 100, 100, 100, 102, 102, 102, 102}
+		assert.Empty(t, DetectChangePoints(values, opts))
+		opts.MinChange = 1
+		assert.Len(t, DetectChangePoints(values, opts), 1)
+	})
+}
+
+func TestWelchStatistic(t *testing.T) {
+	assert.Zero(t, welchStatistic([]float64{1, 2, 3}, []float64{3, 2, 1}))
+	assert.True(t, math.IsInf(welchStatistic([]float64{1, 1}, []float64{2, 2}), 1))
+	assert.InDelta(t, 3.674, welchStatistic([]float64{1, 2, 3}, []float64{4, 5, 6}), 0.001)
+}
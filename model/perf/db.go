@@ -0,0 +1,269 @@
+package perf
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	// BSON fields for the result struct
+	IDKey                  = bsonutil.MustHaveTag(Result{}, "ID")
+	TaskIDKey              = bsonutil.MustHaveTag(Result{}, "TaskID")
+	ExecutionKey           = bsonutil.MustHaveTag(Result{}, "Execution")
+	ProjectKey             = bsonutil.MustHaveTag(Result{}, "Project")
+	VersionKey             = bsonutil.MustHaveTag(Result{}, "Version")
+	VariantKey             = bsonutil.MustHaveTag(Result{}, "Variant")
+	TaskNameKey            = bsonutil.MustHaveTag(Result{}, "TaskName")
+	RequesterKey           = bsonutil.MustHaveTag(Result{}, "Requester")
+	RevisionOrderNumberKey = bsonutil.MustHaveTag(Result{}, "RevisionOrderNumber")
+	TestNameKey            = bsonutil.MustHaveTag(Result{}, "TestName")
+	MetricsKey             = bsonutil.MustHaveTag(Result{}, "Metrics")
+	AnalyzedKey            = bsonutil.MustHaveTag(Result{}, "Analyzed")
+	AnalysisFailuresKey    = bsonutil.MustHaveTag(Result{}, "AnalysisFailures")
+	MetricNameKey          = bsonutil.MustHaveTag(Metric{}, "Name")
+
+	// BSON fields for the regression struct
+	RegressionMetricKey     = bsonutil.MustHaveTag(SeriesID{}, "Metric")
+	RegressionStatusKey     = bsonutil.MustHaveTag(Regression{}, "Status")
+	RegressionTriagedByKey  = bsonutil.MustHaveTag(Regression{}, "TriagedBy")
+	RegressionTriagedAtKey  = bsonutil.MustHaveTag(Regression{}, "TriagedAt")
+	RegressionNoteKey       = bsonutil.MustHaveTag(Regression{}, "Note")
+	RegressionCreateTimeKey = bsonutil.MustHaveTag(Regression{}, "CreateTime")
+)
+
+// InsertResults stores the results, replacing any results the same task
+// execution already reported for the same tests.
+func InsertResults(results []Result) error {
+	for _, r := range results {
+		if _, err := db.Upsert(Collection, bson.M{IDKey: r.ID}, r); err != nil {
+			return errors.Wrapf(err, "storing results of test '%s' for task '%s'", r.TestName, r.TaskID)
+		}
+	}
+	return nil
+}
+
+// FindUnanalyzedResults returns up to limit of the project's mainline results
+// that change-point detection hasn't run over yet, oldest first.
+func FindUnanalyzedResults(projectID string, limit int) ([]Result, error) {
+	q := db.Query(bson.M{
+		ProjectKey:   projectID,
+		RequesterKey: evergreen.RepotrackerVersionRequester,
+		AnalyzedKey:  false,
+	}).Sort([]string{RevisionOrderNumberKey}).Limit(limit)
+
+	results := []Result{}
+	if err := db.FindAllQ(Collection, q, &results); err != nil {
+		return nil, errors.Wrapf(err, "finding unanalyzed results for project '%s'", projectID)
+	}
+	return results, nil
+}
+
+// FindProjectsWithUnanalyzedResults returns the IDs of the projects with
+// mainline results that change-point detection hasn't run over yet.
+func FindProjectsWithUnanalyzedResults() ([]string, error) {
+	pipeline := []bson.M{
+		{"$match": bson.M{
+			RequesterKey: evergreen.RepotrackerVersionRequester,
+			AnalyzedKey:  false,
+		}},
+		{"$group": bson.M{"_id": "$" + ProjectKey}},
+	}
+	out := []struct {
+		ID string `bson:"_id"`
+	}{}
+	if err := db.Aggregate(Collection, pipeline, &out); err != nil {
+		return nil, errors.Wrap(err, "finding projects with unanalyzed results")
+	}
+
+	projects := make([]string, 0, len(out))
+	for _, p := range out {
+		projects = append(projects, p.ID)
+	}
+	return projects, nil
+}
+
+// MarkResultsAnalyzed marks the results as having had change-point detection
+// run over them.
+func MarkResultsAnalyzed(ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := db.UpdateAll(Collection,
+		bson.M{IDKey: bson.M{"$in": ids}},
+		bson.M{"$set": bson.M{AnalyzedKey: true}},
+	)
+	return errors.Wrap(err, "marking results as analyzed")
+}
+
+// RecordFailedAnalysis records that change-point detection failed to run over
+// the results' series. Results that have failed too many times are marked as
+// analyzed, so that they don't keep the project's newer results from being
+// analyzed.
+func RecordFailedAnalysis(ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if _, err := db.UpdateAll(Collection,
+		bson.M{IDKey: bson.M{"$in": ids}},
+		bson.M{"$inc": bson.M{AnalysisFailuresKey: 1}},
+	); err != nil {
+		return errors.Wrap(err, "recording failed analysis of results")
+	}
+	_, err := db.UpdateAll(Collection,
+		bson.M{
+			IDKey:               bson.M{"$in": ids},
+			AnalysisFailuresKey: bson.M{"$gte": MaxAnalysisFailures},
+		},
+		bson.M{"$set": bson.M{AnalyzedKey: true}},
+	)
+	return errors.Wrap(err, "marking results that failed analysis too many times as analyzed")
+}
+
+// FindSeries returns the values of the series in the latest limit mainline
+// versions, oldest first. Only the latest execution of the task in each
+// version is counted.
+func FindSeries(series SeriesID, limit int) ([]Point, error) {
+	pipeline := []bson.M{
+		{"$match": bson.M{
+			ProjectKey:   series.Project,
+			VariantKey:   series.Variant,
+			TaskNameKey:  series.TaskName,
+			TestNameKey:  series.TestName,
+			RequesterKey: evergreen.RepotrackerVersionRequester,
+			bsonutil.GetDottedKeyName(MetricsKey, MetricNameKey): series.Metric,
+		}},
+		{"$sort": bson.D{{Key: RevisionOrderNumberKey, Value: -1}, {Key: ExecutionKey, Value: -1}}},
+		{"$group": bson.M{
+			"_id": "$" + VersionKey,
+			"doc": bson.M{"$first": "$$ROOT"},
+		}},
+		{"$replaceRoot": bson.M{"newRoot": "$doc"}},
+		{"$sort": bson.M{RevisionOrderNumberKey: -1}},
+	}
+	if limit > 0 {
+		pipeline = append(pipeline, bson.M{"$limit": limit})
+	}
+
+	results := []Result{}
+	if err := db.Aggregate(Collection, pipeline, &results); err != nil {
+		return nil, errors.Wrapf(err, "finding values of series '%s'", series.String())
+	}
+	for i, j := 0, len(results)-1; i < j; i, j = i+1, j-1 {
+		results[i], results[j] = results[j], results[i]
+	}
+	return pointsFromResults(results, series.Metric), nil
+}
+
+// ByRegressionID returns a query for the regression with the given ID.
+func ByRegressionID(id string) db.Q {
+	return db.Query(bson.M{IDKey: id})
+}
+
+// FindOneRegression returns the regression that matches the query, or nil if
+// there is none.
+func FindOneRegression(query db.Q) (*Regression, error) {
+	r := &Regression{}
+	err := db.FindOneQ(RegressionCollection, query, r)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	return r, err
+}
+
+// FindOneRegressionByID returns the regression with the given ID, or nil if
+// there is none.
+func FindOneRegressionByID(id string) (*Regression, error) {
+	return FindOneRegression(ByRegressionID(id))
+}
+
+// RegressionOptions filter a project's regressions. Empty fields match any
+// value.
+type RegressionOptions struct {
+	Project  string
+	Status   string
+	Variant  string
+	TaskName string
+	TestName string
+	Metric   string
+	Limit    int
+}
+
+// FindRegressions returns the regressions matching the options, most recent
+// first.
+func FindRegressions(opts RegressionOptions) ([]Regression, error) {
+	filter := bson.M{ProjectKey: opts.Project}
+	for key, val := range map[string]string{
+		RegressionStatusKey: opts.Status,
+		VariantKey:          opts.Variant,
+		TaskNameKey:         opts.TaskName,
+		TestNameKey:         opts.TestName,
+		RegressionMetricKey: opts.Metric,
+	} {
+		if val != "" {
+			filter[key] = val
+		}
+	}
+	q := db.Query(filter).Sort([]string{"-" + RevisionOrderNumberKey, "-" + RegressionCreateTimeKey}).Limit(opts.Limit)
+
+	regressions := []Regression{}
+	if err := db.FindAllQ(RegressionCollection, q, &regressions); err != nil {
+		return nil, errors.Wrapf(err, "finding regressions for project '%s'", opts.Project)
+	}
+	return regressions, nil
+}
+
+// CountRegressionsInRange returns the number of regressions in the series
+// between the two orders, inclusive.
+func CountRegressionsInRange(series SeriesID, minOrder, maxOrder int) (int, error) {
+	return db.Count(RegressionCollection, bson.M{
+		ProjectKey:          series.Project,
+		VariantKey:          series.Variant,
+		TaskNameKey:         series.TaskName,
+		TestNameKey:         series.TestName,
+		RegressionMetricKey: series.Metric,
+		RevisionOrderNumberKey: bson.M{
+			"$gte": minOrder,
+			"$lte": maxOrder,
+		},
+	})
+}
+
+// Insert stores the regression if it doesn't already exist and returns
+// whether it was stored. An existing regression is left untouched so that its
+// triage state is kept.
+func (r *Regression) Insert() (bool, error) {
+	change, err := db.Upsert(RegressionCollection, bson.M{IDKey: r.ID}, bson.M{"$setOnInsert": r})
+	if err != nil {
+		return false, errors.Wrapf(err, "storing regression '%s'", r.ID)
+	}
+	return change.UpsertedId != nil, nil
+}
+
+// Triage sets the regression's status and records who triaged it.
+func (r *Regression) Triage(status, user, note string) error {
+	if err := ValidateStatus(status); err != nil {
+		return err
+	}
+	now := time.Now()
+	if err := db.Update(RegressionCollection, bson.M{IDKey: r.ID}, bson.M{
+		"$set": bson.M{
+			RegressionStatusKey:    status,
+			RegressionTriagedByKey: user,
+			RegressionTriagedAtKey: now,
+			RegressionNoteKey:      note,
+		},
+	}); err != nil {
+		return errors.Wrapf(err, "triaging regression '%s'", r.ID)
+	}
+	r.Status = status
+	r.TriagedBy = user
+	r.TriagedAt = now
+	r.Note = note
+	return nil
+}
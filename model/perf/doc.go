@@ -0,0 +1,4 @@
+// Package perf models the performance results reported by tasks and the
+// regressions that change-point detection finds in a project's performance
+// series.
+package perf
@@ -0,0 +1,168 @@
+package perf
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/task"
+)
+
+// Collection is the name of the performance results collection in the
+// database.
+const Collection = "perf_results"
+
+// Metric is a numeric value measured by a performance test.
+type Metric struct {
+	Name  string  `bson:"name" json:"name"`
+	Value float64 `bson:"value" json:"value"`
+}
+
+// TestResult is the metrics of a single performance test reported by a task.
+type TestResult struct {
+	TestName string   `bson:"test_name" json:"test_name"`
+	Metrics  []Metric `bson:"metrics" json:"metrics"`
+}
+
+// Result is the metrics of a performance test reported by a task execution.
+// Fields from the task are denormalized so that a series can be read without
+// joining on tasks.
+type Result struct {
+	ID                  string    `bson:"_id" json:"id"`
+	TaskID              string    `bson:"task_id" json:"task_id"`
+	Execution           int       `bson:"execution" json:"execution"`
+	Project             string    `bson:"project" json:"project"`
+	Version             string    `bson:"version" json:"version"`
+	Variant             string    `bson:"variant" json:"variant"`
+	TaskName            string    `bson:"task_name" json:"task_name"`
+	Requester           string    `bson:"requester" json:"requester"`
+	Revision            string    `bson:"revision" json:"revision"`
+	RevisionOrderNumber int       `bson:"order" json:"order"`
+	CreateTime          time.Time `bson:"create_time" json:"create_time"`
+	TestName            string    `bson:"test_name" json:"test_name"`
+	Metrics             []Metric  `bson:"metrics" json:"metrics"`
+	// Analyzed is whether change-point detection has run over the result's
+	// series since the result was reported.
+	Analyzed bool `bson:"analyzed" json:"analyzed"`
+	// AnalysisFailures is the number of times change-point detection failed
+	// to run over the result's series.
+	AnalysisFailures int `bson:"analysis_failures,omitempty" json:"analysis_failures,omitempty"`
+}
+
+// MaxAnalysisFailures is the number of times change-point detection tries to
+// run over a result's series before giving up on the result.
+const MaxAnalysisFailures = 3
+
+// InSeries returns whether the result has a value in the series.
+func (r *Result) InSeries(series SeriesID) bool {
+	if r.Project != series.Project || r.Variant != series.Variant || r.TaskName != series.TaskName || r.TestName != series.TestName {
+		return false
+	}
+	for _, m := range r.Metrics {
+		if m.Name == series.Metric {
+			return true
+		}
+	}
+	return false
+}
+
+// NewResults returns the results of the task's performance tests.
+func NewResults(t *task.Task, tests []TestResult) []Result {
+	results := make([]Result, 0, len(tests))
+	for _, test := range tests {
+		results = append(results, Result{
+			ID:                  resultID(t.Id, t.Execution, test.TestName),
+			TaskID:              t.Id,
+			Execution:           t.Execution,
+			Project:             t.Project,
+			Version:             t.Version,
+			Variant:             t.BuildVariant,
+			TaskName:            t.DisplayName,
+			Requester:           t.Requester,
+			Revision:            t.Revision,
+			RevisionOrderNumber: t.RevisionOrderNumber,
+			CreateTime:          t.CreateTime,
+			TestName:            test.TestName,
+			Metrics:             test.Metrics,
+		})
+	}
+	return results
+}
+
+func resultID(taskID string, execution int, testName string) string {
+	return fmt.Sprintf("%s_%d_%s", taskID, execution, testName)
+}
+
+// SeriesID identifies the values of a metric measured by a test over a
+// project's mainline versions.
+type SeriesID struct {
+	Project  string `bson:"project" json:"project"`
+	Variant  string `bson:"variant" json:"variant"`
+	TaskName string `bson:"task_name" json:"task_name"`
+	TestName string `bson:"test_name" json:"test_name"`
+	Metric   string `bson:"metric" json:"metric"`
+}
+
+func (s SeriesID) String() string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s", s.Project, s.Variant, s.TaskName, s.TestName, s.Metric)
+}
+
+// SeriesFromResults returns the series that the results' metrics belong to,
+// sorted and without duplicates.
+func SeriesFromResults(results []Result) []SeriesID {
+	seen := map[SeriesID]bool{}
+	series := []SeriesID{}
+	for _, r := range results {
+		for _, m := range r.Metrics {
+			id := SeriesID{
+				Project:  r.Project,
+				Variant:  r.Variant,
+				TaskName: r.TaskName,
+				TestName: r.TestName,
+				Metric:   m.Name,
+			}
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			series = append(series, id)
+		}
+	}
+	sort.Slice(series, func(i, j int) bool {
+		return series[i].String() < series[j].String()
+	})
+	return series
+}
+
+// Point is the value of a series in a mainline version.
+type Point struct {
+	ResultID            string
+	TaskID              string
+	Version             string
+	Revision            string
+	RevisionOrderNumber int
+	Value               float64
+}
+
+// pointsFromResults returns the value of the metric in each result that
+// measured it.
+func pointsFromResults(results []Result, metric string) []Point {
+	points := make([]Point, 0, len(results))
+	for _, r := range results {
+		for _, m := range r.Metrics {
+			if m.Name != metric {
+				continue
+			}
+			points = append(points, Point{
+				ResultID:            r.ID,
+				TaskID:              r.TaskID,
+				Version:             r.Version,
+				Revision:            r.Revision,
+				RevisionOrderNumber: r.RevisionOrderNumber,
+				Value:               m.Value,
+			})
+			break
+		}
+	}
+	return points
+}
@@ -0,0 +1,169 @@
+package perf
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/task"
+	_ "github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeriesFromResults(t *testing.T) {
+	tsk := &task.Task{Id: "t1", Project: "project", BuildVariant: "ubuntu", DisplayName: "bench"}
+	results := NewResults(tsk, []TestResult{
+		{TestName: "insert", Metrics: []Metric{{Name: "latency", Value: 2}, {Name: "throughput", Value: 100}}},
+		{TestName: "find", Metrics: []Metric{{Name: "latency", Value: 1}}},
+	})
+	require.Len(t, results, 2)
+	assert.Equal(t, "t1_0_insert", results[0].ID)
+	assert.Equal(t, "ubuntu", results[0].Variant)
+
+	// Reporting the same test twice doesn't duplicate its series.
+	results = append(results, results[1])
+	series := SeriesFromResults(results)
+	require.Len(t, series, 3)
+	assert.Equal(t, SeriesID{Project: "project", Variant: "ubuntu", TaskName: "bench", TestName: "find", Metric: "latency"}, series[0])
+	assert.Equal(t, "insert", series[1].TestName)
+	assert.Equal(t, "latency", series[1].Metric)
+	assert.Equal(t, "throughput", series[2].Metric)
+
+	points := pointsFromResults(results, "throughput")
+	require.Len(t, points, 1)
+	assert.Equal(t, 100.0, points[0].Value)
+}
+
+func TestResultInSeries(t *testing.T) {
+	r := Result{
+		Project:  "project",
+		Variant:  "ubuntu",
+		TaskName: "bench",
+		TestName: "insert",
+		Metrics:  []Metric{{Name: "latency", Value: 1}},
+	}
+	series := SeriesID{Project: "project", Variant: "ubuntu", TaskName: "bench", TestName: "insert", Metric: "latency"}
+	assert.True(t, r.InSeries(series))
+
+	series.Metric = "throughput"
+	assert.False(t, r.InSeries(series))
+	series.Metric = "latency"
+	series.TestName = "update"
+	assert.False(t, r.InSeries(series))
+}
+
+func TestNewRegression(t *testing.T) {
+	series := SeriesID{Project: "project", Variant: "ubuntu", TaskName: "bench", TestName: "insert", Metric: "latency"}
+	points := []Point{
+		{Version: "v1", RevisionOrderNumber: 1, Value: 1},
+		{Version: "v2", RevisionOrderNumber: 2, Value: 2},
+	}
+	cp := ChangePoint{Index: 1, PreviousMean: 1, NewMean: 2, Statistic: 10}
+
+	r := NewRegression(series, points, cp)
+	assert.Equal(t, "v2", r.Version)
+	assert.Equal(t, 2, r.RevisionOrderNumber)
+	assert.Equal(t, 100.0, r.PercentChange)
+	assert.Equal(t, RegressionStatusUntriaged, r.Status)
+	assert.Equal(t, r.ID, NewRegression(series, points, cp).ID)
+
+	series.Metric = "throughput"
+	assert.NotEqual(t, r.ID, NewRegression(series, points, cp).ID)
+
+	assert.NoError(t, ValidateStatus(RegressionStatusFalsePositive))
+	assert.Error(t, ValidateStatus("ignored"))
+}
+
+func TestSeriesAndRegressions(t *testing.T) {
+	require.NoError(t, db.ClearCollections(Collection, RegressionCollection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(Collection, RegressionCollection))
+	}()
+
+	makeTask := func(id, version string, execution, order int) *task.Task {
+		return &task.Task{
+			Id:                  id,
+			Execution:           execution,
+			Project:             "project",
+			Version:             version,
+			BuildVariant:        "ubuntu",
+			DisplayName:         "bench",
+			Requester:           evergreen.RepotrackerVersionRequester,
+			RevisionOrderNumber: order,
+		}
+	}
+	insert := func(tsk *task.Task, value float64) {
+		require.NoError(t, InsertResults(NewResults(tsk, []TestResult{
+			{TestName: "insert", Metrics: []Metric{{Name: "latency", Value: value}}},
+		})))
+	}
+	insert(makeTask("t1", "v1", 0, 1), 1)
+	insert(makeTask("t2", "v2", 0, 2), 5)
+	// Only the latest execution of a task counts.
+	insert(makeTask("t2", "v2", 1, 2), 2)
+	insert(makeTask("t3", "v3", 0, 3), 3)
+	patchTask := makeTask("t4", "p1", 0, 3)
+	patchTask.Requester = evergreen.PatchVersionRequester
+	insert(patchTask, 100)
+
+	projects, err := FindProjectsWithUnanalyzedResults()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"project"}, projects)
+
+	unanalyzed, err := FindUnanalyzedResults("project", 10)
+	require.NoError(t, err)
+	assert.Len(t, unanalyzed, 4)
+
+	series := SeriesFromResults(unanalyzed)
+	require.Len(t, series, 1)
+	points, err := FindSeries(series[0], 2)
+	require.NoError(t, err)
+	require.Len(t, points, 2)
+	assert.Equal(t, "v2", points[0].Version)
+	assert.Equal(t, 2.0, points[0].Value)
+	assert.Equal(t, "v3", points[1].Version)
+
+	require.NoError(t, MarkResultsAnalyzed([]string{unanalyzed[0].ID}))
+	unanalyzed, err = FindUnanalyzedResults("project", 10)
+	require.NoError(t, err)
+	assert.Len(t, unanalyzed, 3)
+
+	// Results whose analysis keeps failing are eventually given up on.
+	for i := 0; i < MaxAnalysisFailures; i++ {
+		unanalyzed, err = FindUnanalyzedResults("project", 10)
+		require.NoError(t, err)
+		assert.Len(t, unanalyzed, 3)
+		require.NoError(t, RecordFailedAnalysis([]string{resultID("t3", 0, "insert")}))
+	}
+	unanalyzed, err = FindUnanalyzedResults("project", 10)
+	require.NoError(t, err)
+	assert.Len(t, unanalyzed, 2)
+
+	r := NewRegression(series[0], points, ChangePoint{Index: 1, PreviousMean: 2, NewMean: 3})
+	inserted, err := r.Insert()
+	require.NoError(t, err)
+	assert.True(t, inserted)
+	require.NoError(t, r.Triage(RegressionStatusAcknowledged, "me", "looking into it"))
+
+	// Detecting the regression again keeps its triage state.
+	inserted, err = NewRegression(series[0], points, ChangePoint{Index: 1, PreviousMean: 2, NewMean: 3}).Insert()
+	require.NoError(t, err)
+	assert.False(t, inserted)
+	dbRegression, err := FindOneRegressionByID(r.ID)
+	require.NoError(t, err)
+	require.NotNil(t, dbRegression)
+	assert.Equal(t, RegressionStatusAcknowledged, dbRegression.Status)
+	assert.Equal(t, "me", dbRegression.TriagedBy)
+
+	count, err := CountRegressionsInRange(series[0], 1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	regressions, err := FindRegressions(RegressionOptions{Project: "project", Status: RegressionStatusUntriaged})
+	require.NoError(t, err)
+	assert.Empty(t, regressions)
+	regressions, err = FindRegressions(RegressionOptions{Project: "project", Metric: "latency"})
+	require.NoError(t, err)
+	assert.Len(t, regressions, 1)
+}
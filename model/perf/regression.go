@@ -0,0 +1,97 @@
+package perf
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// RegressionCollection is the name of the performance regressions collection
+// in the database.
+const RegressionCollection = "perf_regressions"
+
+const (
+	// RegressionStatusUntriaged is the status of a regression that no one
+	// has looked at yet.
+	RegressionStatusUntriaged = "untriaged"
+	// RegressionStatusAcknowledged is the status of a regression that is
+	// real and is being investigated.
+	RegressionStatusAcknowledged = "acknowledged"
+	// RegressionStatusFalsePositive is the status of a regression caused by
+	// noise rather than a change in performance.
+	RegressionStatusFalsePositive = "false_positive"
+	// RegressionStatusResolved is the status of a regression that has been
+	// fixed or accepted.
+	RegressionStatusResolved = "resolved"
+)
+
+// ValidRegressionStatuses are the triage statuses a regression can have.
+var ValidRegressionStatuses = []string{
+	RegressionStatusUntriaged,
+	RegressionStatusAcknowledged,
+	RegressionStatusFalsePositive,
+	RegressionStatusResolved,
+}
+
+// Regression is a change point detected in a performance series, along with
+// its triage state. Although it's called a regression, the change may be an
+// improvement, since whether a higher value is better depends on the metric.
+type Regression struct {
+	ID       string `bson:"_id" json:"id"`
+	SeriesID `bson:",inline"`
+
+	// Version, Revision, RevisionOrderNumber, and TaskID identify the first
+	// mainline version whose value differs from the values before it.
+	Version             string `bson:"version" json:"version"`
+	Revision            string `bson:"revision" json:"revision"`
+	RevisionOrderNumber int    `bson:"order" json:"order"`
+	TaskID              string `bson:"task_id" json:"task_id"`
+
+	PreviousMean  float64 `bson:"previous_mean" json:"previous_mean"`
+	NewMean       float64 `bson:"new_mean" json:"new_mean"`
+	PercentChange float64 `bson:"percent_change" json:"percent_change"`
+	Statistic     float64 `bson:"statistic" json:"statistic"`
+
+	Status     string    `bson:"status" json:"status"`
+	TriagedBy  string    `bson:"triaged_by,omitempty" json:"triaged_by,omitempty"`
+	TriagedAt  time.Time `bson:"triaged_at,omitempty" json:"triaged_at,omitempty"`
+	Note       string    `bson:"note,omitempty" json:"note,omitempty"`
+	CreateTime time.Time `bson:"create_time" json:"create_time"`
+}
+
+// NewRegression returns an untriaged regression for the change point in the
+// series' points.
+func NewRegression(series SeriesID, points []Point, cp ChangePoint) *Regression {
+	point := points[cp.Index]
+	return &Regression{
+		ID:                  regressionID(series, point.RevisionOrderNumber),
+		SeriesID:            series,
+		Version:             point.Version,
+		Revision:            point.Revision,
+		RevisionOrderNumber: point.RevisionOrderNumber,
+		TaskID:              point.TaskID,
+		PreviousMean:        cp.PreviousMean,
+		NewMean:             cp.NewMean,
+		PercentChange:       cp.PercentChange(),
+		Statistic:           cp.Statistic,
+		Status:              RegressionStatusUntriaged,
+		CreateTime:          time.Now(),
+	}
+}
+
+// regressionID derives the regression's ID from the series and order, so
+// that detecting the same change point again refers to the same regression.
+func regressionID(series SeriesID, order int) string {
+	return fmt.Sprintf("%x", sha1.Sum([]byte(fmt.Sprintf("%s/%d", series.String(), order))))
+}
+
+// ValidateStatus returns an error if the status isn't a valid triage status.
+func ValidateStatus(status string) error {
+	if !utility.StringSliceContains(ValidRegressionStatuses, status) {
+		return errors.Errorf("invalid regression status '%s', must be one of %v", status, ValidRegressionStatuses)
+	}
+	return nil
+}
@@ -64,6 +64,7 @@ type Project struct {
 	GitTagAliases       []ProjectAlias             `yaml:"git_tag_aliases,omitempty" bson:"git_tag_aliases,omitempty"`
 	GitHubChecksAliases []ProjectAlias             `yaml:"github_checks_aliases,omitempty" bson:"github_checks_aliases,omitempty"`
 	PatchAliases        []ProjectAlias             `yaml:"patch_aliases,omitempty" bson:"patch_aliases,omitempty"`
+	Notifications       []ProjectNotification      `yaml:"notifications,omitempty" bson:"notifications,omitempty"`
 
 	// Flag that indicates a project as requiring user authentication
 	Private bool `yaml:"private,omitempty" bson:"private"`
@@ -276,6 +277,11 @@ type BuildVariant struct {
 	// all of the tasks/groups to be run on the build variant, compile through tests.
 	Tasks        []BuildVariantTaskUnit `yaml:"tasks,omitempty" bson:"tasks"`
 	DisplayTasks []patch.DisplayTask    `yaml:"display_tasks,omitempty" bson:"display_tasks,omitempty"`
+
+	// Inputs are gitignore-style patterns of the files all of the variant's
+	// tasks depend on. Its tasks are only activated in a version or PR patch
+	// that changes at least one of them.
+	Inputs []string `yaml:"inputs,omitempty" bson:"inputs,omitempty"`
 }
 
 // ParameterInfo is used to provide extra information about a parameter.
@@ -554,6 +560,11 @@ type ProjectTask struct {
 	GitTagOnly      *bool `yaml:"git_tag_only,omitempty" bson:"git_tag_only,omitempty"`
 	Stepback        *bool `yaml:"stepback,omitempty" bson:"stepback,omitempty"`
 	MustHaveResults *bool `yaml:"must_have_test_results,omitempty" bson:"must_have_test_results,omitempty"`
+	// Inputs are gitignore-style patterns of the files the task depends on.
+	// The task is only activated in a version or PR patch that changes at
+	// least one of them, and PR patches can reuse its previous result when
+	// none of them changed.
+	Inputs []string `yaml:"inputs,omitempty" bson:"inputs,omitempty"`
 }
 
 type LoggerConfig struct {
@@ -776,6 +787,8 @@ func NewTaskIdTable(p *Project, v *Version, sourceRev, defID string) TaskIdConfi
 			rev = v.Id
 		} else if v.Requester == evergreen.GitTagRequester {
 			rev = fmt.Sprintf("%s_%s", sourceRev, v.TriggeredByGitTag.Tag)
+		} else if v.Requester == evergreen.GithubMergeRequester {
+			rev = fmt.Sprintf("merge_group_%s", v.Revision)
 		}
 		for _, t := range bv.Tasks {
 			// omit tasks excluded from the version
@@ -1001,6 +1014,8 @@ func PopulateExpansions(t *task.Task, h *host.Host, oauthToken string) (util.Exp
 		requesterExpansion = "trigger"
 	case evergreen.MergeTestRequester:
 		requesterExpansion = "commit_queue"
+	case evergreen.GithubMergeRequester:
+		requesterExpansion = "github_merge_queue"
 	case evergreen.AdHocRequester:
 		requesterExpansion = "ad_hoc"
 	default:
@@ -1365,6 +1380,29 @@ func (p *Project) FindAllBuildVariantTasks() []BuildVariantTaskUnit {
 	return allBVTs
 }
 
+// AllTVPairs returns a pair for every task on every enabled variant of the
+// project, with task groups expanded into their tasks.
+func (p *Project) AllTVPairs() TVPairSet {
+	pairs := TVPairSet{}
+	for _, bv := range p.BuildVariants {
+		if bv.Disabled {
+			continue
+		}
+		for _, t := range bv.Tasks {
+			if t.IsGroup {
+				if tg := p.FindTaskGroup(t.Name); tg != nil {
+					for _, name := range tg.Tasks {
+						pairs = append(pairs, TVPair{Variant: bv.Name, TaskName: name})
+					}
+				}
+				continue
+			}
+			pairs = append(pairs, TVPair{Variant: bv.Name, TaskName: t.Name})
+		}
+	}
+	return pairs
+}
+
 func (p *Project) FindAllTasksMap() map[string]ProjectTask {
 	allTasks := map[string]ProjectTask{}
 	for _, task := range p.Tasks {
@@ -1388,6 +1426,33 @@ func (p *Project) FindVariantsWithTask(task string) []string {
 	return variants
 }
 
+// InputsChanged returns whether any of the files matches the task's declared
+// inputs. A task without declared inputs is always considered affected, since
+// there's no way to know what it depends on.
+func (pt *ProjectTask) InputsChanged(files []string) bool {
+	return inputsChanged(pt.Inputs, files)
+}
+
+// InputsChanged returns whether any of the files matches the variant's
+// declared inputs. A variant without declared inputs is always considered
+// affected.
+func (bv *BuildVariant) InputsChanged(files []string) bool {
+	return inputsChanged(bv.Inputs, files)
+}
+
+func inputsChanged(inputs, files []string) bool {
+	if len(inputs) == 0 {
+		return true
+	}
+	matcher := ignore.CompileIgnoreLines(inputs...)
+	for _, f := range files {
+		if matcher.MatchesPath(f) {
+			return true
+		}
+	}
+	return false
+}
+
 // IgnoresAllFiles takes in a slice of filepaths and checks to see if
 // all files are matched by the project's Ignore regular expressions.
 func (p *Project) IgnoresAllFiles(files []string) bool {
@@ -1404,6 +1469,85 @@ func (p *Project) IgnoresAllFiles(files []string) bool {
 	return true
 }
 
+// HasInputs returns whether any variant or task in the project declares the
+// files it depends on.
+func (p *Project) HasInputs() bool {
+	for _, bv := range p.BuildVariants {
+		if len(bv.Inputs) > 0 {
+			return true
+		}
+	}
+	for _, t := range p.Tasks {
+		if len(t.Inputs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAffectedByChangedFiles returns whether the inputs of both the variant and
+// the task include at least one of the changed files. If there are no changed
+// files to go by, every task is considered affected.
+func (p *Project) IsAffectedByChangedFiles(variant, taskName string, files []string) bool {
+	if len(files) == 0 {
+		return true
+	}
+	if bv := p.FindBuildVariant(variant); bv != nil && !bv.InputsChanged(files) {
+		return false
+	}
+	if pt := p.FindProjectTask(taskName); pt != nil && !pt.InputsChanged(files) {
+		return false
+	}
+	return true
+}
+
+// SelectTVPairsForChangedFiles narrows down the pairs to the tasks affected by
+// the changed files, along with the tasks they depend on. Display tasks are
+// kept if any of their execution tasks are. As with IncludeDependencies, a
+// returned error is only informational.
+func (p *Project) SelectTVPairsForChangedFiles(pairs TaskVariantPairs, requester string, files []string) (TaskVariantPairs, error) {
+	if len(files) == 0 || !p.HasInputs() {
+		return pairs, nil
+	}
+
+	affected := []TVPair{}
+	for _, pair := range pairs.ExecTasks {
+		if p.IsAffectedByChangedFiles(pair.Variant, pair.TaskName, files) {
+			affected = append(affected, pair)
+		}
+	}
+	withDeps, err := IncludeDependencies(p, affected, requester)
+	keep := map[TVPair]bool{}
+	for _, pair := range withDeps {
+		keep[pair] = true
+	}
+
+	selected := TaskVariantPairs{}
+	for _, pair := range pairs.ExecTasks {
+		if keep[pair] {
+			selected.ExecTasks = append(selected.ExecTasks, pair)
+		}
+	}
+	for _, pair := range pairs.DisplayTasks {
+		bv := p.FindBuildVariant(pair.Variant)
+		if bv == nil {
+			continue
+		}
+		for _, dt := range bv.DisplayTasks {
+			if dt.Name != pair.TaskName {
+				continue
+			}
+			for _, execTask := range dt.ExecTasks {
+				if keep[TVPair{Variant: pair.Variant, TaskName: execTask}] {
+					selected.DisplayTasks = append(selected.DisplayTasks, pair)
+					break
+				}
+			}
+		}
+	}
+	return selected, errors.Wrap(err, "including dependencies of affected tasks")
+}
+
 // BuildProjectTVPairs resolves the build variants and tasks into which build
 // variants will run and which tasks will run on each build variant.
 func (p *Project) BuildProjectTVPairs(patchDoc *patch.Patch, alias string) {
@@ -0,0 +1,135 @@
+package model
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/pkg/errors"
+)
+
+// ProjectNotification is a subscription declared in the notifications section
+// of a project's configuration file. Notifications are reconciled into
+// project-owned subscriptions whenever a mainline version is created, so that
+// they're versioned along with the code they watch.
+type ProjectNotification struct {
+	ResourceType   string                        `yaml:"resource_type" bson:"resource_type"`
+	Trigger        string                        `yaml:"trigger" bson:"trigger"`
+	Selectors      []ProjectNotificationSelector `yaml:"selectors,omitempty" bson:"selectors,omitempty"`
+	RegexSelectors []ProjectNotificationSelector `yaml:"regex_selectors,omitempty" bson:"regex_selectors,omitempty"`
+	TriggerData    map[string]string             `yaml:"trigger_data,omitempty" bson:"trigger_data,omitempty"`
+	Subscriber     ProjectNotificationSubscriber `yaml:"subscriber" bson:"subscriber"`
+}
+
+type ProjectNotificationSelector struct {
+	Type string `yaml:"type" bson:"type"`
+	Data string `yaml:"data" bson:"data"`
+}
+
+// ProjectNotificationSubscriber is the subscriber of a project notification.
+// Target is used by subscribers that are addressed by a single string (e.g. an
+// email address or a Slack channel); JIRA issue subscribers set Project and
+// IssueType instead. Subscribers that require secrets, such as webhooks, can't
+// be declared in a configuration file.
+type ProjectNotificationSubscriber struct {
+	Type      string `yaml:"type" bson:"type"`
+	Target    string `yaml:"target,omitempty" bson:"target,omitempty"`
+	Project   string `yaml:"project,omitempty" bson:"project,omitempty"`
+	IssueType string `yaml:"issue_type,omitempty" bson:"issue_type,omitempty"`
+}
+
+// ProjectNotificationResourceTypes are the resource types that can be
+// subscribed to in a project's configuration file.
+var ProjectNotificationResourceTypes = []string{
+	event.ResourceTypeTask,
+	event.ResourceTypeBuild,
+	event.ResourceTypeVersion,
+	event.ResourceTypePerfRegression,
+}
+
+func (s *ProjectNotificationSubscriber) toService() (event.Subscriber, error) {
+	sub := event.Subscriber{Type: s.Type}
+	switch s.Type {
+	case event.EmailSubscriberType, event.SlackSubscriberType, event.MSTeamsSubscriberType,
+		event.ChatWebhookSubscriberType, event.JIRACommentSubscriberType:
+		if s.Target == "" {
+			return sub, errors.Errorf("'%s' subscriber must have a target", s.Type)
+		}
+		sub.Target = s.Target
+	case event.JIRAIssueSubscriberType:
+		if s.Project == "" || s.IssueType == "" {
+			return sub, errors.New("JIRA issue subscriber must have a project and issue type")
+		}
+		sub.Target = &event.JIRAIssueSubscriber{
+			Project:   s.Project,
+			IssueType: s.IssueType,
+		}
+	default:
+		return sub, errors.Errorf("'%s' subscribers cannot be declared in the project configuration", s.Type)
+	}
+
+	return sub, nil
+}
+
+// ToSubscription converts the notification into a subscription owned by the
+// given project. The subscription is scoped to the project and, unless a
+// requester selector is given, to mainline commits. Its ID is derived from
+// the notification's contents, so reconciling an unchanged notification
+// updates the same subscription.
+func (n *ProjectNotification) ToSubscription(projectID string) (event.Subscription, error) {
+	subscriber, err := n.Subscriber.toService()
+	if err != nil {
+		return event.Subscription{}, errors.Wrap(err, "invalid subscriber")
+	}
+
+	sub := event.Subscription{
+		ResourceType: n.ResourceType,
+		Trigger:      n.Trigger,
+		Selectors: []event.Selector{
+			{
+				Type: event.SelectorProject,
+				Data: projectID,
+			},
+		},
+		Subscriber:  subscriber,
+		OwnerType:   event.OwnerTypeProject,
+		Owner:       projectID,
+		TriggerData: n.TriggerData,
+	}
+	hasRequester := false
+	selectors := []event.Selector{}
+	for _, selector := range n.Selectors {
+		if selector.Type == event.SelectorProject {
+			return event.Subscription{}, errors.New("project selector cannot be specified because it is implied")
+		}
+		if selector.Type == event.SelectorRequester {
+			hasRequester = true
+		}
+		selectors = append(selectors, event.Selector{Type: selector.Type, Data: selector.Data})
+	}
+	for _, selector := range n.RegexSelectors {
+		sub.RegexSelectors = append(sub.RegexSelectors, event.Selector{Type: selector.Type, Data: selector.Data})
+	}
+	for _, s := range [][]event.Selector{selectors, sub.RegexSelectors} {
+		if ok, msg := event.ValidateSelectors(s); !ok {
+			return event.Subscription{}, errors.New(msg)
+		}
+	}
+	sub.Selectors = append(sub.Selectors, selectors...)
+	if !hasRequester {
+		sub.Selectors = append(sub.Selectors, event.Selector{
+			Type: event.SelectorRequester,
+			Data: evergreen.RepotrackerVersionRequester,
+		})
+	}
+
+	key, err := json.Marshal(n)
+	if err != nil {
+		return event.Subscription{}, errors.Wrap(err, "error computing subscription ID")
+	}
+	sub.ID = event.ProjectConfigSubscriptionID(projectID, fmt.Sprintf("%x", sha1.Sum(key)))
+
+	return sub, nil
+}
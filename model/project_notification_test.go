@@ -0,0 +1,135 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectNotificationsParse(t *testing.T) {
+	yml := `
+buildvariants:
+- name: bv
+  tasks:
+  - name: t1
+tasks:
+- name: t1
+notifications:
+- resource_type: TASK
+  trigger: failure
+  selectors:
+  - type: build-variant
+    data: bv
+  subscriber:
+    type: slack
+    target: "#alerts"
+- resource_type: VERSION
+  trigger: outcome
+  subscriber:
+    type: jira-issue
+    project: EVG
+    issue_type: Bug
+`
+	pp, err := createIntermediateProject([]byte(yml))
+	require.NoError(t, err)
+	require.Len(t, pp.Notifications, 2)
+
+	p, err := TranslateProject(pp)
+	require.NoError(t, err)
+	require.Len(t, p.Notifications, 2)
+	assert.Equal(t, event.ResourceTypeTask, p.Notifications[0].ResourceType)
+	assert.Equal(t, "#alerts", p.Notifications[0].Subscriber.Target)
+	assert.Equal(t, "EVG", p.Notifications[1].Subscriber.Project)
+	assert.Equal(t, "Bug", p.Notifications[1].Subscriber.IssueType)
+}
+
+func TestProjectNotificationToSubscription(t *testing.T) {
+	n := ProjectNotification{
+		ResourceType: event.ResourceTypeTask,
+		Trigger:      event.TriggerFailure,
+		Selectors: []ProjectNotificationSelector{
+			{Type: event.SelectorBuildVariant, Data: "bv"},
+		},
+		TriggerData: map[string]string{event.TestRegexKey: "test.*"},
+		Subscriber: ProjectNotificationSubscriber{
+			Type:   event.EmailSubscriberType,
+			Target: "a@example.com",
+		},
+	}
+
+	t.Run("AddsImpliedSelectors", func(t *testing.T) {
+		sub, err := n.ToSubscription("proj")
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(sub.ID, event.ProjectConfigSubscriptionID("proj", "")))
+		assert.Equal(t, "proj", sub.Owner)
+		assert.Equal(t, event.OwnerTypeProject, sub.OwnerType)
+		assert.Equal(t, "a@example.com", sub.Subscriber.Target)
+		assert.Equal(t, n.TriggerData, sub.TriggerData)
+		assert.Equal(t, []event.Selector{
+			{Type: event.SelectorProject, Data: "proj"},
+			{Type: event.SelectorBuildVariant, Data: "bv"},
+			{Type: event.SelectorRequester, Data: evergreen.RepotrackerVersionRequester},
+		}, sub.Selectors)
+		assert.NoError(t, sub.Validate())
+	})
+	t.Run("IDIsStable", func(t *testing.T) {
+		sub1, err := n.ToSubscription("proj")
+		require.NoError(t, err)
+		sub2, err := n.ToSubscription("proj")
+		require.NoError(t, err)
+		assert.Equal(t, sub1.ID, sub2.ID)
+
+		changed := n
+		changed.Trigger = event.TriggerOutcome
+		sub3, err := changed.ToSubscription("proj")
+		require.NoError(t, err)
+		assert.NotEqual(t, sub1.ID, sub3.ID)
+	})
+	t.Run("KeepsRequesterSelector", func(t *testing.T) {
+		withRequester := n
+		withRequester.Selectors = []ProjectNotificationSelector{
+			{Type: event.SelectorRequester, Data: evergreen.GitTagRequester},
+		}
+		sub, err := withRequester.ToSubscription("proj")
+		require.NoError(t, err)
+		assert.Equal(t, []event.Selector{
+			{Type: event.SelectorProject, Data: "proj"},
+			{Type: event.SelectorRequester, Data: evergreen.GitTagRequester},
+		}, sub.Selectors)
+	})
+	t.Run("RejectsProjectSelector", func(t *testing.T) {
+		withProject := n
+		withProject.Selectors = []ProjectNotificationSelector{
+			{Type: event.SelectorProject, Data: "other"},
+		}
+		_, err := withProject.ToSubscription("proj")
+		assert.Error(t, err)
+	})
+	t.Run("RejectsWebhooks", func(t *testing.T) {
+		webhook := n
+		webhook.Subscriber = ProjectNotificationSubscriber{
+			Type:   event.EvergreenWebhookSubscriberType,
+			Target: "https://example.com",
+		}
+		_, err := webhook.ToSubscription("proj")
+		assert.Error(t, err)
+	})
+	t.Run("RequiresJIRAIssueFields", func(t *testing.T) {
+		jira := n
+		jira.Subscriber = ProjectNotificationSubscriber{
+			Type:    event.JIRAIssueSubscriberType,
+			Project: "EVG",
+		}
+		_, err := jira.ToSubscription("proj")
+		assert.Error(t, err)
+
+		jira.Subscriber.IssueType = "Bug"
+		sub, err := jira.ToSubscription("proj")
+		require.NoError(t, err)
+		assert.Equal(t, &event.JIRAIssueSubscriber{Project: "EVG", IssueType: "Bug"}, sub.Subscriber.Target)
+	})
+}
@@ -2,7 +2,6 @@ package model
 
 import (
 	"context"
-	"encoding/base64"
 	"fmt"
 	"io/ioutil"
 	"reflect"
@@ -82,6 +81,7 @@ type ParserProject struct {
 	Tasks              []parserTask               `yaml:"tasks,omitempty" bson:"tasks,omitempty"`
 	ExecTimeoutSecs    *int                       `yaml:"exec_timeout_secs,omitempty" bson:"exec_timeout_secs,omitempty"`
 	Loggers            *LoggerConfig              `yaml:"loggers,omitempty" bson:"loggers,omitempty"`
+	Notifications      []ProjectNotification      `yaml:"notifications,omitempty" bson:"notifications,omitempty"`
 	CreateTime         time.Time                  `yaml:"create_time,omitempty" bson:"create_time,omitempty"`
 	// List of yamls to merge
 	Include []Include `yaml:"include,omitempty" bson:"include,omitempty"`
@@ -134,6 +134,7 @@ type parserTask struct {
 	GitTagOnly      *bool               `yaml:"git_tag_only,omitempty" bson:"git_tag_only,omitempty"`
 	Stepback        *bool               `yaml:"stepback,omitempty" bson:"stepback,omitempty"`
 	MustHaveResults *bool               `yaml:"must_have_test_results,omitempty" bson:"must_have_test_results,omitempty"`
+	Inputs          parserStringSlice   `yaml:"inputs,omitempty" bson:"inputs,omitempty"`
 }
 
 func (pp *ParserProject) Insert() error {
@@ -328,6 +329,7 @@ type parserBV struct {
 	Tasks         parserBVTaskUnits  `yaml:"tasks,omitempty" bson:"tasks,omitempty"`
 	DisplayTasks  []displayTask      `yaml:"display_tasks,omitempty" bson:"display_tasks,omitempty"`
 	DependsOn     parserDependencies `yaml:"depends_on,omitempty" bson:"depends_on,omitempty"`
+	Inputs        parserStringSlice  `yaml:"inputs,omitempty" bson:"inputs,omitempty"`
 	// If Activate is set to false, then we don't initially activate the build variant.
 	Activate *bool `yaml:"activate,omitempty" bson:"activate,omitempty"`
 
@@ -695,14 +697,10 @@ func retrieveFile(ctx context.Context, opts GetProjectOpts) ([]byte, error) {
 			}
 			opts.Token = ghToken
 		}
-		configFile, err := thirdparty.GetGithubFile(ctx, opts.Token, opts.Ref.Owner, opts.Ref.Repo, opts.RemotePath, opts.Revision)
+		fileContents, err := opts.Ref.GetCodeHost(opts.Token).GetFile(ctx, opts.RemotePath, opts.Revision)
 		if err != nil {
 			return nil, errors.Wrapf(err, "error fetching project file for '%s' at '%s'", opts.Identifier, opts.Revision)
 		}
-		fileContents, err := base64.StdEncoding.DecodeString(*configFile.Content)
-		if err != nil {
-			return nil, errors.Wrapf(err, "unable to decode config file for '%s'", opts.Identifier)
-		}
 		return fileContents, nil
 	}
 }
@@ -724,11 +722,16 @@ func retrieveFileForModule(ctx context.Context, opts GetProjectOpts, modules Mod
 		return nil, errors.Wrapf(err, "can't get module for module name '%s'", moduleName)
 	}
 	repoOwner, repoName := module.GetRepoOwnerAndName()
+	moduleRef := &ProjectRef{
+		Owner: repoOwner,
+		Repo:  repoName,
+	}
+	if opts.Ref != nil && opts.Ref.IsGitHosted() {
+		moduleRef.CodeHost = opts.Ref.CodeHost
+		moduleRef.GitURL = module.Repo
+	}
 	moduleOpts := GetProjectOpts{
-		Ref: &ProjectRef{
-			Owner: repoOwner,
-			Repo:  repoName,
-		},
+		Ref:          moduleRef,
 		RemotePath:   opts.RemotePath,
 		Revision:     module.Branch,
 		Token:        opts.Token,
@@ -745,22 +748,13 @@ func getFileForPatchDiff(ctx context.Context, opts GetProjectOpts) ([]byte, erro
 	if opts.Ref == nil {
 		return nil, errors.New("project not passed in")
 	}
-	var projectFileBytes []byte
-	githubFile, err := thirdparty.GetGithubFile(ctx, opts.Token, opts.Ref.Owner,
-		opts.Ref.Repo, opts.RemotePath, opts.Revision)
+	projectFileBytes, err := opts.Ref.GetCodeHost(opts.Token).GetFile(ctx, opts.RemotePath, opts.Revision)
 	if err != nil {
 		// if the project file doesn't exist, but our patch includes a project file,
 		// we try to apply the diff and proceed.
 		if !(opts.PatchOpts.patch.ConfigChanged(opts.RemotePath) && thirdparty.IsFileNotFound(err)) {
 			// return an error if the github error is network/auth-related or we aren't patching the config
-			return nil, errors.Wrapf(err, "Could not get github file at '%s/%s'@%s: %s", opts.Ref.Owner,
-				opts.Ref.Repo, opts.RemotePath, opts.Revision)
-		}
-	} else {
-		// we successfully got the project file in base64, so we decode it
-		projectFileBytes, err = base64.StdEncoding.DecodeString(*githubFile.Content)
-		if err != nil {
-			return nil, errors.Wrapf(err, "Could not decode github file at '%s/%s'@%s: %s", opts.Ref.Owner,
+			return nil, errors.Wrapf(err, "Could not get file at '%s/%s'@%s: %s", opts.Ref.Owner,
 				opts.Ref.Repo, opts.RemotePath, opts.Revision)
 		}
 	}
@@ -831,6 +825,7 @@ func TranslateProject(pp *ParserProject) (*Project, error) {
 		Functions:          pp.Functions,
 		ExecTimeoutSecs:    utility.FromIntPtr(pp.ExecTimeoutSecs),
 		Loggers:            pp.Loggers,
+		Notifications:      pp.Notifications,
 	}
 	catcher := grip.NewBasicCatcher()
 	tse := NewParserTaskSelectorEvaluator(pp.Tasks)
@@ -905,6 +900,7 @@ func evaluateTaskUnits(tse *taskSelectorEvaluator, tgse *tagSelectorEvaluator, v
 			GitTagOnly:      pt.GitTagOnly,
 			Stepback:        pt.Stepback,
 			MustHaveResults: pt.MustHaveResults,
+			Inputs:          pt.Inputs,
 		}
 		if strings.Contains(strings.TrimSpace(pt.Name), " ") {
 			evalErrs = append(evalErrs, errors.Errorf("spaces are unauthorized in task names ('%s')", pt.Name))
@@ -966,6 +962,7 @@ func evaluateBuildVariants(tse *taskSelectorEvaluator, tgse *tagSelectorEvaluato
 			Stepback:      pbv.Stepback,
 			RunOn:         pbv.RunOn,
 			Tags:          pbv.Tags,
+			Inputs:        pbv.Inputs,
 		}
 		bv.Tasks, errs = evaluateBVTasks(tse, tgse, vse, pbv, tasks)
 
@@ -45,6 +45,7 @@ var (
 	ParserProjectTasksKey             = bsonutil.MustHaveTag(ParserProject{}, "Tasks")
 	ParserProjectExecTimeoutSecsKey   = bsonutil.MustHaveTag(ParserProject{}, "ExecTimeoutSecs")
 	ParserProjectLoggersKey           = bsonutil.MustHaveTag(ParserProject{}, "Loggers")
+	ParserProjectNotificationsKey     = bsonutil.MustHaveTag(ParserProject{}, "Notifications")
 	ParserProjectAxesKey              = bsonutil.MustHaveTag(ParserProject{}, "Axes")
 	ParserProjectCreateTimeKey        = bsonutil.MustHaveTag(ParserProject{}, "CreateTime")
 )
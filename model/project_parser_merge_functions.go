@@ -81,9 +81,10 @@ func (pp *ParserProject) mergeUnorderedUnique(toMerge *ParserProject) error {
 
 // mergeUnordered merges fields that are lists where the order doesn't matter.
 // These fields can only be defined in one yaml and does not consider naming conflicts.
-// These fields include: [ignore, loggers]
+// These fields include: [ignore, loggers, notifications]
 func (pp *ParserProject) mergeUnordered(toMerge *ParserProject) {
 	pp.Ignore = append(pp.Ignore, toMerge.Ignore...)
+	pp.Notifications = append(pp.Notifications, toMerge.Notifications...)
 	pp.Loggers = mergeAllLogs(pp.Loggers, toMerge.Loggers)
 }
 
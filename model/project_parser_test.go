@@ -1266,6 +1266,32 @@ buildvariants:
 	assert.Nil(proj.BuildVariants[2].Tasks[0].PatchOnly)
 }
 
+func TestInputsParsing(t *testing.T) {
+	yml := `
+tasks:
+- name: docs
+  inputs: ["docs/**"]
+- name: test
+  inputs: "src/**"
+buildvariants:
+- name: bv
+  inputs: ["src/**", "docs/**", "!src/vendor/**"]
+  tasks:
+  - name: docs
+  - name: test
+`
+
+	proj := &Project{}
+	_, _, err := LoadProjectInto(context.Background(), []byte(yml), nil, "id", proj)
+	require.NoError(t, err)
+	require.Len(t, proj.Tasks, 2)
+	assert.Equal(t, []string{"docs/**"}, proj.Tasks[0].Inputs)
+	assert.Equal(t, []string{"src/**"}, proj.Tasks[1].Inputs)
+	require.Len(t, proj.BuildVariants, 1)
+	assert.Equal(t, []string{"src/**", "docs/**", "!src/vendor/**"}, proj.BuildVariants[0].Inputs)
+	assert.True(t, proj.HasInputs())
+}
+
 func TestAllowForGitTagTasks(t *testing.T) {
 	yml := `
 tasks:
@@ -17,6 +17,7 @@ import (
 	"github.com/evergreen-ci/evergreen/db"
 	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
 	"github.com/evergreen-ci/evergreen/model/build"
+	"github.com/evergreen-ci/evergreen/model/commitqueue"
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/evergreen-ci/evergreen/model/task"
@@ -52,11 +53,14 @@ type ProjectRef struct {
 	Repo                 string              `bson:"repo_name" json:"repo_name" yaml:"repo"`
 	Branch               string              `bson:"branch_name" json:"branch_name" yaml:"branch"`
 	RemotePath           string              `bson:"remote_path" json:"remote_path" yaml:"remote_path"`
+	CodeHost             string              `bson:"code_host,omitempty" json:"code_host,omitempty" yaml:"code_host"`
+	GitURL               string              `bson:"git_url,omitempty" json:"git_url,omitempty" yaml:"git_url"`
 	PatchingDisabled     *bool               `bson:"patching_disabled,omitempty" json:"patching_disabled,omitempty"`
 	RepotrackerDisabled  *bool               `bson:"repotracker_disabled,omitempty" json:"repotracker_disabled,omitempty" yaml:"repotracker_disabled"`
 	DispatchingDisabled  *bool               `bson:"dispatching_disabled,omitempty" json:"dispatching_disabled,omitempty" yaml:"dispatching_disabled"`
 	PRTestingEnabled     *bool               `bson:"pr_testing_enabled,omitempty" json:"pr_testing_enabled,omitempty" yaml:"pr_testing_enabled"`
 	GithubChecksEnabled  *bool               `bson:"github_checks_enabled,omitempty" json:"github_checks_enabled,omitempty" yaml:"github_checks_enabled"`
+	PRTaskReuseEnabled   *bool               `bson:"pr_task_reuse_enabled,omitempty" json:"pr_task_reuse_enabled,omitempty" yaml:"pr_task_reuse_enabled"`
 	BatchTime            int                 `bson:"batch_time" json:"batch_time" yaml:"batchtime"`
 	DeactivatePrevious   *bool               `bson:"deactivate_previous,omitempty" json:"deactivate_previous,omitempty" yaml:"deactivate_previous"`
 	DefaultLogger        string              `bson:"default_logger" json:"default_logger" yaml:"default_logger"`
@@ -83,6 +87,10 @@ type ProjectRef struct {
 	// TaskSync holds settings for synchronizing task directories to S3.
 	TaskSync TaskSyncOptions `bson:"task_sync" json:"task_sync" yaml:"task_sync"`
 
+	// ArtifactRetention determines how long files attached to the project's
+	// tasks are kept before they are deleted.
+	ArtifactRetention ArtifactRetentionPolicy `bson:"artifact_retention,omitempty" json:"artifact_retention,omitempty" yaml:"artifact_retention,omitempty"`
+
 	// GitTagAuthorizedUsers contains a list of users who are able to create versions from git tags.
 	GitTagAuthorizedUsers []string `bson:"git_tag_authorized_users" json:"git_tag_authorized_users"`
 	GitTagAuthorizedTeams []string `bson:"git_tag_authorized_teams" json:"git_tag_authorized_teams"`
@@ -118,6 +126,69 @@ type CommitQueueParams struct {
 	RequireSigned *bool  `bson:"require_signed" json:"require_signed" yaml:"require_signed"`
 	MergeMethod   string `bson:"merge_method" json:"merge_method" yaml:"merge_method"`
 	Message       string `bson:"message,omitempty" json:"message,omitempty" yaml:"message"`
+	// SpeculativeWindow is the number of items at the front of the queue
+	// that are tested at once, each on top of the items ahead of it. If it's
+	// not set, items are tested in batches of the globally configured size.
+	SpeculativeWindow int `bson:"speculative_window,omitempty" json:"speculative_window,omitempty" yaml:"speculative_window"`
+	// BisectBatchSize is the number of CLI patches that are merged into a
+	// single version and tested together. If the version fails, the batch is
+	// split in half and retested until the failing patch is isolated.
+	BisectBatchSize int `bson:"bisect_batch_size,omitempty" json:"bisect_batch_size,omitempty" yaml:"bisect_batch_size"`
+	// Lanes are the named lanes items can be enqueued in, in priority order.
+	Lanes []commitqueue.Lane `bson:"lanes,omitempty" json:"lanes,omitempty" yaml:"lanes"`
+	// MaxItemsPerAuthor is the number of items a single user can have in the
+	// queue at once. If it's not set, there's no limit.
+	MaxItemsPerAuthor int `bson:"max_items_per_author,omitempty" json:"max_items_per_author,omitempty" yaml:"max_items_per_author"`
+	// MergeQueue is the queue that merges PRs into the branch, either
+	// Evergreen's commit queue or GitHub's merge queue. If it's not set,
+	// Evergreen's commit queue is used.
+	MergeQueue string `bson:"merge_queue,omitempty" json:"merge_queue,omitempty" yaml:"merge_queue"`
+}
+
+const (
+	MergeQueueEvergreen = "EVERGREEN"
+	MergeQueueGitHub    = "GITHUB"
+)
+
+var ValidMergeQueues = []string{"", MergeQueueEvergreen, MergeQueueGitHub}
+
+// Validate checks that the commit queue's lanes are valid and that the
+// per-author item limit is not negative.
+func (p *CommitQueueParams) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.Wrap(commitqueue.ValidateLanes(p.Lanes), "invalid commit queue lanes")
+	catcher.NewWhen(p.MaxItemsPerAuthor < 0, "commit queue max items per author cannot be negative")
+	return catcher.Resolve()
+}
+
+// ArtifactRetentionPolicy determines how many days the files attached to a
+// project's tasks are kept. A number of days that is not set means the files
+// are kept forever.
+type ArtifactRetentionPolicy struct {
+	MainlineDays int `bson:"mainline_days,omitempty" json:"mainline_days,omitempty" yaml:"mainline_days,omitempty"`
+	PatchDays    int `bson:"patch_days,omitempty" json:"patch_days,omitempty" yaml:"patch_days,omitempty"`
+	// Buckets are the buckets that expired files are deleted from with
+	// Evergreen's own credentials. Files stored in any other bucket are kept.
+	Buckets []string `bson:"buckets,omitempty" json:"buckets,omitempty" yaml:"buckets,omitempty"`
+	// PinnedVersions are versions whose files are kept forever.
+	PinnedVersions []string `bson:"pinned_versions,omitempty" json:"pinned_versions,omitempty" yaml:"pinned_versions,omitempty"`
+}
+
+// IsEnabled returns whether the policy expires any files.
+func (p *ArtifactRetentionPolicy) IsEnabled() bool {
+	return p.MainlineDays > 0 || p.PatchDays > 0
+}
+
+// Validate checks that the policy's number of days are not negative.
+func (p *ArtifactRetentionPolicy) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(p.MainlineDays < 0, "mainline artifact retention days cannot be negative")
+	catcher.NewWhen(p.PatchDays < 0, "patch artifact retention days cannot be negative")
+	catcher.NewWhen(p.IsEnabled() && len(p.Buckets) == 0, "artifact retention must list the buckets to delete files from")
+	for _, bucket := range p.Buckets {
+		catcher.NewWhen(bucket == "", "artifact retention bucket cannot be empty")
+	}
+	return catcher.Resolve()
 }
 
 // TaskSyncOptions contains information about which features are allowed for
@@ -199,6 +270,8 @@ var (
 	ProjectRefIdKey                      = bsonutil.MustHaveTag(ProjectRef{}, "Id")
 	ProjectRefOwnerKey                   = bsonutil.MustHaveTag(ProjectRef{}, "Owner")
 	ProjectRefRepoKey                    = bsonutil.MustHaveTag(ProjectRef{}, "Repo")
+	projectRefCodeHostKey                = bsonutil.MustHaveTag(ProjectRef{}, "CodeHost")
+	projectRefGitURLKey                  = bsonutil.MustHaveTag(ProjectRef{}, "GitURL")
 	ProjectRefBranchKey                  = bsonutil.MustHaveTag(ProjectRef{}, "Branch")
 	ProjectRefEnabledKey                 = bsonutil.MustHaveTag(ProjectRef{}, "Enabled")
 	ProjectRefPrivateKey                 = bsonutil.MustHaveTag(ProjectRef{}, "Private")
@@ -220,11 +293,13 @@ var (
 	projectRefDefaultLoggerKey           = bsonutil.MustHaveTag(ProjectRef{}, "DefaultLogger")
 	projectRefCedarTestResultsEnabledKey = bsonutil.MustHaveTag(ProjectRef{}, "CedarTestResultsEnabled")
 	projectRefPRTestingEnabledKey        = bsonutil.MustHaveTag(ProjectRef{}, "PRTestingEnabled")
+	projectRefPRTaskReuseEnabledKey      = bsonutil.MustHaveTag(ProjectRef{}, "PRTaskReuseEnabled")
 	projectRefGithubChecksEnabledKey     = bsonutil.MustHaveTag(ProjectRef{}, "GithubChecksEnabled")
 	projectRefGitTagVersionsEnabledKey   = bsonutil.MustHaveTag(ProjectRef{}, "GitTagVersionsEnabled")
 	projectRefRepotrackerDisabledKey     = bsonutil.MustHaveTag(ProjectRef{}, "RepotrackerDisabled")
 	projectRefCommitQueueKey             = bsonutil.MustHaveTag(ProjectRef{}, "CommitQueue")
 	projectRefTaskSyncKey                = bsonutil.MustHaveTag(ProjectRef{}, "TaskSync")
+	projectRefArtifactRetentionKey       = bsonutil.MustHaveTag(ProjectRef{}, "ArtifactRetention")
 	projectRefPatchingDisabledKey        = bsonutil.MustHaveTag(ProjectRef{}, "PatchingDisabled")
 	projectRefDispatchingDisabledKey     = bsonutil.MustHaveTag(ProjectRef{}, "DispatchingDisabled")
 	projectRefNotifyOnFailureKey         = bsonutil.MustHaveTag(ProjectRef{}, "NotifyOnBuildFailure")
@@ -238,8 +313,10 @@ var (
 	projectRefBuildBaronSettingsKey      = bsonutil.MustHaveTag(ProjectRef{}, "BuildBaronSettings")
 	projectRefPerfEnabledKey             = bsonutil.MustHaveTag(ProjectRef{}, "PerfEnabled")
 
-	commitQueueEnabledKey       = bsonutil.MustHaveTag(CommitQueueParams{}, "Enabled")
-	triggerDefinitionProjectKey = bsonutil.MustHaveTag(TriggerDefinition{}, "Project")
+	commitQueueEnabledKey            = bsonutil.MustHaveTag(CommitQueueParams{}, "Enabled")
+	artifactRetentionMainlineDaysKey = bsonutil.MustHaveTag(ArtifactRetentionPolicy{}, "MainlineDays")
+	artifactRetentionPatchDaysKey    = bsonutil.MustHaveTag(ArtifactRetentionPolicy{}, "PatchDays")
+	triggerDefinitionProjectKey      = bsonutil.MustHaveTag(TriggerDefinition{}, "Project")
 )
 
 func (p *ProjectRef) IsEnabled() bool {
@@ -274,6 +351,40 @@ func (p *ProjectRef) IsGithubChecksEnabled() bool {
 	return utility.FromBoolPtr(p.GithubChecksEnabled)
 }
 
+// IsPRTaskReuseEnabled returns whether new PR patches should only schedule the
+// tasks affected by changes since the previous patch for the pull request.
+func (p *ProjectRef) IsPRTaskReuseEnabled() bool {
+	return utility.FromBoolPtr(p.PRTaskReuseEnabled)
+}
+
+// IsGitHosted returns whether the project's repository is on a plain git
+// server rather than GitHub.
+func (p *ProjectRef) IsGitHosted() bool {
+	return p.CodeHost == thirdparty.CodeHostGit
+}
+
+// GetCodeHost returns the code host for the project's repository. The token
+// is only used for repositories hosted on GitHub.
+func (p *ProjectRef) GetCodeHost(githubToken string) thirdparty.CodeHost {
+	if p.IsGitHosted() {
+		return thirdparty.NewGitCodeHost(p.GitURL)
+	}
+	return thirdparty.NewGitHubCodeHost(githubToken, p.Owner, p.Repo)
+}
+
+// GetModuleCodeHost returns the code host for one of the project's modules.
+// Modules are assumed to live on the same kind of code host as the project.
+func (p *ProjectRef) GetModuleCodeHost(module Module, githubToken string) (thirdparty.CodeHost, error) {
+	if p.IsGitHosted() {
+		return thirdparty.NewGitCodeHost(module.Repo), nil
+	}
+	owner, repo, err := thirdparty.ParseGitUrl(module.Repo)
+	if err != nil {
+		return nil, errors.Wrapf(err, "module '%s' misconfigured (malformed URL)", module.Name)
+	}
+	return thirdparty.NewGitHubCodeHost(githubToken, owner, repo), nil
+}
+
 func (p *ProjectRef) ShouldDeactivatePrevious() bool {
 	return utility.FromBoolPtr(p.DeactivatePrevious)
 }
@@ -314,6 +425,12 @@ func (p *CommitQueueParams) IsEnabled() bool {
 	return utility.FromBoolPtr(p.Enabled)
 }
 
+// IsGithubMergeQueueEnabled returns whether PRs are merged by GitHub's merge
+// queue, with Evergreen testing the merge groups it creates.
+func (p *CommitQueueParams) IsGithubMergeQueueEnabled() bool {
+	return p.IsEnabled() && p.MergeQueue == MergeQueueGitHub
+}
+
 func (ts *TaskSyncOptions) IsPatchEnabled() bool {
 	return utility.FromBoolPtr(ts.PatchEnabled)
 }
@@ -1090,6 +1207,17 @@ func FindAllMergedProjectRefs() ([]ProjectRef, error) {
 	return FindProjectRefsQ(bson.M{})
 }
 
+// FindProjectRefsWithArtifactRetention returns the project refs with an
+// artifact retention policy that expires files.
+func FindProjectRefsWithArtifactRetention() ([]ProjectRef, error) {
+	return FindProjectRefsQ(bson.M{
+		"$or": []bson.M{
+			{bsonutil.GetDottedKeyName(projectRefArtifactRetentionKey, artifactRetentionMainlineDaysKey): bson.M{"$gt": 0}},
+			{bsonutil.GetDottedKeyName(projectRefArtifactRetentionKey, artifactRetentionPatchDaysKey): bson.M{"$gt": 0}},
+		},
+	})
+}
+
 func FindProjectRefsByIds(ids []string) ([]ProjectRef, error) {
 	return FindProjectRefsQ(bson.M{
 		ProjectRefIdKey: bson.M{
@@ -1207,7 +1335,7 @@ func FindOneProjectRefByRepoAndBranchWithPRTesting(owner, repo, branch string) (
 			owner, repo, branch)
 	}
 	for _, p := range projectRefs {
-		if p.IsPRTestingEnabled() {
+		if p.IsPRTestingEnabled() && !p.IsGitHosted() {
 			p.checkDefaultLogger()
 			return &p, nil
 		}
@@ -1561,12 +1689,15 @@ func SaveProjectPageForSection(projectId string, p *ProjectRef, section ProjectP
 			projectRefCedarTestResultsEnabledKey: p.CedarTestResultsEnabled,
 			projectRefPatchingDisabledKey:        p.PatchingDisabled,
 			projectRefTaskSyncKey:                p.TaskSync,
+			projectRefArtifactRetentionKey:       p.ArtifactRetention,
 			ProjectRefDisabledStatsCacheKey:      p.DisabledStatsCache,
 			ProjectRefFilesIgnoredFromCacheKey:   p.FilesIgnoredFromCache,
 		}
 		if !isRepo && !p.UseRepoSettings() {
 			setUpdate[ProjectRefOwnerKey] = p.Owner
 			setUpdate[ProjectRefRepoKey] = p.Repo
+			setUpdate[projectRefCodeHostKey] = p.CodeHost
+			setUpdate[projectRefGitURLKey] = p.GitURL
 			setUpdate[ProjectRefRepoRefIdKey] = p.RepoRefId // just in case this is outdated somehow
 		}
 		err = db.Update(coll,
@@ -1600,6 +1731,7 @@ func SaveProjectPageForSection(projectId string, p *ProjectRef, section ProjectP
 			bson.M{
 				"$set": bson.M{
 					projectRefPRTestingEnabledKey:      p.PRTestingEnabled,
+					projectRefPRTaskReuseEnabledKey:    p.PRTaskReuseEnabled,
 					projectRefGithubChecksEnabledKey:   p.GithubChecksEnabled,
 					projectRefGithubTriggerAliasesKey:  p.PatchTriggerAliases,
 					projectRefGitTagVersionsEnabledKey: p.GitTagVersionsEnabled,
@@ -1847,6 +1979,19 @@ func (p *ProjectRef) ValidateOwnerAndRepo(validOrgs []string) error {
 	if p.Owner == "" || p.Repo == "" {
 		return errors.New("no owner/repo specified")
 	}
+	if !utility.StringSliceContains(thirdparty.ValidCodeHosts, p.CodeHost) {
+		return errors.Errorf("invalid code host '%s'", p.CodeHost)
+	}
+	if p.IsGitHosted() {
+		if p.GitURL == "" {
+			return errors.New("git URL must be specified for projects hosted on a git server")
+		}
+		if p.IsPRTestingEnabled() || p.IsGithubChecksEnabled() {
+			return errors.New("PR testing and GitHub checks require the project to be hosted on GitHub")
+		}
+		// GitHub organization restrictions don't apply outside of GitHub.
+		return nil
+	}
 
 	if len(validOrgs) > 0 && !utility.StringSliceContains(validOrgs, p.Owner) {
 		return errors.New("owner not authorized")
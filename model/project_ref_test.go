@@ -10,10 +10,12 @@ import (
 	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/evergreen-ci/evergreen/db"
 	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/model/commitqueue"
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/evergreen-ci/evergreen/model/user"
 	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/evergreen-ci/evergreen/thirdparty"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/evergreen-ci/utility"
 	"github.com/stretchr/testify/assert"
@@ -1401,6 +1403,67 @@ func TestFindProjectRefsWithCommitQueueEnabled(t *testing.T) {
 	assert.Len(projectRefs, 2)
 }
 
+func TestGetCodeHost(t *testing.T) {
+	pRef := ProjectRef{Owner: "evergreen-ci", Repo: "evergreen"}
+	assert.Equal(t, thirdparty.CodeHostGitHub, pRef.GetCodeHost("token").Type())
+	assert.True(t, pRef.GetCodeHost("token").SupportsPullRequests())
+	assert.Equal(t, "git@github.com:evergreen-ci/evergreen.git", pRef.GetCodeHost("token").CloneURL())
+
+	pRef.CodeHost = thirdparty.CodeHostGit
+	pRef.GitURL = "ssh://git.example.com/evergreen.git"
+	host := pRef.GetCodeHost("token")
+	assert.Equal(t, thirdparty.CodeHostGit, host.Type())
+	assert.False(t, host.SupportsPullRequests())
+	assert.Equal(t, pRef.GitURL, host.CloneURL())
+
+	moduleHost, err := pRef.GetModuleCodeHost(Module{Name: "mod", Repo: "https://git.example.com/mod.git"}, "token")
+	require.NoError(t, err)
+	assert.Equal(t, "https://git.example.com/mod.git", moduleHost.CloneURL())
+}
+
+func TestCommitQueueParamsValidate(t *testing.T) {
+	params := CommitQueueParams{
+		Lanes:             []commitqueue.Lane{{Name: "hotfix", MaxConcurrent: 1}, {Name: "bulk"}},
+		MaxItemsPerAuthor: 2,
+	}
+	assert.NoError(t, params.Validate())
+
+	params.Lanes = append(params.Lanes, commitqueue.Lane{Name: "hotfix"})
+	assert.Error(t, params.Validate())
+
+	params.Lanes = nil
+	params.MaxItemsPerAuthor = -1
+	assert.Error(t, params.Validate())
+}
+
+func TestArtifactRetentionPolicyValidate(t *testing.T) {
+	policy := ArtifactRetentionPolicy{}
+	assert.NoError(t, policy.Validate())
+
+	policy.MainlineDays = 30
+	assert.Error(t, policy.Validate(), "enabled policy must list its buckets")
+
+	policy.Buckets = []string{"bucket"}
+	assert.NoError(t, policy.Validate())
+
+	policy.Buckets = append(policy.Buckets, "")
+	assert.Error(t, policy.Validate())
+}
+
+func TestValidateOwnerAndRepoWithCodeHost(t *testing.T) {
+	pRef := ProjectRef{Owner: "evergreen-ci", Repo: "evergreen", CodeHost: "svn"}
+	assert.Error(t, pRef.ValidateOwnerAndRepo(nil))
+
+	pRef.CodeHost = thirdparty.CodeHostGit
+	assert.Error(t, pRef.ValidateOwnerAndRepo(nil), "git URL is required")
+
+	pRef.GitURL = "ssh://git.example.com/evergreen.git"
+	assert.NoError(t, pRef.ValidateOwnerAndRepo([]string{"mongodb"}), "GitHub organizations should not apply")
+
+	pRef.PRTestingEnabled = utility.TruePtr()
+	assert.Error(t, pRef.ValidateOwnerAndRepo(nil))
+}
+
 func TestValidatePeriodicBuildDefinition(t *testing.T) {
 	assert := assert.New(t)
 	testCases := map[PeriodicBuildDefinition]bool{
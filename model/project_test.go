@@ -1790,3 +1790,90 @@ func TestSkipOnNonGitTagBuild(t *testing.T) {
 	assert.False(t, !evergreen.IsGitTagRequester(r) && bvt.SkipOnNonGitTagBuild())
 	assert.False(t, bvt.SkipOnRequester(r))
 }
+
+func TestInputsChanged(t *testing.T) {
+	pt := ProjectTask{Name: "t"}
+	assert.True(t, pt.InputsChanged(nil))
+	assert.True(t, pt.InputsChanged([]string{"README.md"}))
+
+	pt.Inputs = []string{"src/**", "*.go", "!docs/*.go"}
+	assert.False(t, pt.InputsChanged(nil))
+	assert.False(t, pt.InputsChanged([]string{"README.md", "docs/example.go"}))
+	assert.True(t, pt.InputsChanged([]string{"README.md", "src/lib/file.c"}))
+	assert.True(t, pt.InputsChanged([]string{"cmd/main.go"}))
+}
+
+func TestSelectTVPairsForChangedFiles(t *testing.T) {
+	p := &Project{
+		Tasks: []ProjectTask{
+			{Name: "compile"},
+			{Name: "docs", Inputs: []string{"docs/**"}},
+			{Name: "test", Inputs: []string{"*", "!*.md"}, DependsOn: []TaskUnitDependency{{Name: "compile"}}},
+			{Name: "lint", Inputs: []string{"*.go", "!vendor/**"}},
+		},
+		BuildVariants: []BuildVariant{
+			{
+				Name:  "bv",
+				Tasks: []BuildVariantTaskUnit{{Name: "compile"}, {Name: "docs"}, {Name: "test"}, {Name: "lint"}},
+				DisplayTasks: []patch.DisplayTask{
+					{Name: "docs_dt", ExecTasks: []string{"docs"}},
+					{Name: "all_dt", ExecTasks: []string{"compile", "test"}},
+				},
+			},
+			{
+				Name:   "web",
+				Inputs: []string{"web/**"},
+				Tasks:  []BuildVariantTaskUnit{{Name: "compile"}},
+			},
+		},
+	}
+	pairs := TaskVariantPairs{
+		ExecTasks: p.AllTVPairs(),
+		DisplayTasks: TVPairSet{
+			{Variant: "bv", TaskName: "docs_dt"},
+			{Variant: "bv", TaskName: "all_dt"},
+		},
+	}
+	require.Len(t, pairs.ExecTasks, 5)
+
+	for testName, testCase := range map[string]struct {
+		files        []string
+		execTasks    TVPairSet
+		displayTasks TVPairSet
+	}{
+		"NoChangedFilesSelectsEverything": {
+			execTasks:    pairs.ExecTasks,
+			displayTasks: pairs.DisplayTasks,
+		},
+		"IgnoredFilesOnly": {
+			files:        []string{"README.md"},
+			execTasks:    TVPairSet{{Variant: "bv", TaskName: "compile"}},
+			displayTasks: TVPairSet{{Variant: "bv", TaskName: "all_dt"}},
+		},
+		"WatchedPaths": {
+			files: []string{"docs/index.md", "web/app.js"},
+			execTasks: TVPairSet{
+				{Variant: "bv", TaskName: "compile"},
+				{Variant: "bv", TaskName: "docs"},
+				{Variant: "bv", TaskName: "test"},
+				{Variant: "web", TaskName: "compile"},
+			},
+			displayTasks: pairs.DisplayTasks,
+		},
+		"NegatedInputsExcludeFiles": {
+			files: []string{"vendor/lib.go"},
+			execTasks: TVPairSet{
+				{Variant: "bv", TaskName: "compile"},
+				{Variant: "bv", TaskName: "test"},
+			},
+			displayTasks: TVPairSet{{Variant: "bv", TaskName: "all_dt"}},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			selected, err := p.SelectTVPairsForChangedFiles(pairs, evergreen.PatchVersionRequester, testCase.files)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, testCase.execTasks, selected.ExecTasks)
+			assert.ElementsMatch(t, testCase.displayTasks, selected.DisplayTasks)
+		})
+	}
+}
@@ -72,6 +72,8 @@ var (
 	GenerateTaskKey             = bsonutil.MustHaveTag(Task{}, "GenerateTask")
 	GeneratedTasksKey           = bsonutil.MustHaveTag(Task{}, "GeneratedTasks")
 	GeneratedByKey              = bsonutil.MustHaveTag(Task{}, "GeneratedBy")
+	ReusedFromTaskKey           = bsonutil.MustHaveTag(Task{}, "ReusedFromTask")
+	ReusedFromPatchKey          = bsonutil.MustHaveTag(Task{}, "ReusedFromPatch")
 	HasLegacyResultsKey         = bsonutil.MustHaveTag(Task{}, "HasLegacyResults")
 	HasCedarResultsKey          = bsonutil.MustHaveTag(Task{}, "HasCedarResults")
 	CedarResultsFailedKey       = bsonutil.MustHaveTag(Task{}, "CedarResultsFailed")
@@ -529,6 +531,27 @@ func ByBeforeRevisionWithStatusesAndRequesters(revisionOrder int, statuses []str
 	})
 }
 
+// ByAfterRevisionWithStatusesAndRequesters creates a query that returns the
+// tasks after the given revision order number, up to and including the second
+// revision order number, that have one of the given statuses and requesters.
+func ByAfterRevisionWithStatusesAndRequesters(afterRevisionOrder, throughRevisionOrder int, statuses []string, buildVariant, displayName, project string, requesters []string) db.Q {
+	return db.Query(bson.M{
+		BuildVariantKey: buildVariant,
+		DisplayNameKey:  displayName,
+		RequesterKey: bson.M{
+			"$in": requesters,
+		},
+		RevisionOrderNumberKey: bson.M{
+			"$gt":  afterRevisionOrder,
+			"$lte": throughRevisionOrder,
+		},
+		StatusKey: bson.M{
+			"$in": statuses,
+		},
+		ProjectKey: project,
+	}).Sort([]string{RevisionOrderNumberKey})
+}
+
 // ByTimeRun returns all tasks that are running in between two given times.
 func ByTimeRun(startTime, endTime time.Time) db.Q {
 	return db.Query(
@@ -902,6 +925,40 @@ type BuildVariantTuple struct {
 	DisplayName  string `bson:"display_name"`
 }
 
+// FindLatestMainlineFailures returns, for each task name and variant in the
+// given projects, the latest mainline task that finished after the given time
+// if it failed.
+func FindLatestMainlineFailures(projectIDs []string, finishedAfter time.Time) ([]Task, error) {
+	if len(projectIDs) == 0 {
+		return nil, nil
+	}
+	pipeline := []bson.M{
+		{"$match": bson.M{
+			ProjectKey:    bson.M{"$in": projectIDs},
+			RequesterKey:  bson.M{"$in": evergreen.SystemVersionRequesterTypes},
+			StatusKey:     bson.M{"$in": []string{evergreen.TaskFailed, evergreen.TaskSucceeded}},
+			FinishTimeKey: bson.M{"$gt": finishedAfter},
+		}},
+		{"$sort": bson.M{RevisionOrderNumberKey: -1}},
+		{"$group": bson.M{
+			"_id": bson.M{
+				ProjectKey:      "$" + ProjectKey,
+				BuildVariantKey: "$" + BuildVariantKey,
+				DisplayNameKey:  "$" + DisplayNameKey,
+			},
+			"latest": bson.M{"$first": "$$ROOT"},
+		}},
+		{"$replaceRoot": bson.M{"newRoot": "$latest"}},
+		{"$match": bson.M{StatusKey: evergreen.TaskFailed}},
+	}
+
+	tasks := []Task{}
+	if err := Aggregate(pipeline, &tasks); err != nil {
+		return nil, errors.Wrap(err, "can't get latest mainline failures")
+	}
+	return tasks, nil
+}
+
 const VersionLimit = 50
 
 // FindUniqueBuildVariantNamesByTask returns a list of unique build variants names and their display names for a given task name.
@@ -206,6 +206,12 @@ type Task struct {
 
 	CommitQueueMerge bool `bson:"commit_queue_merge,omitempty" json:"commit_queue_merge,omitempty"`
 
+	// ReusedFromTask and ReusedFromPatch are set on PR patch tasks that were
+	// not scheduled because their inputs are unchanged since a previous patch
+	// for the same pull request, in which the task succeeded.
+	ReusedFromTask  string `bson:"reused_from_task,omitempty" json:"reused_from_task,omitempty"`
+	ReusedFromPatch string `bson:"reused_from_patch,omitempty" json:"reused_from_patch,omitempty"`
+
 	CanSync       bool             `bson:"can_sync" json:"can_sync"`
 	SyncAtEndOpts SyncAtEndOptions `bson:"sync_at_end_opts,omitempty" json:"sync_at_end_opts,omitempty"`
 
@@ -306,6 +312,18 @@ type TestResult struct {
 	TaskID          string  `json:"task_id" bson:"task_id"`
 	Execution       int     `json:"execution" bson:"execution"`
 
+	// FailureMessage and StackTrace describe why a test failed, as reported
+	// by the test framework.
+	FailureMessage string `json:"failure_message,omitempty" bson:"failure_message,omitempty"`
+	StackTrace     string `json:"stack_trace,omitempty" bson:"stack_trace,omitempty"`
+	// Tags are the categories or labels the test framework attached to the
+	// test, and Owners are the people or teams responsible for it.
+	Tags   []string `json:"tags,omitempty" bson:"tags,omitempty"`
+	Owners []string `json:"owners,omitempty" bson:"owners,omitempty"`
+	// Retries is the number of times the test was retried within the test
+	// suite before reaching its final status.
+	Retries int `json:"retries,omitempty" bson:"retries,omitempty"`
+
 	// LogRaw and LogTestName are not saved in the task
 	LogRaw      string `json:"log_raw" bson:"log_raw,omitempty"`
 	LogTestName string `json:"log_test_name" bson:"log_test_name"`
@@ -1200,6 +1218,11 @@ func ActivateTasks(tasks []Task, activationTime time.Time, caller string) error
 				ActivatedByKey:   caller,
 				ActivatedTimeKey: activationTime,
 			},
+			// a task that runs no longer reuses an earlier result
+			"$unset": bson.M{
+				ReusedFromTaskKey:  1,
+				ReusedFromPatchKey: 1,
+			},
 		})
 	if err != nil {
 		return errors.Wrap(err, "can't activate tasks")
@@ -1839,6 +1862,11 @@ func (t TestResult) convertToNewStyleTestResult(task *Task) testresult.TestResul
 		ExitCode:        t.ExitCode,
 		StartTime:       t.StartTime,
 		EndTime:         t.EndTime,
+		FailureMessage:  t.FailureMessage,
+		StackTrace:      t.StackTrace,
+		Tags:            t.Tags,
+		Owners:          t.Owners,
+		Retries:         t.Retries,
 
 		// copy field values from enclosing tasks.
 		TaskID:               task.Id,
@@ -1872,6 +1900,11 @@ func ConvertToOld(in *testresult.TestResult) TestResult {
 		LogRaw:          in.LogRaw,
 		TaskID:          in.TaskID,
 		Execution:       in.Execution,
+		FailureMessage:  in.FailureMessage,
+		StackTrace:      in.StackTrace,
+		Tags:            in.Tags,
+		Owners:          in.Owners,
+		Retries:         in.Retries,
 	}
 }
 
@@ -3563,6 +3596,9 @@ func (t *Task) getCedarTestResults() ([]TestResult, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "getting test results from cedar")
 	}
+	if err = AddCedarTestResultMetadata(cedarResults.Results); err != nil {
+		return nil, errors.WithStack(err)
+	}
 
 	results := make([]TestResult, len(cedarResults.Results))
 	for i, result := range cedarResults.Results {
@@ -3635,5 +3671,86 @@ func ConvertCedarTestResult(result apimodels.CedarTestResult) TestResult {
 		StartTime:       float64(result.Start.Unix()),
 		EndTime:         float64(result.End.Unix()),
 		Status:          result.Status,
+		FailureMessage:  result.FailureMessage,
+		StackTrace:      result.StackTrace,
+		Tags:            result.Tags,
+		Owners:          result.Owners,
+		Retries:         result.Retries,
+	}
+}
+
+// AddCedarTestResultMetadata fills in the test results fetched from Cedar
+// with the metadata that Evergreen keeps for them because Cedar doesn't store
+// it.
+func AddCedarTestResultMetadata(results []apimodels.CedarTestResult) error {
+	var (
+		taskIDs    []string
+		executions []int
+	)
+	seenExecutions := map[int]bool{}
+	for _, result := range results {
+		if !utility.StringSliceContains(taskIDs, result.TaskID) {
+			taskIDs = append(taskIDs, result.TaskID)
+		}
+		if !seenExecutions[result.Execution] {
+			seenExecutions[result.Execution] = true
+			executions = append(executions, result.Execution)
+		}
+	}
+	metadata, err := testresult.FindCedarMetadata(taskIDs, executions)
+	if err != nil {
+		return errors.Wrap(err, "getting test result metadata")
+	}
+
+	for i := range results {
+		m, ok := metadata[testresult.CedarMetadataKey(results[i].TaskID, results[i].Execution, results[i].TestName)]
+		if !ok {
+			continue
+		}
+		results[i].FailureMessage = m.FailureMessage
+		results[i].StackTrace = m.StackTrace
+		results[i].Tags = m.Tags
+		results[i].Owners = m.Owners
+		results[i].Retries = m.Retries
+	}
+	return nil
+}
+
+// FilterCedarTestResultsByMetadata returns the test results that have all of
+// the given tags and are owned by any of the given owners, the same as the
+// filters on test results stored by Evergreen.
+func FilterCedarTestResultsByMetadata(results []apimodels.CedarTestResult, tags, owners []string) []apimodels.CedarTestResult {
+	filtered := []apimodels.CedarTestResult{}
+	for _, result := range results {
+		if hasAllStrings(result.Tags, tags) && (len(owners) == 0 || len(utility.StringSliceIntersection(result.Owners, owners)) > 0) {
+			filtered = append(filtered, result)
+		}
+	}
+	return filtered
+}
+
+// PageCedarTestResults returns the given page of the test results. A
+// non-positive limit returns all of them.
+func PageCedarTestResults(results []apimodels.CedarTestResult, limit, page int) []apimodels.CedarTestResult {
+	if limit <= 0 {
+		return results
+	}
+	start := page * limit
+	if start > len(results) {
+		start = len(results)
+	}
+	end := start + limit
+	if end > len(results) {
+		end = len(results)
+	}
+	return results[start:end]
+}
+
+func hasAllStrings(slice, elems []string) bool {
+	for _, elem := range elems {
+		if !utility.StringSliceContains(slice, elem) {
+			return false
+		}
 	}
+	return true
 }
@@ -3132,3 +3132,62 @@ func assertTasksAreEqual(t *testing.T, expected, actual Task, exectedExecution i
 	assert.Equal(t, expected.Status, actual.Status)
 	assert.Equal(t, exectedExecution, actual.Execution)
 }
+
+func TestCedarTestResultMetadata(t *testing.T) {
+	require.NoError(t, db.ClearCollections(testresult.CedarMetadataCollection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(testresult.CedarMetadataCollection))
+	}()
+
+	metadata := []testresult.CedarMetadata{
+		{TaskID: "et0", Execution: 1, TestName: "t0", FailureMessage: "expected 1 but was 2", StackTrace: "at Test.java:10", Tags: []string{"slow", "db"}, Owners: []string{"team0"}, Retries: 2},
+		{TaskID: "et1", Execution: 1, TestName: "t1", Tags: []string{"slow"}, Owners: []string{"team1"}},
+		{TaskID: "et1", Execution: 0, TestName: "t1", Tags: []string{"old"}},
+	}
+	require.NoError(t, testresult.InsertCedarMetadata(metadata))
+	// a retried request shouldn't fail
+	require.NoError(t, testresult.InsertCedarMetadata(metadata[:1]))
+
+	// results of a display task's execution tasks as returned by Cedar
+	results := []apimodels.CedarTestResult{
+		{TaskID: "et0", Execution: 1, TestName: "t0", DisplayTestName: "TestA"},
+		{TaskID: "et1", Execution: 1, TestName: "t1", DisplayTestName: "TestB"},
+		{TaskID: "et1", Execution: 1, TestName: "t2", DisplayTestName: "TestC"},
+	}
+	require.NoError(t, AddCedarTestResultMetadata(results))
+	assert.Equal(t, "expected 1 but was 2", results[0].FailureMessage)
+	assert.Equal(t, "at Test.java:10", results[0].StackTrace)
+	assert.Equal(t, []string{"slow", "db"}, results[0].Tags)
+	assert.Equal(t, []string{"team0"}, results[0].Owners)
+	assert.Equal(t, 2, results[0].Retries)
+	assert.Equal(t, []string{"slow"}, results[1].Tags)
+	assert.Equal(t, []string{"team1"}, results[1].Owners)
+	assert.Empty(t, results[2].Tags)
+	assert.Empty(t, results[2].Owners)
+
+	converted := ConvertCedarTestResult(results[0])
+	assert.Equal(t, results[0].FailureMessage, converted.FailureMessage)
+	assert.Equal(t, results[0].Tags, converted.Tags)
+	assert.Equal(t, results[0].Retries, converted.Retries)
+
+	filtered := FilterCedarTestResultsByMetadata(results, []string{"slow"}, nil)
+	require.Len(t, filtered, 2)
+	assert.Equal(t, "t0", filtered[0].TestName)
+	assert.Equal(t, "t1", filtered[1].TestName)
+
+	filtered = FilterCedarTestResultsByMetadata(results, []string{"slow", "db"}, nil)
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "t0", filtered[0].TestName)
+
+	filtered = FilterCedarTestResultsByMetadata(results, nil, []string{"team1", "team2"})
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "t1", filtered[0].TestName)
+
+	assert.Empty(t, FilterCedarTestResultsByMetadata(results, []string{"slow"}, []string{"team2"}))
+	assert.Len(t, FilterCedarTestResultsByMetadata(results, nil, nil), 3)
+
+	assert.Len(t, PageCedarTestResults(results, 2, 0), 2)
+	assert.Equal(t, "t2", PageCedarTestResults(results, 2, 1)[0].TestName)
+	assert.Empty(t, PageCedarTestResults(results, 2, 2))
+	assert.Len(t, PageCedarTestResults(results, 0, 0), 3)
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"time"
@@ -691,7 +692,11 @@ func RestartItemsAfterVersion(cq *commitqueue.CommitQueue, project, version, cal
 				"caller":             caller,
 			})
 			// this block executes on all items after the given task
-			catcher.Add(RestartTasksInVersion(item.Version, true, caller))
+			if err := RestartTasksInVersion(item.Version, true, caller); err != nil {
+				catcher.Add(err)
+				continue
+			}
+			event.LogCommitQueueRestartTest(item.Version, fmt.Sprintf("version '%s' ahead of it in the queue was removed", version))
 		}
 	}
 
@@ -711,6 +716,10 @@ func tryDequeueAndAbortCommitQueueVersion(t *task.Task, cq commitqueue.CommitQue
 		return nil
 	}
 
+	if len(cq.ItemsForVersion(t.Version)) > 1 {
+		return errors.Wrap(bisectCommitQueueBatch(t, p, cq, caller), "can't bisect failed commit queue batch")
+	}
+
 	issue := p.Id.Hex()
 	err = removeNextMergeTaskDependency(cq, issue)
 	grip.Error(message.WrapError(err, message.Fields{
@@ -732,6 +741,14 @@ func tryDequeueAndAbortCommitQueueVersion(t *task.Task, cq commitqueue.CommitQue
 	if removed == nil {
 		return errors.Errorf("no commit queue entry removed for '%s'", issue)
 	}
+	reason := ""
+	if removed.BisectBatchSize == 1 {
+		reason = notifyIsolatedCommitQueueItem(p)
+	}
+	grip.Error(message.WrapError(RemoveStackedCommitQueueItems(&cq, issue, caller), message.Fields{
+		"message": "error removing items stacked on failed item",
+		"patch":   issue,
+	}))
 
 	if p.IsPRMergePatch() {
 		err = SendCommitQueueResult(p, message.GithubStateFailure, "merge test failed")
@@ -741,10 +758,53 @@ func tryDequeueAndAbortCommitQueueVersion(t *task.Task, cq commitqueue.CommitQue
 		}))
 	}
 
-	event.LogCommitQueueConcludeTest(p.Id.Hex(), evergreen.MergeTestFailed)
+	event.LogCommitQueueConcludeTestWithReason(p.Id.Hex(), evergreen.MergeTestFailed, reason)
 	return errors.Wrapf(CancelPatch(p, task.AbortInfo{TaskID: t.Id, User: caller}), "Error aborting failed commit queue patch")
 }
 
+// bisectCommitQueueBatch splits the items tested by a failed batch version in
+// half so that each half is retested on its own, and aborts the version.
+func bisectCommitQueueBatch(t *task.Task, p *patch.Patch, cq commitqueue.CommitQueue, caller string) error {
+	first, second, err := cq.SplitBatch(t.Version, caller)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	for _, half := range [][]commitqueue.CommitQueueItem{first, second} {
+		for _, item := range half {
+			event.LogCommitQueueBisectBatch(item.Issue, fmt.Sprintf("batch '%s' failed, retesting in a batch of %d", t.Version, len(half)))
+		}
+	}
+	grip.Info(message.Fields{
+		"message": "split failed commit queue batch",
+		"batch":   t.Version,
+		"first":   len(first),
+		"second":  len(second),
+		"project": t.Project,
+		"caller":  caller,
+	})
+
+	return errors.Wrap(CancelPatch(p, task.AbortInfo{TaskID: t.Id, User: caller}), "can't abort failed commit queue batch")
+}
+
+// notifyIsolatedCommitQueueItem logs that a retested item was found to have
+// caused the failure of the batch it was tested in, comments on its pull
+// request if it has one, and returns the reason the item was removed. The
+// reason is logged with the item's conclude test event so that the author of
+// an item without a pull request is notified through their commit queue
+// subscription.
+func notifyIsolatedCommitQueueItem(p *patch.Patch) string {
+	issue := p.Id.Hex()
+	event.LogCommitQueueBisectBatch(issue, "isolated as the cause of the failure of the batch it was tested in")
+
+	reason := "This item was removed from the commit queue because it failed when retested on its own after the batch it was tested in failed."
+	err := SendCommitQueueComment(context.Background(), p, reason)
+	grip.Error(message.WrapError(err, message.Fields{
+		"message": "error commenting on isolated commit queue item",
+		"patch":   issue,
+	}))
+	return reason
+}
+
 // removeNextMergeTaskDependency basically removes the given merge task from a linked list of
 // merge task dependencies. It makes the next merge not depend on the current one and also makes
 // the next merge depend on the previous one, if there is one
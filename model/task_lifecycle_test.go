@@ -1664,8 +1664,125 @@ func TestTryDequeueAndAbortCommitQueueVersion(t *testing.T) {
 	assert.NotNil(t, p)
 }
 
+func TestTryDequeueAndAbortCommitQueueBatch(t *testing.T) {
+	assert.NoError(t, db.ClearCollections(patch.Collection, VersionCollection, task.Collection, build.Collection, commitqueue.Collection, event.AllLogCollection))
+
+	versionId := bson.NewObjectId()
+	v := &Version{
+		Id:     versionId.Hex(),
+		Status: evergreen.VersionStarted,
+	}
+	p := &patch.Patch{
+		Id:      versionId,
+		Version: v.Id,
+		Alias:   evergreen.CommitQueueAlias,
+		Status:  evergreen.PatchStarted,
+	}
+	m := task.Task{
+		Id:               "merge",
+		Status:           evergreen.TaskUndispatched,
+		Activated:        true,
+		CommitQueueMerge: true,
+		Version:          v.Id,
+	}
+	q := []commitqueue.CommitQueueItem{
+		{Issue: "1", Source: commitqueue.SourceDiff, Version: v.Id},
+		{Issue: "2", Source: commitqueue.SourceDiff, Version: v.Id},
+		{Issue: "3", Source: commitqueue.SourceDiff, Version: v.Id},
+		{Issue: "4", Source: commitqueue.SourceDiff},
+	}
+	cq := &commitqueue.CommitQueue{ProjectID: "my-project", Queue: q}
+	require.NoError(t, v.Insert())
+	require.NoError(t, p.Insert())
+	require.NoError(t, m.Insert())
+	require.NoError(t, commitqueue.InsertQueue(cq))
+
+	assert.NoError(t, tryDequeueAndAbortCommitQueueVersion(&task.Task{Id: "t1", Version: v.Id, Project: cq.ProjectID}, *cq, evergreen.User))
+
+	// the items stay on the queue to be retested in two halves
+	cq, err := commitqueue.FindOneId(cq.ProjectID)
+	require.NoError(t, err)
+	require.Len(t, cq.Queue, 4)
+	for i, expected := range []int{2, 2, 1, 0} {
+		assert.Empty(t, cq.Queue[i].Version)
+		assert.Equal(t, expected, cq.Queue[i].BisectBatchSize)
+	}
+	assert.False(t, cq.Processing())
+
+	mergeTask, err := task.FindOneId(m.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), mergeTask.Priority)
+
+	for _, issue := range []string{"1", "2", "3"} {
+		events, err := event.FindAllByResourceID(issue)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, event.CommitQueueBisectBatch, events[0].EventType)
+	}
+}
+
+func TestTryDequeueAndAbortIsolatedCommitQueueItem(t *testing.T) {
+	assert.NoError(t, db.ClearCollections(patch.Collection, VersionCollection, task.Collection, build.Collection, commitqueue.Collection, event.AllLogCollection))
+
+	versionId := bson.NewObjectId()
+	v := &Version{
+		Id:     versionId.Hex(),
+		Status: evergreen.VersionStarted,
+	}
+	// a CLI item has no pull request to comment on
+	p := &patch.Patch{
+		Id:      versionId,
+		Version: v.Id,
+		Project: "my-project",
+		Author:  "me",
+		Alias:   evergreen.CommitQueueAlias,
+		Status:  evergreen.PatchStarted,
+	}
+	m := task.Task{
+		Id:               "merge",
+		Status:           evergreen.TaskUndispatched,
+		Activated:        true,
+		CommitQueueMerge: true,
+		Version:          v.Id,
+	}
+	q := []commitqueue.CommitQueueItem{
+		{Issue: v.Id, PatchId: v.Id, Source: commitqueue.SourceDiff, Author: "me", Version: v.Id, BisectBatchSize: 1},
+		{Issue: "2", Source: commitqueue.SourceDiff, BisectBatchSize: 1},
+	}
+	cq := &commitqueue.CommitQueue{ProjectID: "my-project", Queue: q}
+	require.NoError(t, v.Insert())
+	require.NoError(t, p.Insert())
+	require.NoError(t, m.Insert())
+	require.NoError(t, commitqueue.InsertQueue(cq))
+
+	assert.NoError(t, tryDequeueAndAbortCommitQueueVersion(&task.Task{Id: "t1", Version: v.Id, Project: cq.ProjectID}, *cq, evergreen.User))
+
+	cq, err := commitqueue.FindOneId(cq.ProjectID)
+	require.NoError(t, err)
+	require.Len(t, cq.Queue, 1)
+	assert.Equal(t, "2", cq.Queue[0].Issue)
+
+	events, err := event.FindAllByResourceID(v.Id)
+	require.NoError(t, err)
+	eventTypes := []string{}
+	for _, e := range events {
+		eventTypes = append(eventTypes, e.EventType)
+		if e.EventType != event.CommitQueueConcludeTest {
+			continue
+		}
+		// the author is notified of why the item was removed through
+		// their commit queue subscription
+		data, ok := e.Data.(*event.CommitQueueEventData)
+		require.True(t, ok)
+		assert.Equal(t, evergreen.MergeTestFailed, data.Status)
+		assert.Contains(t, data.Reason, "failed when retested on its own")
+	}
+	assert.Contains(t, eventTypes, event.CommitQueueBisectBatch)
+	assert.Contains(t, eventTypes, event.CommitQueueConcludeTest)
+}
+
 func TestDequeueAndRestart(t *testing.T) {
-	assert.NoError(t, db.ClearCollections(VersionCollection, patch.Collection, build.Collection, task.Collection, commitqueue.Collection, task.OldCollection))
+	assert.NoError(t, db.ClearCollections(VersionCollection, patch.Collection, build.Collection, task.Collection, commitqueue.Collection, task.OldCollection, event.AllLogCollection))
 	v1 := bson.NewObjectId()
 	v2 := bson.NewObjectId()
 	v3 := bson.NewObjectId()
@@ -1787,6 +1904,17 @@ func TestDequeueAndRestart(t *testing.T) {
 	dbTask4, err := task.FindOneId(t4.Id)
 	assert.NoError(t, err)
 	assert.Equal(t, 1, dbTask4.Execution)
+
+	events, err := event.Find(event.AllLogCollection, db.Query(bson.M{
+		event.ResourceIdKey: v3.Hex(),
+		event.TypeKey:       event.CommitQueueRestartTest,
+	}))
+	assert.NoError(t, err)
+	require.Len(t, events, 1)
+	data, ok := events[0].Data.(*event.CommitQueueEventData)
+	require.True(t, ok)
+	assert.Equal(t, evergreen.MergeTestRestarted, data.Status)
+	assert.Contains(t, data.Reason, v2.Hex())
 }
 
 func TestMarkStart(t *testing.T) {
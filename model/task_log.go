@@ -1,14 +1,19 @@
 package model
 
 import (
+	"context"
 	"time"
 
+	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/evergreen-ci/evergreen/db"
 	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/anser/bsonutil"
 	adb "github.com/mongodb/anser/db"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
 )
 
@@ -162,6 +167,15 @@ func FindTaskLogsBeforeTime(taskId string, execution int, ts time.Time, limit in
 
 func GetRawTaskLogChannel(taskId string, execution int, severities []string,
 	msgTypes []string) (chan apimodels.LogMessage, error) {
+	return getRawTaskLogChannelSince(taskId, execution, severities, msgTypes, time.Time{})
+}
+
+// getRawTaskLogChannelSince is GetRawTaskLogChannel, but it only reads the
+// log chunks sent at or after the given time if it is set. Chunks are stamped
+// when they are sent, after all of their messages were logged, so no message
+// logged at or after the time is skipped.
+func getRawTaskLogChannelSince(taskId string, execution int, severities []string,
+	msgTypes []string, since time.Time) (chan apimodels.LogMessage, error) {
 	session, db, err := getSessionAndDB()
 	if err != nil {
 		return nil, err
@@ -187,6 +201,12 @@ func GetRawTaskLogChannel(taskId string, execution int, severities []string,
 			TaskLogExecutionKey: execution,
 		}
 	}
+	if !since.IsZero() {
+		query = bson.M{"$and": []bson.M{
+			query,
+			{TaskLogTimestampKey: bson.M{"$gte": since}},
+		}}
+	}
 	iter := db.C(TaskLogCollection).Find(query).Sort(TaskLogTimestampKey).Iter()
 
 	oldMsgTypes := []string{}
@@ -296,3 +316,48 @@ func FindMostRecentLogMessages(taskId string, execution int, numMsgs int,
 
 	return logMsgs, nil
 }
+
+// getTaskLogChannel returns a channel of the lines of the given log type in a
+// task execution's logs, from the oldest to the newest. The lines are read
+// from Cedar if the project's default logger is buildlogger and from the
+// database otherwise. If start is set, reading begins close to it, so the
+// channel includes every line logged at or after start, but may also include
+// some lines logged before it. The channel must be read until it is closed,
+// after which the returned function must be called to release its resources.
+func getTaskLogChannel(ctx context.Context, taskID string, execution int, defaultLogger, logType string, start time.Time) (<-chan apimodels.LogMessage, func(), error) {
+	if defaultLogger == BuildloggerLogSender {
+		ctx, cancel := context.WithCancel(ctx)
+		r, err := apimodels.GetBuildloggerLogs(ctx, apimodels.GetBuildloggerLogsOptions{
+			BaseURL:       evergreen.GetEnvironment().Settings().Cedar.BaseURL,
+			TaskID:        taskID,
+			Execution:     utility.ToIntPtr(execution),
+			PrintPriority: true,
+			LogType:       logType,
+			Start:         start,
+		})
+		if err != nil {
+			cancel()
+			return nil, nil, errors.Wrap(err, "getting buildlogger logs")
+		}
+
+		lines := make(chan apimodels.LogMessage, 100)
+		go apimodels.ReadBuildloggerToChan(ctx, taskID, r, lines)
+		return lines, func() {
+			cancel()
+			grip.Warning(message.WrapError(r.Close(), message.Fields{
+				"task_id": taskID,
+				"message": "problem closing buildlogger log reader",
+			}))
+		}, nil
+	}
+
+	var msgTypes []string
+	if logType != "" && logType != apimodels.AllTaskLevelLogs {
+		msgTypes = []string{logType}
+	}
+	lines, err := getRawTaskLogChannelSince(taskID, execution, nil, msgTypes, start)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "getting task logs")
+	}
+	return lines, func() {}, nil
+}
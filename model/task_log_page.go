@@ -0,0 +1,123 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultTaskLogPageLimit = 1000
+	MaxTaskLogPageLimit     = 10000
+)
+
+// TaskLogPageOptions are the options for reading a task execution's logs
+// from a cursor, so that the logs of a running task can be followed by
+// repeatedly reading the lines after the last line read.
+type TaskLogPageOptions struct {
+	TaskID    string
+	Execution int
+	// DefaultLogger is the logger the task's project sends its logs to. Logs
+	// are read from Cedar if it is buildlogger and from the database
+	// otherwise.
+	DefaultLogger string
+
+	// LogType is the type of log to read, which is one of the task, agent,
+	// system or all log prefixes. It defaults to all logs.
+	LogType    string
+	Severities []string
+	// StartTime and StartSequence are the cursor to start reading from. Lines
+	// logged before StartTime are skipped, as are the first StartSequence
+	// lines logged at StartTime. Lines are counted before they are filtered by
+	// severity, so that the next page always starts where the previous one
+	// ended. Each log type is stamped and sent by its own logger, so lines are
+	// only in timestamp order within a single log type, and a cursor can only
+	// be used to read one log type.
+	StartTime     time.Time
+	StartSequence int
+	Limit         int
+}
+
+// TaskLogPage is the lines of a task's logs read from a cursor.
+type TaskLogPage struct {
+	Lines []apimodels.LogMessage
+	// NextTime and NextSequence are the cursor to start reading the next
+	// page from.
+	NextTime     time.Time
+	NextSequence int
+}
+
+// SetDefaultsAndValidate sets the log type and page size if they are unset and
+// checks that the options are valid.
+func (opts *TaskLogPageOptions) SetDefaultsAndValidate() error {
+	if opts.LogType == "" {
+		opts.LogType = apimodels.AllTaskLevelLogs
+	}
+	if opts.Limit == 0 {
+		opts.Limit = DefaultTaskLogPageLimit
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(opts.TaskID == "", "must specify a task")
+	catcher.Add(validateTaskLogFilters(opts.LogType, opts.Severities))
+	catcher.NewWhen(opts.StartSequence < 0, "start sequence cannot be negative")
+	catcher.NewWhen(opts.StartTime.IsZero() && opts.StartSequence > 0, "must specify a start time with a start sequence")
+	catcher.NewWhen(!opts.StartTime.IsZero() && opts.LogType == apimodels.AllTaskLevelLogs, "must specify a single log type to read from a start time")
+	catcher.ErrorfWhen(opts.Limit < 0 || opts.Limit > MaxTaskLogPageLimit, "limit must be between 1 and %d", MaxTaskLogPageLimit)
+
+	return catcher.Resolve()
+}
+
+// GetTaskLogPage returns up to the options' limit of lines of a task
+// execution's logs, starting from the options' cursor.
+func GetTaskLogPage(ctx context.Context, opts TaskLogPageOptions) (*TaskLogPage, error) {
+	if err := opts.SetDefaultsAndValidate(); err != nil {
+		return nil, errors.Wrap(err, "invalid task log page options")
+	}
+
+	lines, closer, err := getTaskLogChannel(ctx, opts.TaskID, opts.Execution, opts.DefaultLogger, opts.LogType, opts.StartTime)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer closer()
+
+	return readTaskLogPage(lines, opts), nil
+}
+
+// readTaskLogPage returns the page of lines requested by the options. It reads
+// the channel until it is closed.
+func readTaskLogPage(lines <-chan apimodels.LogMessage, opts TaskLogPageOptions) *TaskLogPage {
+	page := &TaskLogPage{
+		NextTime:     opts.StartTime,
+		NextSequence: opts.StartSequence,
+	}
+	skipped := 0
+	for line := range lines {
+		if line.Timestamp.Before(opts.StartTime) {
+			continue
+		}
+		if line.Timestamp.Equal(opts.StartTime) && skipped < opts.StartSequence {
+			skipped++
+			continue
+		}
+		if len(page.Lines) >= opts.Limit {
+			continue
+		}
+
+		if len(opts.Severities) == 0 || utility.StringSliceContains(opts.Severities, line.Severity) {
+			page.Lines = append(page.Lines, line)
+		}
+		if line.Timestamp.Equal(page.NextTime) {
+			page.NextSequence++
+		} else {
+			page.NextTime = line.Timestamp
+			page.NextSequence = 1
+		}
+	}
+
+	return page
+}
@@ -0,0 +1,108 @@
+package model
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadTaskLogPage(t *testing.T) {
+	// Lines 4 to 6 are logged at the same time.
+	base := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	timestamps := make([]time.Time, 10)
+	for i := range timestamps {
+		timestamps[i] = base.Add(time.Duration(i) * time.Second)
+		if i > 4 && i <= 6 {
+			timestamps[i] = timestamps[4]
+		}
+	}
+	read := func(t *testing.T, opts TaskLogPageOptions) *TaskLogPage {
+		lines := make(chan apimodels.LogMessage, 10)
+		for i := 0; i < 10; i++ {
+			severity := apimodels.LogInfoPrefix
+			if i%2 == 0 {
+				severity = apimodels.LogErrorPrefix
+			}
+			lines <- apimodels.LogMessage{Severity: severity, Message: fmt.Sprintf("line %d", i), Timestamp: timestamps[i]}
+		}
+		close(lines)
+
+		opts.TaskID = "task"
+		opts.LogType = apimodels.TaskLogPrefix
+		require.NoError(t, opts.SetDefaultsAndValidate())
+		return readTaskLogPage(lines, opts)
+	}
+
+	t.Run("AllLines", func(t *testing.T) {
+		page := read(t, TaskLogPageOptions{})
+		assert.Len(t, page.Lines, 10)
+		assert.Equal(t, timestamps[9], page.NextTime)
+		assert.Equal(t, 1, page.NextSequence)
+	})
+	t.Run("StartTimeAndLimit", func(t *testing.T) {
+		page := read(t, TaskLogPageOptions{StartTime: timestamps[3], Limit: 2})
+		require.Len(t, page.Lines, 2)
+		assert.Equal(t, "line 3", page.Lines[0].Message)
+		assert.Equal(t, "line 4", page.Lines[1].Message)
+		assert.Equal(t, timestamps[4], page.NextTime)
+		assert.Equal(t, 1, page.NextSequence)
+	})
+	t.Run("ResumesWithinLinesLoggedAtTheSameTime", func(t *testing.T) {
+		page := read(t, TaskLogPageOptions{StartTime: timestamps[4], StartSequence: 1, Limit: 1})
+		require.Len(t, page.Lines, 1)
+		assert.Equal(t, "line 5", page.Lines[0].Message)
+		assert.Equal(t, timestamps[4], page.NextTime)
+		assert.Equal(t, 2, page.NextSequence)
+
+		page = read(t, TaskLogPageOptions{StartTime: page.NextTime, StartSequence: page.NextSequence, Limit: 2})
+		require.Len(t, page.Lines, 2)
+		assert.Equal(t, "line 6", page.Lines[0].Message)
+		assert.Equal(t, "line 7", page.Lines[1].Message)
+		assert.Equal(t, timestamps[7], page.NextTime)
+		assert.Equal(t, 1, page.NextSequence)
+	})
+	t.Run("NoNewLines", func(t *testing.T) {
+		page := read(t, TaskLogPageOptions{StartTime: timestamps[9], StartSequence: 1})
+		assert.Empty(t, page.Lines)
+		assert.Equal(t, timestamps[9], page.NextTime)
+		assert.Equal(t, 1, page.NextSequence)
+	})
+	t.Run("SeveritiesDoNotAffectCursor", func(t *testing.T) {
+		page := read(t, TaskLogPageOptions{StartTime: timestamps[1], Limit: 2, Severities: []string{apimodels.LogErrorPrefix}})
+		require.Len(t, page.Lines, 2)
+		assert.Equal(t, "line 2", page.Lines[0].Message)
+		assert.Equal(t, "line 4", page.Lines[1].Message)
+		assert.Equal(t, timestamps[4], page.NextTime)
+		assert.Equal(t, 1, page.NextSequence)
+
+		page = read(t, TaskLogPageOptions{StartTime: timestamps[9], Severities: []string{apimodels.LogErrorPrefix}})
+		assert.Empty(t, page.Lines)
+		assert.Equal(t, timestamps[9], page.NextTime)
+		assert.Equal(t, 1, page.NextSequence)
+	})
+}
+
+func TestTaskLogPageOptionsValidate(t *testing.T) {
+	opts := TaskLogPageOptions{TaskID: "task"}
+	require.NoError(t, opts.SetDefaultsAndValidate())
+	assert.Equal(t, apimodels.AllTaskLevelLogs, opts.LogType)
+	assert.Equal(t, DefaultTaskLogPageLimit, opts.Limit)
+
+	for name, opts := range map[string]TaskLogPageOptions{
+		"MissingTask":              {},
+		"InvalidLogType":           {TaskID: "task", LogType: "X"},
+		"InvalidSeverity":          {TaskID: "task", Severities: []string{"F"}},
+		"NegativeStartSequence":    {TaskID: "task", LogType: apimodels.TaskLogPrefix, StartTime: time.Now(), StartSequence: -1},
+		"StartSequenceWithoutTime": {TaskID: "task", LogType: apimodels.TaskLogPrefix, StartSequence: 1},
+		"StartTimeForAllLogs":      {TaskID: "task", StartTime: time.Now()},
+		"LimitAboveMaximum":        {TaskID: "task", Limit: MaxTaskLogPageLimit + 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, opts.SetDefaultsAndValidate())
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package model
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultTaskLogSearchLimit    = 100
+	MaxTaskLogSearchLimit        = 1000
+	MaxTaskLogSearchContextLines = 50
+)
+
+// TaskLogSearchOptions are the options for searching a task execution's logs
+// for lines that match a regular expression.
+type TaskLogSearchOptions struct {
+	TaskID    string
+	Execution int
+	// DefaultLogger is the logger the task's project sends its logs to. Logs
+	// are searched in Cedar if it is buildlogger and in the database
+	// otherwise.
+	DefaultLogger string
+
+	Pattern string
+	// Severities only filters the lines that can match. Context lines are
+	// returned regardless of their severity.
+	Severities []string
+	// LogType is the type of log to search, which is one of the task, agent,
+	// system or all log prefixes. It defaults to all logs.
+	LogType string
+	// ContextLines is the number of lines before and after each match to
+	// return with it.
+	ContextLines int
+
+	// Page is the zero-indexed page of matches to return, where each page
+	// has at most Limit matches.
+	Page  int
+	Limit int
+
+	pattern *regexp.Regexp
+}
+
+// TaskLogSearchMatch is a log line that matches a search, along with the
+// lines around it.
+type TaskLogSearchMatch struct {
+	// LineNumber is the zero-indexed position of the line among the lines of
+	// the searched log type.
+	LineNumber int
+	Line       apimodels.LogMessage
+	Before     []apimodels.LogMessage
+	After      []apimodels.LogMessage
+}
+
+// TaskLogSearchResult is a page of the matches of a task log search.
+type TaskLogSearchResult struct {
+	Matches []TaskLogSearchMatch
+	// TotalMatches is the number of matches across all pages.
+	TotalMatches int
+}
+
+// SetDefaultsAndValidate sets the log type and page size if they are unset,
+// checks that the options are valid, and compiles the search pattern.
+func (opts *TaskLogSearchOptions) SetDefaultsAndValidate() error {
+	if opts.LogType == "" {
+		opts.LogType = apimodels.AllTaskLevelLogs
+	}
+	if opts.Limit == 0 {
+		opts.Limit = DefaultTaskLogSearchLimit
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(opts.TaskID == "", "must specify a task")
+	catcher.NewWhen(opts.Pattern == "", "must specify a search pattern")
+	if opts.Pattern != "" {
+		pattern, err := regexp.Compile(opts.Pattern)
+		catcher.Wrapf(err, "invalid search pattern '%s'", opts.Pattern)
+		opts.pattern = pattern
+	}
+	catcher.Add(validateTaskLogFilters(opts.LogType, opts.Severities))
+	catcher.ErrorfWhen(opts.ContextLines < 0 || opts.ContextLines > MaxTaskLogSearchContextLines, "number of context lines must be between 0 and %d", MaxTaskLogSearchContextLines)
+	catcher.NewWhen(opts.Page < 0, "page cannot be negative")
+	catcher.ErrorfWhen(opts.Limit < 0 || opts.Limit > MaxTaskLogSearchLimit, "limit must be between 1 and %d", MaxTaskLogSearchLimit)
+
+	return catcher.Resolve()
+}
+
+// validateTaskLogFilters checks that the log type and severities used to
+// filter a task's logs are valid.
+func validateTaskLogFilters(logType string, severities []string) error {
+	catcher := grip.NewBasicCatcher()
+	validLogTypes := []string{
+		apimodels.TaskLogPrefix,
+		apimodels.AgentLogPrefix,
+		apimodels.SystemLogPrefix,
+		apimodels.AllTaskLevelLogs,
+	}
+	catcher.ErrorfWhen(!utility.StringSliceContains(validLogTypes, logType), "invalid log type '%s'", logType)
+	validSeverities := []string{
+		apimodels.LogErrorPrefix,
+		apimodels.LogWarnPrefix,
+		apimodels.LogDebugPrefix,
+		apimodels.LogInfoPrefix,
+	}
+	for _, severity := range severities {
+		catcher.ErrorfWhen(!utility.StringSliceContains(validSeverities, severity), "invalid severity '%s'", severity)
+	}
+	return catcher.Resolve()
+}
+
+// SearchTaskLogs searches a task execution's logs for lines that match the
+// options' pattern, from the oldest line to the newest.
+func SearchTaskLogs(ctx context.Context, opts TaskLogSearchOptions) (*TaskLogSearchResult, error) {
+	if err := opts.SetDefaultsAndValidate(); err != nil {
+		return nil, errors.Wrap(err, "invalid task log search options")
+	}
+
+	lines, closer, err := getTaskLogChannel(ctx, opts.TaskID, opts.Execution, opts.DefaultLogger, opts.LogType, time.Time{})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer closer()
+
+	return searchLogMessages(lines, opts), nil
+}
+
+// searchLogMessages returns the requested page of lines that match the
+// search. It reads every line from the channel so that the total number of
+// matches can be counted, but only keeps the lines needed for the page.
+func searchLogMessages(lines <-chan apimodels.LogMessage, opts TaskLogSearchOptions) *TaskLogSearchResult {
+	result := &TaskLogSearchResult{}
+	first := opts.Page * opts.Limit
+
+	// before holds the most recent lines, up to the number of context lines.
+	var before []apimodels.LogMessage
+	// pending are the matches on the page that still need lines after them.
+	var pending []int
+	lineNumber := 0
+	for line := range lines {
+		stillPending := pending[:0]
+		for _, i := range pending {
+			result.Matches[i].After = append(result.Matches[i].After, line)
+			if len(result.Matches[i].After) < opts.ContextLines {
+				stillPending = append(stillPending, i)
+			}
+		}
+		pending = stillPending
+
+		if (len(opts.Severities) == 0 || utility.StringSliceContains(opts.Severities, line.Severity)) &&
+			opts.pattern.MatchString(line.Message) {
+			if result.TotalMatches >= first && len(result.Matches) < opts.Limit {
+				result.Matches = append(result.Matches, TaskLogSearchMatch{
+					LineNumber: lineNumber,
+					Line:       line,
+					Before:     append([]apimodels.LogMessage{}, before...),
+				})
+				if opts.ContextLines > 0 {
+					pending = append(pending, len(result.Matches)-1)
+				}
+			}
+			result.TotalMatches++
+		}
+
+		if opts.ContextLines > 0 {
+			if len(before) == opts.ContextLines {
+				before = before[1:]
+			}
+			before = append(before, line)
+		}
+		lineNumber++
+	}
+
+	return result
+}
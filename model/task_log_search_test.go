@@ -0,0 +1,94 @@
+package model
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchLogMessages(t *testing.T) {
+	makeLines := func() <-chan apimodels.LogMessage {
+		lines := make(chan apimodels.LogMessage, 10)
+		for i := 0; i < 10; i++ {
+			severity := apimodels.LogInfoPrefix
+			if i%3 == 0 {
+				severity = apimodels.LogErrorPrefix
+			}
+			lines <- apimodels.LogMessage{Severity: severity, Message: fmt.Sprintf("line %d", i)}
+		}
+		close(lines)
+		return lines
+	}
+	search := func(t *testing.T, opts TaskLogSearchOptions) *TaskLogSearchResult {
+		opts.TaskID = "task"
+		require.NoError(t, opts.SetDefaultsAndValidate())
+		return searchLogMessages(makeLines(), opts)
+	}
+
+	t.Run("ContextLines", func(t *testing.T) {
+		result := search(t, TaskLogSearchOptions{Pattern: `line [05]$`, ContextLines: 2})
+		assert.Equal(t, 2, result.TotalMatches)
+		require.Len(t, result.Matches, 2)
+
+		assert.Equal(t, 0, result.Matches[0].LineNumber)
+		assert.Empty(t, result.Matches[0].Before)
+		require.Len(t, result.Matches[0].After, 2)
+		assert.Equal(t, "line 2", result.Matches[0].After[1].Message)
+
+		assert.Equal(t, 5, result.Matches[1].LineNumber)
+		require.Len(t, result.Matches[1].Before, 2)
+		assert.Equal(t, "line 3", result.Matches[1].Before[0].Message)
+		assert.Equal(t, "line 4", result.Matches[1].Before[1].Message)
+		require.Len(t, result.Matches[1].After, 2)
+		assert.Equal(t, "line 7", result.Matches[1].After[1].Message)
+	})
+	t.Run("ContextAtEndOfLog", func(t *testing.T) {
+		result := search(t, TaskLogSearchOptions{Pattern: `line 9`, ContextLines: 3})
+		require.Len(t, result.Matches, 1)
+		assert.Len(t, result.Matches[0].Before, 3)
+		assert.Empty(t, result.Matches[0].After)
+	})
+	t.Run("Severities", func(t *testing.T) {
+		result := search(t, TaskLogSearchOptions{Pattern: `line`, Severities: []string{apimodels.LogErrorPrefix}, ContextLines: 1})
+		assert.Equal(t, 4, result.TotalMatches)
+		require.Len(t, result.Matches, 4)
+		assert.Equal(t, 3, result.Matches[1].LineNumber)
+		require.Len(t, result.Matches[1].Before, 1)
+		assert.Equal(t, apimodels.LogInfoPrefix, result.Matches[1].Before[0].Severity)
+	})
+	t.Run("Pagination", func(t *testing.T) {
+		result := search(t, TaskLogSearchOptions{Pattern: `line`, Page: 1, Limit: 3})
+		assert.Equal(t, 10, result.TotalMatches)
+		require.Len(t, result.Matches, 3)
+		assert.Equal(t, 3, result.Matches[0].LineNumber)
+		assert.Equal(t, 5, result.Matches[2].LineNumber)
+
+		result = search(t, TaskLogSearchOptions{Pattern: `line`, Page: 4, Limit: 3})
+		assert.Equal(t, 10, result.TotalMatches)
+		assert.Empty(t, result.Matches)
+	})
+}
+
+func TestTaskLogSearchOptionsValidate(t *testing.T) {
+	opts := TaskLogSearchOptions{TaskID: "task", Pattern: "error"}
+	require.NoError(t, opts.SetDefaultsAndValidate())
+	assert.Equal(t, apimodels.AllTaskLevelLogs, opts.LogType)
+	assert.Equal(t, DefaultTaskLogSearchLimit, opts.Limit)
+
+	for name, opts := range map[string]TaskLogSearchOptions{
+		"MissingPattern":    {TaskID: "task"},
+		"InvalidPattern":    {TaskID: "task", Pattern: "("},
+		"InvalidLogType":    {TaskID: "task", Pattern: "error", LogType: "X"},
+		"InvalidSeverity":   {TaskID: "task", Pattern: "error", Severities: []string{"F"}},
+		"TooManyContext":    {TaskID: "task", Pattern: "error", ContextLines: MaxTaskLogSearchContextLines + 1},
+		"NegativePage":      {TaskID: "task", Pattern: "error", Page: -1},
+		"LimitAboveMaximum": {TaskID: "task", Pattern: "error", Limit: MaxTaskLogSearchLimit + 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, opts.SetDefaultsAndValidate())
+		})
+	}
+}
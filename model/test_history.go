@@ -0,0 +1,359 @@
+package model
+
+import (
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	DefaultTestHistoryRevisions = 100
+	MaxTestHistoryRevisions     = 1000
+)
+
+// testHistoryTaskBatchSize is the maximum number of tasks whose test results
+// are found with a single query.
+var testHistoryTaskBatchSize = 1000
+
+// TestHistoryOptions are the options for looking up the runs of a single test
+// across a project's most recent mainline commits.
+type TestHistoryOptions struct {
+	ProjectID string
+	// TestName matches either the test's file name or its display name.
+	TestName      string
+	BuildVariants []string
+	TaskNames     []string
+	// NumRevisions is the number of most recent mainline revisions to
+	// search.
+	NumRevisions int
+
+	// Statuses, MinDuration and MaxDuration only filter the returned runs.
+	// When the test started failing is always determined from all of its
+	// runs.
+	Statuses    []string
+	MinDuration time.Duration
+	MaxDuration time.Duration
+}
+
+// TestHistoryRun is a single run of a test in a mainline task.
+type TestHistoryRun struct {
+	TestFile        string
+	DisplayTestName string
+	Status          string
+	StartTime       time.Time
+	Duration        time.Duration
+	FailureMessage  string
+	URL             string
+	LogID           string
+	LineNum         int
+
+	TaskID              string
+	Execution           int
+	TaskName            string
+	BuildVariant        string
+	VersionID           string
+	Revision            string
+	RevisionOrderNumber int
+}
+
+// TestHistory is the history of a test across mainline commits.
+type TestHistory struct {
+	// Runs are the test's runs that match the options' filters, from the
+	// newest revision to the oldest.
+	Runs []TestHistoryRun
+	// FailingSince is the first failing run of the test's current streak of
+	// failing revisions. It is nil if the test did not fail in the most
+	// recent revision it ran in.
+	FailingSince *TestHistoryRun
+}
+
+// SetDefaultsAndValidate sets the number of revisions to search if it is
+// unset and checks that the options are valid.
+func (opts *TestHistoryOptions) SetDefaultsAndValidate() error {
+	if opts.NumRevisions == 0 {
+		opts.NumRevisions = DefaultTestHistoryRevisions
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(opts.ProjectID == "", "must specify a project")
+	catcher.NewWhen(opts.TestName == "", "must specify a test name")
+	catcher.ErrorfWhen(opts.NumRevisions < 0 || opts.NumRevisions > MaxTestHistoryRevisions, "number of revisions must be between 1 and %d", MaxTestHistoryRevisions)
+	catcher.NewWhen(opts.MinDuration < 0 || opts.MaxDuration < 0, "durations cannot be negative")
+	catcher.NewWhen(opts.MaxDuration > 0 && opts.MinDuration > opts.MaxDuration, "minimum duration cannot be greater than the maximum duration")
+	validStatuses := []string{
+		evergreen.TestFailedStatus,
+		evergreen.TestSilentlyFailedStatus,
+		evergreen.TestSkippedStatus,
+		evergreen.TestSucceededStatus,
+	}
+	for _, status := range opts.Statuses {
+		catcher.ErrorfWhen(!utility.StringSliceContains(validStatuses, status), "invalid test status '%s'", status)
+	}
+
+	return catcher.Resolve()
+}
+
+// GetTestHistoryForTest returns the runs of a test in the latest execution of
+// the project's finished mainline tasks. Only test results stored in Evergreen
+// can be searched, so an error is returned if any of the tasks stored its test
+// results in Cedar.
+func GetTestHistoryForTest(opts TestHistoryOptions) (*TestHistory, error) {
+	if err := opts.SetDefaultsAndValidate(); err != nil {
+		return nil, errors.Wrap(err, "invalid test history options")
+	}
+
+	versions, err := VersionFind(db.Query(bson.M{
+		VersionIdentifierKey: opts.ProjectID,
+		VersionRequesterKey:  evergreen.RepotrackerVersionRequester,
+	}).Sort([]string{"-" + VersionRevisionOrderNumberKey}).
+		WithFields(VersionIdKey, VersionRevisionKey, VersionRevisionOrderNumberKey).
+		Limit(opts.NumRevisions))
+	if err != nil {
+		return nil, errors.Wrap(err, "finding mainline versions")
+	}
+	if len(versions) == 0 {
+		return &TestHistory{}, nil
+	}
+	versionIDs := make([]string, 0, len(versions))
+	for _, v := range versions {
+		versionIDs = append(versionIDs, v.Id)
+	}
+
+	tasks, err := findTestHistoryTasks(opts, versionIDs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(tasks) == 0 {
+		return &TestHistory{}, nil
+	}
+	taskIDs := make([]string, 0, len(tasks))
+	for id := range tasks {
+		taskIDs = append(taskIDs, id)
+	}
+
+	// The failed and succeeded runs are always needed to find when the test
+	// started failing, so only the other statuses can be filtered out.
+	var statuses []string
+	if len(opts.Statuses) > 0 {
+		statuses = []string{evergreen.TestFailedStatus, evergreen.TestSucceededStatus}
+		for _, status := range opts.Statuses {
+			if !utility.StringSliceContains(statuses, status) {
+				statuses = append(statuses, status)
+			}
+		}
+	}
+
+	// The test results are found in batches of tasks, since a single query
+	// for all the tasks in a wide revision window can exceed the maximum
+	// size of a BSON document.
+	var results []testresult.TestResult
+	for start := 0; start < len(taskIDs); start += testHistoryTaskBatchSize {
+		end := start + testHistoryTaskBatchSize
+		if end > len(taskIDs) {
+			end = len(taskIDs)
+		}
+		query := bson.M{
+			testresult.TaskIDKey: bson.M{"$in": taskIDs[start:end]},
+			"$or": []bson.M{
+				{testresult.TestFileKey: opts.TestName},
+				{testresult.DisplayTestNameKey: opts.TestName},
+			},
+		}
+		if len(statuses) > 0 {
+			query[testresult.StatusKey] = bson.M{"$in": statuses}
+		}
+		batch, err := testresult.Find(db.Query(query))
+		if err != nil {
+			return nil, errors.Wrap(err, "finding test results")
+		}
+		results = append(results, batch...)
+	}
+
+	var runs []TestHistoryRun
+	for _, res := range results {
+		t, ok := tasks[res.TaskID]
+		// Only the task's latest execution is part of the history.
+		if !ok || res.Execution != t.Execution {
+			continue
+		}
+		startTime := utility.FromPythonTime(res.StartTime)
+		runs = append(runs, TestHistoryRun{
+			TestFile:            res.TestFile,
+			DisplayTestName:     res.DisplayTestName,
+			Status:              res.Status,
+			StartTime:           startTime,
+			Duration:            utility.FromPythonTime(res.EndTime).Sub(startTime),
+			FailureMessage:      res.FailureMessage,
+			URL:                 res.URL,
+			LogID:               res.LogID,
+			LineNum:             res.LineNum,
+			TaskID:              t.Id,
+			Execution:           t.Execution,
+			TaskName:            t.DisplayName,
+			BuildVariant:        t.BuildVariant,
+			VersionID:           t.Version,
+			Revision:            t.Revision,
+			RevisionOrderNumber: t.RevisionOrderNumber,
+		})
+	}
+
+	return makeTestHistory(runs, opts), nil
+}
+
+// testHistoryExecutionTasksKey is the field that the execution tasks of
+// display tasks are looked up into when finding the test history tasks.
+const testHistoryExecutionTasksKey = "execution_task_docs"
+
+// testHistoryTask is a task found for the test history along with its
+// execution tasks, if it is a display task.
+type testHistoryTask struct {
+	task.Task         `bson:",inline"`
+	ExecutionTaskDocs []task.Task `bson:"execution_task_docs"`
+}
+
+// findTestHistoryTasks returns the finished tasks in the given versions that
+// can have test results, keyed by task ID. Execution tasks are returned with
+// the name of their display task so that they match display task names. The
+// tasks are filtered and their execution tasks are looked up in the database
+// so that only the fields of the matching tasks are loaded. An error is
+// returned if any of the tasks stored its test results in Cedar, since only
+// test results stored in Evergreen can be searched.
+func findTestHistoryTasks(opts TestHistoryOptions, versionIDs []string) (map[string]task.Task, error) {
+	match := bson.M{
+		task.VersionKey: bson.M{"$in": versionIDs},
+		task.StatusKey:  bson.M{"$in": evergreen.CompletedStatuses},
+	}
+	if len(opts.BuildVariants) > 0 {
+		match[task.BuildVariantKey] = bson.M{"$in": opts.BuildVariants}
+	}
+	if len(opts.TaskNames) > 0 {
+		match[task.DisplayNameKey] = bson.M{"$in": opts.TaskNames}
+	}
+	fields := []string{
+		task.IdKey,
+		task.ExecutionKey,
+		task.DisplayNameKey,
+		task.BuildVariantKey,
+		task.VersionKey,
+		task.RevisionKey,
+		task.RevisionOrderNumberKey,
+		task.DisplayOnlyKey,
+		task.HasCedarResultsKey,
+	}
+	project := bson.M{}
+	for _, field := range fields {
+		project[field] = 1
+		project[bsonutil.GetDottedKeyName(testHistoryExecutionTasksKey, field)] = 1
+	}
+	pipeline := []bson.M{
+		{"$match": match},
+		{"$lookup": bson.M{
+			"from":         task.Collection,
+			"localField":   task.ExecutionTasksKey,
+			"foreignField": task.IdKey,
+			"as":           testHistoryExecutionTasksKey,
+		}},
+		{"$project": project},
+	}
+
+	found := []testHistoryTask{}
+	if err := task.Aggregate(pipeline, &found); err != nil {
+		return nil, errors.Wrap(err, "finding tasks")
+	}
+
+	tasks := map[string]task.Task{}
+	for _, t := range found {
+		if !t.DisplayOnly {
+			tasks[t.Id] = t.Task
+			continue
+		}
+		for _, execTask := range t.ExecutionTaskDocs {
+			execTask.DisplayName = t.DisplayName
+			tasks[execTask.Id] = execTask
+		}
+	}
+	for _, t := range tasks {
+		if t.HasCedarResults {
+			return nil, errors.Errorf("test results of task '%s' are stored in Cedar, which test history does not search", t.Id)
+		}
+	}
+
+	return tasks, nil
+}
+
+// makeTestHistory sorts the runs from newest to oldest, finds when the test
+// started failing, and then filters the runs.
+func makeTestHistory(runs []TestHistoryRun, opts TestHistoryOptions) *TestHistory {
+	sort.SliceStable(runs, func(i, j int) bool {
+		if runs[i].RevisionOrderNumber != runs[j].RevisionOrderNumber {
+			return runs[i].RevisionOrderNumber > runs[j].RevisionOrderNumber
+		}
+		if runs[i].BuildVariant != runs[j].BuildVariant {
+			return runs[i].BuildVariant < runs[j].BuildVariant
+		}
+		return runs[i].TaskName < runs[j].TaskName
+	})
+
+	history := &TestHistory{FailingSince: findFailingSince(runs)}
+	for _, run := range runs {
+		if len(opts.Statuses) > 0 && !utility.StringSliceContains(opts.Statuses, run.Status) {
+			continue
+		}
+		if opts.MinDuration > 0 && run.Duration < opts.MinDuration {
+			continue
+		}
+		if opts.MaxDuration > 0 && run.Duration > opts.MaxDuration {
+			continue
+		}
+		history.Runs = append(history.Runs, run)
+	}
+
+	return history
+}
+
+// findFailingSince returns the first failing run in the current streak of
+// revisions where the test failed, given runs sorted from newest to oldest.
+// A revision fails if any of its runs failed and passes if none failed and at
+// least one succeeded. Revisions where the test was only skipped don't end
+// the streak.
+func findFailingSince(runs []TestHistoryRun) *TestHistoryRun {
+	var failingSince *TestHistoryRun
+	for i := 0; i < len(runs); {
+		order := runs[i].RevisionOrderNumber
+		var failed *TestHistoryRun
+		passed := false
+		for ; i < len(runs) && runs[i].RevisionOrderNumber == order; i++ {
+			switch runs[i].Status {
+			case evergreen.TestFailedStatus:
+				if failed == nil {
+					failed = &runs[i]
+				}
+			case evergreen.TestSucceededStatus:
+				passed = true
+			}
+		}
+
+		if failed != nil {
+			failingSince = failed
+			continue
+		}
+		if passed {
+			break
+		}
+	}
+
+	if failingSince == nil {
+		return nil
+	}
+	run := *failingSince
+	return &run
+}
@@ -0,0 +1,250 @@
+package model
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeTestHistory(t *testing.T) {
+	run := func(order int, variant, status string, duration time.Duration) TestHistoryRun {
+		return TestHistoryRun{
+			RevisionOrderNumber: order,
+			BuildVariant:        variant,
+			Status:              status,
+			Duration:            duration,
+		}
+	}
+
+	t.Run("FailingSinceSpansVariants", func(t *testing.T) {
+		history := makeTestHistory([]TestHistoryRun{
+			run(1, "ubuntu", evergreen.TestSucceededStatus, time.Second),
+			run(4, "ubuntu", evergreen.TestFailedStatus, time.Second),
+			run(2, "ubuntu", evergreen.TestSucceededStatus, time.Second),
+			run(3, "rhel", evergreen.TestFailedStatus, time.Second),
+			run(3, "ubuntu", evergreen.TestSucceededStatus, time.Second),
+		}, TestHistoryOptions{})
+		require.Len(t, history.Runs, 5)
+		assert.Equal(t, 4, history.Runs[0].RevisionOrderNumber)
+		assert.Equal(t, "rhel", history.Runs[1].BuildVariant)
+		require.NotNil(t, history.FailingSince)
+		assert.Equal(t, 3, history.FailingSince.RevisionOrderNumber)
+		assert.Equal(t, "rhel", history.FailingSince.BuildVariant)
+	})
+	t.Run("SkippedRevisionsDoNotEndStreak", func(t *testing.T) {
+		history := makeTestHistory([]TestHistoryRun{
+			run(3, "ubuntu", evergreen.TestFailedStatus, time.Second),
+			run(2, "ubuntu", evergreen.TestSkippedStatus, time.Second),
+			run(1, "ubuntu", evergreen.TestFailedStatus, time.Second),
+		}, TestHistoryOptions{})
+		require.NotNil(t, history.FailingSince)
+		assert.Equal(t, 1, history.FailingSince.RevisionOrderNumber)
+	})
+	t.Run("PassingInLatestRevision", func(t *testing.T) {
+		history := makeTestHistory([]TestHistoryRun{
+			run(2, "ubuntu", evergreen.TestSucceededStatus, time.Second),
+			run(1, "ubuntu", evergreen.TestFailedStatus, time.Second),
+		}, TestHistoryOptions{})
+		assert.Nil(t, history.FailingSince)
+	})
+	t.Run("FiltersDoNotAffectFailingSince", func(t *testing.T) {
+		history := makeTestHistory([]TestHistoryRun{
+			run(3, "ubuntu", evergreen.TestFailedStatus, 5*time.Second),
+			run(2, "ubuntu", evergreen.TestFailedStatus, time.Minute),
+			run(1, "ubuntu", evergreen.TestSucceededStatus, time.Minute),
+		}, TestHistoryOptions{
+			Statuses:    []string{evergreen.TestFailedStatus},
+			MinDuration: 10 * time.Second,
+		})
+		require.Len(t, history.Runs, 1)
+		assert.Equal(t, 2, history.Runs[0].RevisionOrderNumber)
+		require.NotNil(t, history.FailingSince)
+		assert.Equal(t, 2, history.FailingSince.RevisionOrderNumber)
+	})
+}
+
+func TestTestHistoryOptionsValidate(t *testing.T) {
+	opts := TestHistoryOptions{ProjectID: "project", TestName: "test"}
+	require.NoError(t, opts.SetDefaultsAndValidate())
+	assert.Equal(t, DefaultTestHistoryRevisions, opts.NumRevisions)
+
+	for name, opts := range map[string]TestHistoryOptions{
+		"MissingTestName":  {ProjectID: "project"},
+		"TooManyRevisions": {ProjectID: "project", TestName: "test", NumRevisions: MaxTestHistoryRevisions + 1},
+		"InvalidStatus":    {ProjectID: "project", TestName: "test", Statuses: []string{"flaky"}},
+		"MinAboveMax":      {ProjectID: "project", TestName: "test", MinDuration: time.Minute, MaxDuration: time.Second},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, opts.SetDefaultsAndValidate())
+		})
+	}
+}
+
+func TestGetTestHistoryForTest(t *testing.T) {
+	require.NoError(t, db.ClearCollections(VersionCollection, task.Collection, testresult.Collection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(VersionCollection, task.Collection, testresult.Collection))
+	}()
+
+	start := time.Now().Add(-time.Hour)
+	for i := 1; i <= 3; i++ {
+		v := &Version{
+			Id:                  fmt.Sprintf("v%d", i),
+			Identifier:          "project",
+			Requester:           evergreen.RepotrackerVersionRequester,
+			Revision:            fmt.Sprintf("rev%d", i),
+			RevisionOrderNumber: i,
+		}
+		require.NoError(t, v.Insert())
+		tsk := &task.Task{
+			Id:                  fmt.Sprintf("t%d", i),
+			Version:             v.Id,
+			Project:             "project",
+			DisplayName:         "unit",
+			BuildVariant:        "ubuntu",
+			Revision:            v.Revision,
+			RevisionOrderNumber: i,
+			Execution:           1,
+			Status:              evergreen.TaskSucceeded,
+		}
+		require.NoError(t, tsk.Insert())
+
+		status := evergreen.TestFailedStatus
+		if i == 1 {
+			status = evergreen.TestSucceededStatus
+		}
+		for execution := 0; execution <= 1; execution++ {
+			res := testresult.TestResult{
+				TaskID:    tsk.Id,
+				Execution: execution,
+				TestFile:  "TestFoo",
+				Status:    status,
+				StartTime: utility.ToPythonTime(start),
+				EndTime:   utility.ToPythonTime(start.Add(time.Duration(i) * time.Second)),
+			}
+			require.NoError(t, res.Insert())
+		}
+	}
+	patch := &Version{
+		Id:                  "patch",
+		Identifier:          "project",
+		Requester:           evergreen.PatchVersionRequester,
+		RevisionOrderNumber: 4,
+	}
+	require.NoError(t, patch.Insert())
+
+	history, err := GetTestHistoryForTest(TestHistoryOptions{ProjectID: "project", TestName: "TestFoo"})
+	require.NoError(t, err)
+	require.Len(t, history.Runs, 3)
+	assert.Equal(t, "t3", history.Runs[0].TaskID)
+	assert.Equal(t, "rev3", history.Runs[0].Revision)
+	assert.Equal(t, 1, history.Runs[0].Execution)
+	assert.Equal(t, "unit", history.Runs[0].TaskName)
+	require.NotNil(t, history.FailingSince)
+	assert.Equal(t, "t2", history.FailingSince.TaskID)
+
+	// The results of tasks found in separate batches are merged.
+	defer func(batchSize int) {
+		testHistoryTaskBatchSize = batchSize
+	}(testHistoryTaskBatchSize)
+	testHistoryTaskBatchSize = 2
+	history, err = GetTestHistoryForTest(TestHistoryOptions{ProjectID: "project", TestName: "TestFoo"})
+	require.NoError(t, err)
+	require.Len(t, history.Runs, 3)
+	assert.Equal(t, "t3", history.Runs[0].TaskID)
+	require.NotNil(t, history.FailingSince)
+	assert.Equal(t, "t2", history.FailingSince.TaskID)
+
+	history, err = GetTestHistoryForTest(TestHistoryOptions{ProjectID: "project", TestName: "TestFoo", NumRevisions: 1})
+	require.NoError(t, err)
+	require.Len(t, history.Runs, 1)
+	assert.Equal(t, "t3", history.Runs[0].TaskID)
+
+	history, err = GetTestHistoryForTest(TestHistoryOptions{ProjectID: "project", TestName: "TestFoo", MaxDuration: 2 * time.Second})
+	require.NoError(t, err)
+	require.Len(t, history.Runs, 2)
+
+	history, err = GetTestHistoryForTest(TestHistoryOptions{ProjectID: "project", TestName: "TestFoo", BuildVariants: []string{"rhel"}})
+	require.NoError(t, err)
+	assert.Empty(t, history.Runs)
+	assert.Nil(t, history.FailingSince)
+
+	history, err = GetTestHistoryForTest(TestHistoryOptions{ProjectID: "project", TestName: "TestFoo", Statuses: []string{evergreen.TestSkippedStatus}})
+	require.NoError(t, err)
+	assert.Empty(t, history.Runs)
+	require.NotNil(t, history.FailingSince)
+	assert.Equal(t, "t2", history.FailingSince.TaskID)
+
+	displayTask := &task.Task{
+		Id:                  "display",
+		Version:             "v3",
+		DisplayName:         "integration",
+		BuildVariant:        "ubuntu",
+		Revision:            "rev3",
+		RevisionOrderNumber: 3,
+		Status:              evergreen.TaskFailed,
+		DisplayOnly:         true,
+		ExecutionTasks:      []string{"exec"},
+	}
+	require.NoError(t, displayTask.Insert())
+	execTask := &task.Task{
+		Id:                  "exec",
+		Version:             "v3",
+		DisplayName:         "integration_part",
+		BuildVariant:        "ubuntu",
+		Revision:            "rev3",
+		RevisionOrderNumber: 3,
+		Status:              evergreen.TaskFailed,
+	}
+	require.NoError(t, execTask.Insert())
+	unfinishedTask := &task.Task{
+		Id:                  "unfinished",
+		Version:             "v3",
+		DisplayName:         "lint",
+		BuildVariant:        "ubuntu",
+		Revision:            "rev3",
+		RevisionOrderNumber: 3,
+		Status:              evergreen.TaskStarted,
+	}
+	require.NoError(t, unfinishedTask.Insert())
+	for _, taskID := range []string{execTask.Id, unfinishedTask.Id} {
+		res := testresult.TestResult{
+			TaskID:   taskID,
+			TestFile: "TestFoo",
+			Status:   evergreen.TestFailedStatus,
+		}
+		require.NoError(t, res.Insert())
+	}
+
+	history, err = GetTestHistoryForTest(TestHistoryOptions{ProjectID: "project", TestName: "TestFoo", TaskNames: []string{"integration"}})
+	require.NoError(t, err)
+	require.Len(t, history.Runs, 1)
+	assert.Equal(t, "exec", history.Runs[0].TaskID)
+	assert.Equal(t, "integration", history.Runs[0].TaskName)
+
+	history, err = GetTestHistoryForTest(TestHistoryOptions{ProjectID: "project", TestName: "TestFoo", TaskNames: []string{"lint"}})
+	require.NoError(t, err)
+	assert.Empty(t, history.Runs)
+
+	cedarTask := &task.Task{
+		Id:                  "cedar",
+		Version:             "v3",
+		DisplayName:         "unit",
+		BuildVariant:        "ubuntu",
+		Revision:            "rev3",
+		RevisionOrderNumber: 3,
+		Status:              evergreen.TaskSucceeded,
+		HasCedarResults:     true,
+	}
+	require.NoError(t, cedarTask.Insert())
+	_, err = GetTestHistoryForTest(TestHistoryOptions{ProjectID: "project", TestName: "TestFoo"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,82 @@
+package testresult
+
+import (
+	"fmt"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// CedarMetadataCollection is the name of the collection of the test
+	// result information that Cedar does not store.
+	CedarMetadataCollection = "cedar_test_result_metadata"
+)
+
+// CedarMetadata is the information reported by the test framework about a
+// test result stored in Cedar that Cedar does not store itself. TestName is
+// the name the test result was sent to Cedar with.
+type CedarMetadata struct {
+	ID             string   `bson:"_id" json:"-"`
+	TaskID         string   `bson:"task_id" json:"task_id"`
+	Execution      int      `bson:"task_execution" json:"task_execution"`
+	TestName       string   `bson:"test_name" json:"test_name"`
+	FailureMessage string   `bson:"failure_message,omitempty" json:"failure_message,omitempty"`
+	StackTrace     string   `bson:"stack_trace,omitempty" json:"stack_trace,omitempty"`
+	Tags           []string `bson:"tags,omitempty" json:"tags,omitempty"`
+	Owners         []string `bson:"owners,omitempty" json:"owners,omitempty"`
+	Retries        int      `bson:"retries,omitempty" json:"retries,omitempty"`
+}
+
+var (
+	cedarMetadataTaskIDKey    = bsonutil.MustHaveTag(CedarMetadata{}, "TaskID")
+	cedarMetadataExecutionKey = bsonutil.MustHaveTag(CedarMetadata{}, "Execution")
+)
+
+// Key returns the key identifying the test result the metadata belongs to.
+func (m *CedarMetadata) Key() string {
+	return CedarMetadataKey(m.TaskID, m.Execution, m.TestName)
+}
+
+// CedarMetadataKey returns the key identifying a test result stored in Cedar.
+func CedarMetadataKey(taskID string, execution int, testName string) string {
+	return fmt.Sprintf("%s_%d_%s", taskID, execution, testName)
+}
+
+// InsertCedarMetadata inserts the metadata of the given test results. Metadata
+// that was already inserted is ignored, so that requests can be retried.
+func InsertCedarMetadata(metadata []CedarMetadata) error {
+	docs := make([]interface{}, 0, len(metadata))
+	for i := range metadata {
+		metadata[i].ID = metadata[i].Key()
+		docs = append(docs, metadata[i])
+	}
+	if err := db.InsertManyUnordered(CedarMetadataCollection, docs...); err != nil && !db.IsDuplicateKey(err) {
+		return errors.Wrap(err, "inserting Cedar test result metadata")
+	}
+	return nil
+}
+
+// FindCedarMetadata returns the metadata of the test results of the given
+// task executions, keyed by CedarMetadataKey.
+func FindCedarMetadata(taskIDs []string, executions []int) (map[string]CedarMetadata, error) {
+	if len(taskIDs) == 0 {
+		return nil, nil
+	}
+	metadata := []CedarMetadata{}
+	err := db.FindAllQ(CedarMetadataCollection, db.Query(bson.M{
+		cedarMetadataTaskIDKey:    bson.M{"$in": taskIDs},
+		cedarMetadataExecutionKey: bson.M{"$in": executions},
+	}), &metadata)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding Cedar test result metadata")
+	}
+
+	byKey := make(map[string]CedarMetadata, len(metadata))
+	for _, m := range metadata {
+		byKey[m.Key()] = m
+	}
+	return byKey, nil
+}
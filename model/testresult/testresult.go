@@ -40,6 +40,13 @@ type TestResult struct {
 	TaskID    string `bson:"task_id" json:"task_id"`
 	Execution int    `bson:"task_execution" json:"task_execution"`
 
+	// Metadata reported by the test framework.
+	FailureMessage string   `json:"failure_message,omitempty" bson:"failure_message,omitempty"`
+	StackTrace     string   `json:"stack_trace,omitempty" bson:"stack_trace,omitempty"`
+	Tags           []string `json:"tags,omitempty" bson:"tags,omitempty"`
+	Owners         []string `json:"owners,omitempty" bson:"owners,omitempty"`
+	Retries        int      `json:"retries,omitempty" bson:"retries,omitempty"`
+
 	// LogRaw is not persisted to the database
 	LogRaw string `json:"log_raw" bson:"log_raw,omitempty"`
 
@@ -73,6 +80,11 @@ var (
 	EndTimeKey         = bsonutil.MustHaveTag(TestResult{}, "EndTime")
 	TaskIDKey          = bsonutil.MustHaveTag(TestResult{}, "TaskID")
 	ExecutionKey       = bsonutil.MustHaveTag(TestResult{}, "Execution")
+	FailureMessageKey  = bsonutil.MustHaveTag(TestResult{}, "FailureMessage")
+	StackTraceKey      = bsonutil.MustHaveTag(TestResult{}, "StackTrace")
+	TagsKey            = bsonutil.MustHaveTag(TestResult{}, "Tags")
+	OwnersKey          = bsonutil.MustHaveTag(TestResult{}, "Owners")
+	RetriesKey         = bsonutil.MustHaveTag(TestResult{}, "Retries")
 
 	ProjectKey      = bsonutil.MustHaveTag(TestResult{}, "Project")
 	BuildVariantKey = bsonutil.MustHaveTag(TestResult{}, "BuildVariant")
@@ -148,7 +160,7 @@ func Aggregate(pipeline []bson.M, results interface{}) error {
 		results)
 }
 
-func TestResultCount(taskIds []string, testName string, statuses []string, execution int) (int, error) {
+func TestResultCount(taskIds []string, testName string, statuses, tags, owners []string, execution int) (int, error) {
 	filter := bson.M{
 		TaskIDKey:    bson.M{"$in": taskIds},
 		ExecutionKey: execution,
@@ -156,6 +168,7 @@ func TestResultCount(taskIds []string, testName string, statuses []string, execu
 	if len(statuses) > 0 {
 		filter[StatusKey] = bson.M{"$in": statuses}
 	}
+	addMetadataFilters(filter, tags, owners)
 	if testName != "" {
 		filter[TestFileKey] = bson.M{"$regex": testName, "$options": "i"}
 	}
@@ -172,12 +185,25 @@ type TestResultsFilterSortPaginateOpts struct {
 	SortBy    string
 	SortDir   int
 	Statuses  []string
+	// Tags matches test results that have all of the given tags.
+	Tags []string
+	// Owners matches test results owned by any of the given owners.
+	Owners []string
 	// TaskIDs is the only required option.
 	TaskIDs  []string
 	TestID   string
 	TestName string
 }
 
+func addMetadataFilters(filter bson.M, tags, owners []string) {
+	if len(tags) > 0 {
+		filter[TagsKey] = bson.M{"$all": tags}
+	}
+	if len(owners) > 0 {
+		filter[OwnersKey] = bson.M{"$in": owners}
+	}
+}
+
 var TestResultsIndex = bson.D{
 	{
 		Key:   TaskIDKey,
@@ -202,6 +228,7 @@ func TestResultsFilterSortPaginate(opts TestResultsFilterSortPaginateOpts) ([]Te
 	if opts.GroupID != "" {
 		match[GroupIDKey] = opts.GroupID
 	}
+	addMetadataFilters(match, opts.Tags, opts.Owners)
 
 	if opts.TestID != "" {
 		match[IDKey] = bson.M{"$gte": mgobson.ObjectId(opts.TestID)}
@@ -216,11 +243,16 @@ func TestResultsFilterSortPaginate(opts TestResultsFilterSortPaginateOpts) ([]Te
 		DisplayTestNameKey: 1,
 		EndTimeKey:         1,
 		ExitCodeKey:        1,
+		FailureMessageKey:  1,
 		GroupIDKey:         1,
 		LineNumKey:         1,
 		LogIDKey:           1,
+		OwnersKey:          1,
+		RetriesKey:         1,
+		StackTraceKey:      1,
 		StartTimeKey:       1,
 		StatusKey:          1,
+		TagsKey:            1,
 		TaskIDKey:          1,
 		TestFileKey:        1,
 		URLKey:             1,
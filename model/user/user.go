@@ -427,3 +427,31 @@ func IsValidSubscriptionPreference(in string) bool {
 		return false
 	}
 }
+
+// MakeBuildBreakSubscriber returns a subscriber for the user's build break
+// notification preference, or nil if they've opted out.
+func MakeBuildBreakSubscriber(userID string) (*event.Subscriber, error) {
+	u, err := FindOne(ById(userID))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to find user")
+	}
+	if u == nil {
+		return nil, errors.Errorf("user %s does not exist", userID)
+	}
+	var subscriber *event.Subscriber
+	preference := u.Settings.Notifications.BuildBreak
+	if preference != "" && preference != PreferenceNone {
+		subscriber = &event.Subscriber{
+			Type: string(preference),
+		}
+		if preference == PreferenceEmail {
+			subscriber.Target = u.Email()
+		} else if preference == PreferenceSlack {
+			subscriber.Target = u.Settings.SlackUsername
+		} else {
+			return nil, errors.Errorf("invalid subscription preference for build break: %s", preference)
+		}
+	}
+
+	return subscriber, nil
+}
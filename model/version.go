@@ -12,6 +12,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/user"
+	"github.com/evergreen-ci/evergreen/thirdparty"
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/anser/bsonutil"
 	"github.com/pkg/errors"
@@ -239,12 +240,15 @@ func GetVersionForCommitQueueItem(cq *commitqueue.CommitQueue, issue string) (*V
 	return VersionFindOneId(cq.Queue[spot].Version)
 }
 
-// VersionBuildStatus stores metadata relating to each build
+// VersionBuildStatus stores metadata relating to each build. PathFilteredTasks
+// are the tasks that aren't activated with the build because none of the files
+// they watch changed.
 type VersionBuildStatus struct {
-	BuildVariant     string                `bson:"build_variant" json:"id"`
-	BuildId          string                `bson:"build_id,omitempty" json:"build_id,omitempty"`
-	BatchTimeTasks   []BatchTimeTaskStatus `bson:"batchtime_tasks,omitempty" json:"batchtime_tasks,omitempty"`
-	ActivationStatus `bson:",inline"`
+	BuildVariant      string                `bson:"build_variant" json:"id"`
+	BuildId           string                `bson:"build_id,omitempty" json:"build_id,omitempty"`
+	BatchTimeTasks    []BatchTimeTaskStatus `bson:"batchtime_tasks,omitempty" json:"batchtime_tasks,omitempty"`
+	PathFilteredTasks []string              `bson:"path_filtered_tasks,omitempty" json:"path_filtered_tasks,omitempty"`
+	ActivationStatus  `bson:",inline"`
 }
 
 type BatchTimeTaskStatus struct {
@@ -277,6 +281,12 @@ type VersionMetadata struct {
 	PeriodicBuildID     string
 	RemotePath          string
 	GitTag              GitTag
+	// ChangedFiles are the files changed by the revision, used to select tasks
+	// by the files they watch. Nil if they aren't known.
+	ChangedFiles []string
+	// GithubMergeGroup is the GitHub merge queue group the version tests, if
+	// any.
+	GithubMergeGroup *thirdparty.GithubMergeGroup
 }
 
 var (
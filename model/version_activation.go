@@ -41,7 +41,7 @@ func ActivateElapsedBuildsAndTasks(v *Version) (bool, error) {
 
 	for i, bv := range v.BuildVariants {
 		// if there are batchtime tasks, consider if these should/shouldn't be activated, regardless of build
-		ignoreTasks := []string{}
+		ignoreTasks := append([]string{}, bv.PathFilteredTasks...)
 		readyTasks := []string{}
 		for j, t := range bv.BatchTimeTasks {
 			isElapsedTask := t.ShouldActivate(now)
@@ -104,6 +104,17 @@ func BaseVersionByProjectIdAndRevision(projectId, revision string) db.Q {
 		})
 }
 
+// VersionByGithubMergeGroup finds the versions testing the GitHub merge
+// queue group with the given head commit.
+func VersionByGithubMergeGroup(projectId, headSHA string) db.Q {
+	return db.Query(
+		bson.M{
+			VersionIdentifierKey: projectId,
+			VersionRevisionKey:   headSHA,
+			VersionRequesterKey:  evergreen.GithubMergeRequester,
+		})
+}
+
 func VersionByProjectIdAndRevisionPrefix(projectId, revisionPrefix string) db.Q {
 	lengthHash := (40 - len(revisionPrefix))
 	return db.Query(
@@ -117,7 +117,6 @@ func requireStringFlag(name string) cli.BeforeFunc {
 	}
 }
 
-// nolint: unused, deadcode
 func requireStringSliceValueChoices(name string, options []string) cli.BeforeFunc {
 	return func(c *cli.Context) error {
 		catcher := grip.NewBasicCatcher()
@@ -8,6 +8,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model"
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/timber"
 	"github.com/evergreen-ci/timber/buildlogger"
 	"github.com/evergreen-ci/utility"
@@ -21,6 +24,7 @@ func Buildlogger() cli.Command {
 		Usage: "operations for buildlogger logs",
 		Subcommands: []cli.Command{
 			fetch(),
+			search(),
 		},
 	}
 }
@@ -178,3 +182,124 @@ func fetch() cli.Command {
 		},
 	}
 }
+
+func search() cli.Command {
+	const (
+		taskIDFlagName    = "task_id"
+		executionFlagName = "execution"
+		patternFlagName   = "pattern"
+		severityFlagName  = "severity"
+		logTypeFlagName   = "type"
+		contextFlagName   = "context"
+		pageFlagName      = "page"
+		limitFlagName     = "limit"
+	)
+
+	return cli.Command{
+		Name:  "search",
+		Usage: "search a task's logs for lines matching a regular expression",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  taskIDFlagName,
+				Usage: "The task id of the logs you would like to search. (Required)",
+			},
+			cli.IntFlag{
+				Name:  executionFlagName,
+				Usage: "The execution of the task id. Defaults to the latest.",
+			},
+			cli.StringFlag{
+				Name:  fmt.Sprintf("%s,e", patternFlagName),
+				Usage: "The regular expression to search for. (Required)",
+			},
+			cli.StringSliceFlag{
+				Name:  severityFlagName,
+				Usage: "Only match lines with these severities (E, W, I or D).",
+			},
+			cli.StringFlag{
+				Name:  logTypeFlagName,
+				Usage: "The log to search: T (task), E (agent), S (system) or ALL.",
+				Value: apimodels.AllTaskLevelLogs,
+			},
+			cli.IntFlag{
+				Name:  fmt.Sprintf("%s,C", contextFlagName),
+				Usage: "Print N lines of context before and after each match.",
+			},
+			cli.IntFlag{
+				Name:  pageFlagName,
+				Usage: "The zero-indexed page of matches to print.",
+			},
+			cli.IntFlag{
+				Name:  limitFlagName,
+				Usage: "The number of matches per page.",
+				Value: model.DefaultTaskLogSearchLimit,
+			},
+		},
+		Before: mergeBeforeFuncs(
+			requireStringFlag(taskIDFlagName),
+			requireStringFlag(patternFlagName),
+			requireIntValueBetween(contextFlagName, 0, model.MaxTaskLogSearchContextLines),
+			requireIntValueBetween(limitFlagName, 1, model.MaxTaskLogSearchLimit),
+		),
+		Action: func(c *cli.Context) error {
+			confPath := c.Parent().Parent().String(confFlagName)
+			opts := model.TaskLogSearchOptions{
+				TaskID:       c.String(taskIDFlagName),
+				Pattern:      c.String(patternFlagName),
+				Severities:   c.StringSlice(severityFlagName),
+				LogType:      c.String(logTypeFlagName),
+				ContextLines: c.Int(contextFlagName),
+				Page:         c.Int(pageFlagName),
+				Limit:        c.Int(limitFlagName),
+			}
+			var execution *int
+			if c.IsSet(executionFlagName) {
+				execution = utility.ToIntPtr(c.Int(executionFlagName))
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "problem loading configuration")
+			}
+			client := conf.setupRestCommunicator(ctx)
+			defer client.Close()
+
+			result, err := client.SearchTaskLogs(ctx, opts, execution)
+			if err != nil {
+				return errors.Wrapf(err, "searching logs for task '%s'", opts.TaskID)
+			}
+
+			printTaskLogSearchResult(result, opts)
+			return nil
+		},
+	}
+}
+
+// printTaskLogSearchResult prints each match with its context lines in the
+// style of grep, prefixing each line with its line number.
+func printTaskLogSearchResult(result *restmodel.APITaskLogSearchResult, opts model.TaskLogSearchOptions) {
+	printLine := func(lineNumber int, sep string, line restmodel.APITaskLogLine) {
+		fmt.Printf("%d%s[%s] %s\n", lineNumber+1, sep, utility.FromStringPtr(line.Severity), utility.FromStringPtr(line.Message))
+	}
+	for i, match := range result.Matches {
+		if i > 0 && opts.ContextLines > 0 {
+			fmt.Println("--")
+		}
+		for j, line := range match.Before {
+			printLine(match.LineNumber-len(match.Before)+j, "-", line)
+		}
+		printLine(match.LineNumber, ":", match.Line)
+		for j, line := range match.After {
+			printLine(match.LineNumber+j+1, "-", line)
+		}
+	}
+
+	if len(result.Matches) == 0 {
+		fmt.Printf("No matches on this page (%d total matches).\n", result.TotalMatches)
+		return
+	}
+	first := opts.Page*opts.Limit + 1
+	fmt.Printf("\nShowing matches %d-%d of %d.\n", first, first+len(result.Matches)-1, result.TotalMatches)
+}
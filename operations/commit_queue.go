@@ -30,6 +30,7 @@ const (
 	commitShaFlag       = "commit-sha"
 	commitMessageFlag   = "commit-message"
 	githubAuthorFlag    = "author"
+	laneFlagName        = "lane"
 
 	noCommits             = "No Commits Added"
 	commitQueuePatchLabel = "Commit Queue Merge:"
@@ -137,6 +138,10 @@ func mergeCommand() cli.Command {
 				Name:  forceFlagName,
 				Usage: "force item to front of queue",
 			},
+			cli.StringFlag{
+				Name:  laneFlagName,
+				Usage: "name of the commit queue lane to enqueue the item in (default is the normal lane)",
+			},
 			cli.StringFlag{
 				Name: githubAuthorFlag,
 				Usage: "optionally define the patch author by providing a Github username (this will only work if the " +
@@ -146,6 +151,7 @@ func mergeCommand() cli.Command {
 		Before: mergeBeforeFuncs(
 			setPlainLogger,
 			mutuallyExclusiveArgs(false, refFlagName, commitsFlagName),
+			mutuallyExclusiveArgs(false, forceFlagName, laneFlagName),
 		),
 		Action: func(c *cli.Context) error {
 			ctx, cancel := context.WithCancel(context.Background())
@@ -165,6 +171,7 @@ func mergeCommand() cli.Command {
 				skipConfirm:  c.Bool(skipConfirmFlagName),
 				large:        c.Bool(largeFlagName),
 				force:        c.Bool(forceFlagName),
+				lane:         c.String(laneFlagName),
 				githubAuthor: c.String(githubAuthorFlag),
 			}
 			if params.force && !params.skipConfirm && !confirm("Forcing item to front of queue will be reported. Continue? (y/N)", false) {
@@ -240,16 +247,22 @@ func enqueuePatch() cli.Command {
 				Name:  commitMessageFlag,
 				Usage: "commit message for the new commit (default is the existing patch description)",
 			},
+			cli.StringFlag{
+				Name:  laneFlagName,
+				Usage: "name of the commit queue lane to enqueue the item in (default is the normal lane)",
+			},
 		)),
 		Before: mergeBeforeFuncs(
 			requirePatchIDFlag,
 			setPlainLogger,
+			mutuallyExclusiveArgs(false, forceFlagName, laneFlagName),
 		),
 		Action: func(c *cli.Context) error {
 			confPath := c.Parent().Parent().String(confFlagName)
 			patchID := c.String(patchIDFlagName)
 			commitMessage := c.String(commitMessageFlag)
 			force := c.Bool(forceFlagName)
+			lane := c.String(laneFlagName)
 			skipConfirm := c.Bool(skipConfirmFlagName)
 
 			ctx, cancel := context.WithCancel(context.Background())
@@ -310,7 +323,7 @@ func enqueuePatch() cli.Command {
 			grip.Info(patchDisp)
 
 			// enqueue the patch
-			position, err := client.EnqueueItem(ctx, utility.FromStringPtr(mergePatch.Id), force)
+			position, err := client.EnqueueItem(ctx, utility.FromStringPtr(mergePatch.Id), force, lane)
 			if err != nil {
 				return errors.Wrap(err, "problem enqueueing new patch")
 			}
@@ -528,6 +541,7 @@ type mergeParams struct {
 	skipConfirm  bool
 	large        bool
 	force        bool
+	lane         string
 	githubAuthor string
 }
 
@@ -547,7 +561,7 @@ func (p *mergeParams) mergeBranch(ctx context.Context, conf *ClientSettings, cli
 	if p.pause {
 		return nil
 	}
-	position, err := client.EnqueueItem(ctx, p.id, p.force)
+	position, err := client.EnqueueItem(ctx, p.id, p.force, p.lane)
 	if err != nil {
 		return err
 	}
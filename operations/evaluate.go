@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"io/ioutil"
 
+	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/mongodb/grip"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 	yaml "gopkg.in/yaml.v3"
@@ -15,6 +18,7 @@ func Evaluate() cli.Command {
 	const (
 		taskFlagName     = "tasks"
 		variantsFlagName = "variants"
+		diffFlagName     = "diff"
 	)
 
 	return cli.Command{
@@ -28,12 +32,17 @@ func Evaluate() cli.Command {
 			cli.BoolFlag{
 				Name:  variantsFlagName,
 				Usage: "only show variant definitions",
+			},
+			cli.StringFlag{
+				Name:  diffFlagName,
+				Usage: "show which tasks would be selected by their inputs for the files changed in this diff file",
 			}),
 		Before: requirePathFlag,
 		Action: func(c *cli.Context) error {
 			path := c.String(pathFlagName)
 			showTasks := c.Bool(taskFlagName)
 			showVariants := c.Bool(variantsFlagName)
+			diffPath := c.String(diffFlagName)
 
 			configBytes, err := ioutil.ReadFile(path)
 			if err != nil {
@@ -51,7 +60,12 @@ func Evaluate() cli.Command {
 			}
 
 			var out interface{}
-			if showTasks || showVariants {
+			if diffPath != "" {
+				out, err = evaluateChangedFiles(p, diffPath)
+				if err != nil {
+					return errors.Wrap(err, "error selecting tasks for diff")
+				}
+			} else if showTasks || showVariants {
 				tmp := struct {
 					Functions interface{} `yaml:"functions,omitempty"`
 					Tasks     interface{} `yaml:"tasks,omitempty"`
@@ -79,3 +93,60 @@ func Evaluate() cli.Command {
 		},
 	}
 }
+
+type evaluatedVariantTasks struct {
+	Name         string   `yaml:"name"`
+	Tasks        []string `yaml:"tasks,omitempty"`
+	SkippedTasks []string `yaml:"skipped_tasks,omitempty"`
+}
+
+// evaluateChangedFiles returns the tasks on each variant that would be
+// selected and skipped by their inputs for the diff's changed files.
+func evaluateChangedFiles(p *model.Project, diffPath string) (interface{}, error) {
+	diff, err := ioutil.ReadFile(diffPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading diff")
+	}
+	summaries, err := thirdparty.GetPatchSummaries(string(diff))
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting changed files from diff")
+	}
+	files := make([]string, 0, len(summaries))
+	for _, summary := range summaries {
+		files = append(files, summary.Name)
+	}
+
+	allPairs := p.AllTVPairs()
+	selected, err := p.SelectTVPairsForChangedFiles(model.TaskVariantPairs{ExecTasks: allPairs}, evergreen.PatchVersionRequester, files)
+	if err != nil {
+		grip.Warning(err)
+	}
+	isSelected := map[model.TVPair]bool{}
+	for _, pair := range selected.ExecTasks {
+		isSelected[pair] = true
+	}
+
+	variants := []evaluatedVariantTasks{}
+	for _, bv := range p.BuildVariants {
+		if bv.Disabled {
+			continue
+		}
+		variant := evaluatedVariantTasks{Name: bv.Name}
+		for _, taskName := range allPairs.TaskNames(bv.Name) {
+			if isSelected[model.TVPair{Variant: bv.Name, TaskName: taskName}] {
+				variant.Tasks = append(variant.Tasks, taskName)
+			} else {
+				variant.SkippedTasks = append(variant.SkippedTasks, taskName)
+			}
+		}
+		variants = append(variants, variant)
+	}
+
+	return struct {
+		ChangedFiles []string                `yaml:"changed_files"`
+		Variants     []evaluatedVariantTasks `yaml:"buildvariants"`
+	}{
+		ChangedFiles: files,
+		Variants:     variants,
+	}, nil
+}
@@ -541,6 +541,8 @@ func (ac *legacyClient) PutPatch(incomingPatch patchSubmission) (*patch.Patch, e
 		GitMetadata       patch.GitMetadata  `json:"git_metadata"`
 		ReuseDefinition   bool               `json:"reuse_definition"`
 		GithubAuthor      string             `json:"github_author"`
+		StackedOn         string             `json:"stacked_on"`
+		WaitForStackedOn  bool               `json:"wait_for_stacked_on"`
 	}{
 		Description:       incomingPatch.description,
 		Project:           incomingPatch.projectName,
@@ -561,6 +563,8 @@ func (ac *legacyClient) PutPatch(incomingPatch patchSubmission) (*patch.Patch, e
 		GitMetadata:       incomingPatch.gitMetadata,
 		ReuseDefinition:   incomingPatch.reuseDefinition,
 		GithubAuthor:      incomingPatch.githubAuthor,
+		StackedOn:         incomingPatch.stackedOn,
+		WaitForStackedOn:  incomingPatch.waitForStackedOn,
 	}
 
 	rPipe, wPipe := io.Pipe()
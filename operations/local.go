@@ -0,0 +1,192 @@
+package operations
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/evergreen-ci/evergreen/agent"
+	"github.com/evergreen-ci/evergreen/model"
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func Local() cli.Command {
+	return cli.Command{
+		Name:  "local",
+		Usage: "run project tasks on the local machine",
+		Subcommands: []cli.Command{
+			localRun(),
+		},
+	}
+}
+
+func localRun() cli.Command {
+	const (
+		taskFlagName      = "task"
+		variantFlagName   = "variant"
+		varFlagName       = "var"
+		noVarsFlagName    = "no-project-vars"
+		skipSetupFlagName = "skip-setup"
+	)
+
+	return cli.Command{
+		Name:  "run",
+		Usage: "run a task's commands in a local working directory, skipping commands that only work in Evergreen (e.g. s3.put, host.create)",
+		Flags: addPathFlag(addProjectFlag(
+			cli.StringFlag{
+				Name:  joinFlagNames(taskFlagName, "t"),
+				Usage: "name of the task to run",
+			},
+			cli.StringFlag{
+				Name:  joinFlagNames(variantFlagName, "v"),
+				Usage: "name of the build variant to run the task on",
+			},
+			cli.StringFlag{
+				Name:  joinFlagNames(dirFlagName, "d"),
+				Usage: "working directory to run the task in (defaults to the current directory)",
+			},
+			cli.StringSliceFlag{
+				Name:  varFlagName,
+				Usage: "set an expansion as a KEY=VALUE pair, overriding project variables (can be specified multiple times)",
+			},
+			cli.BoolFlag{
+				Name:  noVarsFlagName,
+				Usage: "do not fetch the project's variables from Evergreen",
+			},
+			cli.BoolFlag{
+				Name:  skipSetupFlagName,
+				Usage: "only run the task's commands, skipping pre/post and task group setup/teardown",
+			})...),
+		Before: mergeBeforeFuncs(
+			requirePathFlag,
+			requireStringFlag(taskFlagName),
+			requireStringFlag(variantFlagName),
+			requireWorkingDirFlag(dirFlagName),
+		),
+		Action: func(c *cli.Context) error {
+			confPath := c.Parent().Parent().String(confFlagName)
+			path := c.String(pathFlagName)
+			projectID := c.String(projectFlagName)
+			taskName := c.String(taskFlagName)
+			variant := c.String(variantFlagName)
+			workDir := c.String(dirFlagName)
+			skipSetup := c.Bool(skipSetupFlagName)
+
+			userVars, err := parseLocalVars(c.StringSlice(varFlagName))
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			configBytes, err := ioutil.ReadFile(path)
+			if err != nil {
+				return errors.Wrap(err, "error reading project config")
+			}
+			project := &model.Project{}
+			opts := &model.GetProjectOpts{
+				ReadFileFrom: model.ReadFromLocal,
+			}
+			if _, _, err = model.LoadProjectInto(ctx, configBytes, opts, "", project); err != nil {
+				return errors.Wrap(err, "error loading project")
+			}
+
+			projectRef := &model.ProjectRef{Id: projectID, Identifier: projectID}
+			expansions := util.Expansions{}
+			if !c.Bool(noVarsFlagName) {
+				conf, err := NewClientSettings(confPath)
+				if err != nil {
+					return errors.Wrap(err, "problem loading configuration")
+				}
+				if projectID == "" {
+					cwd, err := os.Getwd()
+					grip.Error(errors.Wrap(err, "unable to get current working directory"))
+					cwd, err = filepath.EvalSymlinks(cwd)
+					grip.Error(errors.Wrap(err, "unable to resolve symlinks"))
+					projectID = conf.FindDefaultProject(cwd, false)
+				}
+				if projectID != "" {
+					client := conf.setupRestCommunicator(ctx)
+					defer client.Close()
+
+					apiProject, err := client.GetProject(ctx, projectID)
+					if err != nil {
+						return errors.Wrapf(err, "error getting variables for project '%s'", projectID)
+					}
+					projectRef = localProjectRef(apiProject)
+					expansions.Update(localProjectVars(apiProject.Variables, userVars))
+				}
+			}
+			expansions.Update(userVars)
+
+			return agent.RunLocalTask(ctx, agent.LocalTaskOptions{
+				Project:    project,
+				ProjectRef: projectRef,
+				TaskName:   taskName,
+				Variant:    variant,
+				WorkDir:    workDir,
+				Expansions: expansions,
+				SkipSetup:  skipSetup,
+				Output:     os.Stdout,
+			})
+		},
+	}
+}
+
+// parseLocalVars parses KEY=VALUE pairs into a map of expansions.
+func parseLocalVars(pairs []string) (map[string]string, error) {
+	vars := map[string]string{}
+	catcher := grip.NewBasicCatcher()
+	for _, pair := range pairs {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			catcher.Errorf("variable '%s' must be a KEY=VALUE pair", pair)
+			continue
+		}
+		vars[parts[0]] = parts[1]
+	}
+	return vars, catcher.Resolve()
+}
+
+// localProjectVars returns the project variables the user can see. Private
+// variables are redacted by the server, so they are left unset unless the
+// user overrides them.
+func localProjectVars(vars restmodel.APIProjectVars, overrides map[string]string) map[string]string {
+	out := map[string]string{}
+	var missing []string
+	for key, val := range vars.Vars {
+		if vars.PrivateVars[key] {
+			if _, ok := overrides[key]; !ok {
+				missing = append(missing, key)
+			}
+			continue
+		}
+		out[key] = val
+	}
+	if len(missing) != 0 {
+		sort.Strings(missing)
+		grip.Warningf("private project variables are not available locally and must be set with --var: %s", strings.Join(missing, ", "))
+	}
+	return out
+}
+
+func localProjectRef(apiProject *restmodel.APIProjectRef) *model.ProjectRef {
+	return &model.ProjectRef{
+		Id:         utility.FromStringPtr(apiProject.Id),
+		Identifier: utility.FromStringPtr(apiProject.Identifier),
+		Owner:      utility.FromStringPtr(apiProject.Owner),
+		Repo:       utility.FromStringPtr(apiProject.Repo),
+		Branch:     utility.FromStringPtr(apiProject.Branch),
+	}
+}
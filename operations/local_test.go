@@ -0,0 +1,28 @@
+package operations
+
+import (
+	"testing"
+
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLocalVars(t *testing.T) {
+	vars, err := parseLocalVars([]string{"foo=bar", "url=http://a?b=c", "empty="})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"foo": "bar", "url": "http://a?b=c", "empty": ""}, vars)
+
+	_, err = parseLocalVars([]string{"foo"})
+	assert.Error(t, err)
+	_, err = parseLocalVars([]string{"=bar"})
+	assert.Error(t, err)
+}
+
+func TestLocalProjectVars(t *testing.T) {
+	vars := restmodel.APIProjectVars{
+		Vars:        map[string]string{"public": "value", "secret": "", "token": ""},
+		PrivateVars: map[string]bool{"secret": true, "token": true},
+	}
+	assert.Equal(t, map[string]string{"public": "value"}, localProjectVars(vars, map[string]string{"token": "mine"}))
+}
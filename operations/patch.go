@@ -18,6 +18,8 @@ const (
 	patchVerboseFlagName     = "verbose"
 	patchTriggerAliasFlag    = "trigger-alias"
 	reuseDefinitionFlag      = "reuse"
+	stackedOnFlagName        = "stacked-on"
+	waitForStackedOnFlagName = "wait-for-stacked-on"
 )
 
 func getPatchFlags(flags ...cli.Flag) []cli.Flag {
@@ -64,6 +66,15 @@ func getPatchFlags(flags ...cli.Flag) []cli.Flag {
 				Name:  pathFlagName,
 				Usage: "path to an evergreen project configuration file",
 			},
+			cli.StringFlag{
+				Name: stackedOnFlagName,
+				Usage: "ID of the patch to stack this patch on. The diff is taken against the upstream of the current " +
+					"branch, which should be the branch the stacked on patch was made from",
+			},
+			cli.BoolFlag{
+				Name:  waitForStackedOnFlagName,
+				Usage: "finalize this patch only once the patch it is stacked on succeeds",
+			},
 		))
 }
 
@@ -110,6 +121,8 @@ func Patch() cli.Command {
 				PreserveCommits:   c.Bool(preserveCommitsFlag),
 				TriggerAliases:    utility.SplitCommas(c.StringSlice(patchTriggerAliasFlag)),
 				ReuseDefinition:   c.Bool(reuseDefinitionFlag),
+				StackedOn:         c.String(stackedOnFlagName),
+				WaitForStackedOn:  c.Bool(waitForStackedOnFlagName),
 			}
 
 			var err error
@@ -129,6 +142,9 @@ func Patch() cli.Command {
 			if params.ReuseDefinition && (len(params.Tasks) > 0 || len(params.Variants) > 0) {
 				return errors.Errorf("can't define tasks/variants when reusing previous patch's tasks and variants")
 			}
+			if params.WaitForStackedOn && params.StackedOn == "" {
+				return errors.Errorf("can't wait for the stacked on patch without specifying one with --%s", stackedOnFlagName)
+			}
 
 			params.PreserveCommits = params.PreserveCommits || conf.PreserveCommits
 			if !params.SkipConfirm {
@@ -159,7 +175,14 @@ func Patch() cli.Command {
 			}
 			params.Description = params.getDescription()
 
-			diffData, err := loadGitData(ref.Branch, params.Ref, "", params.PreserveCommits, args...)
+			// A stacked patch only contains the changes made on top of the
+			// patch it is stacked on, so take the diff against the current
+			// branch's upstream rather than the project's branch.
+			baseBranch := ref.Branch
+			if params.StackedOn != "" {
+				baseBranch = ""
+			}
+			diffData, err := loadGitData(baseBranch, params.Ref, "", params.PreserveCommits, args...)
 			if err != nil {
 				return err
 			}
@@ -77,6 +77,8 @@ type patchParams struct {
 	Parameters        []patch.Parameter
 	ReuseDefinition   bool
 	GithubAuthor      string
+	StackedOn         string
+	WaitForStackedOn  bool
 }
 
 type patchSubmission struct {
@@ -99,6 +101,8 @@ type patchSubmission struct {
 	gitMetadata       patch.GitMetadata
 	reuseDefinition   bool
 	githubAuthor      string
+	stackedOn         string
+	waitForStackedOn  bool
 }
 
 func (p *patchParams) createPatch(ac *legacyClient, diffData *localDiff) (*patch.Patch, error) {
@@ -122,6 +126,8 @@ func (p *patchParams) createPatch(ac *legacyClient, diffData *localDiff) (*patch
 		reuseDefinition:   p.ReuseDefinition,
 		path:              p.Path,
 		githubAuthor:      p.GithubAuthor,
+		stackedOn:         p.StackedOn,
+		waitForStackedOn:  p.WaitForStackedOn,
 	}
 
 	newPatch, err := ac.PutPatch(patchSub)
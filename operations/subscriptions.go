@@ -2,10 +2,30 @@ package operations
 
 import (
 	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
 
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/rest/client"
+	restModel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/mongodb/grip"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
+	yaml "gopkg.in/yaml.v3"
+)
+
+const (
+	subscriptionIDFlagName            = "id"
+	subscriptionResourceTypeFlagName  = "resource-type"
+	subscriptionTriggerFlagName       = "trigger"
+	subscriptionSelectorFlagName      = "selector"
+	subscriptionRegexSelectorFlagName = "regex-selector"
+	subscriptionTriggerDataFlagName   = "trigger-data"
+	subscriptionSubscriberFlagName    = "subscriber"
+	subscriptionTargetFlagName        = "target"
 )
 
 func Subscriptions() cli.Command {
@@ -15,6 +35,12 @@ func Subscriptions() cli.Command {
 		Before: setPlainLogger,
 		Subcommands: []cli.Command{
 			subscriptionsList(),
+			subscriptionsCreate(),
+			subscriptionsUpdate(),
+			subscriptionsDelete(),
+			subscriptionsTest(),
+			subscriptionsImport(),
+			subscriptionsExport(),
 		},
 	}
 }
@@ -22,7 +48,8 @@ func Subscriptions() cli.Command {
 func subscriptionsList() cli.Command {
 	return cli.Command{
 		Name:  "list",
-		Usage: "list subscriptions belonging to a user",
+		Usage: "list subscriptions belonging to a user or project",
+		Flags: addProjectFlag(),
 		Action: func(c *cli.Context) error {
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
@@ -35,7 +62,7 @@ func subscriptionsList() cli.Command {
 
 			comm := conf.setupRestCommunicator(ctx)
 			defer comm.Close()
-			subs, err := comm.GetSubscriptions(ctx)
+			subs, err := getOwnedSubscriptions(ctx, comm, c.String(projectFlagName))
 			if err != nil {
 				return errors.Wrap(err, "error fetching subscriptions")
 			}
@@ -52,3 +79,465 @@ func subscriptionsList() cli.Command {
 		},
 	}
 }
+
+func subscriptionFieldFlags(flags ...cli.Flag) []cli.Flag {
+	return append(flags,
+		cli.StringFlag{
+			Name:  subscriptionResourceTypeFlagName,
+			Usage: "subscribe to events for resources of `TYPE` (e.g. TASK, BUILD, VERSION, PATCH, HOST)",
+		},
+		cli.StringFlag{
+			Name:  subscriptionTriggerFlagName,
+			Usage: "notify when `TRIGGER` occurs (e.g. outcome, failure, regression)",
+		},
+		cli.StringSliceFlag{
+			Name:  subscriptionSelectorFlagName,
+			Usage: "match resources by `TYPE=DATA` (e.g. project=evergreen); may be specified multiple times",
+		},
+		cli.StringSliceFlag{
+			Name:  subscriptionRegexSelectorFlagName,
+			Usage: "match resources by `TYPE=REGEX` (e.g. display-name=^lint); may be specified multiple times",
+		},
+		cli.StringSliceFlag{
+			Name:  subscriptionTriggerDataFlagName,
+			Usage: "configure the trigger with `KEY=VALUE` (e.g. task-duration-secs=600); may be specified multiple times",
+		},
+		cli.StringFlag{
+			Name:  subscriptionSubscriberFlagName,
+			Usage: "notify a subscriber of `TYPE` (e.g. email, slack, evergreen-webhook, jira-issue)",
+		},
+		cli.StringFlag{
+			Name:  subscriptionTargetFlagName,
+			Usage: "notify `TARGET`, either a string such as an email address or a YAML/JSON object for structured subscribers",
+		},
+	)
+}
+
+func subscriptionsCreate() cli.Command {
+	return cli.Command{
+		Name:  "create",
+		Usage: "create a subscription for the user, or for a project",
+		Flags: subscriptionFieldFlags(addProjectFlag()...),
+		Before: mergeBeforeFuncs(
+			requireStringFlag(subscriptionResourceTypeFlagName),
+			requireStringFlag(subscriptionTriggerFlagName),
+			requireStringFlag(subscriptionSubscriberFlagName),
+			requireStringFlag(subscriptionTargetFlagName),
+		),
+		Action: func(c *cli.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			confPath := c.Parent().Parent().String(confFlagName)
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "problem loading configuration")
+			}
+
+			sub := event.Subscription{
+				OwnerType: event.OwnerTypePerson,
+				Owner:     conf.User,
+			}
+			if project := c.String(projectFlagName); project != "" {
+				sub.OwnerType = event.OwnerTypeProject
+				sub.Owner = project
+			}
+			if err = applySubscriptionFlags(c, &sub); err != nil {
+				return errors.Wrap(err, "invalid subscription")
+			}
+
+			comm := conf.setupRestCommunicator(ctx)
+			defer comm.Close()
+			if err = comm.SaveSubscriptions(ctx, []event.Subscription{sub}); err != nil {
+				return errors.Wrap(err, "error creating subscription")
+			}
+
+			grip.Infof("Created subscription: %s", sub.String())
+
+			return nil
+		},
+	}
+}
+
+func subscriptionsUpdate() cli.Command {
+	return cli.Command{
+		Name:   "update",
+		Usage:  "update the given fields of an existing subscription",
+		Flags:  subscriptionFieldFlags(addSubscriptionIDFlag(addProjectFlag()...)...),
+		Before: requireStringFlag(subscriptionIDFlagName),
+		Action: func(c *cli.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			confPath := c.Parent().Parent().String(confFlagName)
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "problem loading configuration")
+			}
+
+			comm := conf.setupRestCommunicator(ctx)
+			defer comm.Close()
+			subs, err := getOwnedSubscriptions(ctx, comm, c.String(projectFlagName))
+			if err != nil {
+				return errors.Wrap(err, "error fetching subscriptions")
+			}
+
+			id := c.String(subscriptionIDFlagName)
+			var sub *event.Subscription
+			for i := range subs {
+				if subs[i].ID == id {
+					sub = &subs[i]
+					break
+				}
+			}
+			if sub == nil {
+				return errors.Errorf("subscription '%s' not found; use --%s for project subscriptions", id, projectFlagName)
+			}
+			if err = applySubscriptionFlags(c, sub); err != nil {
+				return errors.Wrap(err, "invalid subscription")
+			}
+
+			if err = comm.SaveSubscriptions(ctx, []event.Subscription{*sub}); err != nil {
+				return errors.Wrap(err, "error updating subscription")
+			}
+
+			grip.Infof("Updated subscription: %s", sub.String())
+
+			return nil
+		},
+	}
+}
+
+func subscriptionsDelete() cli.Command {
+	return cli.Command{
+		Name:   "delete",
+		Usage:  "delete a subscription",
+		Flags:  addSubscriptionIDFlag(),
+		Before: requireStringFlag(subscriptionIDFlagName),
+		Action: func(c *cli.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			confPath := c.Parent().Parent().String(confFlagName)
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "problem loading configuration")
+			}
+
+			comm := conf.setupRestCommunicator(ctx)
+			defer comm.Close()
+			id := c.String(subscriptionIDFlagName)
+			if err = comm.DeleteSubscription(ctx, id); err != nil {
+				return errors.Wrap(err, "error deleting subscription")
+			}
+
+			grip.Infof("Deleted subscription '%s'.", id)
+
+			return nil
+		},
+	}
+}
+
+func subscriptionsTest() cli.Command {
+	return cli.Command{
+		Name:   "test",
+		Usage:  "send a test notification to a subscription's subscriber",
+		Flags:  addSubscriptionIDFlag(),
+		Before: requireStringFlag(subscriptionIDFlagName),
+		Action: func(c *cli.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			confPath := c.Parent().Parent().String(confFlagName)
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "problem loading configuration")
+			}
+
+			comm := conf.setupRestCommunicator(ctx)
+			defer comm.Close()
+			id := c.String(subscriptionIDFlagName)
+			if err = comm.TestSubscription(ctx, id); err != nil {
+				return errors.Wrap(err, "error testing subscription")
+			}
+
+			grip.Infof("Sent a test notification for subscription '%s'.", id)
+
+			return nil
+		},
+	}
+}
+
+func subscriptionsImport() cli.Command {
+	return cli.Command{
+		Name:   "import",
+		Usage:  "create or update the subscriptions defined in a YAML file",
+		Flags:  addPathFlag(),
+		Before: mergeBeforeFuncs(requireStringFlag(pathFlagName), requireFileExists(pathFlagName)),
+		Action: func(c *cli.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			confPath := c.Parent().Parent().String(confFlagName)
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "problem loading configuration")
+			}
+
+			in, err := ioutil.ReadFile(c.String(pathFlagName))
+			if err != nil {
+				return errors.Wrap(err, "problem reading subscriptions file")
+			}
+			subs, err := unmarshalSubscriptionsYAML(in)
+			if err != nil {
+				return errors.Wrap(err, "problem parsing subscriptions file")
+			}
+			if len(subs) == 0 {
+				grip.Info("no subscriptions found")
+				return nil
+			}
+
+			comm := conf.setupRestCommunicator(ctx)
+			defer comm.Close()
+			if err = comm.SaveSubscriptions(ctx, subs); err != nil {
+				return errors.Wrap(err, "error saving subscriptions")
+			}
+
+			grip.Infof("Imported %d subscription(s).", len(subs))
+
+			return nil
+		},
+	}
+}
+
+func subscriptionsExport() cli.Command {
+	return cli.Command{
+		Name:  "export",
+		Usage: "write the subscriptions belonging to a user or project as YAML",
+		Flags: addOutputPath(addProjectFlag()...),
+		Action: func(c *cli.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			confPath := c.Parent().Parent().String(confFlagName)
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "problem loading configuration")
+			}
+
+			comm := conf.setupRestCommunicator(ctx)
+			defer comm.Close()
+			subs, err := getOwnedSubscriptions(ctx, comm, c.String(projectFlagName))
+			if err != nil {
+				return errors.Wrap(err, "error fetching subscriptions")
+			}
+
+			out, err := marshalSubscriptionsYAML(subs)
+			if err != nil {
+				return errors.Wrap(err, "problem writing subscriptions as YAML")
+			}
+
+			var w io.Writer = os.Stdout
+			if path := c.String(pathFlagName); path != "" {
+				f, err := os.Create(path)
+				if err != nil {
+					return errors.Wrapf(err, "problem creating file '%s'", path)
+				}
+				defer f.Close()
+				w = f
+			}
+			_, err = w.Write(out)
+
+			return errors.Wrap(err, "problem writing subscriptions")
+		},
+	}
+}
+
+func addSubscriptionIDFlag(flags ...cli.Flag) []cli.Flag {
+	return append(flags, cli.StringFlag{
+		Name:  joinFlagNames(subscriptionIDFlagName, "i"),
+		Usage: "specify the `ID` of the subscription",
+	})
+}
+
+func getOwnedSubscriptions(ctx context.Context, comm client.Communicator, project string) ([]event.Subscription, error) {
+	if project != "" {
+		return comm.GetProjectSubscriptions(ctx, project)
+	}
+	return comm.GetSubscriptions(ctx)
+}
+
+// applySubscriptionFlags overwrites the subscription's fields with any that
+// were specified as flags.
+func applySubscriptionFlags(c *cli.Context, sub *event.Subscription) error {
+	if resourceType := c.String(subscriptionResourceTypeFlagName); resourceType != "" {
+		sub.ResourceType = strings.ToUpper(resourceType)
+	}
+	if trigger := c.String(subscriptionTriggerFlagName); trigger != "" {
+		sub.Trigger = trigger
+	}
+
+	catcher := grip.NewBasicCatcher()
+	if c.IsSet(subscriptionSelectorFlagName) {
+		selectors, err := parseSubscriptionSelectors(c.StringSlice(subscriptionSelectorFlagName))
+		catcher.Wrap(err, "invalid selectors")
+		sub.Selectors = selectors
+	}
+	if c.IsSet(subscriptionRegexSelectorFlagName) {
+		selectors, err := parseSubscriptionSelectors(c.StringSlice(subscriptionRegexSelectorFlagName))
+		catcher.Wrap(err, "invalid regex selectors")
+		sub.RegexSelectors = selectors
+	}
+	if c.IsSet(subscriptionTriggerDataFlagName) {
+		sub.TriggerData = map[string]string{}
+		for _, pair := range c.StringSlice(subscriptionTriggerDataFlagName) {
+			key, val, err := splitKeyValue(pair)
+			if err != nil {
+				catcher.Wrap(err, "invalid trigger data")
+				continue
+			}
+			sub.TriggerData[key] = val
+		}
+	}
+	if catcher.HasErrors() {
+		return catcher.Resolve()
+	}
+
+	subscriberType := c.String(subscriptionSubscriberFlagName)
+	target := c.String(subscriptionTargetFlagName)
+	if subscriberType == "" && target == "" {
+		return nil
+	}
+	if subscriberType == "" {
+		subscriberType = sub.Subscriber.Type
+	}
+	if target == "" {
+		return errors.Errorf("flag '--%s' is required when changing the subscriber", subscriptionTargetFlagName)
+	}
+	subscriber, err := parseSubscriber(subscriberType, target)
+	if err != nil {
+		return errors.Wrap(err, "invalid subscriber")
+	}
+	sub.Subscriber = *subscriber
+
+	return nil
+}
+
+func parseSubscriptionSelectors(pairs []string) ([]event.Selector, error) {
+	selectors := make([]event.Selector, 0, len(pairs))
+	for _, pair := range pairs {
+		selectorType, data, err := splitKeyValue(pair)
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, event.Selector{
+			Type: selectorType,
+			Data: data,
+		})
+	}
+
+	return selectors, nil
+}
+
+func splitKeyValue(pair string) (string, string, error) {
+	parts := strings.SplitN(pair, "=", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.Errorf("'%s' is not of the form KEY=VALUE", pair)
+	}
+	return parts[0], parts[1], nil
+}
+
+// parseSubscriber converts the target into a subscriber of the given type.
+// Targets that are objects, such as webhooks and JIRA issues, are given as
+// YAML or JSON, and all other targets are plain strings.
+func parseSubscriber(subscriberType, target string) (*event.Subscriber, error) {
+	apiSubscriber := restModel.APISubscriber{
+		Type:   &subscriberType,
+		Target: target,
+	}
+	if strings.HasPrefix(strings.TrimSpace(target), "{") {
+		var structured map[string]interface{}
+		if err := yaml.Unmarshal([]byte(target), &structured); err != nil {
+			return nil, errors.Wrap(err, "target is not a valid object")
+		}
+		apiSubscriber.Target = structured
+	}
+
+	subscriberInterface, err := apiSubscriber.ToService()
+	if err != nil {
+		return nil, err
+	}
+	subscriber, ok := subscriberInterface.(event.Subscriber)
+	if !ok {
+		return nil, errors.Errorf("unexpected subscriber type '%T'", subscriberInterface)
+	}
+
+	return &subscriber, nil
+}
+
+// subscriptionsFile is the format used to import and export subscriptions,
+// which uses the same field names as the REST API.
+type subscriptionsFile struct {
+	Subscriptions []restModel.APISubscription `json:"subscriptions"`
+}
+
+// marshalSubscriptionsYAML writes the subscriptions as YAML. Webhook secrets
+// are redacted so that the file can be shared; importing the file keeps the
+// existing secrets.
+func marshalSubscriptionsYAML(subs []event.Subscription) ([]byte, error) {
+	file := subscriptionsFile{
+		Subscriptions: make([]restModel.APISubscription, len(subs)),
+	}
+	for i := range subs {
+		sub := subs[i]
+		sub.Subscriber.RedactSecrets()
+		if err := file.Subscriptions[i].BuildFromService(sub); err != nil {
+			return nil, errors.Wrapf(err, "problem converting subscription '%s'", subs[i].ID)
+		}
+	}
+
+	// round trip through JSON so that the YAML uses the API's field names
+	jsonBytes, err := json.Marshal(file)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var generic interface{}
+	if err = json.Unmarshal(jsonBytes, &generic); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return yaml.Marshal(generic)
+}
+
+func unmarshalSubscriptionsYAML(in []byte) ([]event.Subscription, error) {
+	var generic interface{}
+	if err := yaml.Unmarshal(in, &generic); err != nil {
+		return nil, errors.Wrap(err, "invalid YAML")
+	}
+	jsonBytes, err := json.Marshal(generic)
+	if err != nil {
+		return nil, errors.Wrap(err, "subscriptions cannot be represented as JSON")
+	}
+	file := subscriptionsFile{}
+	if err = json.Unmarshal(jsonBytes, &file); err != nil {
+		return nil, errors.Wrap(err, "invalid subscriptions")
+	}
+
+	subs := make([]event.Subscription, 0, len(file.Subscriptions))
+	catcher := grip.NewBasicCatcher()
+	for i := range file.Subscriptions {
+		subInterface, err := file.Subscriptions[i].ToService()
+		if err != nil {
+			catcher.Wrapf(err, "subscription %d", i)
+			continue
+		}
+		sub, ok := subInterface.(event.Subscription)
+		if !ok {
+			catcher.Errorf("subscription %d has unexpected type '%T'", i, subInterface)
+			continue
+		}
+		subs = append(subs, sub)
+	}
+
+	return subs, catcher.Resolve()
+}
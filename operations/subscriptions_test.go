@@ -0,0 +1,121 @@
+package operations
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionsYAMLRoundTrip(t *testing.T) {
+	subs := []event.Subscription{
+		{
+			ID:           "sub0",
+			ResourceType: event.ResourceTypeTask,
+			Trigger:      event.TriggerExceedsDuration,
+			Selectors: []event.Selector{
+				{Type: event.SelectorProject, Data: "evergreen"},
+				{Type: event.SelectorRequester, Data: "gitter_request"},
+			},
+			RegexSelectors: []event.Selector{
+				{Type: event.SelectorDisplayName, Data: "^lint"},
+			},
+			Subscriber: event.Subscriber{
+				Type:   event.EmailSubscriberType,
+				Target: "evergreen@example.com",
+			},
+			Owner:       "evergreen",
+			OwnerType:   event.OwnerTypeProject,
+			TriggerData: map[string]string{event.TaskDurationKey: "600"},
+		},
+		{
+			ID:           "sub1",
+			ResourceType: event.ResourceTypeVersion,
+			Trigger:      event.TriggerFailure,
+			Selectors: []event.Selector{
+				{Type: event.SelectorProject, Data: "evergreen"},
+			},
+			Subscriber: event.Subscriber{
+				Type: event.EvergreenWebhookSubscriberType,
+				Target: &event.WebhookSubscriber{
+					URL:    "https://example.com/hook",
+					Secret: []byte("shh"),
+				},
+			},
+			Owner:     "evergreen",
+			OwnerType: event.OwnerTypeProject,
+		},
+	}
+
+	out, err := marshalSubscriptionsYAML(subs)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "resource_type: TASK")
+	assert.Contains(t, string(out), "regex_selectors:")
+	assert.NotContains(t, string(out), "shh")
+	assert.Equal(t, []byte("shh"), subs[1].Subscriber.Target.(*event.WebhookSubscriber).Secret, "exporting should not modify the subscriptions")
+
+	parsed, err := unmarshalSubscriptionsYAML(out)
+	require.NoError(t, err)
+	require.Len(t, parsed, 2)
+	assert.Equal(t, subs[0], parsed[0])
+	assert.Equal(t, subs[1].Selectors, parsed[1].Selectors)
+	webhook, ok := parsed[1].Subscriber.Target.(event.WebhookSubscriber)
+	require.True(t, ok)
+	assert.Equal(t, "https://example.com/hook", webhook.URL)
+	assert.Equal(t, []byte(event.RedactedWebhookSecret), webhook.Secret)
+	assert.True(t, parsed[1].Subscriber.HasRedactedSecret())
+}
+
+func TestUnmarshalSubscriptionsYAML(t *testing.T) {
+	subs, err := unmarshalSubscriptionsYAML([]byte(`
+subscriptions:
+  - resource_type: TASK
+    trigger: outcome
+    owner_type: person
+    owner: me
+    selectors:
+      - type: id
+        data: task0
+    subscriber:
+      type: slack
+      target: "#evergreen"
+`))
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+	assert.Equal(t, "#evergreen", subs[0].Subscriber.Target)
+	assert.Equal(t, []event.Selector{{Type: "id", Data: "task0"}}, subs[0].Selectors)
+
+	_, err = unmarshalSubscriptionsYAML([]byte("subscriptions: [{subscriber: {type: evergreen-webhook, target: [1, 2]}}]"))
+	assert.Error(t, err)
+}
+
+func TestParseSubscriber(t *testing.T) {
+	subscriber, err := parseSubscriber(event.EmailSubscriberType, "me@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "me@example.com", subscriber.Target)
+
+	subscriber, err = parseSubscriber(event.JIRAIssueSubscriberType, `{project: BF, issue_type: Build Failure}`)
+	require.NoError(t, err)
+	issue, ok := subscriber.Target.(event.JIRAIssueSubscriber)
+	require.True(t, ok)
+	assert.Equal(t, "BF", issue.Project)
+	assert.Equal(t, "Build Failure", issue.IssueType)
+
+	_, err = parseSubscriber(event.JIRAIssueSubscriberType, "{project: [")
+	assert.Error(t, err)
+}
+
+func TestParseSubscriptionSelectors(t *testing.T) {
+	selectors, err := parseSubscriptionSelectors([]string{"project=evergreen", "display-name=a=b"})
+	require.NoError(t, err)
+	assert.Equal(t, []event.Selector{
+		{Type: "project", Data: "evergreen"},
+		{Type: "display-name", Data: "a=b"},
+	}, selectors)
+
+	_, err = parseSubscriptionSelectors([]string{"project"})
+	assert.Error(t, err)
+	_, err = parseSubscriptionSelectors([]string{"=evergreen"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,311 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model"
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func Task() cli.Command {
+	return cli.Command{
+		Name:  "task",
+		Usage: "operations on tasks",
+		Subcommands: []cli.Command{
+			taskLogs(),
+		},
+	}
+}
+
+const (
+	ansiReset  = "\033[0m"
+	ansiRed    = "\033[31m"
+	ansiYellow = "\033[33m"
+	ansiGray   = "\033[90m"
+)
+
+func taskLogs() cli.Command {
+	const (
+		taskFlagName      = "task"
+		executionFlagName = "execution"
+		logTypeFlagName   = "type"
+		severityFlagName  = "severity"
+		followFlagName    = "follow"
+		intervalFlagName  = "interval"
+		noColorFlagName   = "no-color"
+	)
+
+	return cli.Command{
+		Name:  "logs",
+		Usage: "print a task's logs, optionally following them while the task runs",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  joinFlagNames(taskFlagName, "t"),
+				Usage: "the ID of the task",
+			},
+			cli.IntFlag{
+				Name:  executionFlagName,
+				Usage: "the execution of the task (default: the latest)",
+			},
+			cli.StringFlag{
+				Name:  logTypeFlagName,
+				Usage: "the logs to print: T (task), E (agent), S (system) or ALL",
+				Value: apimodels.AllTaskLevelLogs,
+			},
+			cli.StringSliceFlag{
+				Name:  severityFlagName,
+				Usage: "only print lines with these severities (E, W, I or D)",
+			},
+			cli.BoolFlag{
+				Name:  joinFlagNames(followFlagName, "f"),
+				Usage: "keep printing new lines until the task finishes",
+			},
+			cli.DurationFlag{
+				Name:  intervalFlagName,
+				Usage: "how often to check for new lines when following the logs",
+				Value: 2 * time.Second,
+			},
+			cli.BoolFlag{
+				Name:  noColorFlagName,
+				Usage: "do not color lines by severity",
+			},
+		},
+		Before: mergeBeforeFuncs(
+			requireStringFlag(taskFlagName),
+			requireStringValueChoices(logTypeFlagName, []string{
+				apimodels.TaskLogPrefix,
+				apimodels.AgentLogPrefix,
+				apimodels.SystemLogPrefix,
+				apimodels.AllTaskLevelLogs,
+			}),
+			requireStringSliceValueChoices(severityFlagName, []string{
+				apimodels.LogErrorPrefix,
+				apimodels.LogWarnPrefix,
+				apimodels.LogInfoPrefix,
+				apimodels.LogDebugPrefix,
+			}),
+		),
+		Action: func(c *cli.Context) error {
+			confPath := c.Parent().Parent().String(confFlagName)
+			opts := model.TaskLogPageOptions{
+				TaskID:     c.String(taskFlagName),
+				LogType:    c.String(logTypeFlagName),
+				Severities: c.StringSlice(severityFlagName),
+			}
+			var execution *int
+			if c.IsSet(executionFlagName) {
+				execution = utility.ToIntPtr(c.Int(executionFlagName))
+			}
+			follow := c.Bool(followFlagName)
+			interval := c.Duration(intervalFlagName)
+			printer := taskLogPrinter{
+				out:          os.Stdout,
+				color:        !c.Bool(noColorFlagName) && isTerminal(os.Stdout),
+				showLogTypes: opts.LogType == apimodels.AllTaskLevelLogs,
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "problem loading configuration")
+			}
+			client := conf.setupRestCommunicator(ctx)
+			defer client.Close()
+
+			follower := newTaskLogFollower(client.GetTaskLogPage, opts, execution)
+			for {
+				read, err := follower.readNewLines(ctx)
+				if err != nil {
+					return errors.Wrapf(err, "getting logs for task '%s'", opts.TaskID)
+				}
+				printer.printLines(read.lines)
+
+				if !read.caughtUp {
+					continue
+				}
+				if !follow {
+					return nil
+				}
+				if read.finished {
+					printer.printFinished(read.status)
+					return nil
+				}
+
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(interval):
+				}
+			}
+		},
+	}
+}
+
+// taskLogCursor is the position to resume reading a log type from.
+type taskLogCursor struct {
+	time     time.Time
+	sequence int
+}
+
+// taskLogFollower reads the lines of a task's logs that were logged since it
+// last read them. Each log type is stamped and sent by its own logger, so a
+// log type can be sent after lines of another type that were logged later.
+// Each log type is therefore read from its own cursor, so that lines sent late
+// are neither skipped nor printed twice.
+type taskLogFollower struct {
+	getPage   func(context.Context, model.TaskLogPageOptions, *int) (*restmodel.APITaskLogPage, error)
+	opts      model.TaskLogPageOptions
+	execution *int
+	logTypes  []string
+	cursors   map[string]taskLogCursor
+}
+
+// taskLogRead is the lines read by a taskLogFollower.
+type taskLogRead struct {
+	lines []restmodel.APITaskLogLine
+	// caughtUp is whether there were no lines left to read.
+	caughtUp bool
+	// finished is whether the task had finished before the lines were read,
+	// in which case there are no lines left to read once caughtUp is true.
+	finished bool
+	status   string
+}
+
+func newTaskLogFollower(getPage func(context.Context, model.TaskLogPageOptions, *int) (*restmodel.APITaskLogPage, error), opts model.TaskLogPageOptions, execution *int) *taskLogFollower {
+	logTypes := []string{opts.LogType}
+	if opts.LogType == "" || opts.LogType == apimodels.AllTaskLevelLogs {
+		logTypes = []string{apimodels.TaskLogPrefix, apimodels.AgentLogPrefix, apimodels.SystemLogPrefix}
+	}
+	return &taskLogFollower{
+		getPage:   getPage,
+		opts:      opts,
+		execution: execution,
+		logTypes:  logTypes,
+		cursors:   map[string]taskLogCursor{},
+	}
+}
+
+// readNewLines reads a page of each log type from where it was last read,
+// returning the lines in timestamp order.
+func (f *taskLogFollower) readNewLines(ctx context.Context) (*taskLogRead, error) {
+	read := &taskLogRead{caughtUp: true}
+	for i, logType := range f.logTypes {
+		cursor := f.cursors[logType]
+		opts := f.opts
+		opts.LogType = logType
+		opts.StartTime = cursor.time
+		opts.StartSequence = cursor.sequence
+
+		page, err := f.getPage(ctx, opts, f.execution)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading log type '%s'", logType)
+		}
+		// Keep reading the same execution if the task is restarted while its
+		// logs are being followed.
+		f.execution = utility.ToIntPtr(page.Execution)
+		// The task's status is read before its logs, so only the status read
+		// before all the log types is known to precede all of their lines.
+		if i == 0 {
+			read.finished = page.Finished
+			read.status = utility.FromStringPtr(page.Status)
+		}
+
+		next := taskLogCursor{sequence: page.NextSequence}
+		if page.NextTime != nil {
+			next.time = *page.NextTime
+		}
+		if !next.time.Equal(cursor.time) || next.sequence != cursor.sequence {
+			read.caughtUp = false
+		}
+		f.cursors[logType] = next
+		read.lines = append(read.lines, page.Lines...)
+	}
+
+	sort.SliceStable(read.lines, func(i, j int) bool {
+		return taskLogLineTime(read.lines[i]).Before(taskLogLineTime(read.lines[j]))
+	})
+
+	return read, nil
+}
+
+func taskLogLineTime(line restmodel.APITaskLogLine) time.Time {
+	if line.Timestamp == nil {
+		return time.Time{}
+	}
+	return *line.Timestamp
+}
+
+type taskLogPrinter struct {
+	out          io.Writer
+	color        bool
+	showLogTypes bool
+}
+
+func (p *taskLogPrinter) printLines(lines []restmodel.APITaskLogLine) {
+	for _, line := range lines {
+		text := utility.FromStringPtr(line.Message)
+		if p.showLogTypes && line.Type != nil {
+			text = fmt.Sprintf("[%s] %s", taskLogTypeName(utility.FromStringPtr(line.Type)), text)
+		}
+		if line.Timestamp != nil {
+			text = fmt.Sprintf("[%s] %s", line.Timestamp.Format("2006/01/02 15:04:05.000"), text)
+		}
+
+		if color := taskLogSeverityColor(utility.FromStringPtr(line.Severity)); p.color && color != "" {
+			text = color + text + ansiReset
+		}
+		fmt.Fprintln(p.out, text)
+	}
+}
+
+func (p *taskLogPrinter) printFinished(status string) {
+	fmt.Fprintf(p.out, "Task finished with status '%s'.\n", status)
+}
+
+func taskLogTypeName(logType string) string {
+	switch logType {
+	case apimodels.TaskLogPrefix:
+		return "task"
+	case apimodels.AgentLogPrefix:
+		return "agent"
+	case apimodels.SystemLogPrefix:
+		return "system"
+	default:
+		// Old log messages stored the type's full name.
+		return logType
+	}
+}
+
+func taskLogSeverityColor(severity string) string {
+	switch severity {
+	case apimodels.LogErrorPrefix:
+		return ansiRed
+	case apimodels.LogWarnPrefix:
+		return ansiYellow
+	case apimodels.LogDebugPrefix:
+		return ansiGray
+	default:
+		return ""
+	}
+}
+
+// isTerminal returns whether the file is a terminal rather than a pipe or a
+// regular file.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,140 @@
+package operations
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model"
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskLogPrinter(t *testing.T) {
+	ts := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	lines := []restmodel.APITaskLogLine{
+		{
+			Type:      utility.ToStringPtr(apimodels.TaskLogPrefix),
+			Severity:  utility.ToStringPtr(apimodels.LogInfoPrefix),
+			Message:   utility.ToStringPtr("running tests"),
+			Timestamp: &ts,
+		},
+		{
+			Type:     utility.ToStringPtr(apimodels.AgentLogPrefix),
+			Severity: utility.ToStringPtr(apimodels.LogErrorPrefix),
+			Message:  utility.ToStringPtr("command failed"),
+		},
+	}
+
+	t.Run("WithLogTypes", func(t *testing.T) {
+		var out bytes.Buffer
+		p := taskLogPrinter{out: &out, showLogTypes: true}
+		p.printLines(lines)
+		assert.Equal(t, "[2021/03/04 05:06:07.000] [task] running tests\n[agent] command failed\n", out.String())
+	})
+	t.Run("WithColor", func(t *testing.T) {
+		var out bytes.Buffer
+		p := taskLogPrinter{out: &out, color: true}
+		p.printLines(lines)
+		assert.Equal(t, "[2021/03/04 05:06:07.000] running tests\n"+ansiRed+"command failed"+ansiReset+"\n", out.String())
+	})
+	t.Run("Finished", func(t *testing.T) {
+		var out bytes.Buffer
+		p := taskLogPrinter{out: &out}
+		p.printFinished(evergreen.TaskSucceeded)
+		assert.Equal(t, "Task finished with status 'success'.\n", out.String())
+	})
+}
+
+func TestTaskLogFollower(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	base := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	logs := map[string][]restmodel.APITaskLogLine{}
+	log := func(logType string, offset time.Duration, msg string) {
+		ts := base.Add(offset)
+		logs[logType] = append(logs[logType], restmodel.APITaskLogLine{
+			Type:      utility.ToStringPtr(logType),
+			Severity:  utility.ToStringPtr(apimodels.LogInfoPrefix),
+			Message:   utility.ToStringPtr(msg),
+			Timestamp: &ts,
+		})
+	}
+	finished := false
+	getPage := func(_ context.Context, opts model.TaskLogPageOptions, execution *int) (*restmodel.APITaskLogPage, error) {
+		page := &restmodel.APITaskLogPage{
+			NextSequence: opts.StartSequence,
+			Execution:    1,
+			Status:       utility.ToStringPtr(evergreen.TaskStarted),
+			Finished:     finished,
+		}
+		if !opts.StartTime.IsZero() {
+			page.NextTime = &opts.StartTime
+		}
+		skipped := 0
+		for _, line := range logs[opts.LogType] {
+			if line.Timestamp.Before(opts.StartTime) {
+				continue
+			}
+			if line.Timestamp.Equal(opts.StartTime) && skipped < opts.StartSequence {
+				skipped++
+				continue
+			}
+			page.Lines = append(page.Lines, line)
+			if page.NextTime != nil && line.Timestamp.Equal(*page.NextTime) {
+				page.NextSequence++
+			} else {
+				page.NextTime = line.Timestamp
+				page.NextSequence = 1
+			}
+		}
+		return page, nil
+	}
+	messages := func(read *taskLogRead) []string {
+		msgs := []string{}
+		for _, line := range read.lines {
+			msgs = append(msgs, utility.FromStringPtr(line.Message))
+		}
+		return msgs
+	}
+
+	f := newTaskLogFollower(getPage, model.TaskLogPageOptions{TaskID: "t1", LogType: apimodels.AllTaskLevelLogs}, nil)
+	log(apimodels.TaskLogPrefix, 0, "task 1")
+	log(apimodels.TaskLogPrefix, 2*time.Second, "task 2")
+	log(apimodels.SystemLogPrefix, time.Second, "system 1")
+
+	read, err := f.readNewLines(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"task 1", "system 1", "task 2"}, messages(read))
+	assert.False(t, read.caughtUp)
+	require.NotNil(t, f.execution)
+	assert.Equal(t, 1, *f.execution)
+
+	read, err = f.readNewLines(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, read.lines)
+	assert.True(t, read.caughtUp)
+
+	// Agent lines that are sent after later task lines are still read, and
+	// lines logged at the same time as the last line read are not skipped.
+	log(apimodels.AgentLogPrefix, time.Second, "agent 1")
+	log(apimodels.TaskLogPrefix, 2*time.Second, "task 3")
+	finished = true
+	read, err = f.readNewLines(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"agent 1", "task 3"}, messages(read))
+	assert.False(t, read.caughtUp)
+	assert.True(t, read.finished)
+
+	read, err = f.readNewLines(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, read.lines)
+	assert.True(t, read.caughtUp)
+	assert.Equal(t, evergreen.TaskStarted, read.status)
+}
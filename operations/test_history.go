@@ -0,0 +1,129 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cheynewallace/tabby"
+	"github.com/evergreen-ci/evergreen/model"
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func TestHistory() cli.Command {
+	const (
+		testFlagName        = "test"
+		statusesFlagName    = "statuses"
+		minDurationFlagName = "min-duration"
+		maxDurationFlagName = "max-duration"
+		revisionsFlagName   = "revisions"
+	)
+
+	return cli.Command{
+		Name:  "test-history",
+		Usage: "show the results of a test across a project's most recent mainline commits",
+		Flags: addProjectFlag(addVariantsFlag(
+			cli.StringFlag{
+				Name:  joinFlagNames(testFlagName, "t"),
+				Usage: "the name of the test",
+			},
+			cli.StringSliceFlag{
+				Name:  tasksFlagName,
+				Usage: "only show runs of the test in these tasks",
+			},
+			cli.StringSliceFlag{
+				Name:  statusesFlagName,
+				Usage: "only show runs of the test with these statuses",
+			},
+			cli.DurationFlag{
+				Name:  minDurationFlagName,
+				Usage: "only show runs of the test that took at least this long (e.g. 30s)",
+			},
+			cli.DurationFlag{
+				Name:  maxDurationFlagName,
+				Usage: "only show runs of the test that took at most this long (e.g. 5m)",
+			},
+			cli.IntFlag{
+				Name:  revisionsFlagName,
+				Usage: "the number of most recent mainline commits to search",
+				Value: model.DefaultTestHistoryRevisions,
+			},
+		)...),
+		Before: mergeBeforeFuncs(
+			requireProjectFlag,
+			requireStringFlag(testFlagName),
+			requireIntValueBetween(revisionsFlagName, 1, model.MaxTestHistoryRevisions),
+		),
+		Action: func(c *cli.Context) error {
+			confPath := c.Parent().String(confFlagName)
+			opts := model.TestHistoryOptions{
+				ProjectID:     c.String(projectFlagName),
+				TestName:      c.String(testFlagName),
+				BuildVariants: c.StringSlice(variantsFlagName),
+				TaskNames:     c.StringSlice(tasksFlagName),
+				Statuses:      c.StringSlice(statusesFlagName),
+				MinDuration:   c.Duration(minDurationFlagName),
+				MaxDuration:   c.Duration(maxDurationFlagName),
+				NumRevisions:  c.Int(revisionsFlagName),
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "problem loading configuration")
+			}
+			client := conf.setupRestCommunicator(ctx)
+			defer client.Close()
+
+			history, err := client.GetTestHistory(ctx, opts)
+			if err != nil {
+				return errors.Wrapf(err, "getting history for test '%s'", opts.TestName)
+			}
+
+			printTestHistory(history, conf.UIServerHost)
+			return nil
+		},
+	}
+}
+
+func printTestHistory(history *restmodel.APITestHistory, uiHost string) {
+	if len(history.Runs) == 0 {
+		fmt.Println("No matching runs of the test were found.")
+	} else {
+		t := tabby.New()
+		t.AddHeader("Revision", "Variant", "Task", "Status", "Duration", "Failure")
+		for _, run := range history.Runs {
+			t.AddLine(
+				shortRevision(utility.FromStringPtr(run.Revision)),
+				utility.FromStringPtr(run.BuildVariant),
+				utility.FromStringPtr(run.TaskName),
+				utility.FromStringPtr(run.Status),
+				time.Duration(run.Duration*float64(time.Second)).Round(time.Millisecond),
+				utility.FromStringPtr(run.FailureMessage),
+			)
+		}
+		t.Print()
+	}
+
+	fmt.Println()
+	if history.FailingSince == nil {
+		fmt.Println("The test is not failing in the most recent commit it ran in.")
+		return
+	}
+	failing := history.FailingSince
+	fmt.Printf("Failing since revision %s on variant '%s' in task '%s':\n",
+		utility.FromStringPtr(failing.Revision), utility.FromStringPtr(failing.BuildVariant), utility.FromStringPtr(failing.TaskName))
+	fmt.Printf("\t%s/task/%s/%d\n", uiHost, utility.FromStringPtr(failing.TaskID), failing.Execution)
+}
+
+func shortRevision(revision string) string {
+	if len(revision) > 10 {
+		return revision[:10]
+	}
+	return revision
+}
@@ -170,6 +170,36 @@ mciModule.controller(
           validator: validatePercentage,
         }, ],
       },
+      {
+        trigger: "persistent-failure",
+        resource_type: "TASK",
+        label: "a task keeps failing in consecutive commits",
+        regex_selectors: taskRegexSelectors(),
+        extraFields: [{
+            text: "Consecutive failed versions (0 to disable)",
+            key: "persistent-failure-versions",
+            validator: validateCount,
+            default: "3",
+          },
+          {
+            text: "Hours failing (0 to disable)",
+            key: "persistent-failure-hours",
+            validator: validateDuration,
+            default: "0",
+          },
+          {
+            text: "Escalate up to",
+            key: "escalation-tier",
+            type: "select",
+            options: {
+              "committer": "Committer",
+              "project-admins": "Project admins",
+              "paging": "Paging webhook",
+            },
+            default: "committer",
+          },
+        ],
+      },
     ];
 
     // refreshTrackedProjects will populate the list of projects that should be displayed
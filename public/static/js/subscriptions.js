@@ -400,6 +400,16 @@ function validateDuration(duration) {
   return "";
 }
 
+function validateCount(count) {
+  if (count === "" || !Number.isInteger(+count)) {
+    return "A count must be an integer: " + count;
+  }
+  if (+count < 0) {
+    return "A count cannot be negative: " + count;
+  }
+  return "";
+}
+
 function validatePercentage(percent) {
   if (!isFinite(percent)) {
     return percent + " must be a number";
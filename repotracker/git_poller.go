@@ -0,0 +1,96 @@
+package repotracker
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/pkg/errors"
+)
+
+// GitRepositoryPoller is a RepoPoller for projects hosted on a plain git
+// server. It reads the repository through a local mirror instead of the
+// GitHub API.
+type GitRepositoryPoller struct {
+	ProjectRef *model.ProjectRef
+	host       *thirdparty.GitCodeHost
+}
+
+// NewGitRepositoryPoller constructs and returns a pointer to a
+// GitRepositoryPoller struct
+func NewGitRepositoryPoller(projectRef *model.ProjectRef) *GitRepositoryPoller {
+	return &GitRepositoryPoller{
+		ProjectRef: projectRef,
+		host:       thirdparty.NewGitCodeHost(projectRef.GitURL),
+	}
+}
+
+func codeHostCommitToRevision(commit thirdparty.CodeHostCommit) model.Revision {
+	return model.Revision{
+		Author:          commit.Author,
+		AuthorEmail:     commit.AuthorEmail,
+		RevisionMessage: commit.Message,
+		Revision:        commit.Revision,
+		CreateTime:      commit.CreateTime,
+	}
+}
+
+// GetRemoteConfig fetches the contents of the project's configuration file
+// as at a given revision
+func (p *GitRepositoryPoller) GetRemoteConfig(ctx context.Context, projectFileRevision string) (model.ProjectInfo, error) {
+	var cancel context.CancelFunc
+	ctx, cancel = context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	opts := model.GetProjectOpts{
+		Ref:        p.ProjectRef,
+		RemotePath: p.ProjectRef.RemotePath,
+		Revision:   projectFileRevision,
+	}
+	return model.GetProjectFromFile(ctx, opts)
+}
+
+// GetChangedFiles returns the paths of all files modified by a revision.
+func (p *GitRepositoryPoller) GetChangedFiles(ctx context.Context, commitRevision string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	files, err := p.host.GetChangedFiles(ctx, commitRevision)
+	return files, errors.Wrapf(err, "error loading commit '%v'", commitRevision)
+}
+
+// GetRevisionsSince fetches all commits on the project's branch that were
+// made after 'revision'
+func (p *GitRepositoryPoller) GetRevisionsSince(revision string, maxRevisionsToSearch int) ([]model.Revision, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Minute)
+	defer cancel()
+
+	commits, err := p.host.GetCommitsSince(ctx, p.ProjectRef.Branch, revision, maxRevisionsToSearch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting revisions since '%s' for project '%s'", revision, p.ProjectRef.Id)
+	}
+
+	revisions := make([]model.Revision, 0, len(commits))
+	for _, commit := range commits {
+		revisions = append(revisions, codeHostCommitToRevision(commit))
+	}
+	return revisions, nil
+}
+
+// GetRecentRevisions fetches the most recent 'maxRevisions'
+func (p *GitRepositoryPoller) GetRecentRevisions(maxRevisions int) ([]model.Revision, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Minute)
+	defer cancel()
+
+	commits, err := p.host.GetRecentCommits(ctx, p.ProjectRef.Branch, maxRevisions)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting recent revisions for project '%s'", p.ProjectRef.Id)
+	}
+
+	revisions := make([]model.Revision, 0, len(commits))
+	for _, commit := range commits {
+		revisions = append(revisions, codeHostCommitToRevision(commit))
+	}
+	return revisions, nil
+}
@@ -301,14 +301,15 @@ func (repoTracker *RepoTracker) StoreRevisions(ctx context.Context, revisions []
 			return err
 		}
 
-		// "Ignore" a version if all changes are to ignored files
+		// "Ignore" a version if all changes are to ignored files, and
+		// select tasks by the files they watch otherwise
 		var ignore bool
-		if len(pInfo.Project.Ignore) > 0 {
-			var filenames []string
+		var filenames []string
+		if len(pInfo.Project.Ignore) > 0 || pInfo.Project.HasInputs() {
 			filenames, err = repoTracker.GetChangedFiles(ctx, revision)
 			if err != nil {
 				grip.Error(message.WrapError(err, message.Fields{
-					"message":            "error checking GitHub for ignored files",
+					"message":            "error getting changed files for revision",
 					"runner":             RunnerName,
 					"project":            ref.Id,
 					"project_identifier": ref.Identifier,
@@ -322,7 +323,8 @@ func (repoTracker *RepoTracker) StoreRevisions(ctx context.Context, revisions []
 		}
 
 		metadata := model.VersionMetadata{
-			Revision: revisions[i],
+			Revision:     revisions[i],
+			ChangedFiles: filenames,
 		}
 		projectInfo := &model.ProjectInfo{
 			Ref:                 ref,
@@ -529,6 +531,46 @@ func addGithubCheckSubscriptions(v *model.Version) error {
 	return catcher.Resolve()
 }
 
+// reconcileConfigSubscriptions makes the project's config subscriptions match
+// the notifications declared in its configuration file. Declared notifications
+// are upserted and subscriptions whose declarations were removed are deleted.
+// If any declaration is invalid, no subscriptions are deleted, so that a
+// mistake in the configuration file doesn't remove a working subscription.
+func reconcileConfigSubscriptions(projectID string, notifications []model.ProjectNotification) error {
+	existing, err := event.FindProjectConfigSubscriptions(projectID)
+	if err != nil {
+		return errors.Wrap(err, "error finding existing config subscriptions")
+	}
+
+	catcher := grip.NewBasicCatcher()
+	declared := map[string]bool{}
+	hasInvalid := false
+	for _, notification := range notifications {
+		sub, err := notification.ToSubscription(projectID)
+		if err == nil {
+			err = sub.Validate()
+		}
+		if err != nil {
+			hasInvalid = true
+			catcher.Wrapf(err, "invalid notification for trigger '%s'", notification.Trigger)
+			continue
+		}
+		declared[sub.ID] = true
+		sub.LastUpdated = time.Now()
+		catcher.Wrapf(sub.Upsert(), "error upserting subscription '%s'", sub.ID)
+	}
+	if hasInvalid {
+		return catcher.Resolve()
+	}
+	for _, sub := range existing {
+		if !declared[sub.ID] {
+			catcher.Wrapf(event.RemoveSubscription(sub.ID), "error removing subscription '%s'", sub.ID)
+		}
+	}
+
+	return catcher.Resolve()
+}
+
 // AddBuildBreakSubscriptions will subscribe admins of a project to a version if no one
 // else would receive a build break notification
 func AddBuildBreakSubscriptions(v *model.Version, projectRef *model.ProjectRef) error {
@@ -575,7 +617,7 @@ func AddBuildBreakSubscriptions(v *model.Version, projectRef *model.ProjectRef)
 	}
 	// if the project has build break notifications, subscribe admins if no one subscribed
 	for _, admin := range projectRef.Admins {
-		subscriber, err := makeBuildBreakSubscriber(admin)
+		subscriber, err := user.MakeBuildBreakSubscriber(admin)
 		if err != nil {
 			catcher.Add(err)
 			continue
@@ -593,32 +635,6 @@ func AddBuildBreakSubscriptions(v *model.Version, projectRef *model.ProjectRef)
 	return catcher.Resolve()
 }
 
-func makeBuildBreakSubscriber(userID string) (*event.Subscriber, error) {
-	u, err := user.FindOne(user.ById(userID))
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to find user")
-	}
-	if u == nil {
-		return nil, errors.Errorf("user %s does not exist", userID)
-	}
-	var subscriber *event.Subscriber
-	preference := u.Settings.Notifications.BuildBreak
-	if preference != "" && preference != user.PreferenceNone {
-		subscriber = &event.Subscriber{
-			Type: string(preference),
-		}
-		if preference == user.PreferenceEmail {
-			subscriber.Target = u.Email()
-		} else if preference == user.PreferenceSlack {
-			subscriber.Target = u.Settings.SlackUsername
-		} else {
-			return nil, errors.Errorf("invalid subscription preference for build break: %s", preference)
-		}
-	}
-
-	return subscriber, nil
-}
-
 func CreateManifest(v model.Version, proj *model.Project, projectRef *model.ProjectRef, settings *evergreen.Settings) (*manifest.Manifest, error) {
 	if len(proj.Modules) == 0 {
 		return nil, nil
@@ -641,6 +657,13 @@ func CreateManifest(v model.Version, proj *model.Project, projectRef *model.Proj
 	var gitCommit *github.RepositoryCommit
 	modules := map[string]*manifest.Module{}
 	for _, module := range proj.Modules {
+		if projectRef.IsGitHosted() {
+			modules[module.Name], err = getGitHostedManifestModule(ctx, v, projectRef, module)
+			if err != nil {
+				return nil, errors.Wrapf(err, "problem getting manifest for module %s", module.Name)
+			}
+			continue
+		}
 		var sha, url string
 		owner, repo := module.GetRepoOwnerAndName()
 		if module.Ref == "" {
@@ -697,6 +720,39 @@ func CreateManifest(v model.Version, proj *model.Project, projectRef *model.Proj
 	return newManifest, errors.Wrap(err, "error inserting manifest")
 }
 
+// getGitHostedManifestModule resolves a module's revision for a project
+// hosted on a plain git server. Like modules hosted on GitHub, a module
+// without a pinned ref uses the last commit on its branch before the
+// version's revision was committed.
+func getGitHostedManifestModule(ctx context.Context, v model.Version, projectRef *model.ProjectRef, module model.Module) (*manifest.Module, error) {
+	owner, repo := module.GetRepoOwnerAndName()
+	moduleHost := thirdparty.NewGitCodeHost(module.Repo)
+	manifestModule := &manifest.Module{
+		Branch: module.Branch,
+		Repo:   repo,
+		Owner:  owner,
+		URL:    module.Repo,
+	}
+	if module.Ref != "" {
+		if _, err := moduleHost.GetCommit(ctx, module.Ref); err != nil {
+			return nil, errors.Wrapf(err, "can't get commit '%s'", module.Ref)
+		}
+		manifestModule.Revision = module.Ref
+		return manifestModule, nil
+	}
+
+	commit, err := projectRef.GetCodeHost("").GetCommit(ctx, v.Revision)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't get commit '%s' on '%s'", v.Revision, projectRef.GitURL)
+	}
+	moduleCommit, err := moduleHost.GetLatestCommitBefore(ctx, module.Branch, commit.CreateTime)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't get commit on branch '%s'", module.Branch)
+	}
+	manifestModule.Revision = moduleCommit.Revision
+	return manifestModule, nil
+}
+
 func CreateVersionFromConfig(ctx context.Context, projectInfo *model.ProjectInfo,
 	metadata model.VersionMetadata, ignore bool, versionErrs *VersionErrors) (*model.Version, error) {
 	if projectInfo.NotPopulated() {
@@ -778,8 +834,32 @@ func CreateVersionFromConfig(ctx context.Context, projectInfo *model.ProjectInfo
 			return v, errors.Wrap(err, "error finding project alias")
 		}
 	}
+	if v.Requester == evergreen.GithubMergeRequester && len(aliases) == 0 {
+		return v, errors.Errorf("project '%s' has no '%s' aliases to test merge groups with", projectInfo.Ref.Id, metadata.Alias)
+	}
+
+	if err = createVersionItems(ctx, v, metadata, projectInfo, aliases); err != nil {
+		return v, errors.Wrap(err, "error creating version items")
+	}
+
+	if v.Requester == evergreen.GithubMergeRequester {
+		// GitHub's merge queue waits on the version's status to merge the
+		// group, so it's always reported regardless of the project's GitHub
+		// checks setting.
+		if err = addGithubCheckSubscriptions(v); err != nil {
+			return v, errors.Wrap(err, "error adding github check subscriptions")
+		}
+	}
 
-	return v, errors.Wrap(createVersionItems(ctx, v, metadata, projectInfo, aliases), "error creating version items")
+	if v.Requester == evergreen.RepotrackerVersionRequester {
+		grip.Error(message.WrapError(reconcileConfigSubscriptions(projectInfo.Ref.Id, projectInfo.Project.Notifications), message.Fields{
+			"message": "error reconciling subscriptions declared in project config",
+			"project": projectInfo.Ref.Id,
+			"version": v.Id,
+		}))
+	}
+
+	return v, nil
 }
 
 // shellVersionFromRevision populates a new Version with metadata from a model.Revision.
@@ -861,6 +941,14 @@ func ShellVersionFromRevision(ctx context.Context, ref *model.ProjectRef, metada
 		if metadata.RemotePath != "" {
 			v.RemotePath = metadata.RemotePath
 		}
+	} else if metadata.GithubMergeGroup != nil {
+		if !ref.CommitQueue.IsGithubMergeQueueEnabled() {
+			return nil, errors.Errorf("GitHub merge queue is not enabled for project '%s'", ref.Id)
+		}
+		v.Id = makeVersionIdWithMergeGroup(ref.Identifier, metadata.GithubMergeGroup.HeadSHA)
+		v.Requester = evergreen.GithubMergeRequester
+		v.CreateTime = time.Now()
+		v.Message = fmt.Sprintf("GitHub merge queue '%s': %s", metadata.GithubMergeGroup.HeadRef, v.Message)
 	} else {
 		v.Id = makeVersionId(ref.Identifier, metadata.Revision.Revision)
 	}
@@ -880,6 +968,10 @@ func makeVersionIdWithTag(project, tag, id string) string {
 	return util.CleanName(fmt.Sprintf("%s_%s_%s", project, tag, id))
 }
 
+func makeVersionIdWithMergeGroup(project, headSHA string) string {
+	return util.CleanName(fmt.Sprintf("%s_merge_group_%s", project, headSHA))
+}
+
 // Verifies that the given revision order number is higher than the latest number stored for the project.
 func sanityCheckOrderNum(revOrderNum int, projectId, revision string) error {
 	latest, err := model.VersionFindOne(model.VersionByMostRecentSystemRequester(projectId))
@@ -981,6 +1073,23 @@ func createVersionItems(ctx context.Context, v *model.Version, metadata model.Ve
 			"project": projectInfo.Project.Identifier,
 			"version": v.Id,
 		}))
+	} else if v.Requester == evergreen.GithubMergeRequester {
+		// every task selected for a merge group counts towards its status
+		githubCheckAliases = aliases
+	}
+	var pathSelectedPairs map[model.TVPair]bool
+	if len(metadata.ChangedFiles) > 0 && projectInfo.Project.HasInputs() {
+		var selected model.TaskVariantPairs
+		selected, err = projectInfo.Project.SelectTVPairsForChangedFiles(model.TaskVariantPairs{ExecTasks: projectInfo.Project.AllTVPairs()}, v.Requester, metadata.ChangedFiles)
+		grip.Warning(message.WrapError(err, message.Fields{
+			"message": "problem selecting tasks for changed files",
+			"project": projectInfo.Project.Identifier,
+			"version": v.Id,
+		}))
+		pathSelectedPairs = map[model.TVPair]bool{}
+		for _, pair := range selected.ExecTasks {
+			pathSelectedPairs[pair] = true
+		}
 	}
 	for _, buildvariant := range projectInfo.Project.BuildVariants {
 		taskNames := pairsToCreate.TaskNames(buildvariant.Name)
@@ -1030,6 +1139,7 @@ func createVersionItems(ctx context.Context, v *model.Version, metadata model.Ve
 			taskNameToId[t.DisplayName] = t.Id
 			tasksToCreate = append(tasksToCreate, t)
 		}
+		pathFilteredTasks := getPathFilteredTasks(buildvariant.Name, tasks, pathSelectedPairs)
 
 		activateVariantAt := time.Now()
 		taskStatuses := []model.BatchTimeTaskStatus{}
@@ -1039,7 +1149,7 @@ func createVersionItems(ctx context.Context, v *model.Version, metadata model.Ve
 			// add only tasks that require activation times
 			for _, bvt := range buildvariant.Tasks {
 				tId, ok := taskNameToId[bvt.Name]
-				if !ok || !bvt.HasBatchTime() {
+				if !ok || !bvt.HasBatchTime() || utility.StringSliceContains(pathFilteredTasks, tId) {
 					continue
 				}
 				bvt.Variant = buildvariant.Name
@@ -1069,9 +1179,10 @@ func createVersionItems(ctx context.Context, v *model.Version, metadata model.Ve
 		})
 		v.BuildIds = append(v.BuildIds, b.Id)
 		v.BuildVariants = append(v.BuildVariants, model.VersionBuildStatus{
-			BuildVariant:   buildvariant.Name,
-			BuildId:        b.Id,
-			BatchTimeTasks: taskStatuses,
+			BuildVariant:      buildvariant.Name,
+			BuildId:           b.Id,
+			BatchTimeTasks:    taskStatuses,
+			PathFilteredTasks: pathFilteredTasks,
 			ActivationStatus: model.ActivationStatus{
 				ActivateAt: activateVariantAt,
 				Activated:  false,
@@ -1224,3 +1335,36 @@ func transactionWithRetries(ctx context.Context, versionId string, sessionFunc f
 	}
 	return errors.Wrapf(errs.Resolve(), "hit max client retries for version '%s'", versionId)
 }
+
+// getPathFilteredTasks returns the IDs of the variant's tasks that weren't
+// selected by the files changed in the version, including display tasks whose
+// execution tasks all weren't. A nil selection means every task is selected.
+func getPathFilteredTasks(variant string, tasks task.Tasks, selected map[model.TVPair]bool) []string {
+	if selected == nil {
+		return nil
+	}
+	filtered := map[string]bool{}
+	for _, t := range tasks {
+		if !t.DisplayOnly && !selected[model.TVPair{Variant: variant, TaskName: t.DisplayName}] {
+			filtered[t.Id] = true
+		}
+	}
+	for _, t := range tasks {
+		if !t.DisplayOnly || len(t.ExecutionTasks) == 0 {
+			continue
+		}
+		allFiltered := true
+		for _, execTask := range t.ExecutionTasks {
+			allFiltered = allFiltered && filtered[execTask]
+		}
+		filtered[t.Id] = allFiltered
+	}
+
+	ids := []string{}
+	for _, t := range tasks {
+		if filtered[t.Id] {
+			ids = append(ids, t.Id)
+		}
+	}
+	return ids
+}
@@ -742,6 +742,74 @@ func TestBuildBreakSubscriptions(t *testing.T) {
 	assert.Len(subs, 2)
 }
 
+func TestReconcileConfigSubscriptions(t *testing.T) {
+	require.NoError(t, db.Clear(event.SubscriptionsCollection))
+	defer func() {
+		assert.NoError(t, db.Clear(event.SubscriptionsCollection))
+	}()
+
+	manual := event.NewSubscriptionByOwner("proj", event.Subscriber{
+		Type:   event.EmailSubscriberType,
+		Target: "admin@example.com",
+	}, event.ResourceTypeTask, event.TriggerFailure)
+	manual.OwnerType = event.OwnerTypeProject
+	require.NoError(t, manual.Upsert())
+
+	failure := model.ProjectNotification{
+		ResourceType: event.ResourceTypeTask,
+		Trigger:      event.TriggerFailure,
+		Subscriber: model.ProjectNotificationSubscriber{
+			Type:   event.SlackSubscriberType,
+			Target: "#alerts",
+		},
+	}
+	outcome := model.ProjectNotification{
+		ResourceType: event.ResourceTypeVersion,
+		Trigger:      event.TriggerOutcome,
+		Subscriber: model.ProjectNotificationSubscriber{
+			Type:   event.EmailSubscriberType,
+			Target: "team@example.com",
+		},
+	}
+
+	require.NoError(t, reconcileConfigSubscriptions("proj", []model.ProjectNotification{failure, outcome}))
+	subs, err := event.FindProjectConfigSubscriptions("proj")
+	require.NoError(t, err)
+	assert.Len(t, subs, 2)
+
+	// reconciling the same notifications again doesn't duplicate them
+	require.NoError(t, reconcileConfigSubscriptions("proj", []model.ProjectNotification{failure, outcome}))
+	subs, err = event.FindProjectConfigSubscriptions("proj")
+	require.NoError(t, err)
+	assert.Len(t, subs, 2)
+
+	// removing a notification removes its subscription
+	require.NoError(t, reconcileConfigSubscriptions("proj", []model.ProjectNotification{outcome}))
+	subs, err = event.FindProjectConfigSubscriptions("proj")
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+	assert.Equal(t, event.ResourceTypeVersion, subs[0].ResourceType)
+
+	// an invalid declaration doesn't remove the existing subscriptions
+	invalid := failure
+	invalid.Selectors = []model.ProjectNotificationSelector{{Type: event.SelectorProject, Data: "other"}}
+	assert.Error(t, reconcileConfigSubscriptions("proj", []model.ProjectNotification{invalid}))
+	subs, err = event.FindProjectConfigSubscriptions("proj")
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+	assert.Equal(t, event.ResourceTypeVersion, subs[0].ResourceType)
+
+	// subscriptions that weren't declared in the config are left alone
+	require.NoError(t, reconcileConfigSubscriptions("proj", nil))
+	subs, err = event.FindProjectConfigSubscriptions("proj")
+	require.NoError(t, err)
+	assert.Len(t, subs, 0)
+	allSubs, err := event.FindSubscriptionsByOwner("proj", event.OwnerTypeProject)
+	require.NoError(t, err)
+	require.Len(t, allSubs, 1)
+	assert.Equal(t, manual.ID, allSubs[0].ID)
+}
+
 type CreateVersionFromConfigSuite struct {
 	ref           *model.ProjectRef
 	rev           *model.Revision
@@ -1185,3 +1253,20 @@ func TestShellVersionFromRevisionGitTags(t *testing.T) {
 	assert.Contains(t, v.Id, "my_project_release_")
 	assert.Equal(t, "Triggered From Git Tag 'release': EVG-1234 good version", v.Message)
 }
+
+func TestGetPathFilteredTasks(t *testing.T) {
+	tasks := task.Tasks{
+		{Id: "compile", DisplayName: "compile"},
+		{Id: "docs", DisplayName: "docs"},
+		{Id: "lint", DisplayName: "lint"},
+		{Id: "docs_dt", DisplayName: "docs_dt", DisplayOnly: true, ExecutionTasks: []string{"docs"}},
+		{Id: "all_dt", DisplayName: "all_dt", DisplayOnly: true, ExecutionTasks: []string{"compile", "lint"}},
+	}
+	assert.Nil(t, getPathFilteredTasks("bv", tasks, nil))
+
+	selected := map[model.TVPair]bool{
+		{Variant: "bv", TaskName: "compile"}: true,
+		{Variant: "other", TaskName: "docs"}: true,
+	}
+	assert.Equal(t, []string{"docs", "lint", "docs_dt"}, getPathFilteredTasks("bv", tasks, selected))
+}
@@ -95,6 +95,20 @@ type Communicator interface {
 	// in the local evergreen yaml
 	GetSubscriptions(context.Context) ([]event.Subscription, error)
 
+	// GetProjectSubscriptions fetches the subscriptions owned by the project
+	GetProjectSubscriptions(context.Context, string) ([]event.Subscription, error)
+
+	// SaveSubscriptions creates the given subscriptions, or updates them if
+	// they already exist
+	SaveSubscriptions(context.Context, []event.Subscription) error
+
+	// DeleteSubscription deletes the subscription with the given ID
+	DeleteSubscription(context.Context, string) error
+
+	// TestSubscription sends a test notification to the subscriber of the
+	// subscription with the given ID
+	TestSubscription(context.Context, string) error
+
 	// CreateVersionFromConfig takes an evergreen config and makes runnable tasks from it
 	CreateVersionFromConfig(context.Context, string, string, bool, []byte) (*model.Version, error)
 
@@ -102,7 +116,7 @@ type Communicator interface {
 	GetCommitQueue(ctx context.Context, projectID string) (*restmodel.APICommitQueue, error)
 	DeleteCommitQueueItem(ctx context.Context, item string) error
 	// if enqueueNext is true then allow item to be processed next
-	EnqueueItem(ctx context.Context, patchID string, enqueueNext bool) (int, error)
+	EnqueueItem(ctx context.Context, patchID string, enqueueNext bool, lane string) (int, error)
 	CreatePatchForMerge(ctx context.Context, patchID, commitMessage string) (*restmodel.APIPatch, error)
 	GetMessageForPatch(ctx context.Context, patchID string) (string, error)
 
@@ -114,6 +128,22 @@ type Communicator interface {
 
 	GetRecentVersionsForProject(ctx context.Context, projectID, requester string) ([]restmodel.APIVersion, error)
 
+	// GetProject returns the project settings, including its variables with
+	// the private ones redacted.
+	GetProject(ctx context.Context, projectID string) (*restmodel.APIProjectRef, error)
+
+	// GetTestHistory returns the runs of a single test across a project's
+	// most recent mainline commits.
+	GetTestHistory(ctx context.Context, opts model.TestHistoryOptions) (*restmodel.APITestHistory, error)
+	// SearchTaskLogs returns the lines of a task's logs that match a regular
+	// expression. If execution is nil, the task's latest execution is
+	// searched.
+	SearchTaskLogs(ctx context.Context, opts model.TaskLogSearchOptions, execution *int) (*restmodel.APITaskLogSearchResult, error)
+	// GetTaskLogPage returns the lines of a task's logs starting from the
+	// options' cursor. If execution is nil, the task's latest execution
+	// is read.
+	GetTaskLogPage(ctx context.Context, opts model.TaskLogPageOptions, execution *int) (*restmodel.APITaskLogPage, error)
+
 	// GetTaskSyncReadCredentials returns the credentials to fetch task
 	// directory from S3.
 	GetTaskSyncReadCredentials(ctx context.Context) (*evergreen.S3Credentials, error)
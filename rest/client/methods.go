@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/evergreen-ci/evergreen"
@@ -998,8 +999,16 @@ func (c *communicatorImpl) GetParameters(ctx context.Context, project string) ([
 }
 
 func (c *communicatorImpl) GetSubscriptions(ctx context.Context) ([]event.Subscription, error) {
+	return c.getSubscriptions(ctx, c.apiUser, event.OwnerTypePerson)
+}
+
+func (c *communicatorImpl) GetProjectSubscriptions(ctx context.Context, project string) ([]event.Subscription, error) {
+	return c.getSubscriptions(ctx, project, event.OwnerTypeProject)
+}
+
+func (c *communicatorImpl) getSubscriptions(ctx context.Context, owner string, ownerType event.OwnerType) ([]event.Subscription, error) {
 	info := requestInfo{
-		path:   fmt.Sprintf("/subscriptions?owner=%s&type=person", c.apiUser),
+		path:   fmt.Sprintf("/subscriptions?owner=%s&type=%s", url.QueryEscape(owner), ownerType),
 		method: http.MethodGet,
 	}
 	resp, err := c.request(ctx, info, nil)
@@ -1048,6 +1057,76 @@ func (c *communicatorImpl) GetSubscriptions(ctx context.Context) ([]event.Subscr
 	return subs, nil
 }
 
+func (c *communicatorImpl) SaveSubscriptions(ctx context.Context, subs []event.Subscription) error {
+	apiSubs := make([]model.APISubscription, len(subs))
+	for i := range subs {
+		if err := apiSubs[i].BuildFromService(subs[i]); err != nil {
+			return errors.Wrap(err, "failed to convert subscription to api model")
+		}
+	}
+
+	info := requestInfo{
+		path:   "/subscriptions",
+		method: http.MethodPost,
+	}
+	resp, err := c.request(ctx, info, apiSubs)
+	if err != nil {
+		return errors.Wrap(err, "failed to save subscriptions")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return AuthError
+	}
+	if resp.StatusCode != http.StatusOK {
+		return utility.RespErrorf(resp, "saving subscriptions")
+	}
+
+	return nil
+}
+
+func (c *communicatorImpl) DeleteSubscription(ctx context.Context, id string) error {
+	info := requestInfo{
+		path:   fmt.Sprintf("/subscriptions?id=%s", url.QueryEscape(id)),
+		method: http.MethodDelete,
+	}
+	resp, err := c.request(ctx, info, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete subscription '%s'", id)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return AuthError
+	}
+	if resp.StatusCode != http.StatusOK {
+		return utility.RespErrorf(resp, "deleting subscription '%s'", id)
+	}
+
+	return nil
+}
+
+func (c *communicatorImpl) TestSubscription(ctx context.Context, id string) error {
+	info := requestInfo{
+		path:   fmt.Sprintf("/subscriptions/%s/test", id),
+		method: http.MethodPost,
+	}
+	resp, err := c.request(ctx, info, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to test subscription '%s'", id)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return AuthError
+	}
+	if resp.StatusCode != http.StatusOK {
+		return utility.RespErrorf(resp, "testing subscription '%s'", id)
+	}
+
+	return nil
+}
+
 func (c *communicatorImpl) CreateVersionFromConfig(ctx context.Context, project, message string, active bool, config []byte) (*serviceModel.Version, error) {
 	info := requestInfo{
 		method: http.MethodPut,
@@ -1138,13 +1217,15 @@ func (c *communicatorImpl) DeleteCommitQueueItem(ctx context.Context, item strin
 	return nil
 }
 
-func (c *communicatorImpl) EnqueueItem(ctx context.Context, patchID string, enqueueNext bool) (int, error) {
+func (c *communicatorImpl) EnqueueItem(ctx context.Context, patchID string, enqueueNext bool, lane string) (int, error) {
 	info := requestInfo{
 		method: http.MethodPut,
 		path:   fmt.Sprintf("/commit_queue/%s", patchID),
 	}
 	if enqueueNext {
 		info.path += "?force=true"
+	} else if lane != "" {
+		info.path += fmt.Sprintf("?lane=%s", url.QueryEscape(lane))
 	}
 
 	resp, err := c.request(ctx, info, nil)
@@ -1453,6 +1534,173 @@ func (c *communicatorImpl) GetRecentVersionsForProject(ctx context.Context, proj
 	return getVersionsResp, nil
 }
 
+func (c *communicatorImpl) GetTestHistory(ctx context.Context, opts serviceModel.TestHistoryOptions) (*model.APITestHistory, error) {
+	params := url.Values{}
+	params.Set("test_name", opts.TestName)
+	for key, list := range map[string][]string{
+		"variants": opts.BuildVariants,
+		"tasks":    opts.TaskNames,
+		"statuses": opts.Statuses,
+	} {
+		for _, val := range list {
+			params.Add(key, val)
+		}
+	}
+	if opts.MinDuration > 0 {
+		params.Set("min_duration", opts.MinDuration.String())
+	}
+	if opts.MaxDuration > 0 {
+		params.Set("max_duration", opts.MaxDuration.String())
+	}
+	if opts.NumRevisions > 0 {
+		params.Set("revisions", fmt.Sprint(opts.NumRevisions))
+	}
+	info := requestInfo{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("projects/%s/test_history?%s", opts.ProjectID, params.Encode()),
+	}
+
+	resp, err := c.request(ctx, info, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "sending request to get test history")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, AuthError
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, utility.RespErrorf(resp, "problem getting history for test '%s'", opts.TestName)
+	}
+
+	history := &model.APITestHistory{}
+	if err = utility.ReadJSON(resp.Body, history); err != nil {
+		return nil, errors.Wrap(err, "reading test history from response")
+	}
+
+	return history, nil
+}
+
+func (c *communicatorImpl) SearchTaskLogs(ctx context.Context, opts serviceModel.TaskLogSearchOptions, execution *int) (*model.APITaskLogSearchResult, error) {
+	params := url.Values{}
+	params.Set("pattern", opts.Pattern)
+	for _, severity := range opts.Severities {
+		params.Add("severities", severity)
+	}
+	if opts.LogType != "" {
+		params.Set("type", opts.LogType)
+	}
+	if opts.ContextLines > 0 {
+		params.Set("context", fmt.Sprint(opts.ContextLines))
+	}
+	if opts.Page > 0 {
+		params.Set("page", fmt.Sprint(opts.Page))
+	}
+	if opts.Limit > 0 {
+		params.Set("limit", fmt.Sprint(opts.Limit))
+	}
+	if execution != nil {
+		params.Set("execution", fmt.Sprint(*execution))
+	}
+	info := requestInfo{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("tasks/%s/logs/search?%s", opts.TaskID, params.Encode()),
+	}
+
+	resp, err := c.request(ctx, info, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "sending request to search task logs")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, AuthError
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, utility.RespErrorf(resp, "problem searching logs for task '%s'", opts.TaskID)
+	}
+
+	result := &model.APITaskLogSearchResult{}
+	if err = utility.ReadJSON(resp.Body, result); err != nil {
+		return nil, errors.Wrap(err, "reading task log search result from response")
+	}
+
+	return result, nil
+}
+
+func (c *communicatorImpl) GetTaskLogPage(ctx context.Context, opts serviceModel.TaskLogPageOptions, execution *int) (*model.APITaskLogPage, error) {
+	params := url.Values{}
+	for _, severity := range opts.Severities {
+		params.Add("severities", severity)
+	}
+	if opts.LogType != "" {
+		params.Set("type", opts.LogType)
+	}
+	if !opts.StartTime.IsZero() {
+		params.Set("start_time", opts.StartTime.Format(time.RFC3339Nano))
+	}
+	if opts.StartSequence > 0 {
+		params.Set("start_sequence", fmt.Sprint(opts.StartSequence))
+	}
+	if opts.Limit > 0 {
+		params.Set("limit", fmt.Sprint(opts.Limit))
+	}
+	if execution != nil {
+		params.Set("execution", fmt.Sprint(*execution))
+	}
+	info := requestInfo{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("tasks/%s/logs?%s", opts.TaskID, params.Encode()),
+	}
+
+	resp, err := c.request(ctx, info, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "sending request to get task logs")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, AuthError
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, utility.RespErrorf(resp, "problem getting logs for task '%s'", opts.TaskID)
+	}
+
+	page := &model.APITaskLogPage{}
+	if err = utility.ReadJSON(resp.Body, page); err != nil {
+		return nil, errors.Wrap(err, "reading task logs from response")
+	}
+
+	return page, nil
+}
+
+func (c *communicatorImpl) GetProject(ctx context.Context, projectID string) (*model.APIProjectRef, error) {
+	info := requestInfo{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("projects/%s", projectID),
+	}
+
+	resp, err := c.request(ctx, info, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error sending request to get project")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, AuthError
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, utility.RespErrorf(resp, "problem getting project '%s'", projectID)
+	}
+
+	project := &model.APIProjectRef{}
+	if err = utility.ReadJSON(resp.Body, project); err != nil {
+		return nil, errors.Wrap(err, "error parsing project")
+	}
+
+	return project, nil
+}
+
 func (c *communicatorImpl) GetTaskSyncReadCredentials(ctx context.Context) (*evergreen.S3Credentials, error) {
 	info := requestInfo{
 		method: http.MethodGet,
@@ -28,6 +28,7 @@ type Mock struct {
 
 	// mock behavior
 	GetSubscriptionsFail bool
+	SavedSubscriptions   []event.Subscription
 }
 
 // NewMock returns a Communicator for testing.
@@ -246,6 +247,23 @@ func (c *Mock) GetSubscriptions(_ context.Context) ([]event.Subscription, error)
 	}, nil
 }
 
+func (c *Mock) GetProjectSubscriptions(ctx context.Context, _ string) ([]event.Subscription, error) {
+	return c.GetSubscriptions(ctx)
+}
+
+func (c *Mock) SaveSubscriptions(_ context.Context, subs []event.Subscription) error {
+	c.SavedSubscriptions = append(c.SavedSubscriptions, subs...)
+	return nil
+}
+
+func (c *Mock) DeleteSubscription(_ context.Context, _ string) error {
+	return nil
+}
+
+func (c *Mock) TestSubscription(_ context.Context, _ string) error {
+	return nil
+}
+
 func (c *Mock) CreateVersionFromConfig(ctx context.Context, project, message string, active bool, config []byte) (*serviceModel.Version, error) {
 	return &serviceModel.Version{}, nil
 }
@@ -280,7 +298,7 @@ func (c *Mock) DeleteCommitQueueItem(ctx context.Context, item string) error {
 	return nil
 }
 
-func (c *Mock) EnqueueItem(ctx context.Context, patchID string, force bool) (int, error) {
+func (c *Mock) EnqueueItem(ctx context.Context, patchID string, force bool, lane string) (int, error) {
 	return 0, nil
 }
 
@@ -312,6 +330,22 @@ func (c *Mock) GetRecentVersionsForProject(context.Context, string, string) ([]r
 	return nil, nil
 }
 
+func (c *Mock) GetProject(context.Context, string) (*restmodel.APIProjectRef, error) {
+	return nil, nil
+}
+
+func (c *Mock) GetTestHistory(context.Context, serviceModel.TestHistoryOptions) (*restmodel.APITestHistory, error) {
+	return nil, nil
+}
+
+func (c *Mock) SearchTaskLogs(context.Context, serviceModel.TaskLogSearchOptions, *int) (*restmodel.APITaskLogSearchResult, error) {
+	return nil, nil
+}
+
+func (c *Mock) GetTaskLogPage(context.Context, serviceModel.TaskLogPageOptions, *int) (*restmodel.APITaskLogPage, error) {
+	return nil, nil
+}
+
 func (c *Mock) GetTaskSyncReadCredentials(context.Context) (*evergreen.S3Credentials, error) {
 	return &evergreen.S3Credentials{}, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -47,6 +48,21 @@ func (pc *DBCommitQueueConnector) GetGitHubPR(ctx context.Context, owner, repo s
 	return pr, nil
 }
 
+func (pc *DBCommitQueueConnector) PostGitHubPRComment(ctx context.Context, owner, repo string, PRNum int, comment string) error {
+	conf, err := evergreen.GetConfig()
+	if err != nil {
+		return errors.Wrap(err, "can't get evergreen configuration")
+	}
+	ghToken, err := conf.GetGithubOauthToken()
+	if err != nil {
+		return errors.Wrap(err, "can't get Github OAuth token from configuration")
+	}
+
+	ctxWithCancel, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	return errors.Wrap(thirdparty.PostCommentToPullRequest(ctxWithCancel, ghToken, owner, repo, PRNum, comment), "call to Github API failed")
+}
+
 func (pc *DBCommitQueueConnector) AddPatchForPr(ctx context.Context, projectRef model.ProjectRef, prNum int, modules []restModel.APIModule, messageOverride string) (string, error) {
 	settings, err := evergreen.GetConfig()
 	if err != nil {
@@ -179,21 +195,125 @@ func (pc *DBCommitQueueConnector) EnqueueItem(projectID string, item restModel.A
 	}
 
 	itemService := itemInterface.(commitqueue.CommitQueueItem)
+	var p *patch.Patch
+	if itemService.PatchId != "" {
+		p, err = patch.FindOneId(itemService.PatchId)
+		if err != nil {
+			return 0, errors.Wrapf(err, "can't find patch '%s'", itemService.PatchId)
+		}
+	}
+	if itemService.Author == "" && p != nil {
+		itemService.Author = p.Author
+	}
+
+	projectRef, err := model.FindMergedProjectRef(projectID, "", false)
+	if err != nil {
+		return 0, errors.Wrapf(err, "can't find project '%s'", projectID)
+	}
+	if projectRef == nil {
+		return 0, errors.Errorf("project '%s' not found", projectID)
+	}
+
+	// every item counts towards its author's limit, including pull requests
+	// and items that are force enqueued
+	if err = q.CheckAuthorLimit(itemService.Author, projectRef.CommitQueue.MaxItemsPerAuthor); err != nil {
+		return 0, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		}
+	}
+
+	if err = commitqueue.ValidateLane(projectRef.CommitQueue.Lanes, itemService.Lane); err != nil {
+		return 0, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		}
+	}
+
+	stackedOn, err := getStackedOnQueueItem(q, itemService, p, projectRef)
+	if err != nil {
+		return 0, err
+	}
+
 	if enqueueNext {
+		if stackedOn != "" {
+			return 0, gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    fmt.Sprintf("can't enqueue item ahead of item '%s' that it's stacked on", stackedOn),
+			}
+		}
 		var position int
 		position, err = q.EnqueueAtFront(itemService)
 		if err != nil {
 			return 0, errors.Wrapf(err, "can't force enqueue item to queue '%s'", projectID)
 		}
-		return position, nil
+		return position, errors.Wrap(setCommitQueueLane(p, itemService.Lane), "can't set commit queue lane on patch")
+	}
+	var position int
+	if stackedOn != "" {
+		position, err = q.EnqueueBehind(itemService, projectRef.CommitQueue.Lanes, stackedOn)
+	} else {
+		position, err = q.EnqueueInLane(itemService, projectRef.CommitQueue.Lanes)
 	}
-
-	position, err := q.Enqueue(itemService)
 	if err != nil {
 		return 0, errors.Wrapf(err, "can't enqueue item to queue '%s'", projectID)
 	}
 
-	return position, nil
+	return position, errors.Wrap(setCommitQueueLane(p, itemService.Lane), "can't set commit queue lane on patch")
+}
+
+// setCommitQueueLane records the lane the patch was enqueued in so that it's
+// enqueued in the same lane when it's restarted or its merge patch is created.
+func setCommitQueueLane(p *patch.Patch, lane string) error {
+	if p == nil || p.CommitQueueLane == lane {
+		return nil
+	}
+	return p.SetCommitQueueLane(lane)
+}
+
+// getStackedOnQueueItem returns the issue of the item in the queue that the
+// given item is stacked on, if any. A stacked diff item can only be enqueued
+// once the item it's stacked on is either in the queue or merged.
+func getStackedOnQueueItem(q *commitqueue.CommitQueue, item commitqueue.CommitQueueItem, p *patch.Patch, projectRef *model.ProjectRef) (string, error) {
+	if item.Source == commitqueue.SourcePullRequest {
+		prNumber, err := strconv.Atoi(item.Issue)
+		if err != nil {
+			return "", nil
+		}
+		prPatch, err := patch.FindLatestGithubPRPatch(projectRef.Owner, projectRef.Repo, prNumber)
+		if err != nil {
+			return "", errors.Wrapf(err, "can't find patch for PR #%d", prNumber)
+		}
+		if prPatch == nil || prPatch.GithubPatchData.StackedOnPR == 0 {
+			return "", nil
+		}
+		// GitHub only lets the pull request merge into the project's branch
+		// once the pull request it's stacked on has merged, so it only needs
+		// to be kept behind it.
+		stackedOn := strconv.Itoa(prPatch.GithubPatchData.StackedOnPR)
+		if q.FindItem(stackedOn) < 0 {
+			return "", nil
+		}
+		return stackedOn, nil
+	}
+
+	if p == nil || !p.IsStacked() {
+		return "", nil
+	}
+	if q.FindItem(p.StackedOn) >= 0 {
+		return p.StackedOn, nil
+	}
+	parent, err := patch.FindOneId(p.StackedOn)
+	if err != nil {
+		return "", errors.Wrapf(err, "can't find stacked on patch '%s'", p.StackedOn)
+	}
+	if parent == nil || parent.Status != evergreen.PatchSucceeded {
+		return "", gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("patch '%s' is stacked on '%s', which is neither in the commit queue nor merged", p.Id.Hex(), p.StackedOn),
+		}
+	}
+	return "", nil
 }
 
 func (pc *DBCommitQueueConnector) FindCommitQueueForProject(name string) (*restModel.APICommitQueue, error) {
@@ -240,6 +360,14 @@ func (pc *DBCommitQueueConnector) CommitQueueRemoveItem(identifier, issue, user
 	if removed == nil {
 		return nil, errors.Errorf("item %s not found in queue", issue)
 	}
+	// The item is already removed, so failing to remove the items stacked on
+	// it shouldn't fail the request.
+	grip.Warning(message.WrapError(model.RemoveStackedCommitQueueItems(cq, removed.Issue, user), message.Fields{
+		"message":    "unable to remove items stacked on removed item",
+		"project_id": cq.ProjectID,
+		"item":       removed.Issue,
+		"user":       user,
+	}))
 	apiRemovedItem := restModel.APICommitQueueItem{}
 	if err = apiRemovedItem.BuildFromService(*removed); err != nil {
 		return nil, err
@@ -365,22 +493,22 @@ func (pc *DBCommitQueueConnector) ConcludeMerge(patchID, status string) error {
 	if cq == nil {
 		return errors.Errorf("no commit queue found for '%s'", p.Project)
 	}
-	item := ""
-	for _, entry := range cq.Queue {
-		if entry.Version == patchID {
-			item = entry.Issue
-			break
-		}
-	}
-	if item == "" {
+	// a batch of items can be tested and merged by the same patch
+	items := cq.ItemsForVersion(patchID)
+	if len(items) == 0 {
 		return errors.Errorf("no entry found for patch '%s'", patchID)
 	}
-	found, err := cq.Remove(item)
-	if err != nil {
-		return errors.Wrapf(err, "can't dequeue '%s' from commit queue", item)
-	}
-	if found == nil {
-		return errors.Errorf("item '%s' did not exist on the queue", item)
+	for _, item := range items {
+		found, err := cq.Remove(item.Issue)
+		if err != nil {
+			return errors.Wrapf(err, "can't dequeue '%s' from commit queue", item.Issue)
+		}
+		if found == nil {
+			return errors.Errorf("item '%s' did not exist on the queue", item.Issue)
+		}
+		if len(items) > 1 {
+			event.LogCommitQueueConcludeTest(item.Issue, status)
+		}
 	}
 	githubStatus := message.GithubStateFailure
 	description := "merge test failed"
@@ -431,6 +559,7 @@ func (pc *DBCommitQueueConnector) GetAdditionalPatches(patchId string) ([]string
 type MockCommitQueueConnector struct {
 	Queue           map[string][]restModel.APICommitQueueItem
 	UserPermissions map[UserRepoInfo]string // map user to permission level in lieu of the Github API
+	PRComments      map[int][]string        // map PR number to the comments posted on it
 }
 
 func (pc *MockCommitQueueConnector) GetGitHubPR(ctx context.Context, owner, repo string, PRNum int) (*github.PullRequest, error) {
@@ -448,6 +577,14 @@ func (pc *MockCommitQueueConnector) GetGitHubPR(ctx context.Context, owner, repo
 	}, nil
 }
 
+func (pc *MockCommitQueueConnector) PostGitHubPRComment(ctx context.Context, owner, repo string, PRNum int, comment string) error {
+	if pc.PRComments == nil {
+		pc.PRComments = map[int][]string{}
+	}
+	pc.PRComments[PRNum] = append(pc.PRComments[PRNum], comment)
+	return nil
+}
+
 func (pc *MockCommitQueueConnector) AddPatchForPr(ctx context.Context, projectRef model.ProjectRef, prNum int, modules []restModel.APIModule, messageOverride string) (string, error) {
 	return "", nil
 }
@@ -10,11 +10,13 @@ import (
 	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/commitqueue"
+	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/evergreen-ci/evergreen/model/user"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/evergreen/testutil"
 	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/evergreen-ci/gimlet"
 	"github.com/evergreen-ci/utility"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -80,6 +82,62 @@ func (s *CommitQueueSuite) TestEnqueue() {
 	s.Equal("important", q.Queue[0].Issue)
 }
 
+func (s *CommitQueueSuite) TestEnqueueAuthorLimit() {
+	s.ctx = &DBConnector{}
+	s.projectRef.CommitQueue.MaxItemsPerAuthor = 1
+	s.Require().NoError(s.projectRef.Upsert())
+
+	_, err := s.ctx.EnqueueItem("mci", restModel.APICommitQueueItem{Source: utility.ToStringPtr(commitqueue.SourceDiff), Issue: utility.ToStringPtr("1234"), Author: utility.ToStringPtr("me")}, false)
+	s.NoError(err)
+
+	// the limit applies to pull requests and force enqueued items too
+	_, err = s.ctx.EnqueueItem("mci", restModel.APICommitQueueItem{Source: utility.ToStringPtr(commitqueue.SourcePullRequest), Issue: utility.ToStringPtr("5678"), Author: utility.ToStringPtr("me")}, false)
+	s.Error(err)
+	_, err = s.ctx.EnqueueItem("mci", restModel.APICommitQueueItem{Source: utility.ToStringPtr(commitqueue.SourceDiff), Issue: utility.ToStringPtr("important"), Author: utility.ToStringPtr("me")}, true)
+	s.Error(err)
+
+	_, err = s.ctx.EnqueueItem("mci", restModel.APICommitQueueItem{Source: utility.ToStringPtr(commitqueue.SourceDiff), Issue: utility.ToStringPtr("important"), Author: utility.ToStringPtr("you")}, true)
+	s.NoError(err)
+
+	q, err := commitqueue.FindOneId("mci")
+	s.NoError(err)
+	s.Require().Len(q.Queue, 2)
+	s.Equal("important", q.Queue[0].Issue)
+	s.Equal("1234", q.Queue[1].Issue)
+}
+
+func (s *CommitQueueSuite) TestEnqueueLane() {
+	s.ctx = &DBConnector{}
+	s.Require().NoError(db.Clear(patch.Collection))
+	s.projectRef.CommitQueue.Lanes = []commitqueue.Lane{{Name: "hotfix", MaxConcurrent: 1}}
+	s.Require().NoError(s.projectRef.Upsert())
+	p := patch.Patch{Id: mgobson.NewObjectId(), Project: "mci", Author: "me"}
+	s.Require().NoError(p.Insert())
+
+	// an unknown lane is rejected whether or not the item is force enqueued
+	for _, force := range []bool{false, true} {
+		_, err := s.ctx.EnqueueItem("mci", restModel.APICommitQueueItem{Source: utility.ToStringPtr(commitqueue.SourceDiff), Issue: utility.ToStringPtr(p.Id.Hex()), PatchId: utility.ToStringPtr(p.Id.Hex()), Lane: utility.ToStringPtr("bulk")}, force)
+		s.Error(err)
+		s.IsType(gimlet.ErrorResponse{}, err)
+	}
+	q, err := commitqueue.FindOneId("mci")
+	s.NoError(err)
+	s.Empty(q.Queue)
+
+	_, err = s.ctx.EnqueueItem("mci", restModel.APICommitQueueItem{Source: utility.ToStringPtr(commitqueue.SourceDiff), Issue: utility.ToStringPtr(p.Id.Hex()), PatchId: utility.ToStringPtr(p.Id.Hex()), Lane: utility.ToStringPtr("hotfix")}, true)
+	s.NoError(err)
+	q, err = commitqueue.FindOneId("mci")
+	s.NoError(err)
+	s.Require().Len(q.Queue, 1)
+	s.Equal("hotfix", q.Queue[0].Lane)
+
+	// the lane is recorded on the patch so that it's kept when the patch is restarted
+	dbPatch, err := patch.FindOneId(p.Id.Hex())
+	s.NoError(err)
+	s.Require().NotNil(dbPatch)
+	s.Equal("hotfix", dbPatch.CommitQueueLane)
+}
+
 func (s *CommitQueueSuite) TestFindCommitQueueByID() {
 	s.ctx = &DBConnector{}
 	cq, err := s.ctx.FindCommitQueueForProject("mci")
@@ -410,3 +468,99 @@ func TestConcludeMerge(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, queue.Queue, 0)
 }
+
+func TestConcludeMergeBatch(t *testing.T) {
+	require.NoError(t, db.ClearCollections(commitqueue.Collection, patch.Collection, event.AllLogCollection))
+	projectID := "evergreen"
+	batchID := bson.NewObjectId()
+	p := patch.Patch{
+		Id:      batchID,
+		Project: projectID,
+	}
+	assert.NoError(t, p.Insert())
+	queue := &commitqueue.CommitQueue{
+		ProjectID: projectID,
+		Queue: []commitqueue.CommitQueueItem{
+			{Issue: "1", Version: batchID.Hex()},
+			{Issue: "2", Version: batchID.Hex()},
+			{Issue: "3"},
+		},
+	}
+	require.NoError(t, commitqueue.InsertQueue(queue))
+	dc := &DBCommitQueueConnector{}
+
+	assert.NoError(t, dc.ConcludeMerge(batchID.Hex(), evergreen.MergeTestSucceeded))
+
+	queue, err := commitqueue.FindOneId(projectID)
+	require.NoError(t, err)
+	require.Len(t, queue.Queue, 1)
+	assert.Equal(t, "3", queue.Queue[0].Issue)
+
+	// each item in the batch has its own conclusion logged
+	for _, issue := range []string{"1", "2"} {
+		events, err := event.FindAllByResourceID(issue)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, event.CommitQueueConcludeTest, events[0].EventType)
+	}
+}
+
+func TestGetStackedOnQueueItem(t *testing.T) {
+	require.NoError(t, db.ClearCollections(patch.Collection))
+	projectRef := &model.ProjectRef{Id: "mci", Owner: "evergreen-ci", Repo: "evergreen"}
+
+	queued := patch.Patch{Id: bson.NewObjectId(), Project: projectRef.Id}
+	merged := patch.Patch{Id: bson.NewObjectId(), Project: projectRef.Id, Status: evergreen.PatchSucceeded}
+	unmerged := patch.Patch{Id: bson.NewObjectId(), Project: projectRef.Id, Status: evergreen.PatchFailed}
+	prPatch := patch.Patch{
+		Id:      bson.NewObjectId(),
+		Project: projectRef.Id,
+		GithubPatchData: thirdparty.GithubPatch{
+			BaseOwner:   projectRef.Owner,
+			BaseRepo:    projectRef.Repo,
+			PRNumber:    2,
+			StackedOnPR: 1,
+		},
+	}
+	for _, p := range []patch.Patch{queued, merged, unmerged, prPatch} {
+		require.NoError(t, p.Insert())
+	}
+	q := &commitqueue.CommitQueue{
+		ProjectID: projectRef.Id,
+		Queue: []commitqueue.CommitQueueItem{
+			{Issue: queued.Id.Hex(), Source: commitqueue.SourceDiff},
+			{Issue: "1", Source: commitqueue.SourcePullRequest},
+		},
+	}
+	diffItem := commitqueue.CommitQueueItem{Issue: "item", Source: commitqueue.SourceDiff}
+
+	t.Run("NotStacked", func(t *testing.T) {
+		stackedOn, err := getStackedOnQueueItem(q, diffItem, &patch.Patch{}, projectRef)
+		assert.NoError(t, err)
+		assert.Empty(t, stackedOn)
+	})
+	t.Run("StackedOnQueuedPatch", func(t *testing.T) {
+		stackedOn, err := getStackedOnQueueItem(q, diffItem, &patch.Patch{StackedOn: queued.Id.Hex()}, projectRef)
+		assert.NoError(t, err)
+		assert.Equal(t, queued.Id.Hex(), stackedOn)
+	})
+	t.Run("StackedOnMergedPatch", func(t *testing.T) {
+		stackedOn, err := getStackedOnQueueItem(q, diffItem, &patch.Patch{StackedOn: merged.Id.Hex()}, projectRef)
+		assert.NoError(t, err)
+		assert.Empty(t, stackedOn)
+	})
+	t.Run("StackedOnUnmergedPatch", func(t *testing.T) {
+		_, err := getStackedOnQueueItem(q, diffItem, &patch.Patch{StackedOn: unmerged.Id.Hex()}, projectRef)
+		assert.Error(t, err)
+	})
+	t.Run("StackedOnQueuedPR", func(t *testing.T) {
+		stackedOn, err := getStackedOnQueueItem(q, commitqueue.CommitQueueItem{Issue: "2", Source: commitqueue.SourcePullRequest}, nil, projectRef)
+		assert.NoError(t, err)
+		assert.Equal(t, "1", stackedOn)
+	})
+	t.Run("PRNotStacked", func(t *testing.T) {
+		stackedOn, err := getStackedOnQueueItem(q, commitqueue.CommitQueueItem{Issue: "3", Source: commitqueue.SourcePullRequest}, nil, projectRef)
+		assert.NoError(t, err)
+		assert.Empty(t, stackedOn)
+	})
+}
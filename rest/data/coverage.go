@@ -0,0 +1,99 @@
+package data
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/evergreen-ci/evergreen/model/coverage"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+type CoverageConnector struct{}
+
+// AttachTaskCoverage stores the coverage of source files reported by the task.
+func (cc *CoverageConnector) AttachTaskCoverage(t *task.Task, files []coverage.FileCoverage) error {
+	_, err := coverage.Attach(t, files)
+	return err
+}
+
+// FindTaskCoverage returns the coverage reported by the task execution.
+func (cc *CoverageConnector) FindTaskCoverage(taskID string, execution int) (*coverage.TaskCoverage, error) {
+	c, err := coverage.FindOneByTaskIDAndExecution(taskID, execution)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding coverage for task '%s'", taskID)
+	}
+	if c == nil {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("task '%s' execution %d did not report coverage", taskID, execution),
+		}
+	}
+	return c, nil
+}
+
+// FindVersionCoverage returns the coverage of each build variant in the version.
+func (cc *CoverageConnector) FindVersionCoverage(versionID string) ([]coverage.VersionCoverage, error) {
+	return coverage.FindVersionCoverage(versionID)
+}
+
+// FindCoverageTrend returns the coverage of build variants in a project's versions.
+func (cc *CoverageConnector) FindCoverageTrend(opts coverage.TrendOptions) ([]coverage.VersionCoverage, error) {
+	return coverage.FindCoverageTrend(opts)
+}
+
+type MockCoverageConnector struct {
+	CachedTaskCoverage    []coverage.TaskCoverage
+	CachedVersionCoverage []coverage.VersionCoverage
+}
+
+// AttachTaskCoverage adds the task's coverage to the cached task coverage.
+func (mcc *MockCoverageConnector) AttachTaskCoverage(t *task.Task, files []coverage.FileCoverage) error {
+	mcc.CachedTaskCoverage = append(mcc.CachedTaskCoverage, *coverage.NewTaskCoverage(t, files))
+	return nil
+}
+
+// FindTaskCoverage returns the cached coverage of the task execution.
+func (mcc *MockCoverageConnector) FindTaskCoverage(taskID string, execution int) (*coverage.TaskCoverage, error) {
+	for i := range mcc.CachedTaskCoverage {
+		if mcc.CachedTaskCoverage[i].TaskID == taskID && mcc.CachedTaskCoverage[i].Execution == execution {
+			return &mcc.CachedTaskCoverage[i], nil
+		}
+	}
+	return nil, gimlet.ErrorResponse{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("task '%s' execution %d did not report coverage", taskID, execution),
+	}
+}
+
+// FindVersionCoverage returns the cached version coverage for the version.
+func (mcc *MockCoverageConnector) FindVersionCoverage(versionID string) ([]coverage.VersionCoverage, error) {
+	var results []coverage.VersionCoverage
+	for _, c := range mcc.CachedVersionCoverage {
+		if c.Version == versionID {
+			results = append(results, c)
+		}
+	}
+	return results, nil
+}
+
+// FindCoverageTrend returns the cached version coverage, enforcing the build
+// variant, before order, and limit fields of the options.
+func (mcc *MockCoverageConnector) FindCoverageTrend(opts coverage.TrendOptions) ([]coverage.VersionCoverage, error) {
+	var results []coverage.VersionCoverage
+	for _, c := range mcc.CachedVersionCoverage {
+		if len(opts.BuildVariants) > 0 && !utility.StringSliceContains(opts.BuildVariants, c.BuildVariant) {
+			continue
+		}
+		if opts.BeforeOrder > 0 && c.RevisionOrderNumber >= opts.BeforeOrder {
+			continue
+		}
+		results = append(results, c)
+	}
+	if opts.Limit > 0 && len(results) > opts.Limit {
+		results = results[:opts.Limit]
+	}
+	return results, nil
+}
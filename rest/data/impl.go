@@ -30,6 +30,8 @@ type DBConnector struct {
 	NotificationConnector
 	DBCreateHostConnector
 	StatsConnector
+	CoverageConnector
+	PerfConnector
 	TaskReliabilityConnector
 	DBCommitQueueConnector
 	SchedulerConnector
@@ -66,6 +68,8 @@ type MockConnector struct {
 	MockNotificationConnector
 	MockCreateHostConnector
 	MockStatsConnector
+	MockCoverageConnector
+	MockPerfConnector
 	MockTaskReliabilityConnector
 	MockCommitQueueConnector
 	MockSchedulerConnector
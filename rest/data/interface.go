@@ -12,18 +12,22 @@ import (
 	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/evergreen-ci/evergreen/cloud"
 	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/artifact"
 	"github.com/evergreen-ci/evergreen/model/build"
+	"github.com/evergreen-ci/evergreen/model/coverage"
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/host"
 	"github.com/evergreen-ci/evergreen/model/manifest"
 	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/perf"
 	"github.com/evergreen-ci/evergreen/model/reliability"
 	"github.com/evergreen-ci/evergreen/model/stats"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/evergreen/model/user"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/thirdparty"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/google/go-github/v34/github"
 	"github.com/mongodb/amboy"
@@ -39,6 +43,10 @@ type FindTestsByTaskIdOpts struct {
 	SortBy   string
 	SortDir  int
 	Statuses []string
+	// Tags matches tests that have all of the given tags.
+	Tags []string
+	// Owners matches tests owned by any of the given owners.
+	Owners []string
 	// TaskID is the only required field.
 	TaskID string
 	// TestID matches all IDs >= TestID.
@@ -81,6 +89,19 @@ type Connector interface {
 	FindBuildById(string) (*build.Build, error)
 	// GetManifestByTask is a method to get the manifest for the given task.
 	GetManifestByTask(string) (*manifest.Manifest, error)
+	// SearchTaskLogs returns a page of the lines in a task execution's logs
+	// that match a regular expression, along with their context lines.
+	SearchTaskLogs(context.Context, model.TaskLogSearchOptions) (*model.TaskLogSearchResult, error)
+	// GetTaskLogPage returns the lines of a task execution's logs starting
+	// from a given cursor.
+	GetTaskLogPage(context.Context, model.TaskLogPageOptions) (*model.TaskLogPage, error)
+	// FindTaskArtifact returns the task's artifact file with the given name
+	// that is visible to users. The files of a display task are the files
+	// of its execution tasks.
+	FindTaskArtifact(*task.Task, string) (*artifact.File, error)
+	// GetArtifactContent returns the content of an artifact file along with
+	// the content type reported by the server storing it.
+	GetArtifactContent(context.Context, artifact.File) (io.ReadCloser, string, error)
 
 	// SetBuildPriority and SetBuildActivated change the status of the input build
 	SetBuildPriority(string, int64, string) error
@@ -138,7 +159,19 @@ type Connector interface {
 	GetProjectVersionsWithOptions(string, model.GetVersionsOptions) ([]restModel.APIVersion, error)
 	GetProjectEventLog(string, time.Time, int) ([]restModel.APIProjectEvent, error)
 	GetRepoEventLog(string, time.Time, int) ([]restModel.APIProjectEvent, error)
+	// FindExpiredArtifacts returns up to the given number of mainline and
+	// patch artifact entries that the project's retention policy has expired
+	// as of the given time.
+	FindExpiredArtifacts(*model.ProjectRef, time.Time, int) ([]artifact.Entry, error)
 	CreateVersionFromConfig(context.Context, *model.ProjectInfo, model.VersionMetadata, bool) (*model.Version, error)
+	// CreateVersionForGithubMergeGroup creates and activates a version that
+	// tests a GitHub merge queue group with the project's commit queue
+	// aliases, or returns the version that was already created for the group.
+	CreateVersionForGithubMergeGroup(context.Context, model.ProjectRef, thirdparty.GithubMergeGroup) (*model.Version, error)
+	// AbortGithubMergeGroupVersions deactivates and aborts the versions of the
+	// given project that test the GitHub merge queue group with the given head
+	// commit.
+	AbortGithubMergeGroupVersions(string, string, string) error
 
 	// Given a version and a project ID, return the translated project and the intermediate project.
 	LoadProjectForVersion(*model.Version, string) (model.ProjectInfo, error)
@@ -151,7 +184,12 @@ type Connector interface {
 	FindTestById(string) ([]testresult.TestResult, error)
 	// FindTestsByTaskId returns a paginated list of TestResults from a required TaskID.
 	FindTestsByTaskId(FindTestsByTaskIdOpts) ([]testresult.TestResult, error)
-	GetTestCountByTaskIdAndFilters(string, string, []string, int) (int, error)
+	// GetTestCountByTaskIdAndFilters returns the number of tests for a task
+	// that match the given test name, statuses, tags and owners.
+	GetTestCountByTaskIdAndFilters(string, string, []string, []string, []string, int) (int, error)
+	// GetTestHistory returns the runs of a single test across a project's
+	// most recent mainline commits.
+	GetTestHistory(model.TestHistoryOptions) (*model.TestHistory, error)
 	FindTasksByVersion(string, TaskFilterOptions) ([]task.Task, int, error)
 	// FindUserById is a method to find a specific user given its ID.
 	FindUserById(string) (gimlet.User, error)
@@ -237,6 +275,9 @@ type Connector interface {
 	FindPatchById(string) (*restModel.APIPatch, error)
 	// FindPatchById fetches the patch corresponding to the input patch ID.
 	GetChildPatchIds(string) ([]string, error)
+	// FindLatestGithubPRPatch fetches the most recent patch for the PR with
+	// the given owner, repo, and PR number.
+	FindLatestGithubPRPatch(string, string, int) (*restModel.APIPatch, error)
 	//FindPatchesByIds fetches an array of patches that corresponding to the input patch IDs
 	FindPatchesByIds([]string) ([]restModel.APIPatch, error)
 	// GetPatchRawPatches fetches the raw patches for a patch
@@ -252,6 +293,8 @@ type Connector interface {
 
 	// RestartVersion restarts all completed tasks of a version given its ID and the caller.
 	RestartVersion(string, string) error
+	// RestartFailedVersionTasks restarts the failed tasks of a version given its ID and the caller.
+	RestartFailedVersionTasks(string, string) error
 	// SetPatchPriority and SetPatchActivated change the status of the input patch
 	SetPatchPriority(string, int64, string) error
 	SetPatchActivated(context.Context, string, string, bool, *evergreen.Settings) error
@@ -348,6 +391,9 @@ type Connector interface {
 	// GetSubscriptions returns the subscriptions that belong to a user
 	GetSubscriptions(string, event.OwnerType) ([]restModel.APISubscription, error)
 	DeleteSubscriptions(string, []string) error
+	// TestSubscription sends a test notification to the subscriber of the
+	// subscription with the given ID, if the user is allowed to edit it.
+	TestSubscription(context.Context, gimlet.User, string) error
 	// CopyProjectSubscriptions copies subscriptions from the first project for the second project.
 	CopyProjectSubscriptions(string, string) error
 	UpdateAdminRoles(*model.ProjectRef, []string, []string) error
@@ -364,12 +410,34 @@ type Connector interface {
 	GetTestStats(stats.StatsFilter) ([]restModel.APITestStats, error)
 	GetTaskStats(stats.StatsFilter) ([]restModel.APITaskStats, error)
 
+	// AttachTaskCoverage stores the coverage of source files reported by the task.
+	AttachTaskCoverage(*task.Task, []coverage.FileCoverage) error
+	// FindTaskCoverage returns the coverage reported by the task execution.
+	FindTaskCoverage(string, int) (*coverage.TaskCoverage, error)
+	// FindVersionCoverage returns the coverage of each build variant in the version.
+	FindVersionCoverage(string) ([]coverage.VersionCoverage, error)
+	// FindCoverageTrend returns the coverage of build variants in a project's versions.
+	FindCoverageTrend(coverage.TrendOptions) ([]coverage.VersionCoverage, error)
+
+	// AddPerfResults stores the metrics of the performance tests reported by the task.
+	AddPerfResults(*task.Task, []perf.TestResult) error
+	// FindPerfRegressions returns a project's performance regressions that match the options.
+	FindPerfRegressions(perf.RegressionOptions) ([]perf.Regression, error)
+	// FindPerfRegressionByID returns the performance regression with the given ID.
+	FindPerfRegressionByID(string) (*perf.Regression, error)
+	// TriagePerfRegression takes the regression ID, status, user, and note
+	// and sets the regression's triage state.
+	TriagePerfRegression(string, string, string, string) (*perf.Regression, error)
+
 	// Get task reliability scores
 	GetTaskReliabilityScores(reliability.TaskReliabilityFilter) ([]restModel.APITaskReliability, error)
 
 	// Commit queue methods
 	// GetGithubPR takes the owner, repo, and PR number.
 	GetGitHubPR(context.Context, string, string, int) (*github.PullRequest, error)
+	// PostGitHubPRComment takes the owner, repo, PR number, and the comment to
+	// leave on the PR.
+	PostGitHubPRComment(context.Context, string, string, int, string) error
 	// if bool is true, move the commit queue item to be processed next.
 	EnqueueItem(string, restModel.APICommitQueueItem, bool) (int, error)
 	AddPatchForPr(ctx context.Context, projectRef model.ProjectRef, prNum int, modules []restModel.APIModule, messageOverride string) (string, error)
@@ -2,6 +2,7 @@ package data
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/notification"
@@ -41,6 +42,17 @@ func (c *NotificationConnector) GetNotificationsStats() (*restModel.APIEventStat
 		}
 	}
 
+	sStats, err := notification.CollectSuppressedNotificationStats(time.Now().Add(-notification.SuppressedStatsWindow))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to collect suppressed notification stats")
+	}
+	if err = stats.SuppressedNotificationsByReason.BuildFromService(sStats); err != nil {
+		return nil, gimlet.ErrorResponse{
+			Message:    "failed to build stats response",
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
 	return &stats, nil
 }
 
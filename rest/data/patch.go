@@ -15,6 +15,7 @@ import (
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/evergreen/units"
 	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
 	"github.com/google/go-github/v34/github"
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/message"
@@ -86,6 +87,28 @@ func (pc *DBPatchConnector) FindPatchById(patchId string) (*restModel.APIPatch,
 	return &apiPatch, nil
 }
 
+// FindLatestGithubPRPatch queries the backing database for the most recent
+// patch created for the given pull request.
+func (pc *DBPatchConnector) FindLatestGithubPRPatch(owner, repo string, prNum int) (*restModel.APIPatch, error) {
+	p, err := patch.FindLatestGithubPRPatch(owner, repo, prNum)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't find patch for PR #%d in '%s/%s'", prNum, owner, repo)
+	}
+	if p == nil {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("no patch found for PR #%d in '%s/%s'", prNum, owner, repo),
+		}
+	}
+
+	apiPatch := restModel.APIPatch{}
+	if err = apiPatch.BuildFromService(*p); err != nil {
+		return nil, errors.Wrap(err, "problem converting patch")
+	}
+
+	return &apiPatch, nil
+}
+
 // GetChildPatchIds queries the backing database for the child patch ids
 func (pc *DBPatchConnector) GetChildPatchIds(patchId string) ([]string, error) {
 	if err := validatePatchID(patchId); err != nil {
@@ -356,6 +379,30 @@ func (pc *MockPatchConnector) FindPatchById(patchId string) (*restModel.APIPatch
 	}
 }
 
+// FindLatestGithubPRPatch returns the cached patch for the PR with the most
+// recent create time.
+func (pc *MockPatchConnector) FindLatestGithubPRPatch(owner, repo string, prNum int) (*restModel.APIPatch, error) {
+	var latest *restModel.APIPatch
+	for idx := range pc.CachedPatches {
+		p := &pc.CachedPatches[idx]
+		if p.GithubPatchData.PRNumber != prNum ||
+			utility.FromStringPtr(p.GithubPatchData.BaseOwner) != owner ||
+			utility.FromStringPtr(p.GithubPatchData.BaseRepo) != repo {
+			continue
+		}
+		if latest == nil || utility.FromTimePtr(p.CreateTime).After(utility.FromTimePtr(latest.CreateTime)) {
+			latest = p
+		}
+	}
+	if latest == nil {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("no patch found for PR #%d in '%s/%s'", prNum, owner, repo),
+		}
+	}
+	return latest, nil
+}
+
 // GetChildPatchIds is not implemented
 func (pc *MockPatchConnector) GetChildPatchIds(patchId string) ([]string, error) {
 	return nil, gimlet.ErrorResponse{
@@ -0,0 +1,122 @@
+package data
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/perf"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/pkg/errors"
+)
+
+type PerfConnector struct{}
+
+// AddPerfResults stores the metrics of the performance tests reported by the
+// task. Only the results of mainline tasks are stored, since change-point
+// detection only runs over mainline series.
+func (pc *PerfConnector) AddPerfResults(t *task.Task, results []perf.TestResult) error {
+	if t.Requester != evergreen.RepotrackerVersionRequester {
+		return nil
+	}
+	return perf.InsertResults(perf.NewResults(t, results))
+}
+
+// FindPerfRegressions returns a project's performance regressions that match
+// the options.
+func (pc *PerfConnector) FindPerfRegressions(opts perf.RegressionOptions) ([]perf.Regression, error) {
+	return perf.FindRegressions(opts)
+}
+
+// FindPerfRegressionByID returns the performance regression with the given
+// ID.
+func (pc *PerfConnector) FindPerfRegressionByID(id string) (*perf.Regression, error) {
+	r, err := perf.FindOneRegressionByID(id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding regression '%s'", id)
+	}
+	if r == nil {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("regression '%s' not found", id),
+		}
+	}
+	return r, nil
+}
+
+// TriagePerfRegression sets the regression's triage state.
+func (pc *PerfConnector) TriagePerfRegression(id, status, user, note string) (*perf.Regression, error) {
+	r, err := pc.FindPerfRegressionByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.Triage(status, user, note); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type MockPerfConnector struct {
+	CachedPerfResults []perf.Result
+	CachedRegressions []perf.Regression
+}
+
+// AddPerfResults adds the results of mainline tasks to the cached perf
+// results.
+func (mpc *MockPerfConnector) AddPerfResults(t *task.Task, results []perf.TestResult) error {
+	if t.Requester != evergreen.RepotrackerVersionRequester {
+		return nil
+	}
+	mpc.CachedPerfResults = append(mpc.CachedPerfResults, perf.NewResults(t, results)...)
+	return nil
+}
+
+// FindPerfRegressions returns the cached regressions, enforcing the project,
+// status, and limit fields of the options.
+func (mpc *MockPerfConnector) FindPerfRegressions(opts perf.RegressionOptions) ([]perf.Regression, error) {
+	var regressions []perf.Regression
+	for _, r := range mpc.CachedRegressions {
+		if r.Project != opts.Project {
+			continue
+		}
+		if opts.Status != "" && r.Status != opts.Status {
+			continue
+		}
+		regressions = append(regressions, r)
+	}
+	if opts.Limit > 0 && len(regressions) > opts.Limit {
+		regressions = regressions[:opts.Limit]
+	}
+	return regressions, nil
+}
+
+// FindPerfRegressionByID returns the cached regression with the given ID.
+func (mpc *MockPerfConnector) FindPerfRegressionByID(id string) (*perf.Regression, error) {
+	for i := range mpc.CachedRegressions {
+		if mpc.CachedRegressions[i].ID == id {
+			return &mpc.CachedRegressions[i], nil
+		}
+	}
+	return nil, gimlet.ErrorResponse{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("regression '%s' not found", id),
+	}
+}
+
+// TriagePerfRegression sets the triage state of the cached regression.
+func (mpc *MockPerfConnector) TriagePerfRegression(id, status, user, note string) (*perf.Regression, error) {
+	r, err := mpc.FindPerfRegressionByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err = perf.ValidateStatus(status); err != nil {
+		return nil, err
+	}
+	r.Status = status
+	r.TriagedBy = user
+	r.TriagedAt = time.Now()
+	r.Note = note
+	return r, nil
+}
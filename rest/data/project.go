@@ -9,6 +9,7 @@ import (
 	"github.com/evergreen-ci/evergreen"
 	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
 	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/artifact"
 	"github.com/evergreen-ci/evergreen/model/commitqueue"
 	"github.com/evergreen-ci/evergreen/model/user"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
@@ -124,6 +125,12 @@ func (pc *DBProjectConnector) GetProjectFromFile(ctx context.Context, pRef model
 
 // EnableWebhooks returns true if a hook for the given owner/repo exists or was inserted.
 func (pc *DBProjectConnector) EnableWebhooks(ctx context.Context, projectRef *model.ProjectRef) (bool, error) {
+	if projectRef.IsGitHosted() {
+		// There are no webhooks outside of GitHub, so the repotracker polls
+		// for new commits instead.
+		projectRef.TracksPushEvents = utility.FalsePtr()
+		return false, nil
+	}
 	hook, err := model.FindGithubHook(projectRef.Owner, projectRef.Repo)
 	if err != nil {
 		return false, errors.Wrapf(err, "Database error finding github hook for project '%s'", projectRef.Id)
@@ -367,6 +374,10 @@ func (ac *DBProjectConnector) GetRepoEventLog(repoId string, before time.Time, n
 	return getEventsById(repoId, before, n)
 }
 
+func (ac *DBProjectConnector) FindExpiredArtifacts(projectRef *model.ProjectRef, now time.Time, limit int) ([]artifact.Entry, error) {
+	return model.FindExpiredArtifacts(projectRef, now, limit)
+}
+
 func getEventsById(id string, before time.Time, n int) ([]restModel.APIProjectEvent, error) {
 	if n == 0 {
 		n = EventLogLimit
@@ -447,6 +458,8 @@ type MockProjectConnector struct {
 	CachedProjects []model.ProjectRef
 	CachedVars     []*model.ProjectVars
 	CachedEvents   []restModel.APIProjectEvent
+	// CachedExpiredArtifacts are returned by FindExpiredArtifacts.
+	CachedExpiredArtifacts []artifact.Entry
 }
 
 func (pc *MockProjectConnector) FindProjectById(projectId string, includeRepo bool, includeParserProject bool) (*model.ProjectRef, error) {
@@ -709,6 +722,10 @@ func (pc *MockProjectConnector) GetRepoEventLog(id string, before time.Time, n i
 	return pc.CachedEvents, nil
 }
 
+func (pc *MockProjectConnector) FindExpiredArtifacts(projectRef *model.ProjectRef, now time.Time, limit int) ([]artifact.Entry, error) {
+	return pc.CachedExpiredArtifacts, nil
+}
+
 func (pc *MockProjectConnector) GetProjectWithCommitQueueByOwnerRepoAndBranch(owner, repo, branch string) (*model.ProjectRef, error) {
 	for _, p := range pc.CachedProjects {
 		if p.Owner == owner && p.Repo == repo && p.Branch == branch && p.CommitQueue.IsEnabled() {
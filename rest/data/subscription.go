@@ -1,14 +1,18 @@
 package data
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
 
+	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/notification"
 	"github.com/evergreen-ci/evergreen/model/patch"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/evergreen/trigger"
+	"github.com/evergreen-ci/evergreen/units"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/grip"
@@ -57,6 +61,15 @@ func (dc *DBSubscriptionConnector) SaveSubscriptions(owner string, subscriptions
 			}
 		}
 
+		if dbSubscription.Subscriber.HasRedactedSecret() {
+			if err = restoreRedactedSecret(&dbSubscription); err != nil {
+				return gimlet.ErrorResponse{
+					StatusCode: http.StatusBadRequest,
+					Message:    err.Error(),
+				}
+			}
+		}
+
 		if ok, msg := event.IsSubscriptionAllowed(dbSubscription); !ok {
 			return gimlet.ErrorResponse{
 				StatusCode: http.StatusBadRequest,
@@ -144,6 +157,24 @@ func getVersionChildren(versionId string) ([]string, error) {
 
 }
 
+// restoreRedactedSecret replaces the redacted webhook secret of an exported
+// subscription with the secret of the existing subscription it was exported
+// from, which must belong to the same owner.
+func restoreRedactedSecret(sub *event.Subscription) error {
+	if sub.ID == "" {
+		return errors.New("webhook secret is redacted but the subscription has no ID")
+	}
+	existing, err := event.FindSubscriptionByID(sub.ID)
+	if err != nil {
+		return errors.Wrapf(err, "problem finding subscription '%s'", sub.ID)
+	}
+	if existing == nil || existing.Owner != sub.Owner || existing.OwnerType != sub.OwnerType {
+		return errors.Errorf("webhook secret is redacted but subscription '%s' does not exist", sub.ID)
+	}
+
+	return errors.Wrap(sub.Subscriber.RestoreRedactedSecret(existing.Subscriber), "problem restoring webhook secret")
+}
+
 func (dc *DBSubscriptionConnector) GetSubscriptions(owner string, ownerType event.OwnerType) ([]restModel.APISubscription, error) {
 	if len(owner) == 0 {
 		return nil, gimlet.ErrorResponse{
@@ -199,6 +230,54 @@ func (dc *DBSubscriptionConnector) DeleteSubscriptions(owner string, ids []strin
 	return catcher.Resolve()
 }
 
+func (dc *DBSubscriptionConnector) TestSubscription(ctx context.Context, u gimlet.User, id string) error {
+	subscription, err := event.FindSubscriptionByID(id)
+	if err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    err.Error(),
+		}
+	}
+	if subscription == nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    "Subscription not found",
+		}
+	}
+	if subscription.Owner != u.Username() {
+		if subscription.OwnerType != event.OwnerTypeProject || !u.HasPermission(gimlet.PermissionOpts{
+			Resource:      subscription.Owner,
+			ResourceType:  evergreen.ProjectResourceType,
+			Permission:    evergreen.PermissionProjectSettings,
+			RequiredLevel: evergreen.ProjectSettingsEdit.Value,
+		}) {
+			return gimlet.ErrorResponse{
+				StatusCode: http.StatusUnauthorized,
+				Message:    "Cannot test subscriptions for someone other than yourself",
+			}
+		}
+	}
+
+	env := evergreen.GetEnvironment()
+	uiConfig := evergreen.UIConfig{}
+	if err = uiConfig.Get(env); err != nil {
+		return errors.Wrap(err, "failed to fetch UI config")
+	}
+	n, err := trigger.MakeTestNotification(subscription, uiConfig)
+	if err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		}
+	}
+	if err = notification.InsertMany(*n); err != nil {
+		return errors.Wrap(err, "failed to insert test notification")
+	}
+
+	ts := utility.RoundPartOfMinute(1).Format(units.TSFormat)
+	return errors.Wrap(env.RemoteQueue().Put(ctx, units.NewEventSendJob(n.ID, ts)), "failed to enqueue test notification")
+}
+
 func (dc *DBSubscriptionConnector) CopyProjectSubscriptions(oldProject, newProject string) error {
 	subs, err := event.FindSubscriptionsByOwner(oldProject, event.OwnerTypeProject)
 	if err != nil {
@@ -252,6 +331,18 @@ func (mc *MockSubscriptionConnector) DeleteSubscriptions(owner string, ids []str
 	return nil
 }
 
+func (mc *MockSubscriptionConnector) TestSubscription(ctx context.Context, u gimlet.User, id string) error {
+	for _, sub := range mc.MockSubscriptions {
+		if utility.FromStringPtr(sub.ID) == id {
+			return nil
+		}
+	}
+	return gimlet.ErrorResponse{
+		StatusCode: http.StatusNotFound,
+		Message:    "Subscription not found",
+	}
+}
+
 func (mc *MockSubscriptionConnector) CopyProjectSubscriptions(oldProject, newProject string) error {
 	return nil
 }
@@ -99,6 +99,47 @@ func TestSaveProjectSubscriptions(t *testing.T) {
 		"DisallowedSubscription": func(t *testing.T, subs []restModel.APISubscription) {
 			assert.Error(t, c.SaveSubscriptions("me", []restModel.APISubscription{subs[2]}, false))
 		},
+		"RedactedWebhookSecretKeepsExistingSecret": func(t *testing.T, subs []restModel.APISubscription) {
+			webhookSub := event.Subscription{
+				ID:           mgobson.NewObjectId().Hex(),
+				Owner:        "my-project",
+				OwnerType:    event.OwnerTypeProject,
+				ResourceType: "PATCH",
+				Trigger:      "outcome",
+				Selectors: []event.Selector{
+					{
+						Type: "id",
+						Data: "1234",
+					},
+				},
+				Subscriber: event.Subscriber{
+					Type: event.EvergreenWebhookSubscriberType,
+					Target: &event.WebhookSubscriber{
+						URL:    "https://example.com/hook",
+						Secret: []byte("shh"),
+					},
+				},
+			}
+			require.NoError(t, webhookSub.Upsert())
+
+			exported := webhookSub
+			exported.Subscriber.RedactSecrets()
+			apiSub := restModel.APISubscription{}
+			require.NoError(t, apiSub.BuildFromService(exported))
+
+			assert.Error(t, c.SaveSubscriptions("other-project", []restModel.APISubscription{apiSub}, true), "secret should not be restored for a different owner")
+			require.NoError(t, c.SaveSubscriptions("my-project", []restModel.APISubscription{apiSub}, true))
+
+			dbSub, err := event.FindSubscriptionByID(webhookSub.ID)
+			require.NoError(t, err)
+			require.NotNil(t, dbSub)
+			webhook, ok := dbSub.Subscriber.Target.(*event.WebhookSubscriber)
+			require.True(t, ok)
+			assert.Equal(t, []byte("shh"), webhook.Secret)
+
+			apiSub.ID = nil
+			assert.Error(t, c.SaveSubscriptions("my-project", []restModel.APISubscription{apiSub}, true), "redacted secret requires an existing subscription")
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			assert.NoError(t, db.ClearCollections(event.SubscriptionsCollection))
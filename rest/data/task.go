@@ -1,13 +1,18 @@
 package data
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"time"
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/model"
 	serviceModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/artifact"
 	"github.com/evergreen-ci/evergreen/model/manifest"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/gimlet"
@@ -240,6 +245,104 @@ func (tc *DBTaskConnector) GetManifestByTask(taskId string) (*manifest.Manifest,
 	return mfest, nil
 }
 
+func (tc *DBTaskConnector) SearchTaskLogs(ctx context.Context, opts model.TaskLogSearchOptions) (*model.TaskLogSearchResult, error) {
+	result, err := model.SearchTaskLogs(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "searching logs for task '%s'", opts.TaskID)
+	}
+	return result, nil
+}
+
+func (tc *DBTaskConnector) GetTaskLogPage(ctx context.Context, opts model.TaskLogPageOptions) (*model.TaskLogPage, error) {
+	page, err := model.GetTaskLogPage(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting logs for task '%s'", opts.TaskID)
+	}
+	return page, nil
+}
+
+func (tc *DBTaskConnector) FindTaskArtifact(t *task.Task, name string) (*artifact.File, error) {
+	var entries []artifact.Entry
+	var err error
+	if t.DisplayOnly {
+		ets := []artifact.TaskIDAndExecution{}
+		for _, execTaskID := range t.ExecutionTasks {
+			ets = append(ets, artifact.TaskIDAndExecution{TaskID: execTaskID, Execution: t.Execution})
+		}
+		if len(ets) > 0 {
+			entries, err = artifact.FindAll(artifact.ByTaskIdsAndExecutions(ets))
+		}
+	} else {
+		entries, err = artifact.FindAll(artifact.ByTaskIdAndExecution(t.Id, t.Execution))
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding artifacts for task '%s'", t.Id)
+	}
+
+	var files []artifact.File
+	for _, entry := range entries {
+		files = append(files, entry.Files...)
+	}
+	return findVisibleArtifact(t.Id, files, name)
+}
+
+// findVisibleArtifact returns the file with the given name that users are
+// allowed to see.
+func findVisibleArtifact(taskID string, files []artifact.File, name string) (*artifact.File, error) {
+	for _, file := range files {
+		if file.Name != name || file.Visibility == artifact.None {
+			continue
+		}
+		if file.Expired {
+			return nil, gimlet.ErrorResponse{
+				StatusCode: http.StatusGone,
+				Message:    fmt.Sprintf("artifact '%s' for task '%s' has expired", name, taskID),
+			}
+		}
+		return &file, nil
+	}
+	return nil, gimlet.ErrorResponse{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("artifact '%s' for task '%s' not found", name, taskID),
+	}
+}
+
+func (tc *DBTaskConnector) GetArtifactContent(ctx context.Context, file artifact.File) (io.ReadCloser, string, error) {
+	contentURL, err := file.ContentURL()
+	if err != nil {
+		return nil, "", gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodGet, contentURL, nil)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "creating request")
+	}
+	req = req.WithContext(ctx)
+	pooledClient := utility.GetHTTPClient()
+	defer utility.PutHTTPClient(pooledClient)
+	// Redirects aren't followed, since they could lead away from S3.
+	client := *pooledClient
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "downloading artifact '%s'", file.Name)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, "", gimlet.ErrorResponse{
+			StatusCode: http.StatusBadGateway,
+			Message:    fmt.Sprintf("downloading artifact '%s' returned status '%s'", file.Name, resp.Status),
+		}
+	}
+
+	return resp.Body, resp.Header.Get("Content-Type"), nil
+}
+
 type TaskFilterOptions struct {
 	Statuses               []string
 	BaseStatuses           []string
@@ -286,6 +389,13 @@ type MockTaskConnector struct {
 	CachedAborted  map[string]string
 	StoredError    error
 	FailOnAbort    bool
+
+	CachedTaskLogSearchResult *model.TaskLogSearchResult
+	CachedTaskLogPage         *model.TaskLogPage
+	// CachedArtifacts are the artifact files of every task, and
+	// CachedArtifactContent is their content by name.
+	CachedArtifacts       []artifact.File
+	CachedArtifactContent map[string][]byte
 }
 
 // FindTaskById provides a mock implementation of the functions for the
@@ -460,6 +570,41 @@ func (tc *MockTaskConnector) GetManifestByTask(taskId string) (*manifest.Manifes
 	return nil, errors.Errorf("task '%s' not found", taskId)
 }
 
+func (tc *MockTaskConnector) SearchTaskLogs(ctx context.Context, opts model.TaskLogSearchOptions) (*model.TaskLogSearchResult, error) {
+	if tc.StoredError != nil {
+		return nil, tc.StoredError
+	}
+	if tc.CachedTaskLogSearchResult == nil {
+		return &model.TaskLogSearchResult{}, nil
+	}
+	return tc.CachedTaskLogSearchResult, nil
+}
+
+func (tc *MockTaskConnector) GetTaskLogPage(ctx context.Context, opts model.TaskLogPageOptions) (*model.TaskLogPage, error) {
+	if tc.StoredError != nil {
+		return nil, tc.StoredError
+	}
+	if tc.CachedTaskLogPage == nil {
+		return &model.TaskLogPage{NextTime: opts.StartTime, NextSequence: opts.StartSequence}, nil
+	}
+	return tc.CachedTaskLogPage, nil
+}
+
+func (tc *MockTaskConnector) FindTaskArtifact(t *task.Task, name string) (*artifact.File, error) {
+	return findVisibleArtifact(t.Id, tc.CachedArtifacts, name)
+}
+
+func (tc *MockTaskConnector) GetArtifactContent(ctx context.Context, file artifact.File) (io.ReadCloser, string, error) {
+	if tc.StoredError != nil {
+		return nil, "", tc.StoredError
+	}
+	content, ok := tc.CachedArtifactContent[file.Name]
+	if !ok {
+		return nil, "", errors.Errorf("artifact '%s' has no content", file.Name)
+	}
+	return ioutil.NopCloser(bytes.NewReader(content)), "", nil
+}
+
 func (tc *MockTaskConnector) FindTasksByVersion(string, TaskFilterOptions) ([]task.Task, int, error) {
 	return nil, 0, nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/gimlet"
@@ -34,7 +35,7 @@ func (tc *DBTestConnector) FindTestById(id string) ([]testresult.TestResult, err
 	return results, nil
 }
 
-func (tc *DBTestConnector) GetTestCountByTaskIdAndFilters(taskId, testName string, statuses []string, execution int) (int, error) {
+func (tc *DBTestConnector) GetTestCountByTaskIdAndFilters(taskId, testName string, statuses, tags, owners []string, execution int) (int, error) {
 	t, err := task.FindOneIdNewOrOld(taskId)
 	if err != nil {
 		return 0, errors.Wrapf(err, fmt.Sprintf("error finding task %s", taskId))
@@ -48,13 +49,38 @@ func (tc *DBTestConnector) GetTestCountByTaskIdAndFilters(taskId, testName strin
 	} else {
 		taskIds = []string{taskId}
 	}
-	count, err := testresult.TestResultCount(taskIds, testName, statuses, execution)
+	count, err := testresult.TestResultCount(taskIds, testName, statuses, tags, owners, execution)
 	if err != nil {
 		return 0, errors.Wrapf(err, fmt.Sprintf("Error counting test results for task %s", taskId))
 	}
 	return count, nil
 }
 
+func (tc *DBTestConnector) GetTestHistory(opts model.TestHistoryOptions) (*model.TestHistory, error) {
+	pRef, err := model.FindMergedProjectRef(opts.ProjectID, "", false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding project '%s'", opts.ProjectID)
+	}
+	if pRef == nil {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("project '%s' not found", opts.ProjectID),
+		}
+	}
+	if pRef.IsCedarTestResultsEnabled() {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("test history is not available for project '%s' because its test results are stored in Cedar", opts.ProjectID),
+		}
+	}
+
+	history, err := model.GetTestHistoryForTest(opts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting history for test '%s'", opts.TestName)
+	}
+	return history, nil
+}
+
 func (tc *DBTestConnector) FindTestsByTaskId(opts FindTestsByTaskIdOpts) ([]testresult.TestResult, error) {
 	t, err := task.FindOneIdNewOrOld(opts.TaskID)
 	if err != nil {
@@ -81,6 +107,8 @@ func (tc *DBTestConnector) FindTestsByTaskId(opts FindTestsByTaskIdOpts) ([]test
 		TaskIDs:   taskIDs,
 		TestName:  opts.TestName,
 		Statuses:  opts.Statuses,
+		Tags:      opts.Tags,
+		Owners:    opts.Owners,
 		SortBy:    opts.SortBy,
 		GroupID:   opts.GroupID,
 		SortDir:   opts.SortDir,
@@ -102,18 +130,29 @@ func (tc *DBTestConnector) FindTestsByTaskId(opts FindTestsByTaskIdOpts) ([]test
 // MockTaskConnector stores a cached set of tests that are queried against by the
 // implementations of the Connector interface's Test related functions.
 type MockTestConnector struct {
-	CachedTests []testresult.TestResult
-	StoredError error
+	CachedTests       []testresult.TestResult
+	CachedTestHistory *model.TestHistory
+	StoredError       error
 }
 
 func (mtc *MockTestConnector) FindTestById(id string) ([]testresult.TestResult, error) {
 	return nil, nil
 }
 
-func (tc *MockTestConnector) GetTestCountByTaskIdAndFilters(taskId, testName string, statuses []string, execution int) (int, error) {
+func (tc *MockTestConnector) GetTestCountByTaskIdAndFilters(taskId, testName string, statuses, tags, owners []string, execution int) (int, error) {
 	return 0, nil
 }
 
+func (mtc *MockTestConnector) GetTestHistory(opts model.TestHistoryOptions) (*model.TestHistory, error) {
+	if mtc.StoredError != nil {
+		return nil, mtc.StoredError
+	}
+	if mtc.CachedTestHistory == nil {
+		return &model.TestHistory{}, nil
+	}
+	return mtc.CachedTestHistory, nil
+}
+
 func (mtc *MockTestConnector) FindTestsByTaskId(opts FindTestsByTaskIdOpts) ([]testresult.TestResult, error) {
 	if mtc.StoredError != nil {
 		return []testresult.TestResult{}, mtc.StoredError
@@ -10,9 +10,11 @@ import (
 
 	"github.com/evergreen-ci/evergreen/db"
 	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -95,51 +97,51 @@ func TestGetTestCountByTaskIdAndFilter(t *testing.T) {
 
 	for i := 0; i < numTasks; i++ {
 		taskId := fmt.Sprintf("task_%d", i)
-		count, err := serviceContext.GetTestCountByTaskIdAndFilters(taskId, "", []string{}, 0)
+		count, err := serviceContext.GetTestCountByTaskIdAndFilters(taskId, "", []string{}, nil, nil, 0)
 		assert.NoError(err)
 		assert.Equal(count, numTests)
 
-		count, err = serviceContext.GetTestCountByTaskIdAndFilters(taskId, "", []string{"pass"}, 0)
+		count, err = serviceContext.GetTestCountByTaskIdAndFilters(taskId, "", []string{"pass"}, nil, nil, 0)
 		assert.NoError(err)
 		assert.Equal(count, numTests/2)
 
-		count, err = serviceContext.GetTestCountByTaskIdAndFilters(taskId, "", []string{"fail"}, 0)
+		count, err = serviceContext.GetTestCountByTaskIdAndFilters(taskId, "", []string{"fail"}, nil, nil, 0)
 		assert.NoError(err)
 		assert.Equal(count, numTests/2)
 
-		count, err = serviceContext.GetTestCountByTaskIdAndFilters(taskId, "", []string{"pass", "fail"}, 0)
+		count, err = serviceContext.GetTestCountByTaskIdAndFilters(taskId, "", []string{"pass", "fail"}, nil, nil, 0)
 		assert.NoError(err)
 		assert.Equal(count, 10)
 
-		count, err = serviceContext.GetTestCountByTaskIdAndFilters(taskId, "TestSuite/TestNum1", []string{}, 0)
+		count, err = serviceContext.GetTestCountByTaskIdAndFilters(taskId, "TestSuite/TestNum1", []string{}, nil, nil, 0)
 		assert.NoError(err)
 		assert.Equal(count, 1)
 
-		count, err = serviceContext.GetTestCountByTaskIdAndFilters(taskId, "TestSuite/TestNum2", []string{}, 0)
+		count, err = serviceContext.GetTestCountByTaskIdAndFilters(taskId, "TestSuite/TestNum2", []string{}, nil, nil, 0)
 		assert.NoError(err)
 		assert.Equal(count, 1)
 
-		count, err = serviceContext.GetTestCountByTaskIdAndFilters(taskId, "TestSuite/TestN", []string{}, 0)
+		count, err = serviceContext.GetTestCountByTaskIdAndFilters(taskId, "TestSuite/TestN", []string{}, nil, nil, 0)
 		assert.NoError(err)
 		assert.Equal(count, numTests)
 
-		count, err = serviceContext.GetTestCountByTaskIdAndFilters(taskId, "TestSuite/TestN", []string{"pass", "fail"}, 0)
+		count, err = serviceContext.GetTestCountByTaskIdAndFilters(taskId, "TestSuite/TestN", []string{"pass", "fail"}, nil, nil, 0)
 		assert.NoError(err)
 		assert.Equal(count, numTests)
 
-		count, err = serviceContext.GetTestCountByTaskIdAndFilters(taskId, "TestSuite/TestN", []string{"pass"}, 0)
+		count, err = serviceContext.GetTestCountByTaskIdAndFilters(taskId, "TestSuite/TestN", []string{"pass"}, nil, nil, 0)
 		assert.NoError(err)
 		assert.Equal(count, numTests/2)
 
-		count, err = serviceContext.GetTestCountByTaskIdAndFilters(taskId, "", []string{"pa"}, 0)
+		count, err = serviceContext.GetTestCountByTaskIdAndFilters(taskId, "", []string{"pa"}, nil, nil, 0)
 		assert.NoError(err)
 		assert.Equal(count, 0)
 
-		count, err = serviceContext.GetTestCountByTaskIdAndFilters(taskId, "", []string{"not_a_real_status"}, 0)
+		count, err = serviceContext.GetTestCountByTaskIdAndFilters(taskId, "", []string{"not_a_real_status"}, nil, nil, 0)
 		assert.NoError(err)
 		assert.Equal(count, 0)
 	}
-	count, err := serviceContext.GetTestCountByTaskIdAndFilters("fake_task", "", []string{}, 0)
+	count, err := serviceContext.GetTestCountByTaskIdAndFilters("fake_task", "", []string{}, nil, nil, 0)
 	assert.Error(err)
 	assert.Equal(count, 0)
 }
@@ -403,3 +405,40 @@ func TestFindTestsByDisplayTaskId(t *testing.T) {
 	assert.Error(err)
 	assert.Len(foundTests, 0)
 }
+
+func TestGetTestHistory(t *testing.T) {
+	require.NoError(t, db.ClearCollections(model.ProjectRefCollection, model.VersionCollection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(model.ProjectRefCollection, model.VersionCollection))
+	}()
+	pRef := &model.ProjectRef{
+		Id:                      "cedar",
+		CedarTestResultsEnabled: utility.TruePtr(),
+	}
+	require.NoError(t, pRef.Insert())
+	pRef = &model.ProjectRef{Id: "evergreen"}
+	require.NoError(t, pRef.Insert())
+
+	sc := &DBConnector{}
+	t.Run("CedarProject", func(t *testing.T) {
+		history, err := sc.GetTestHistory(model.TestHistoryOptions{ProjectID: "cedar", TestName: "test"})
+		require.Error(t, err)
+		assert.Nil(t, history)
+		apiErr, ok := err.(gimlet.ErrorResponse)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	})
+	t.Run("NonexistentProject", func(t *testing.T) {
+		_, err := sc.GetTestHistory(model.TestHistoryOptions{ProjectID: "nonexistent", TestName: "test"})
+		require.Error(t, err)
+		apiErr, ok := err.(gimlet.ErrorResponse)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	})
+	t.Run("EvergreenProject", func(t *testing.T) {
+		history, err := sc.GetTestHistory(model.TestHistoryOptions{ProjectID: "evergreen", TestName: "test"})
+		require.NoError(t, err)
+		require.NotNil(t, history)
+		assert.Empty(t, history.Runs)
+	})
+}
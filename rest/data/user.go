@@ -29,7 +29,14 @@ func (tc *DBUserConnector) FindUserById(userId string) (gimlet.User, error) {
 }
 
 func (tc *DBUserConnector) FindUserByGithubName(name string) (gimlet.User, error) {
-	return user.FindByGithubName(name)
+	u, err := user.FindByGithubName(name)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil {
+		return nil, nil
+	}
+	return u, nil
 }
 
 func (u *DBUserConnector) AddPublicKey(user *user.DBUser, keyName, keyValue string) error {
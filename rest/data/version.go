@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"net/http"
 	"sort"
+	"time"
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/repotracker"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/thirdparty"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/mongodb/grip"
 	"github.com/pkg/errors"
@@ -57,6 +59,22 @@ func (vc *DBVersionConnector) RestartVersion(versionId string, caller string) er
 	return model.RestartTasksInVersion(versionId, true, caller)
 }
 
+// RestartFailedVersionTasks restarts the tasks in the version that failed.
+func (vc *DBVersionConnector) RestartFailedVersionTasks(versionId string, caller string) error {
+	failedTasks, err := task.FindAll(task.FailedTasksByVersion(versionId))
+	if err != nil {
+		return errors.Wrapf(err, "can't find failed tasks in version '%s'", versionId)
+	}
+	if len(failedTasks) == 0 {
+		return nil
+	}
+	taskIds := make([]string, 0, len(failedTasks))
+	for _, t := range failedTasks {
+		taskIds = append(taskIds, t.Id)
+	}
+	return model.RestartVersion(versionId, taskIds, false, caller)
+}
+
 func (bc *DBVersionConnector) LoadProjectForVersion(v *model.Version, projectId string) (model.ProjectInfo, error) {
 	return model.LoadProjectForVersion(v, projectId, false)
 }
@@ -307,6 +325,65 @@ func (vc *DBVersionConnector) CreateVersionFromConfig(ctx context.Context, proje
 	return newVersion, nil
 }
 
+func (vc *DBVersionConnector) CreateVersionForGithubMergeGroup(ctx context.Context, pRef model.ProjectRef,
+	group thirdparty.GithubMergeGroup) (*model.Version, error) {
+	// GitHub may deliver the same merge group event more than once.
+	existing, err := model.VersionFindOne(model.VersionByGithubMergeGroup(pRef.Id, group.HeadSHA))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error finding existing version for merge group '%s'", group.HeadSHA)
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	// the GitHub token is looked up when the config is read, if the
+	// project's code host needs one
+	opts := model.GetProjectOpts{
+		Ref:          &pRef,
+		Revision:     group.HeadSHA,
+		ReadFileFrom: model.ReadfromGithub,
+	}
+	projectInfo, err := model.GetProjectFromFile(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error loading project config at merge group commit '%s'", group.HeadSHA)
+	}
+	projectInfo.Ref = &pRef
+
+	revision := model.Revision{
+		Revision:   group.HeadSHA,
+		CreateTime: time.Now(),
+	}
+	if commit := group.HeadCommit; commit != nil {
+		revision.RevisionMessage = commit.GetMessage()
+		revision.Author = commit.GetAuthor().GetName()
+		revision.AuthorEmail = commit.GetAuthor().GetEmail()
+	}
+	metadata := model.VersionMetadata{
+		Revision:         revision,
+		Alias:            evergreen.CommitQueueAlias,
+		GithubMergeGroup: &group,
+	}
+
+	return vc.CreateVersionFromConfig(ctx, &projectInfo, metadata, true)
+}
+
+func (vc *DBVersionConnector) AbortGithubMergeGroupVersions(projectId, headSHA, caller string) error {
+	versions, err := model.VersionFind(model.VersionByGithubMergeGroup(projectId, headSHA))
+	if err != nil {
+		return errors.Wrapf(err, "error finding versions for merge group '%s'", headSHA)
+	}
+
+	catcher := grip.NewBasicCatcher()
+	for _, v := range versions {
+		if err = model.SetVersionActivation(v.Id, false, caller); err != nil {
+			catcher.Wrapf(err, "error deactivating version '%s'", v.Id)
+			continue
+		}
+		catcher.Wrapf(task.AbortVersion(v.Id, task.AbortInfo{User: caller}), "error aborting version '%s'", v.Id)
+	}
+	return catcher.Resolve()
+}
+
 // MockVersionConnector stores a cached set of tasks that are queried against by the
 // implementations of the Connector interface's Version related functions.
 type MockVersionConnector struct {
@@ -369,6 +446,11 @@ func (mvc *MockVersionConnector) RestartVersion(versionId string, caller string)
 	return nil
 }
 
+func (mvc *MockVersionConnector) RestartFailedVersionTasks(versionId string, caller string) error {
+	mvc.CachedRestartedVersions[versionId] = caller
+	return nil
+}
+
 func (mvc *MockVersionConnector) GetVersionsAndVariants(skip, numVersionElements int, project *model.Project) (*restModel.VersionVariantData, error) {
 	return nil, nil
 }
@@ -380,6 +462,26 @@ func (mvc *MockVersionConnector) CreateVersionFromConfig(ctx context.Context, pr
 	}, nil
 }
 
+func (mvc *MockVersionConnector) CreateVersionForGithubMergeGroup(ctx context.Context, pRef model.ProjectRef, group thirdparty.GithubMergeGroup) (*model.Version, error) {
+	for _, v := range mvc.CachedVersions {
+		if v.Identifier == pRef.Id && v.Revision == group.HeadSHA && v.Requester == evergreen.GithubMergeRequester {
+			return &v, nil
+		}
+	}
+	v := model.Version{
+		Id:         fmt.Sprintf("%s_merge_group_%s", pRef.Identifier, group.HeadSHA),
+		Identifier: pRef.Id,
+		Revision:   group.HeadSHA,
+		Requester:  evergreen.GithubMergeRequester,
+	}
+	mvc.CachedVersions = append(mvc.CachedVersions, v)
+	return &v, nil
+}
+
+func (mvc *MockVersionConnector) AbortGithubMergeGroupVersions(projectId, headSHA, caller string) error {
+	return nil
+}
+
 func (mvc *MockVersionConnector) LoadProjectForVersion(v *model.Version, projectId string) (model.ProjectInfo, error) {
 	if v.Config != "" {
 		p := &model.Project{}
@@ -12,6 +12,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/user"
+	"github.com/evergreen-ci/evergreen/thirdparty"
 	"github.com/evergreen-ci/utility"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -117,6 +118,26 @@ func (s *VersionConnectorSuite) TestFindVersionByIdFail() {
 	s.Nil(v)
 }
 
+func (s *VersionConnectorSuite) TestCreateVersionForGithubMergeGroupReturnsExistingVersion() {
+	existing := model.Version{
+		Id:         "merge_version",
+		Identifier: "project",
+		Revision:   "abcdef",
+		Requester:  evergreen.GithubMergeRequester,
+	}
+	if s.isMock {
+		mock := s.ctx.(*MockConnector)
+		mock.MockVersionConnector.CachedVersions = append(mock.MockVersionConnector.CachedVersions, existing)
+	} else {
+		s.Require().NoError(existing.Insert())
+	}
+
+	v, err := s.ctx.CreateVersionForGithubMergeGroup(context.Background(), model.ProjectRef{Id: "project", Identifier: "project"}, thirdparty.GithubMergeGroup{HeadSHA: "abcdef"})
+	s.Require().NoError(err)
+	s.Require().NotNil(v)
+	s.Equal("merge_version", v.Id)
+}
+
 func (s *VersionConnectorSuite) TestAbortVersion() {
 	versionId := "version1"
 	err := s.ctx.AbortVersion(versionId, "")
@@ -1106,10 +1106,11 @@ func (a *APILogBuffering) ToService() (interface{}, error) {
 }
 
 type APINotifyConfig struct {
-	BufferTargetPerInterval int           `json:"buffer_target_per_interval"`
-	BufferIntervalSeconds   int           `json:"buffer_interval_seconds"`
-	EventProcessingLimit    int           `json:"event_processing_limit"`
-	SMTP                    APISMTPConfig `json:"smtp"`
+	BufferTargetPerInterval int                             `json:"buffer_target_per_interval"`
+	BufferIntervalSeconds   int                             `json:"buffer_interval_seconds"`
+	EventProcessingLimit    int                             `json:"event_processing_limit"`
+	RateLimits              APINotificationRateLimitsConfig `json:"rate_limits"`
+	SMTP                    APISMTPConfig                   `json:"smtp"`
 }
 
 func (a *APINotifyConfig) BuildFromService(h interface{}) error {
@@ -1122,6 +1123,9 @@ func (a *APINotifyConfig) BuildFromService(h interface{}) error {
 		a.BufferTargetPerInterval = v.BufferTargetPerInterval
 		a.BufferIntervalSeconds = v.BufferIntervalSeconds
 		a.EventProcessingLimit = v.EventProcessingLimit
+		if err := a.RateLimits.BuildFromService(v.RateLimits); err != nil {
+			return err
+		}
 	default:
 		return errors.Errorf("%T is not a supported type", h)
 	}
@@ -1133,14 +1137,65 @@ func (a *APINotifyConfig) ToService() (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	rateLimits, err := a.RateLimits.ToService()
+	if err != nil {
+		return nil, err
+	}
 	return evergreen.NotifyConfig{
 		BufferTargetPerInterval: a.BufferTargetPerInterval,
 		BufferIntervalSeconds:   a.BufferIntervalSeconds,
 		EventProcessingLimit:    a.EventProcessingLimit,
+		RateLimits:              rateLimits.(evergreen.NotificationRateLimitsConfig),
 		SMTP:                    smtp.(evergreen.SMTPConfig),
 	}, nil
 }
 
+type APINotificationRateLimitsConfig struct {
+	IntervalSeconds      int                          `json:"interval_seconds"`
+	SubscriberLimit      int                          `json:"subscriber_limit"`
+	SubscriberTypeLimits []APISubscriberTypeRateLimit `json:"subscriber_type_limits"`
+	CollapseIdentical    bool                         `json:"collapse_identical"`
+}
+
+type APISubscriberTypeRateLimit struct {
+	SubscriberType *string `json:"subscriber_type"`
+	Limit          int     `json:"limit"`
+}
+
+func (a *APINotificationRateLimitsConfig) BuildFromService(h interface{}) error {
+	switch v := h.(type) {
+	case evergreen.NotificationRateLimitsConfig:
+		a.IntervalSeconds = v.IntervalSeconds
+		a.SubscriberLimit = v.SubscriberLimit
+		a.CollapseIdentical = v.CollapseIdentical
+		a.SubscriberTypeLimits = nil
+		for _, l := range v.SubscriberTypeLimits {
+			a.SubscriberTypeLimits = append(a.SubscriberTypeLimits, APISubscriberTypeRateLimit{
+				SubscriberType: utility.ToStringPtr(l.SubscriberType),
+				Limit:          l.Limit,
+			})
+		}
+	default:
+		return errors.Errorf("%T is not a supported type", h)
+	}
+	return nil
+}
+
+func (a *APINotificationRateLimitsConfig) ToService() (interface{}, error) {
+	config := evergreen.NotificationRateLimitsConfig{
+		IntervalSeconds:   a.IntervalSeconds,
+		SubscriberLimit:   a.SubscriberLimit,
+		CollapseIdentical: a.CollapseIdentical,
+	}
+	for _, l := range a.SubscriberTypeLimits {
+		config.SubscriberTypeLimits = append(config.SubscriberTypeLimits, evergreen.SubscriberTypeRateLimit{
+			SubscriberType: utility.FromStringPtr(l.SubscriberType),
+			Limit:          l.Limit,
+		})
+	}
+	return config, nil
+}
+
 type APICloudProviders struct {
 	AWS       *APIAWSConfig       `json:"aws"`
 	Docker    *APIDockerConfig    `json:"docker"`
@@ -1929,6 +1984,9 @@ type APIServiceFlags struct {
 	HostAllocatorDisabled      bool `json:"host_allocator_disabled"`
 	BackgroundReauthDisabled   bool `json:"background_reauth_disabled"`
 	BackgroundCleanupDisabled  bool `json:"background_cleanup_disabled"`
+	FailureClusteringDisabled  bool `json:"failure_clustering_disabled"`
+	ArtifactRetentionDisabled  bool `json:"artifact_retention_disabled"`
+	PerfAnalysisDisabled       bool `json:"perf_analysis_disabled"`
 
 	// Notifications Flags
 	EventProcessingDisabled      bool `json:"event_processing_disabled"`
@@ -2192,6 +2250,9 @@ func (as *APIServiceFlags) BuildFromService(h interface{}) error {
 		as.PlannerDisabled = v.PlannerDisabled
 		as.HostAllocatorDisabled = v.HostAllocatorDisabled
 		as.BackgroundCleanupDisabled = v.BackgroundCleanupDisabled
+		as.FailureClusteringDisabled = v.FailureClusteringDisabled
+		as.ArtifactRetentionDisabled = v.ArtifactRetentionDisabled
+		as.PerfAnalysisDisabled = v.PerfAnalysisDisabled
 		as.BackgroundReauthDisabled = v.BackgroundReauthDisabled
 	default:
 		return errors.Errorf("%T is not a supported service flags type", h)
@@ -2229,6 +2290,9 @@ func (as *APIServiceFlags) ToService() (interface{}, error) {
 		PlannerDisabled:              as.PlannerDisabled,
 		HostAllocatorDisabled:        as.HostAllocatorDisabled,
 		BackgroundCleanupDisabled:    as.BackgroundCleanupDisabled,
+		FailureClusteringDisabled:    as.FailureClusteringDisabled,
+		ArtifactRetentionDisabled:    as.ArtifactRetentionDisabled,
+		PerfAnalysisDisabled:         as.PerfAnalysisDisabled,
 		BackgroundReauthDisabled:     as.BackgroundReauthDisabled,
 	}, nil
 }
@@ -133,6 +133,9 @@ func TestModelConversion(t *testing.T) {
 	assert.EqualValues(testSettings.Notify.SMTP.From, utility.FromStringPtr(apiSettings.Notify.SMTP.From))
 	assert.EqualValues(testSettings.Notify.SMTP.Port, apiSettings.Notify.SMTP.Port)
 	assert.Equal(len(testSettings.Notify.SMTP.AdminEmail), len(apiSettings.Notify.SMTP.AdminEmail))
+	assert.EqualValues(testSettings.Notify.RateLimits.SubscriberLimit, apiSettings.Notify.RateLimits.SubscriberLimit)
+	assert.EqualValues(testSettings.Notify.RateLimits.SubscriberTypeLimits[0].SubscriberType, utility.FromStringPtr(apiSettings.Notify.RateLimits.SubscriberTypeLimits[0].SubscriberType))
+	assert.EqualValues(testSettings.Notify.RateLimits.CollapseIdentical, apiSettings.Notify.RateLimits.CollapseIdentical)
 	assert.EqualValues(testSettings.PodInit.S3BaseURL, utility.FromStringPtr(apiSettings.PodInit.S3BaseURL))
 	assert.EqualValues(testSettings.Providers.AWS.EC2Keys[0].Name, utility.FromStringPtr(apiSettings.Providers.AWS.EC2Keys[0].Name))
 	assert.EqualValues(testSettings.Providers.AWS.EC2Keys[0].Key, utility.FromStringPtr(apiSettings.Providers.AWS.EC2Keys[0].Key))
@@ -215,6 +218,7 @@ func TestModelConversion(t *testing.T) {
 	assert.EqualValues(testSettings.Notify.SMTP.From, dbSettings.Notify.SMTP.From)
 	assert.EqualValues(testSettings.Notify.SMTP.Port, dbSettings.Notify.SMTP.Port)
 	assert.Equal(len(testSettings.Notify.SMTP.AdminEmail), len(dbSettings.Notify.SMTP.AdminEmail))
+	assert.EqualValues(testSettings.Notify.RateLimits, dbSettings.Notify.RateLimits)
 	assert.EqualValues(testSettings.PodInit.S3BaseURL, dbSettings.PodInit.S3BaseURL)
 	assert.EqualValues(testSettings.Providers.AWS.EC2Keys[0].Name, dbSettings.Providers.AWS.EC2Keys[0].Name)
 	assert.EqualValues(testSettings.Providers.AWS.EC2Keys[0].Key, dbSettings.Providers.AWS.EC2Keys[0].Key)
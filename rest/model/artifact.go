@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/evergreen-ci/evergreen/model/artifact"
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/grip"
@@ -12,6 +14,7 @@ type APIFile struct {
 	Link           *string `json:"url"`
 	Visibility     *string `json:"visibility"`
 	IgnoreForFetch bool    `json:"ignore_for_fetch"`
+	Expired        bool    `json:"expired"`
 }
 
 type APIEntry struct {
@@ -29,6 +32,7 @@ func (f *APIFile) BuildFromService(h interface{}) error {
 		f.Link = utility.ToStringPtr(v.Link)
 		f.Visibility = utility.ToStringPtr(v.Visibility)
 		f.IgnoreForFetch = v.IgnoreForFetch
+		f.Expired = v.Expired
 	default:
 		return errors.Errorf("%T is not a supported type", h)
 	}
@@ -41,6 +45,7 @@ func (f *APIFile) ToService() (interface{}, error) {
 		Link:           utility.FromStringPtr(f.Link),
 		Visibility:     utility.FromStringPtr(f.Visibility),
 		IgnoreForFetch: f.IgnoreForFetch,
+		Expired:        f.Expired,
 	}, nil
 }
 
@@ -89,3 +94,101 @@ func (e *APIEntry) ToService() (interface{}, error) {
 
 	return entry, nil
 }
+
+// APIArtifactRetentionReport lists the files that a project's artifact
+// retention policy would delete the next time it is applied.
+type APIArtifactRetentionReport struct {
+	ProjectId *string              `json:"project_id"`
+	NumTasks  int                  `json:"num_tasks"`
+	NumFiles  int                  `json:"num_files"`
+	Artifacts []APIExpiredArtifact `json:"artifacts"`
+}
+
+type APIExpiredArtifact struct {
+	TaskId          *string          `json:"task_id"`
+	TaskDisplayName *string          `json:"task_name"`
+	Execution       int              `json:"execution"`
+	VersionId       *string          `json:"version_id"`
+	Requester       *string          `json:"requester"`
+	CreateTime      *time.Time       `json:"create_time"`
+	Files           []APIExpiredFile `json:"files"`
+}
+
+type APIExpiredFile struct {
+	Name    *string `json:"name"`
+	Bucket  *string `json:"bucket"`
+	FileKey *string `json:"file_key"`
+}
+
+func (r *APIArtifactRetentionReport) BuildFromService(h interface{}) error {
+	entries, ok := h.([]artifact.Entry)
+	if !ok {
+		return errors.Errorf("%T is not a supported type", h)
+	}
+
+	r.NumTasks = len(entries)
+	r.NumFiles = 0
+	r.Artifacts = []APIExpiredArtifact{}
+	for _, entry := range entries {
+		expired := APIExpiredArtifact{
+			TaskId:          utility.ToStringPtr(entry.TaskId),
+			TaskDisplayName: utility.ToStringPtr(entry.TaskDisplayName),
+			Execution:       entry.Execution,
+			VersionId:       utility.ToStringPtr(entry.VersionId),
+			Requester:       utility.ToStringPtr(entry.Requester),
+			CreateTime:      ToTimePtr(entry.CreateTime),
+			Files:           []APIExpiredFile{},
+		}
+		for _, file := range entry.Files {
+			expired.Files = append(expired.Files, APIExpiredFile{
+				Name:    utility.ToStringPtr(file.Name),
+				Bucket:  utility.ToStringPtr(file.Bucket),
+				FileKey: utility.ToStringPtr(file.FileKey),
+			})
+		}
+		r.NumFiles += len(entry.Files)
+		r.Artifacts = append(r.Artifacts, expired)
+	}
+	return nil
+}
+
+func (r *APIArtifactRetentionReport) ToService() (interface{}, error) {
+	return nil, errors.New("not implemented")
+}
+
+// APIArtifactArchive lists the contents of an artifact that is a tar or zip
+// archive.
+type APIArtifactArchive struct {
+	Name    *string           `json:"name"`
+	Format  *string           `json:"format"`
+	Entries []APIArchiveEntry `json:"entries"`
+}
+
+type APIArchiveEntry struct {
+	Path    *string    `json:"path"`
+	Size    int64      `json:"size"`
+	IsDir   bool       `json:"is_dir"`
+	ModTime *time.Time `json:"mod_time"`
+}
+
+func (a *APIArtifactArchive) BuildFromService(h interface{}) error {
+	entries, ok := h.([]artifact.ArchiveEntry)
+	if !ok {
+		return errors.Errorf("%T is not a supported type", h)
+	}
+
+	a.Entries = []APIArchiveEntry{}
+	for _, entry := range entries {
+		a.Entries = append(a.Entries, APIArchiveEntry{
+			Path:    utility.ToStringPtr(entry.Path),
+			Size:    entry.Size,
+			IsDir:   entry.IsDir,
+			ModTime: ToTimePtr(entry.ModTime),
+		})
+	}
+	return nil
+}
+
+func (a *APIArtifactArchive) ToService() (interface{}, error) {
+	return nil, errors.New("not implemented")
+}
@@ -15,11 +15,12 @@ import (
 )
 
 var (
-	commitOrigin  = "commit"
-	patchOrigin   = "patch"
-	triggerOrigin = "trigger"
-	triggerAdHoc  = "ad_hoc"
-	gitTagOrigin  = "git_tag"
+	commitOrigin      = "commit"
+	patchOrigin       = "patch"
+	triggerOrigin     = "trigger"
+	triggerAdHoc      = "ad_hoc"
+	gitTagOrigin      = "git_tag"
+	githubMergeOrigin = "github_merge_queue"
 )
 
 // APIBuild is the model to be returned by the API whenever builds are fetched.
@@ -92,6 +93,8 @@ func (apiBuild *APIBuild) BuildFromService(h interface{}) error {
 		origin = triggerAdHoc
 	case evergreen.GitTagRequester:
 		origin = gitTagOrigin
+	case evergreen.GithubMergeRequester:
+		origin = githubMergeOrigin
 	}
 	apiBuild.Origin = utility.ToStringPtr(origin)
 	if v.Project != "" {
@@ -26,6 +26,8 @@ type APICommitQueueItem struct {
 	Patch           *APIPatch   `json:"patch"`
 	MessageOverride *string     `json:"message_override"`
 	Source          *string     `json:"source"`
+	Lane            *string     `json:"lane"`
+	Author          *string     `json:"author"`
 }
 
 type APICommitQueuePosition struct {
@@ -69,6 +71,8 @@ func (item *APICommitQueueItem) BuildFromService(h interface{}) error {
 	item.MessageOverride = utility.ToStringPtr(cqItemService.MessageOverride)
 	item.Source = utility.ToStringPtr(cqItemService.Source)
 	item.PatchId = utility.ToStringPtr(cqItemService.PatchId)
+	item.Lane = utility.ToStringPtr(cqItemService.Lane)
+	item.Author = utility.ToStringPtr(cqItemService.Author)
 
 	for _, module := range cqItemService.Modules {
 		item.Modules = append(item.Modules, *APIModuleBuildFromService(module))
@@ -84,6 +88,8 @@ func (item *APICommitQueueItem) ToService() (interface{}, error) {
 		MessageOverride: utility.FromStringPtr(item.MessageOverride),
 		Source:          utility.FromStringPtr(item.Source),
 		PatchId:         utility.FromStringPtr(item.PatchId),
+		Lane:            utility.FromStringPtr(item.Lane),
+		Author:          utility.FromStringPtr(item.Author),
 	}
 	for _, module := range item.Modules {
 		serviceItem.Modules = append(serviceItem.Modules, *APIModuleToService(module))
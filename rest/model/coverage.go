@@ -0,0 +1,122 @@
+package model
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/coverage"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// APICoverageSummary is the number of lines covered out of the number of
+// lines that can be covered.
+type APICoverageSummary struct {
+	LinesCovered int     `json:"lines_covered"`
+	LinesTotal   int     `json:"lines_total"`
+	Percent      float64 `json:"percent"`
+}
+
+func (s *APICoverageSummary) buildFromService(summary coverage.Summary) {
+	s.LinesCovered = summary.LinesCovered
+	s.LinesTotal = summary.LinesTotal
+	s.Percent = summary.Percent()
+}
+
+// APIFileCoverage is the coverage of a source file.
+type APIFileCoverage struct {
+	Path *string `json:"path"`
+	APICoverageSummary
+}
+
+func (f *APIFileCoverage) BuildFromService(h interface{}) error {
+	switch v := h.(type) {
+	case coverage.FileCoverage:
+		f.Path = utility.ToStringPtr(v.Path)
+		f.buildFromService(v.Summary)
+	default:
+		return errors.Errorf("%T is not a supported type", h)
+	}
+	return nil
+}
+
+func (f *APIFileCoverage) ToService() (interface{}, error) {
+	return nil, errors.New("not implemented")
+}
+
+// APITaskCoverage is the coverage reported by a task execution.
+type APITaskCoverage struct {
+	TaskID       *string           `json:"task_id"`
+	Execution    int               `json:"execution"`
+	TaskName     *string           `json:"task_name"`
+	Project      *string           `json:"project_id"`
+	Version      *string           `json:"version_id"`
+	BuildVariant *string           `json:"build_variant"`
+	Revision     *string           `json:"revision"`
+	Files        []APIFileCoverage `json:"files"`
+	APICoverageSummary
+}
+
+func (c *APITaskCoverage) BuildFromService(h interface{}) error {
+	var v *coverage.TaskCoverage
+	switch t := h.(type) {
+	case coverage.TaskCoverage:
+		v = &t
+	case *coverage.TaskCoverage:
+		v = t
+	default:
+		return errors.Errorf("%T is not a supported type", h)
+	}
+
+	c.TaskID = utility.ToStringPtr(v.TaskID)
+	c.Execution = v.Execution
+	c.TaskName = utility.ToStringPtr(v.TaskName)
+	c.Project = utility.ToStringPtr(v.Project)
+	c.Version = utility.ToStringPtr(v.Version)
+	c.BuildVariant = utility.ToStringPtr(v.BuildVariant)
+	c.Revision = utility.ToStringPtr(v.Revision)
+	c.buildFromService(v.Summary)
+	c.Files = make([]APIFileCoverage, 0, len(v.Files))
+	for _, f := range v.Files {
+		apiFile := APIFileCoverage{}
+		if err := apiFile.BuildFromService(f); err != nil {
+			return errors.Wrapf(err, "converting coverage of file '%s' to API model", f.Path)
+		}
+		c.Files = append(c.Files, apiFile)
+	}
+	return nil
+}
+
+func (c *APITaskCoverage) ToService() (interface{}, error) {
+	return nil, errors.New("not implemented")
+}
+
+// APIVersionCoverage is the coverage of a build variant in a version.
+type APIVersionCoverage struct {
+	Version      *string    `json:"version_id"`
+	BuildVariant *string    `json:"build_variant"`
+	Revision     *string    `json:"revision"`
+	Order        int        `json:"order"`
+	CreateTime   *time.Time `json:"create_time"`
+	NumFiles     int        `json:"num_files"`
+	APICoverageSummary
+}
+
+func (c *APIVersionCoverage) BuildFromService(h interface{}) error {
+	switch v := h.(type) {
+	case coverage.VersionCoverage:
+		c.Version = utility.ToStringPtr(v.Version)
+		c.BuildVariant = utility.ToStringPtr(v.BuildVariant)
+		c.Revision = utility.ToStringPtr(v.Revision)
+		c.Order = v.RevisionOrderNumber
+		c.CreateTime = ToTimePtr(v.CreateTime)
+		c.NumFiles = v.NumFiles
+		c.buildFromService(v.Summary)
+	default:
+		return errors.Errorf("%T is not a supported type", h)
+	}
+	return nil
+}
+
+func (c *APIVersionCoverage) ToService() (interface{}, error) {
+	return nil, errors.New("not implemented")
+}
@@ -0,0 +1,116 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	githubCommentPrefix = "evergreen"
+
+	// GithubCommentPatch schedules tasks in the PR's latest patch.
+	GithubCommentPatch = "patch"
+	// GithubCommentAbort aborts the PR's latest patch.
+	GithubCommentAbort = "abort"
+	// GithubCommentRestart restarts tasks in the PR's latest patch.
+	GithubCommentRestart = "restart"
+
+	githubCommentRestartAll    = "all"
+	githubCommentRestartFailed = "failed"
+)
+
+// GithubPatchComment is a command left in a PR comment that acts on the PR's
+// latest patch, e.g.
+//
+//	evergreen patch --variants ubuntu --tasks lint,test
+//	evergreen abort
+//	evergreen restart failed
+type GithubPatchComment struct {
+	Command string
+	// Variants and Tasks select the tasks to schedule for a patch command.
+	// If no variants are given, the tasks are scheduled on every variant
+	// that has them, and if no tasks are given, every task on the variants
+	// is scheduled.
+	Variants []string
+	Tasks    []string
+	// FailedOnly restricts a restart command to the failed tasks.
+	FailedOnly bool
+}
+
+// ParseGithubPatchComment parses the first line of a PR comment as a patch
+// command. It returns nil if the comment isn't a patch command, and an error
+// if it is one but it's malformed.
+func ParseGithubPatchComment(comment string) (*GithubPatchComment, error) {
+	firstLine := strings.SplitN(strings.TrimSpace(comment), "\n", 2)[0]
+	fields := strings.Fields(firstLine)
+	if len(fields) < 2 || fields[0] != githubCommentPrefix {
+		return nil, nil
+	}
+
+	cmd := &GithubPatchComment{Command: fields[1]}
+	args := fields[2:]
+	switch cmd.Command {
+	case GithubCommentPatch:
+		if err := cmd.parsePatchArgs(args); err != nil {
+			return nil, err
+		}
+	case GithubCommentAbort:
+		if len(args) != 0 {
+			return nil, errors.Errorf("'%s %s' takes no arguments", githubCommentPrefix, GithubCommentAbort)
+		}
+	case GithubCommentRestart:
+		if len(args) > 1 {
+			return nil, errors.Errorf("'%s %s' takes at most one argument", githubCommentPrefix, GithubCommentRestart)
+		}
+		if len(args) == 1 {
+			switch args[0] {
+			case githubCommentRestartAll:
+			case githubCommentRestartFailed:
+				cmd.FailedOnly = true
+			default:
+				return nil, errors.Errorf("can't restart '%s' tasks (expected '%s' or '%s')", args[0], githubCommentRestartAll, githubCommentRestartFailed)
+			}
+		}
+	default:
+		return nil, nil
+	}
+
+	return cmd, nil
+}
+
+func (c *GithubPatchComment) parsePatchArgs(args []string) error {
+	for i := 0; i < len(args); i++ {
+		flag, val := args[i], ""
+		if parts := strings.SplitN(flag, "=", 2); len(parts) == 2 {
+			flag, val = parts[0], parts[1]
+		} else {
+			if i+1 >= len(args) {
+				return errors.Errorf("flag '%s' needs a value", flag)
+			}
+			i++
+			val = args[i]
+		}
+
+		var names []string
+		for _, name := range strings.Split(val, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+
+		switch flag {
+		case "--variants", "--variant", "-v":
+			c.Variants = append(c.Variants, names...)
+		case "--tasks", "--task", "-t":
+			c.Tasks = append(c.Tasks, names...)
+		default:
+			return errors.Errorf("unrecognized flag '%s' (expected --variants or --tasks)", flag)
+		}
+	}
+
+	if len(c.Variants) == 0 && len(c.Tasks) == 0 {
+		return errors.Errorf("'%s %s' needs --variants or --tasks", githubCommentPrefix, GithubCommentPatch)
+	}
+	return nil
+}
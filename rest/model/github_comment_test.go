@@ -0,0 +1,81 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGithubPatchComment(t *testing.T) {
+	for name, test := range map[string]struct {
+		comment  string
+		expected *GithubPatchComment
+		hasErr   bool
+	}{
+		"NotACommand": {
+			comment: "looks good to me",
+		},
+		"OtherEvergreenCommand": {
+			comment: "evergreen merge --module module1:1234",
+		},
+		"PatchWithVariantsAndTasks": {
+			comment: " evergreen patch --variants ubuntu,rhel -t lint --tasks=test ",
+			expected: &GithubPatchComment{
+				Command:  GithubCommentPatch,
+				Variants: []string{"ubuntu", "rhel"},
+				Tasks:    []string{"lint", "test"},
+			},
+		},
+		"PatchWithOnlyTasks": {
+			comment: "evergreen patch --tasks lint\n\nplease run lint",
+			expected: &GithubPatchComment{
+				Command: GithubCommentPatch,
+				Tasks:   []string{"lint"},
+			},
+		},
+		"PatchWithoutSelectors": {
+			comment: "evergreen patch",
+			hasErr:  true,
+		},
+		"PatchWithMissingFlagValue": {
+			comment: "evergreen patch --variants",
+			hasErr:  true,
+		},
+		"PatchWithUnknownFlag": {
+			comment: "evergreen patch --variants ubuntu --everything",
+			hasErr:  true,
+		},
+		"Abort": {
+			comment:  "evergreen abort",
+			expected: &GithubPatchComment{Command: GithubCommentAbort},
+		},
+		"AbortWithArgs": {
+			comment: "evergreen abort now",
+			hasErr:  true,
+		},
+		"Restart": {
+			comment:  "evergreen restart",
+			expected: &GithubPatchComment{Command: GithubCommentRestart},
+		},
+		"RestartFailed": {
+			comment:  "evergreen restart failed",
+			expected: &GithubPatchComment{Command: GithubCommentRestart, FailedOnly: true},
+		},
+		"RestartInvalid": {
+			comment: "evergreen restart flaky",
+			hasErr:  true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cmd, err := ParseGithubPatchComment(test.comment)
+			if test.hasErr {
+				assert.Error(t, err)
+				assert.Nil(t, cmd)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, cmd)
+		})
+	}
+}
@@ -8,9 +8,10 @@ import (
 )
 
 type APIEventStats struct {
-	LastProcessedAt            *time.Time           `json:"last_processed_at"`
-	NumUnprocessedEvents       int                  `json:"unprocessed_events"`
-	PendingNotificationsByType apiNotificationStats `json:"pending_notifications_by_type"`
+	LastProcessedAt                 *time.Time                     `json:"last_processed_at"`
+	NumUnprocessedEvents            int                            `json:"unprocessed_events"`
+	PendingNotificationsByType      apiNotificationStats           `json:"pending_notifications_by_type"`
+	SuppressedNotificationsByReason apiSuppressedNotificationStats `json:"suppressed_notifications_by_reason"`
 }
 
 func (n *APIEventStats) BuildFromService(h interface{}) error {
@@ -57,3 +58,29 @@ func (n *apiNotificationStats) BuildFromService(h interface{}) error {
 func (n *apiNotificationStats) ToService() (interface{}, error) {
 	return nil, errors.New("(*apiNotificationsStats) ToService not implemented")
 }
+
+type apiSuppressedNotificationStats struct {
+	SubscriberRateLimit     int `json:"subscriber_rate_limit"`
+	SubscriberTypeRateLimit int `json:"subscriber_type_rate_limit"`
+	Collapsed               int `json:"collapsed"`
+}
+
+func (n *apiSuppressedNotificationStats) BuildFromService(h interface{}) error {
+	data, ok := h.(*notification.SuppressedNotificationStats)
+	if !ok {
+		return errors.New("can't convert unknown type to apiSuppressedNotificationStats")
+	}
+	if data == nil {
+		return errors.New("can't convert nil to apiSuppressedNotificationStats")
+	}
+
+	n.SubscriberRateLimit = data.SubscriberRateLimit
+	n.SubscriberTypeRateLimit = data.SubscriberTypeRateLimit
+	n.Collapsed = data.Collapsed
+
+	return nil
+}
+
+func (n *apiSuppressedNotificationStats) ToService() (interface{}, error) {
+	return nil, errors.New("(*apiSuppressedNotificationStats) ToService not implemented")
+}
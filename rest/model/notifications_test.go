@@ -57,3 +57,20 @@ func TestNotificationStats(t *testing.T) {
 	assert.EqualError(err, "(*apiNotificationsStats) ToService not implemented")
 	assert.Implements((*Model)(nil), &stats)
 }
+
+func TestSuppressedNotificationStats(t *testing.T) {
+	assert := assert.New(t)
+
+	sstats := notification.SuppressedNotificationStats{
+		SubscriberRateLimit:     1,
+		SubscriberTypeRateLimit: 2,
+		Collapsed:               3,
+	}
+
+	stats := apiSuppressedNotificationStats{}
+	assert.EqualError(stats.BuildFromService(sstats), "can't convert unknown type to apiSuppressedNotificationStats")
+	assert.NoError(stats.BuildFromService(&sstats))
+	assert.Equal(1, stats.SubscriberRateLimit)
+	assert.Equal(2, stats.SubscriberTypeRateLimit)
+	assert.Equal(3, stats.Collapsed)
+}
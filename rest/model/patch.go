@@ -36,6 +36,7 @@ type APIPatch struct {
 	VariantsTasks           []VariantTask        `json:"variants_tasks"`
 	Activated               bool                 `json:"activated"`
 	Alias                   *string              `json:"alias,omitempty"`
+	StackedOn               *string              `json:"stacked_on,omitempty"`
 	GithubPatchData         githubPatch          `json:"github_patch_data,omitempty"`
 	ModuleCodeChanges       []APIModulePatch     `json:"module_code_changes"`
 	Parameters              []APIParameter       `json:"parameters"`
@@ -138,6 +139,9 @@ func (apiPatch *APIPatch) BuildFromService(h interface{}) error {
 	apiPatch.VariantsTasks = variantTasks
 	apiPatch.Activated = v.Activated
 	apiPatch.Alias = utility.ToStringPtr(v.Alias)
+	if v.StackedOn != "" {
+		apiPatch.StackedOn = utility.ToStringPtr(v.StackedOn)
+	}
 	apiPatch.GithubPatchData = githubPatch{}
 	apiPatch.Requester = utility.ToStringPtr(v.GetRequester())
 
@@ -288,6 +292,7 @@ func (apiPatch *APIPatch) ToService() (interface{}, error) {
 	res.Version = utility.FromStringPtr(apiPatch.Version)
 	res.Status = utility.FromStringPtr(apiPatch.Status)
 	res.Alias = utility.FromStringPtr(apiPatch.Alias)
+	res.StackedOn = utility.FromStringPtr(apiPatch.StackedOn)
 	res.Activated = apiPatch.Activated
 	res.CreateTime, err = FromTimePtr(apiPatch.CreateTime)
 	catcher.Add(err)
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"math"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/perf"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// APIPerfRegression is a change point detected in a performance series.
+type APIPerfRegression struct {
+	ID            *string    `json:"id"`
+	Project       *string    `json:"project_id"`
+	Variant       *string    `json:"build_variant"`
+	TaskName      *string    `json:"task_name"`
+	TestName      *string    `json:"test_name"`
+	Metric        *string    `json:"metric"`
+	Version       *string    `json:"version_id"`
+	Revision      *string    `json:"revision"`
+	Order         int        `json:"order"`
+	TaskID        *string    `json:"task_id"`
+	PreviousMean  float64    `json:"previous_mean"`
+	NewMean       float64    `json:"new_mean"`
+	PercentChange float64    `json:"percent_change"`
+	Statistic     *float64   `json:"statistic"`
+	Status        *string    `json:"status"`
+	TriagedBy     *string    `json:"triaged_by"`
+	TriagedAt     *time.Time `json:"triaged_at"`
+	Note          *string    `json:"note"`
+	CreateTime    *time.Time `json:"create_time"`
+}
+
+func (r *APIPerfRegression) BuildFromService(h interface{}) error {
+	var v *perf.Regression
+	switch t := h.(type) {
+	case perf.Regression:
+		v = &t
+	case *perf.Regression:
+		v = t
+	default:
+		return errors.Errorf("%T is not a supported type", h)
+	}
+
+	r.ID = utility.ToStringPtr(v.ID)
+	r.Project = utility.ToStringPtr(v.Project)
+	r.Variant = utility.ToStringPtr(v.Variant)
+	r.TaskName = utility.ToStringPtr(v.TaskName)
+	r.TestName = utility.ToStringPtr(v.TestName)
+	r.Metric = utility.ToStringPtr(v.Metric)
+	r.Version = utility.ToStringPtr(v.Version)
+	r.Revision = utility.ToStringPtr(v.Revision)
+	r.Order = v.RevisionOrderNumber
+	r.TaskID = utility.ToStringPtr(v.TaskID)
+	r.PreviousMean = v.PreviousMean
+	r.NewMean = v.NewMean
+	r.PercentChange = v.PercentChange
+	// The statistic is infinite when the values on both sides of the change
+	// point are constant, which can't be represented in JSON.
+	r.Statistic = nil
+	if !math.IsInf(v.Statistic, 0) && !math.IsNaN(v.Statistic) {
+		r.Statistic = utility.ToFloat64Ptr(v.Statistic)
+	}
+	r.Status = utility.ToStringPtr(v.Status)
+	r.TriagedBy = utility.ToStringPtr(v.TriagedBy)
+	r.TriagedAt = nil
+	if !utility.IsZeroTime(v.TriagedAt) {
+		r.TriagedAt = ToTimePtr(v.TriagedAt)
+	}
+	r.Note = utility.ToStringPtr(v.Note)
+	r.CreateTime = ToTimePtr(v.CreateTime)
+	return nil
+}
+
+func (r *APIPerfRegression) ToService() (interface{}, error) {
+	return nil, errors.New("not implemented")
+}
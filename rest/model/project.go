@@ -7,6 +7,7 @@ import (
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/commitqueue"
 	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/evergreen-ci/evergreen/util"
 	"github.com/evergreen-ci/utility"
@@ -180,10 +181,20 @@ type APIPeriodicBuildDefinition struct {
 }
 
 type APICommitQueueParams struct {
-	Enabled       *bool   `json:"enabled"`
-	RequireSigned *bool   `json:"require_signed"`
-	MergeMethod   *string `json:"merge_method"`
-	Message       *string `json:"message"`
+	Enabled           *bool                `json:"enabled"`
+	RequireSigned     *bool                `json:"require_signed"`
+	MergeMethod       *string              `json:"merge_method"`
+	Message           *string              `json:"message"`
+	SpeculativeWindow *int                 `json:"speculative_window"`
+	BisectBatchSize   *int                 `json:"bisect_batch_size"`
+	Lanes             []APICommitQueueLane `json:"lanes"`
+	MaxItemsPerAuthor *int                 `json:"max_items_per_author"`
+	MergeQueue        *string              `json:"merge_queue"`
+}
+
+type APICommitQueueLane struct {
+	Name          *string `json:"name"`
+	MaxConcurrent *int    `json:"max_concurrent"`
 }
 
 func (bd *APIPeriodicBuildDefinition) ToService() (interface{}, error) {
@@ -231,6 +242,16 @@ func (cqParams *APICommitQueueParams) BuildFromService(h interface{}) error {
 	cqParams.RequireSigned = utility.BoolPtrCopy(params.RequireSigned)
 	cqParams.MergeMethod = utility.ToStringPtr(params.MergeMethod)
 	cqParams.Message = utility.ToStringPtr(params.Message)
+	cqParams.SpeculativeWindow = utility.ToIntPtr(params.SpeculativeWindow)
+	cqParams.BisectBatchSize = utility.ToIntPtr(params.BisectBatchSize)
+	cqParams.MaxItemsPerAuthor = utility.ToIntPtr(params.MaxItemsPerAuthor)
+	cqParams.MergeQueue = utility.ToStringPtr(params.MergeQueue)
+	for _, lane := range params.Lanes {
+		cqParams.Lanes = append(cqParams.Lanes, APICommitQueueLane{
+			Name:          utility.ToStringPtr(lane.Name),
+			MaxConcurrent: utility.ToIntPtr(lane.MaxConcurrent),
+		})
+	}
 
 	return nil
 }
@@ -241,6 +262,16 @@ func (cqParams *APICommitQueueParams) ToService() (interface{}, error) {
 	serviceParams.RequireSigned = utility.BoolPtrCopy(cqParams.RequireSigned)
 	serviceParams.MergeMethod = utility.FromStringPtr(cqParams.MergeMethod)
 	serviceParams.Message = utility.FromStringPtr(cqParams.Message)
+	serviceParams.SpeculativeWindow = utility.FromIntPtr(cqParams.SpeculativeWindow)
+	serviceParams.BisectBatchSize = utility.FromIntPtr(cqParams.BisectBatchSize)
+	serviceParams.MaxItemsPerAuthor = utility.FromIntPtr(cqParams.MaxItemsPerAuthor)
+	serviceParams.MergeQueue = utility.FromStringPtr(cqParams.MergeQueue)
+	for _, lane := range cqParams.Lanes {
+		serviceParams.Lanes = append(serviceParams.Lanes, commitqueue.Lane{
+			Name:          utility.FromStringPtr(lane.Name),
+			MaxConcurrent: utility.FromIntPtr(lane.MaxConcurrent),
+		})
+	}
 
 	return serviceParams, nil
 }
@@ -363,6 +394,35 @@ func (opts *APITaskSyncOptions) ToService() (interface{}, error) {
 	}, nil
 }
 
+type APIArtifactRetentionPolicy struct {
+	MainlineDays   *int      `json:"mainline_days"`
+	PatchDays      *int      `json:"patch_days"`
+	PinnedVersions []*string `json:"pinned_versions"`
+	Buckets        []*string `json:"buckets"`
+}
+
+func (p *APIArtifactRetentionPolicy) BuildFromService(h interface{}) error {
+	switch v := h.(type) {
+	case model.ArtifactRetentionPolicy:
+		p.MainlineDays = utility.ToIntPtr(v.MainlineDays)
+		p.PatchDays = utility.ToIntPtr(v.PatchDays)
+		p.PinnedVersions = utility.ToStringPtrSlice(v.PinnedVersions)
+		p.Buckets = utility.ToStringPtrSlice(v.Buckets)
+		return nil
+	default:
+		return errors.Errorf("invalid type '%T' for API artifact retention policy", v)
+	}
+}
+
+func (p *APIArtifactRetentionPolicy) ToService() (interface{}, error) {
+	return model.ArtifactRetentionPolicy{
+		MainlineDays:   utility.FromIntPtr(p.MainlineDays),
+		PatchDays:      utility.FromIntPtr(p.PatchDays),
+		PinnedVersions: utility.FromStringPtrSlice(p.PinnedVersions),
+		Buckets:        utility.FromStringPtrSlice(p.Buckets),
+	}, nil
+}
+
 type APIWorkstationConfig struct {
 	SetupCommands []APIWorkstationSetupCommand `bson:"setup_commands" json:"setup_commands"`
 	GitClone      *bool                        `bson:"git_clone" json:"git_clone"`
@@ -434,46 +494,50 @@ func (c *APIParameterInfo) BuildFromService(h interface{}) error {
 }
 
 type APIProjectRef struct {
-	Id                          *string                   `json:"id"`
-	Owner                       *string                   `json:"owner_name"`
-	Repo                        *string                   `json:"repo_name"`
-	Branch                      *string                   `json:"branch_name"`
-	Enabled                     *bool                     `json:"enabled"`
-	Private                     *bool                     `json:"private"`
-	BatchTime                   int                       `json:"batch_time"`
-	RemotePath                  *string                   `json:"remote_path"`
-	SpawnHostScriptPath         *string                   `json:"spawn_host_script_path"`
-	Identifier                  *string                   `json:"identifier"`
-	DisplayName                 *string                   `json:"display_name"`
-	DeactivatePrevious          *bool                     `json:"deactivate_previous"`
-	TracksPushEvents            *bool                     `json:"tracks_push_events"`
-	PRTestingEnabled            *bool                     `json:"pr_testing_enabled"`
-	GitTagVersionsEnabled       *bool                     `json:"git_tag_versions_enabled"`
-	GithubChecksEnabled         *bool                     `json:"github_checks_enabled"`
-	CedarTestResultsEnabled     *bool                     `json:"cedar_test_results_enabled"`
-	UseRepoSettings             *bool                     `json:"use_repo_settings"`
-	RepoRefId                   *string                   `json:"repo_ref_id"`
-	DefaultLogger               *string                   `json:"default_logger"`
-	CommitQueue                 APICommitQueueParams      `json:"commit_queue"`
-	TaskSync                    APITaskSyncOptions        `json:"task_sync"`
-	TaskAnnotationSettings      APITaskAnnotationSettings `json:"task_annotation_settings"`
-	BuildBaronSettings          APIBuildBaronSettings     `json:"build_baron_settings"`
-	PerfEnabled                 *bool                     `json:"perf_enabled"`
-	Hidden                      *bool                     `json:"hidden"`
-	PatchingDisabled            *bool                     `json:"patching_disabled"`
-	RepotrackerDisabled         *bool                     `json:"repotracker_disabled"`
-	DispatchingDisabled         *bool                     `json:"dispatching_disabled"`
-	DisabledStatsCache          *bool                     `json:"disabled_stats_cache"`
-	FilesIgnoredFromCache       []*string                 `json:"files_ignored_from_cache"`
-	Admins                      []*string                 `json:"admins"`
-	DeleteAdmins                []*string                 `json:"delete_admins,omitempty"`
-	GitTagAuthorizedUsers       []*string                 `json:"git_tag_authorized_users" bson:"git_tag_authorized_users"`
-	DeleteGitTagAuthorizedUsers []*string                 `json:"delete_git_tag_authorized_users,omitempty" bson:"delete_git_tag_authorized_users,omitempty"`
-	GitTagAuthorizedTeams       []*string                 `json:"git_tag_authorized_teams" bson:"git_tag_authorized_teams"`
-	DeleteGitTagAuthorizedTeams []*string                 `json:"delete_git_tag_authorized_teams,omitempty" bson:"delete_git_tag_authorized_teams,omitempty"`
-	NotifyOnBuildFailure        *bool                     `json:"notify_on_failure"`
-	Restricted                  *bool                     `json:"restricted"`
-	Revision                    *string                   `json:"revision"`
+	Id                          *string                    `json:"id"`
+	Owner                       *string                    `json:"owner_name"`
+	Repo                        *string                    `json:"repo_name"`
+	Branch                      *string                    `json:"branch_name"`
+	Enabled                     *bool                      `json:"enabled"`
+	Private                     *bool                      `json:"private"`
+	BatchTime                   int                        `json:"batch_time"`
+	RemotePath                  *string                    `json:"remote_path"`
+	CodeHost                    *string                    `json:"code_host"`
+	GitURL                      *string                    `json:"git_url"`
+	SpawnHostScriptPath         *string                    `json:"spawn_host_script_path"`
+	Identifier                  *string                    `json:"identifier"`
+	DisplayName                 *string                    `json:"display_name"`
+	DeactivatePrevious          *bool                      `json:"deactivate_previous"`
+	TracksPushEvents            *bool                      `json:"tracks_push_events"`
+	PRTestingEnabled            *bool                      `json:"pr_testing_enabled"`
+	PRTaskReuseEnabled          *bool                      `json:"pr_task_reuse_enabled"`
+	GitTagVersionsEnabled       *bool                      `json:"git_tag_versions_enabled"`
+	GithubChecksEnabled         *bool                      `json:"github_checks_enabled"`
+	CedarTestResultsEnabled     *bool                      `json:"cedar_test_results_enabled"`
+	UseRepoSettings             *bool                      `json:"use_repo_settings"`
+	RepoRefId                   *string                    `json:"repo_ref_id"`
+	DefaultLogger               *string                    `json:"default_logger"`
+	CommitQueue                 APICommitQueueParams       `json:"commit_queue"`
+	TaskSync                    APITaskSyncOptions         `json:"task_sync"`
+	ArtifactRetention           APIArtifactRetentionPolicy `json:"artifact_retention"`
+	TaskAnnotationSettings      APITaskAnnotationSettings  `json:"task_annotation_settings"`
+	BuildBaronSettings          APIBuildBaronSettings      `json:"build_baron_settings"`
+	PerfEnabled                 *bool                      `json:"perf_enabled"`
+	Hidden                      *bool                      `json:"hidden"`
+	PatchingDisabled            *bool                      `json:"patching_disabled"`
+	RepotrackerDisabled         *bool                      `json:"repotracker_disabled"`
+	DispatchingDisabled         *bool                      `json:"dispatching_disabled"`
+	DisabledStatsCache          *bool                      `json:"disabled_stats_cache"`
+	FilesIgnoredFromCache       []*string                  `json:"files_ignored_from_cache"`
+	Admins                      []*string                  `json:"admins"`
+	DeleteAdmins                []*string                  `json:"delete_admins,omitempty"`
+	GitTagAuthorizedUsers       []*string                  `json:"git_tag_authorized_users" bson:"git_tag_authorized_users"`
+	DeleteGitTagAuthorizedUsers []*string                  `json:"delete_git_tag_authorized_users,omitempty" bson:"delete_git_tag_authorized_users,omitempty"`
+	GitTagAuthorizedTeams       []*string                  `json:"git_tag_authorized_teams" bson:"git_tag_authorized_teams"`
+	DeleteGitTagAuthorizedTeams []*string                  `json:"delete_git_tag_authorized_teams,omitempty" bson:"delete_git_tag_authorized_teams,omitempty"`
+	NotifyOnBuildFailure        *bool                      `json:"notify_on_failure"`
+	Restricted                  *bool                      `json:"restricted"`
+	Revision                    *string                    `json:"revision"`
 
 	Triggers             []APITriggerDefinition       `json:"triggers"`
 	GithubTriggerAliases []*string                    `json:"github_trigger_aliases"`
@@ -503,6 +567,15 @@ func (p *APIProjectRef) ToService() (interface{}, error) {
 		return nil, errors.Errorf("expected task sync options but was actually '%T'", i)
 	}
 
+	i, err = p.ArtifactRetention.ToService()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot convert API artifact retention policy to service representation")
+	}
+	artifactRetention, ok := i.(model.ArtifactRetentionPolicy)
+	if !ok {
+		return nil, errors.Errorf("expected artifact retention policy but was actually '%T'", i)
+	}
+
 	i, err = p.WorkstationConfig.ToService()
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot convert API workstation config")
@@ -539,6 +612,8 @@ func (p *APIProjectRef) ToService() (interface{}, error) {
 		Restricted:              utility.BoolPtrCopy(p.Restricted),
 		BatchTime:               p.BatchTime,
 		RemotePath:              utility.FromStringPtr(p.RemotePath),
+		CodeHost:                utility.FromStringPtr(p.CodeHost),
+		GitURL:                  utility.FromStringPtr(p.GitURL),
 		Id:                      utility.FromStringPtr(p.Id),
 		Identifier:              utility.FromStringPtr(p.Identifier),
 		DisplayName:             utility.FromStringPtr(p.DisplayName),
@@ -546,12 +621,14 @@ func (p *APIProjectRef) ToService() (interface{}, error) {
 		TracksPushEvents:        utility.BoolPtrCopy(p.TracksPushEvents),
 		DefaultLogger:           utility.FromStringPtr(p.DefaultLogger),
 		PRTestingEnabled:        utility.BoolPtrCopy(p.PRTestingEnabled),
+		PRTaskReuseEnabled:      utility.BoolPtrCopy(p.PRTaskReuseEnabled),
 		GitTagVersionsEnabled:   utility.BoolPtrCopy(p.GitTagVersionsEnabled),
 		GithubChecksEnabled:     utility.BoolPtrCopy(p.GithubChecksEnabled),
 		CedarTestResultsEnabled: utility.BoolPtrCopy(p.CedarTestResultsEnabled),
 		RepoRefId:               utility.FromStringPtr(p.RepoRefId),
 		CommitQueue:             commitQueue.(model.CommitQueueParams),
 		TaskSync:                taskSync,
+		ArtifactRetention:       artifactRetention,
 		WorkstationConfig:       workstationConfig,
 		BuildBaronSettings:      buildBaronConfig,
 		TaskAnnotationSettings:  taskAnnotationConfig,
@@ -642,6 +719,8 @@ func (p *APIProjectRef) BuildFromService(v interface{}) error {
 	p.Restricted = utility.BoolPtrCopy(projectRef.Restricted)
 	p.BatchTime = projectRef.BatchTime
 	p.RemotePath = utility.ToStringPtr(projectRef.RemotePath)
+	p.CodeHost = utility.ToStringPtr(projectRef.CodeHost)
+	p.GitURL = utility.ToStringPtr(projectRef.GitURL)
 	p.Id = utility.ToStringPtr(projectRef.Id)
 	p.Identifier = utility.ToStringPtr(projectRef.Identifier)
 	p.DisplayName = utility.ToStringPtr(projectRef.DisplayName)
@@ -649,6 +728,7 @@ func (p *APIProjectRef) BuildFromService(v interface{}) error {
 	p.TracksPushEvents = utility.BoolPtrCopy(projectRef.TracksPushEvents)
 	p.DefaultLogger = utility.ToStringPtr(projectRef.DefaultLogger)
 	p.PRTestingEnabled = utility.BoolPtrCopy(projectRef.PRTestingEnabled)
+	p.PRTaskReuseEnabled = utility.BoolPtrCopy(projectRef.PRTaskReuseEnabled)
 	p.GitTagVersionsEnabled = utility.BoolPtrCopy(projectRef.GitTagVersionsEnabled)
 	p.GithubChecksEnabled = utility.BoolPtrCopy(projectRef.GithubChecksEnabled)
 	p.CedarTestResultsEnabled = utility.BoolPtrCopy(projectRef.CedarTestResultsEnabled)
@@ -680,6 +760,12 @@ func (p *APIProjectRef) BuildFromService(v interface{}) error {
 	}
 	p.TaskSync = taskSync
 
+	var artifactRetention APIArtifactRetentionPolicy
+	if err := artifactRetention.BuildFromService(projectRef.ArtifactRetention); err != nil {
+		return errors.Wrap(err, "cannot convert artifact retention policy to API representation")
+	}
+	p.ArtifactRetention = artifactRetention
+
 	workstationConfig := APIWorkstationConfig{}
 	if err := workstationConfig.BuildFromService(projectRef.WorkstationConfig); err != nil {
 		return errors.Wrap(err, "cannot convert workstation config")
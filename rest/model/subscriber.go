@@ -88,7 +88,8 @@ func (s *APISubscriber) BuildFromService(h interface{}) error {
 			target = sub
 
 		case event.JIRACommentSubscriberType, event.EmailSubscriberType,
-			event.SlackSubscriberType, event.EnqueuePatchSubscriberType:
+			event.SlackSubscriberType, event.MSTeamsSubscriberType,
+			event.ChatWebhookSubscriberType, event.EnqueuePatchSubscriberType:
 			target = v.Target
 
 		default:
@@ -176,7 +177,8 @@ func (s *APISubscriber) ToService() (interface{}, error) {
 		}
 
 	case event.JIRACommentSubscriberType, event.EmailSubscriberType,
-		event.SlackSubscriberType, event.EnqueuePatchSubscriberType:
+		event.SlackSubscriberType, event.MSTeamsSubscriberType,
+		event.ChatWebhookSubscriberType, event.EnqueuePatchSubscriberType:
 		target = s.Target
 
 	default:
@@ -192,6 +194,8 @@ func (s *APISubscriber) ToService() (interface{}, error) {
 
 func (s *APIGithubPRSubscriber) BuildFromService(h interface{}) error {
 	switch v := h.(type) {
+	case event.GithubPullRequestSubscriber:
+		return s.BuildFromService(&v)
 	case *event.GithubPullRequestSubscriber:
 		s.Owner = utility.ToStringPtr(v.Owner)
 		s.Repo = utility.ToStringPtr(v.Repo)
@@ -215,6 +219,8 @@ func (s *APIGithubCheckSubscriber) ToService() (interface{}, error) {
 
 func (s *APIGithubCheckSubscriber) BuildFromService(h interface{}) error {
 	switch v := h.(type) {
+	case event.GithubCheckSubscriber:
+		return s.BuildFromService(&v)
 	case *event.GithubCheckSubscriber:
 		s.Owner = utility.ToStringPtr(v.Owner)
 		s.Repo = utility.ToStringPtr(v.Repo)
@@ -261,6 +267,8 @@ func (s *APIPRInfo) ToService() (interface{}, error) {
 
 func (s *APIWebhookSubscriber) BuildFromService(h interface{}) error {
 	switch v := h.(type) {
+	case event.WebhookSubscriber:
+		return s.BuildFromService(&v)
 	case *event.WebhookSubscriber:
 		s.URL = utility.ToStringPtr(v.URL)
 		s.Secret = utility.ToStringPtr(string(v.Secret))
@@ -309,6 +317,8 @@ type APIJIRAIssueSubscriber struct {
 
 func (s *APIJIRAIssueSubscriber) BuildFromService(h interface{}) error {
 	switch v := h.(type) {
+	case event.JIRAIssueSubscriber:
+		return s.BuildFromService(&v)
 	case *event.JIRAIssueSubscriber:
 		s.Project = utility.ToStringPtr(v.Project)
 		s.IssueType = utility.ToStringPtr(v.IssueType)
@@ -85,6 +85,11 @@ func TestSubscriberModelsWebhook(t *testing.T) {
 	serviceModel, err := incoming.ToService()
 	assert.NoError(err)
 	assert.EqualValues(origWebhookSubscriber, serviceModel)
+
+	// converted subscribers can be converted back to the API model
+	roundTrip := APISubscriber{}
+	assert.NoError(roundTrip.BuildFromService(origWebhookSubscriber))
+	assert.Equal(apiWebhookSubscriber, roundTrip)
 }
 
 func TestSubscriberModelsJIRAIssue(t *testing.T) {
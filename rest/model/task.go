@@ -58,6 +58,8 @@ type APITask struct {
 	PreviousExecutions      []APITask           `json:"previous_executions,omitempty"`
 	GenerateTask            bool                `json:"generate_task"`
 	GeneratedBy             string              `json:"generated_by"`
+	ReusedFromTask          string              `json:"reused_from_task,omitempty"`
+	ReusedFromPatch         string              `json:"reused_from_patch,omitempty"`
 	Artifacts               []APIFile           `json:"artifacts"`
 	DisplayOnly             bool                `json:"display_only"`
 	ParentTaskId            string              `json:"parent_task_id"`
@@ -231,6 +233,8 @@ func (at *APITask) BuildFromService(t interface{}) error {
 			ExpectedDuration:        NewAPIDuration(v.ExpectedDuration),
 			GenerateTask:            v.GenerateTask,
 			GeneratedBy:             v.GeneratedBy,
+			ReusedFromTask:          v.ReusedFromTask,
+			ReusedFromPatch:         v.ReusedFromPatch,
 			DisplayOnly:             v.DisplayOnly,
 			Mainline:                (v.Requester == evergreen.RepotrackerVersionRequester),
 			TaskGroup:               v.TaskGroup,
@@ -351,6 +355,8 @@ func (ad *APITask) ToService() (interface{}, error) {
 		ExpectedDuration:    ad.ExpectedDuration.ToDuration(),
 		GenerateTask:        ad.GenerateTask,
 		GeneratedBy:         ad.GeneratedBy,
+		ReusedFromTask:      ad.ReusedFromTask,
+		ReusedFromPatch:     ad.ReusedFromPatch,
 		DisplayOnly:         ad.DisplayOnly,
 		Requester:           utility.FromStringPtr(ad.Requester),
 		CanSync:             ad.CanSync,
@@ -0,0 +1,111 @@
+package model
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// APITaskLogSearchResult is a page of the task log lines that match a search.
+type APITaskLogSearchResult struct {
+	Matches      []APITaskLogSearchMatch `json:"matches"`
+	TotalMatches int                     `json:"total_matches"`
+}
+
+// APITaskLogSearchMatch is a task log line that matches a search, along with
+// the lines around it.
+type APITaskLogSearchMatch struct {
+	LineNumber int              `json:"line_number"`
+	Line       APITaskLogLine   `json:"line"`
+	Before     []APITaskLogLine `json:"before"`
+	After      []APITaskLogLine `json:"after"`
+}
+
+// APITaskLogLine is a single task log line.
+type APITaskLogLine struct {
+	Type      *string    `json:"type,omitempty"`
+	Severity  *string    `json:"severity"`
+	Message   *string    `json:"message"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+func (r *APITaskLogSearchResult) BuildFromService(in interface{}) error {
+	result, ok := in.(*model.TaskLogSearchResult)
+	if !ok {
+		return errors.Errorf("incorrect type '%T' when creating APITaskLogSearchResult", in)
+	}
+
+	r.TotalMatches = result.TotalMatches
+	r.Matches = make([]APITaskLogSearchMatch, 0, len(result.Matches))
+	for _, match := range result.Matches {
+		apiMatch := APITaskLogSearchMatch{
+			LineNumber: match.LineNumber,
+			Before:     makeAPITaskLogLines(match.Before),
+			After:      makeAPITaskLogLines(match.After),
+		}
+		apiMatch.Line.BuildFromService(match.Line)
+		r.Matches = append(r.Matches, apiMatch)
+	}
+
+	return nil
+}
+
+func (r *APITaskLogSearchResult) ToService() (interface{}, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (l *APITaskLogLine) BuildFromService(msg apimodels.LogMessage) {
+	if msg.Type != "" {
+		l.Type = utility.ToStringPtr(msg.Type)
+	}
+	l.Severity = utility.ToStringPtr(msg.Severity)
+	l.Message = utility.ToStringPtr(msg.Message)
+	if !msg.Timestamp.IsZero() {
+		l.Timestamp = ToTimePtr(msg.Timestamp)
+	}
+}
+
+func makeAPITaskLogLines(msgs []apimodels.LogMessage) []APITaskLogLine {
+	lines := make([]APITaskLogLine, 0, len(msgs))
+	for _, msg := range msgs {
+		line := APITaskLogLine{}
+		line.BuildFromService(msg)
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// APITaskLogPage is the lines of a task's logs read from a cursor.
+type APITaskLogPage struct {
+	Lines []APITaskLogLine `json:"lines"`
+	// NextTime and NextSequence are the cursor to start reading the next page
+	// from. NextTime is not set if no lines have been read yet.
+	NextTime     *time.Time `json:"next_time,omitempty"`
+	NextSequence int        `json:"next_sequence"`
+	Execution    int        `json:"execution"`
+	Status       *string    `json:"status"`
+	// Finished is whether the task had finished before the lines were read.
+	Finished bool `json:"finished"`
+}
+
+func (p *APITaskLogPage) BuildFromService(in interface{}) error {
+	page, ok := in.(*model.TaskLogPage)
+	if !ok {
+		return errors.Errorf("incorrect type '%T' when creating APITaskLogPage", in)
+	}
+
+	p.Lines = makeAPITaskLogLines(page.Lines)
+	if !page.NextTime.IsZero() {
+		p.NextTime = ToTimePtr(page.NextTime)
+	}
+	p.NextSequence = page.NextSequence
+
+	return nil
+}
+
+func (p *APITaskLogPage) ToService() (interface{}, error) {
+	return nil, errors.New("not implemented")
+}
@@ -29,6 +29,11 @@ type APITest struct {
 	StartTime       *time.Time `json:"start_time"`
 	EndTime         *time.Time `json:"end_time"`
 	Duration        float64    `json:"duration"`
+	FailureMessage  *string    `json:"failure_message,omitempty"`
+	StackTrace      *string    `json:"stack_trace,omitempty"`
+	Tags            []string   `json:"tags,omitempty"`
+	Owners          []string   `json:"owners,omitempty"`
+	Retries         int        `json:"retries"`
 }
 
 // TestLogs is a struct for storing the information about logs that will be
@@ -70,6 +75,15 @@ func (at *APITest) BuildFromService(st interface{}) error {
 		if v.LogID != "" {
 			at.Logs.LogID = utility.ToStringPtr(v.LogID)
 		}
+		if v.FailureMessage != "" {
+			at.FailureMessage = utility.ToStringPtr(v.FailureMessage)
+		}
+		if v.StackTrace != "" {
+			at.StackTrace = utility.ToStringPtr(v.StackTrace)
+		}
+		at.Tags = v.Tags
+		at.Owners = v.Owners
+		at.Retries = v.Retries
 	case *apimodels.CedarTestResult:
 		at.ID = utility.ToStringPtr(v.TestName)
 		at.Execution = v.Execution
@@ -94,6 +108,15 @@ func (at *APITest) BuildFromService(st interface{}) error {
 		if lobsterURL := tr.GetLogURL(evergreen.LogViewerLobster); lobsterURL != "" {
 			at.Logs.URLLobster = utility.ToStringPtr(lobsterURL)
 		}
+		if v.FailureMessage != "" {
+			at.FailureMessage = utility.ToStringPtr(v.FailureMessage)
+		}
+		if v.StackTrace != "" {
+			at.StackTrace = utility.ToStringPtr(v.StackTrace)
+		}
+		at.Tags = v.Tags
+		at.Owners = v.Owners
+		at.Retries = v.Retries
 	case string:
 		at.TaskID = utility.ToStringPtr(v)
 	default:
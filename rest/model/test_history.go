@@ -0,0 +1,91 @@
+package model
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// APITestHistory is the history of a test across a project's mainline
+// commits.
+type APITestHistory struct {
+	Runs []APITestHistoryRun `json:"runs"`
+	// FailingSince is the first failing run of the test's current streak of
+	// failures, if the test is currently failing.
+	FailingSince *APITestHistoryRun `json:"failing_since,omitempty"`
+}
+
+// APITestHistoryRun is a single run of a test in a mainline task.
+type APITestHistoryRun struct {
+	TestFile       *string    `json:"test_file"`
+	Status         *string    `json:"status"`
+	StartTime      *time.Time `json:"start_time"`
+	Duration       float64    `json:"duration"`
+	FailureMessage *string    `json:"failure_message,omitempty"`
+	URL            *string    `json:"url"`
+	URLRaw         *string    `json:"url_raw"`
+	TaskID         *string    `json:"task_id"`
+	Execution      int        `json:"execution"`
+	TaskName       *string    `json:"task_name"`
+	BuildVariant   *string    `json:"build_variant"`
+	VersionID      *string    `json:"version_id"`
+	Revision       *string    `json:"revision"`
+	Order          int        `json:"order"`
+}
+
+func (h *APITestHistory) BuildFromService(in interface{}) error {
+	history, ok := in.(*model.TestHistory)
+	if !ok {
+		return errors.Errorf("incorrect type '%T' when creating APITestHistory", in)
+	}
+
+	h.Runs = make([]APITestHistoryRun, 0, len(history.Runs))
+	for _, run := range history.Runs {
+		apiRun := APITestHistoryRun{}
+		apiRun.BuildFromService(run)
+		h.Runs = append(h.Runs, apiRun)
+	}
+	if history.FailingSince != nil {
+		h.FailingSince = &APITestHistoryRun{}
+		h.FailingSince.BuildFromService(*history.FailingSince)
+	}
+
+	return nil
+}
+
+func (h *APITestHistory) ToService() (interface{}, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *APITestHistoryRun) BuildFromService(run model.TestHistoryRun) {
+	tr := task.TestResult{
+		TestFile:        run.TestFile,
+		DisplayTestName: run.DisplayTestName,
+		URL:             run.URL,
+		LogId:           run.LogID,
+		LineNum:         run.LineNum,
+		TaskID:          run.TaskID,
+		Execution:       run.Execution,
+	}
+
+	r.TestFile = utility.ToStringPtr(tr.GetDisplayTestName())
+	r.Status = utility.ToStringPtr(run.Status)
+	r.StartTime = ToTimePtr(run.StartTime)
+	r.Duration = run.Duration.Seconds()
+	if run.FailureMessage != "" {
+		r.FailureMessage = utility.ToStringPtr(run.FailureMessage)
+	}
+	r.URL = utility.ToStringPtr(tr.GetLogURL(evergreen.LogViewerHTML))
+	r.URLRaw = utility.ToStringPtr(tr.GetLogURL(evergreen.LogViewerRaw))
+	r.TaskID = utility.ToStringPtr(run.TaskID)
+	r.Execution = run.Execution
+	r.TaskName = utility.ToStringPtr(run.TaskName)
+	r.BuildVariant = utility.ToStringPtr(run.BuildVariant)
+	r.VersionID = utility.ToStringPtr(run.VersionID)
+	r.Revision = utility.ToStringPtr(run.Revision)
+	r.Order = run.RevisionOrderNumber
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPITestHistoryBuildFromService(t *testing.T) {
+	start := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	failing := model.TestHistoryRun{
+		TestFile:            "TestFoo",
+		Status:              evergreen.TestFailedStatus,
+		StartTime:           start,
+		Duration:            1500 * time.Millisecond,
+		FailureMessage:      "expected true",
+		LogID:               "log",
+		TaskID:              "task",
+		Execution:           1,
+		TaskName:            "unit",
+		BuildVariant:        "ubuntu",
+		VersionID:           "version",
+		Revision:            "abc",
+		RevisionOrderNumber: 5,
+	}
+	history := &model.TestHistory{
+		Runs: []model.TestHistoryRun{
+			failing,
+			{
+				TestFile:            "TestFoo",
+				DisplayTestName:     "Foo",
+				Status:              evergreen.TestSucceededStatus,
+				TaskID:              "task2",
+				RevisionOrderNumber: 4,
+			},
+		},
+		FailingSince: &failing,
+	}
+
+	apiHistory := &APITestHistory{}
+	require.NoError(t, apiHistory.BuildFromService(history))
+	require.Len(t, apiHistory.Runs, 2)
+
+	run := apiHistory.Runs[0]
+	assert.Equal(t, "TestFoo", utility.FromStringPtr(run.TestFile))
+	assert.Equal(t, evergreen.TestFailedStatus, utility.FromStringPtr(run.Status))
+	assert.Equal(t, start, *run.StartTime)
+	assert.Equal(t, 1.5, run.Duration)
+	assert.Equal(t, "expected true", utility.FromStringPtr(run.FailureMessage))
+	assert.Contains(t, utility.FromStringPtr(run.URL), "log")
+	assert.Equal(t, "unit", utility.FromStringPtr(run.TaskName))
+	assert.Equal(t, 5, run.Order)
+
+	assert.Equal(t, "Foo", utility.FromStringPtr(apiHistory.Runs[1].TestFile))
+	assert.Nil(t, apiHistory.Runs[1].FailureMessage)
+
+	require.NotNil(t, apiHistory.FailingSince)
+	assert.Equal(t, "task", utility.FromStringPtr(apiHistory.FailingSince.TaskID))
+
+	assert.Error(t, apiHistory.BuildFromService(model.TestHistory{}))
+}
@@ -72,6 +72,11 @@ func TestTestBuildFromService(t *testing.T) {
 					ExitCode:        1,
 					StartTime:       utility.ToPythonTime(start),
 					EndTime:         utility.ToPythonTime(end),
+					FailureMessage:  "expected 1, got 2",
+					StackTrace:      "at test_file:10",
+					Tags:            []string{"smoke"},
+					Owners:          []string{"team"},
+					Retries:         2,
 				}
 				otr := task.ConvertToOld(input)
 
@@ -88,10 +93,15 @@ func TestTestBuildFromService(t *testing.T) {
 						LineNum:    15,
 						LogID:      utility.ToStringPtr(input.LogID),
 					},
-					ExitCode:  1,
-					StartTime: utility.ToTimePtr(start),
-					EndTime:   utility.ToTimePtr(end),
-					Duration:  input.EndTime - input.StartTime,
+					ExitCode:       1,
+					StartTime:      utility.ToTimePtr(start),
+					EndTime:        utility.ToTimePtr(end),
+					Duration:       input.EndTime - input.StartTime,
+					FailureMessage: utility.ToStringPtr(input.FailureMessage),
+					StackTrace:     utility.ToStringPtr(input.StackTrace),
+					Tags:           input.Tags,
+					Owners:         input.Owners,
+					Retries:        2,
 				}
 
 				return input, output
@@ -145,16 +155,26 @@ func TestTestBuildFromService(t *testing.T) {
 					LineNum:         15,
 					Start:           start,
 					End:             end,
+					FailureMessage:  "expected 1 but was 2",
+					StackTrace:      "at Test.java:10",
+					Tags:            []string{"slow"},
+					Owners:          []string{"team"},
+					Retries:         2,
 				}
 				otr := task.ConvertCedarTestResult(*input)
 
 				output := &APITest{
-					ID:         utility.ToStringPtr(input.TestName),
-					Execution:  input.Execution,
-					TestFile:   utility.ToStringPtr(input.DisplayTestName),
-					GroupID:    utility.ToStringPtr(input.GroupID),
-					Status:     utility.ToStringPtr(input.Status),
-					BaseStatus: utility.ToStringPtr(input.BaseStatus),
+					ID:             utility.ToStringPtr(input.TestName),
+					Execution:      input.Execution,
+					TestFile:       utility.ToStringPtr(input.DisplayTestName),
+					GroupID:        utility.ToStringPtr(input.GroupID),
+					Status:         utility.ToStringPtr(input.Status),
+					BaseStatus:     utility.ToStringPtr(input.BaseStatus),
+					FailureMessage: utility.ToStringPtr(input.FailureMessage),
+					StackTrace:     utility.ToStringPtr(input.StackTrace),
+					Tags:           input.Tags,
+					Owners:         input.Owners,
+					Retries:        input.Retries,
 					Logs: TestLogs{
 						URL:        utility.ToStringPtr(otr.GetLogURL(evergreen.LogViewerHTML)),
 						URLRaw:     utility.ToStringPtr(otr.GetLogURL(evergreen.LogViewerRaw)),
@@ -168,6 +168,7 @@ type commitQueueEnqueueItemHandler struct {
 	item    string
 	project string
 	force   bool
+	lane    string
 
 	sc data.Connector
 }
@@ -197,6 +198,10 @@ func (cq *commitQueueEnqueueItemHandler) Parse(ctx context.Context, r *http.Requ
 	if strings.ToLower(force) == "true" {
 		cq.force = true
 	}
+	cq.lane = r.URL.Query().Get("lane")
+	if cq.force && cq.lane != "" {
+		return errors.New("can't force an item to the front of the queue and enqueue it in a lane")
+	}
 	return nil
 }
 
@@ -209,8 +214,17 @@ func (cq *commitQueueEnqueueItemHandler) Run(ctx context.Context) gimlet.Respond
 		return gimlet.MakeJSONErrorResponder(errors.New("can't enqueue item, patch is empty"))
 	}
 	patchId := utility.ToStringPtr(cq.item)
-	position, err := cq.sc.EnqueueItem(cq.project, model.APICommitQueueItem{Issue: patchId, PatchId: patchId, Source: utility.ToStringPtr(commitqueue.SourceDiff)}, cq.force)
+	item := model.APICommitQueueItem{
+		Issue:   patchId,
+		PatchId: patchId,
+		Source:  utility.ToStringPtr(commitqueue.SourceDiff),
+		Lane:    utility.ToStringPtr(cq.lane),
+	}
+	position, err := cq.sc.EnqueueItem(cq.project, item, cq.force)
 	if err != nil {
+		if _, ok := err.(gimlet.ErrorResponse); ok {
+			return gimlet.MakeJSONErrorResponder(err)
+		}
 		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "can't enqueue item"))
 	}
 
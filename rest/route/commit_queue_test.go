@@ -48,6 +48,17 @@ func (s *CommitQueueSuite) TestParse() {
 	req = gimlet.SetURLVars(req, map[string]string{"patch_id": patchID})
 	s.NoError(route.Parse(ctx, req))
 	s.True(route.force)
+
+	route = makeCommitQueueEnqueueItem(s.sc).(*commitQueueEnqueueItemHandler)
+	req, _ = http.NewRequest("PUT", fmt.Sprintf("http://example.com/api/rest/v2/commit_queue/%s?lane=hotfix", patchID), nil)
+	req = gimlet.SetURLVars(req, map[string]string{"patch_id": patchID})
+	s.NoError(route.Parse(ctx, req))
+	s.False(route.force)
+	s.Equal("hotfix", route.lane)
+
+	req, _ = http.NewRequest("PUT", fmt.Sprintf("http://example.com/api/rest/v2/commit_queue/%s?force=true&lane=hotfix", patchID), nil)
+	req = gimlet.SetURLVars(req, map[string]string{"patch_id": patchID})
+	s.Error(route.Parse(ctx, req))
 }
 
 func (s *CommitQueueSuite) TestGetCommitQueue() {
@@ -172,6 +183,33 @@ func (s *CommitQueueSuite) TestEnqueueItem() {
 	s.Equal(model.APICommitQueuePosition{Position: 0}, response.Data())
 }
 
+func TestEnqueueItemInUnknownLane(t *testing.T) {
+	assert.NoError(t, db.ClearCollections(dbModel.ProjectRefCollection, commitqueue.Collection, patch.Collection))
+	projectRef := dbModel.ProjectRef{
+		Id:      "mci",
+		Enabled: utility.TruePtr(),
+		CommitQueue: dbModel.CommitQueueParams{
+			Enabled: utility.TruePtr(),
+			Lanes:   []commitqueue.Lane{{Name: "hotfix", MaxConcurrent: 1}},
+		},
+	}
+	assert.NoError(t, projectRef.Insert())
+	assert.NoError(t, commitqueue.InsertQueue(&commitqueue.CommitQueue{ProjectID: "mci"}))
+	p := patch.Patch{
+		Id:      mgobson.NewObjectId(),
+		Project: "mci",
+		Patches: []patch.ModulePatch{{Githash: "abcdef"}},
+	}
+	assert.NoError(t, p.Insert())
+
+	route := makeCommitQueueEnqueueItem(&data.DBConnector{}).(*commitQueueEnqueueItemHandler)
+	route.item = p.Id.Hex()
+	route.project = "mci"
+	route.lane = "bulk"
+	response := route.Run(context.Background())
+	assert.Equal(t, http.StatusBadRequest, response.Status())
+}
+
 func TestCqMessageForPatch(t *testing.T) {
 	assert.NoError(t, db.ClearCollections(dbModel.ProjectRefCollection, patch.Collection))
 	project := dbModel.ProjectRef{
@@ -0,0 +1,243 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/evergreen-ci/evergreen/model/coverage"
+	"github.com/evergreen-ci/evergreen/rest/data"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/pkg/errors"
+)
+
+const (
+	coverageStatsDefaultLimit = 100
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// Handler for the agent to send the coverage of a task's source files
+//
+//    /tasks/{task_id}/coverage
+type taskCoveragePostHandler struct {
+	taskID string
+	files  []coverage.FileCoverage
+	sc     data.Connector
+}
+
+func makeAttachTaskCoverage(sc data.Connector) gimlet.RouteHandler {
+	return &taskCoveragePostHandler{sc: sc}
+}
+
+func (h *taskCoveragePostHandler) Factory() gimlet.RouteHandler {
+	return &taskCoveragePostHandler{sc: h.sc}
+}
+
+func (h *taskCoveragePostHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.taskID = gimlet.GetVars(r)["task_id"]
+
+	if err := gimlet.GetJSON(r.Body, &h.files); err != nil {
+		return errors.Wrap(err, "unmarshaling the request body")
+	}
+	for _, f := range h.files {
+		if f.Path == "" {
+			return gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    "file coverage must have a path",
+			}
+		}
+		if f.LinesCovered < 0 || f.LinesCovered > f.LinesTotal {
+			return gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    fmt.Sprintf("file '%s' has invalid coverage of %d out of %d lines", f.Path, f.LinesCovered, f.LinesTotal),
+			}
+		}
+	}
+
+	return nil
+}
+
+func (h *taskCoveragePostHandler) Run(ctx context.Context) gimlet.Responder {
+	t, err := h.sc.FindTaskById(h.taskID)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrapf(err, "finding task '%s'", h.taskID))
+	}
+	if t == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("task '%s' not found", h.taskID),
+		})
+	}
+
+	if err = h.sc.AttachTaskCoverage(t, h.files); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "attaching coverage to task '%s'", h.taskID))
+	}
+	return gimlet.NewTextResponse("coverage attached to task")
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// Handler for the coverage reported by a task
+//
+//    /tasks/{task_id}/coverage
+type taskCoverageGetHandler struct {
+	taskID    string
+	execution *int
+	sc        data.Connector
+}
+
+func makeGetTaskCoverage(sc data.Connector) gimlet.RouteHandler {
+	return &taskCoverageGetHandler{sc: sc}
+}
+
+func (h *taskCoverageGetHandler) Factory() gimlet.RouteHandler {
+	return &taskCoverageGetHandler{sc: h.sc}
+}
+
+func (h *taskCoverageGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.taskID = gimlet.GetVars(r)["task_id"]
+
+	vals := r.URL.Query()
+	if vals.Get("execution") != "" {
+		execution, err := parseTaskLogsInt(vals, "execution")
+		if err != nil {
+			return err
+		}
+		h.execution = &execution
+	}
+
+	return nil
+}
+
+func (h *taskCoverageGetHandler) Run(ctx context.Context) gimlet.Responder {
+	t, err := findTaskExecution(h.sc, h.taskID, h.execution)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(err)
+	}
+
+	c, err := h.sc.FindTaskCoverage(t.Id, t.Execution)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(err)
+	}
+
+	apiCoverage := &model.APITaskCoverage{}
+	if err = apiCoverage.BuildFromService(c); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "converting task coverage to API model"))
+	}
+	return gimlet.NewJSONResponse(apiCoverage)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// Handler for the coverage of each build variant in a version
+//
+//    /versions/{version_id}/coverage
+type versionCoverageHandler struct {
+	versionID string
+	sc        data.Connector
+}
+
+func makeGetVersionCoverage(sc data.Connector) gimlet.RouteHandler {
+	return &versionCoverageHandler{sc: sc}
+}
+
+func (h *versionCoverageHandler) Factory() gimlet.RouteHandler {
+	return &versionCoverageHandler{sc: h.sc}
+}
+
+func (h *versionCoverageHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.versionID = gimlet.GetVars(r)["version_id"]
+	return nil
+}
+
+func (h *versionCoverageHandler) Run(ctx context.Context) gimlet.Responder {
+	results, err := h.sc.FindVersionCoverage(h.versionID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding coverage for version '%s'", h.versionID))
+	}
+	return makeVersionCoverageResponse(results)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// Handler for the coverage trend of a project's build variants
+//
+//    /projects/{project_id}/coverage_stats
+type coverageStatsHandler struct {
+	opts coverage.TrendOptions
+	sc   data.Connector
+	StatsHandler
+}
+
+func makeGetProjectCoverageStats(sc data.Connector) gimlet.RouteHandler {
+	return &coverageStatsHandler{sc: sc}
+}
+
+func (h *coverageStatsHandler) Factory() gimlet.RouteHandler {
+	return &coverageStatsHandler{sc: h.sc}
+}
+
+func (h *coverageStatsHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.opts = coverage.TrendOptions{Project: gimlet.GetVars(r)["project_id"]}
+	vals := r.URL.Query()
+
+	var err error
+	h.opts.Requesters, err = h.readRequesters(h.readStringList(vals["requesters"]))
+	if err != nil {
+		return gimlet.ErrorResponse{
+			Message:    "Invalid requesters value",
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+
+	h.opts.BuildVariants = h.readStringList(vals["variants"])
+
+	h.opts.Limit, err = h.readInt(vals.Get("limit"), 1, statsAPIMaxLimit, coverageStatsDefaultLimit)
+	if err != nil {
+		return gimlet.ErrorResponse{
+			Message:    "Invalid limit value",
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+
+	if beforeOrder := vals.Get("before_order"); beforeOrder != "" {
+		h.opts.BeforeOrder, err = strconv.Atoi(beforeOrder)
+		if err != nil || h.opts.BeforeOrder <= 0 {
+			return gimlet.ErrorResponse{
+				Message:    "Invalid before_order value",
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+	}
+
+	return nil
+}
+
+func (h *coverageStatsHandler) Run(ctx context.Context) gimlet.Responder {
+	projectRef, err := h.sc.FindProjectById(h.opts.Project, false, false)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrapf(err, "finding project '%s'", h.opts.Project))
+	}
+	h.opts.Project = projectRef.Id
+
+	results, err := h.sc.FindCoverageTrend(h.opts)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "finding coverage trend"))
+	}
+	return makeVersionCoverageResponse(results)
+}
+
+func makeVersionCoverageResponse(results []coverage.VersionCoverage) gimlet.Responder {
+	apiResults := make([]model.APIVersionCoverage, 0, len(results))
+	for _, c := range results {
+		apiCoverage := model.APIVersionCoverage{}
+		if err := apiCoverage.BuildFromService(c); err != nil {
+			return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "converting version coverage to API model"))
+		}
+		apiResults = append(apiResults, apiCoverage)
+	}
+	return gimlet.NewJSONResponse(apiResults)
+}
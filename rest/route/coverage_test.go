@@ -0,0 +1,131 @@
+package route
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/coverage"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/rest/data"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskCoverageRoutes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sc := &data.MockConnector{}
+	sc.MockTaskConnector.CachedTasks = []task.Task{{Id: "t1", Version: "v1", BuildVariant: "ubuntu"}}
+
+	post := func(files []coverage.FileCoverage) (gimlet.RouteHandler, error) {
+		body, err := json.Marshal(files)
+		require.NoError(t, err)
+		r, err := http.NewRequest(http.MethodPost, "/tasks/t1/coverage", bytes.NewBuffer(body))
+		require.NoError(t, err)
+		r = gimlet.SetURLVars(r, map[string]string{"task_id": "t1"})
+		h := makeAttachTaskCoverage(sc).Factory()
+		return h, h.Parse(ctx, r)
+	}
+
+	t.Run("RejectsInvalidCoverage", func(t *testing.T) {
+		_, err := post([]coverage.FileCoverage{{Path: "a.go", Summary: coverage.Summary{LinesCovered: 2, LinesTotal: 1}}})
+		assert.Error(t, err)
+		_, err = post([]coverage.FileCoverage{{Summary: coverage.Summary{LinesCovered: 1, LinesTotal: 1}}})
+		assert.Error(t, err)
+	})
+	t.Run("AttachAndGet", func(t *testing.T) {
+		h, err := post([]coverage.FileCoverage{
+			{Path: "b.go", Summary: coverage.Summary{LinesCovered: 1, LinesTotal: 4}},
+			{Path: "a.go", Summary: coverage.Summary{LinesCovered: 3, LinesTotal: 4}},
+		})
+		require.NoError(t, err)
+		resp := h.Run(ctx)
+		require.Equal(t, http.StatusOK, resp.Status())
+
+		r, err := http.NewRequest(http.MethodGet, "/tasks/t1/coverage", nil)
+		require.NoError(t, err)
+		r = gimlet.SetURLVars(r, map[string]string{"task_id": "t1"})
+		getHandler := makeGetTaskCoverage(sc).Factory()
+		require.NoError(t, getHandler.Parse(ctx, r))
+		resp = getHandler.Run(ctx)
+		require.Equal(t, http.StatusOK, resp.Status())
+
+		apiCoverage, ok := resp.Data().(*model.APITaskCoverage)
+		require.True(t, ok)
+		assert.Equal(t, "ubuntu", utility.FromStringPtr(apiCoverage.BuildVariant))
+		assert.Equal(t, 4, apiCoverage.LinesCovered)
+		assert.Equal(t, 8, apiCoverage.LinesTotal)
+		assert.InDelta(t, 50.0, apiCoverage.Percent, 0.001)
+		require.Len(t, apiCoverage.Files, 2)
+		assert.Equal(t, "a.go", utility.FromStringPtr(apiCoverage.Files[0].Path))
+	})
+	t.Run("MissingCoverage", func(t *testing.T) {
+		r, err := http.NewRequest(http.MethodGet, "/tasks/t1/coverage?execution=1", nil)
+		require.NoError(t, err)
+		r = gimlet.SetURLVars(r, map[string]string{"task_id": "t1"})
+		getHandler := makeGetTaskCoverage(sc).Factory()
+		require.NoError(t, getHandler.Parse(ctx, r))
+		assert.Equal(t, http.StatusNotFound, getHandler.Run(ctx).Status())
+	})
+}
+
+func TestCoverageStatsRoutes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sc := &data.MockConnector{}
+	sc.MockProjectConnector.CachedProjects = []dbModel.ProjectRef{{Id: "project_id", Identifier: "project"}}
+	sc.MockCoverageConnector.CachedVersionCoverage = []coverage.VersionCoverage{
+		{Version: "v3", BuildVariant: "ubuntu", RevisionOrderNumber: 3, Summary: coverage.Summary{LinesCovered: 9, LinesTotal: 10}},
+		{Version: "v2", BuildVariant: "ubuntu", RevisionOrderNumber: 2, Summary: coverage.Summary{LinesCovered: 8, LinesTotal: 10}},
+		{Version: "v2", BuildVariant: "windows", RevisionOrderNumber: 2, Summary: coverage.Summary{LinesCovered: 1, LinesTotal: 10}},
+		{Version: "v1", BuildVariant: "ubuntu", RevisionOrderNumber: 1, Summary: coverage.Summary{LinesCovered: 7, LinesTotal: 10}},
+	}
+
+	getStats := func(query string) gimlet.Responder {
+		r, err := http.NewRequest(http.MethodGet, "/projects/project/coverage_stats?"+query, nil)
+		require.NoError(t, err)
+		r = gimlet.SetURLVars(r, map[string]string{"project_id": "project"})
+		h := makeGetProjectCoverageStats(sc).Factory()
+		require.NoError(t, h.Parse(ctx, r))
+		return h.Run(ctx)
+	}
+
+	t.Run("Trend", func(t *testing.T) {
+		resp := getStats("variants=ubuntu&before_order=3&limit=1")
+		require.Equal(t, http.StatusOK, resp.Status())
+		results, ok := resp.Data().([]model.APIVersionCoverage)
+		require.True(t, ok)
+		require.Len(t, results, 1)
+		assert.Equal(t, "v2", utility.FromStringPtr(results[0].Version))
+		assert.InDelta(t, 80.0, results[0].Percent, 0.001)
+	})
+	t.Run("InvalidParams", func(t *testing.T) {
+		for _, query := range []string{"limit=0", "before_order=first", "requesters=nightly"} {
+			r, err := http.NewRequest(http.MethodGet, "/projects/project/coverage_stats?"+query, nil)
+			require.NoError(t, err)
+			r = gimlet.SetURLVars(r, map[string]string{"project_id": "project"})
+			assert.Error(t, makeGetProjectCoverageStats(sc).Factory().Parse(ctx, r), query)
+		}
+	})
+	t.Run("Version", func(t *testing.T) {
+		r, err := http.NewRequest(http.MethodGet, "/versions/v2/coverage", nil)
+		require.NoError(t, err)
+		r = gimlet.SetURLVars(r, map[string]string{"version_id": "v2"})
+		h := makeGetVersionCoverage(sc).Factory()
+		require.NoError(t, h.Parse(ctx, r))
+		resp := h.Run(ctx)
+		require.Equal(t, http.StatusOK, resp.Status())
+		results, ok := resp.Data().([]model.APIVersionCoverage)
+		require.True(t, ok)
+		assert.Len(t, results, 2)
+	})
+}
@@ -2,12 +2,14 @@ package route
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/graphql"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/commitqueue"
 	"github.com/evergreen-ci/evergreen/model/patch"
@@ -89,7 +91,12 @@ func (gh *githubHookApi) Parse(ctx context.Context, r *http.Request) error {
 		}
 	}
 
-	gh.event, err = github.ParseWebHook(gh.eventType, body)
+	if gh.eventType == thirdparty.GithubMergeGroupEventType {
+		// the GitHub client library can't parse merge group events
+		gh.event, err = thirdparty.ParseGithubMergeGroupEvent(body)
+	} else {
+		gh.event, err = github.ParseWebHook(gh.eventType, body)
+	}
 	if err != nil {
 		return gimlet.ErrorResponse{
 			StatusCode: http.StatusBadRequest,
@@ -263,6 +270,68 @@ func (gh *githubHookApi) Run(ctx context.Context) gimlet.Responder {
 					return gimlet.MakeJSONErrorResponder(err)
 				}
 			}
+			if triggersPatchCommand(*event.Action, *event.Comment.Body) {
+				grip.Info(message.Fields{
+					"source":    "github hook",
+					"msg_id":    gh.msgID,
+					"event":     gh.eventType,
+					"repo":      *event.Repo.FullName,
+					"pr_number": *event.Issue.Number,
+					"user":      *event.Sender.Login,
+					"message":   "patch command triggered",
+				})
+				if err := gh.handlePatchCommand(ctx, event); err != nil {
+					grip.Error(message.WrapError(err, message.Fields{
+						"source":    "github hook",
+						"msg_id":    gh.msgID,
+						"event":     gh.eventType,
+						"action":    event.Action,
+						"repo":      *event.Repo.FullName,
+						"pr_number": *event.Issue.Number,
+						"user":      *event.Sender.Login,
+						"message":   "can't run patch command",
+					}))
+					return gimlet.MakeJSONErrorResponder(err)
+				}
+			}
+		}
+
+	case *thirdparty.GithubMergeGroupEvent:
+		if err := event.Validate(); err != nil {
+			grip.Error(message.WrapError(err, message.Fields{
+				"source": "github hook",
+				"msg_id": gh.msgID,
+				"event":  gh.eventType,
+				"action": event.Action,
+			}))
+			return gimlet.NewJSONErrorResponse(gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    err.Error(),
+			})
+		}
+		grip.Info(message.Fields{
+			"source":   "github hook",
+			"msg_id":   gh.msgID,
+			"event":    gh.eventType,
+			"action":   event.Action,
+			"reason":   event.Reason,
+			"repo":     event.Repo.GetFullName(),
+			"head_ref": event.MergeGroup.HeadRef,
+			"hash":     event.MergeGroup.HeadSHA,
+			"message":  "merge group received",
+		})
+		if err := gh.handleMergeGroup(ctx, event); err != nil {
+			grip.Error(message.WrapError(err, message.Fields{
+				"source":   "github hook",
+				"msg_id":   gh.msgID,
+				"event":    gh.eventType,
+				"action":   event.Action,
+				"repo":     event.Repo.GetFullName(),
+				"head_ref": event.MergeGroup.HeadRef,
+				"hash":     event.MergeGroup.HeadSHA,
+				"message":  "can't handle merge group",
+			}))
+			return gimlet.MakeJSONErrorResponder(err)
 		}
 
 	case *github.MetaEvent:
@@ -307,6 +376,188 @@ func (gh *githubHookApi) retryPRPatch(ctx context.Context, owner, repo string, p
 	return gh.AddIntentForPR(pr, pr.User.GetLogin())
 }
 
+// handlePatchCommand runs a patch command left in a PR comment against the
+// PR's latest patch, and replies to the comment with what it did.
+func (gh *githubHookApi) handlePatchCommand(ctx context.Context, event *github.IssueCommentEvent) error {
+	userRepo := data.UserRepoInfo{
+		Username: event.Comment.User.GetLogin(),
+		Owner:    event.Repo.Owner.GetLogin(),
+		Repo:     event.Repo.GetName(),
+	}
+	prNum := event.Issue.GetNumber()
+
+	reply, err := gh.runPatchCommand(ctx, userRepo, prNum, event.Comment.GetBody())
+	if err != nil {
+		if isPatchCommandUserError(err) {
+			// problems with the command are only the commenter's to fix
+			reply = fmt.Sprintf("@%s Evergreen couldn't run your command: %s", userRepo.Username, err.Error())
+			err = nil
+		} else {
+			reply = fmt.Sprintf("@%s Evergreen couldn't run your command because of an internal error.", userRepo.Username)
+		}
+	}
+	if reply == "" {
+		return errors.WithStack(err)
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(err)
+	catcher.Wrap(gh.sc.PostGitHubPRComment(ctx, userRepo.Owner, userRepo.Repo, prNum, reply), "can't reply to PR comment")
+	return catcher.Resolve()
+}
+
+// isPatchCommandUserError returns whether the error is caused by the patch
+// command itself rather than by Evergreen.
+func isPatchCommandUserError(err error) bool {
+	errResp, ok := errors.Cause(err).(gimlet.ErrorResponse)
+	return ok && errResp.StatusCode >= http.StatusBadRequest && errResp.StatusCode < http.StatusInternalServerError
+}
+
+// runPatchCommand applies the patch command to the PR's latest patch and
+// returns a summary of what was done. Commands from users who aren't
+// authorized to run them are ignored without a summary. Problems with the
+// command itself are returned as gimlet.ErrorResponse errors with a 4xx status.
+func (gh *githubHookApi) runPatchCommand(ctx context.Context, userRepo data.UserRepoInfo, prNum int, comment string) (string, error) {
+	authorized, err := gh.sc.IsAuthorizedToPatchAndMerge(ctx, gh.settings, userRepo)
+	if err != nil {
+		return "", errors.Wrap(err, "can't get user info from GitHub API")
+	}
+	if !authorized {
+		grip.Info(message.Fields{
+			"source":    "github hook",
+			"msg_id":    gh.msgID,
+			"message":   "ignoring patch command from unauthorized user",
+			"user":      userRepo.Username,
+			"owner":     userRepo.Owner,
+			"repo":      userRepo.Repo,
+			"pr_number": prNum,
+		})
+		return "", nil
+	}
+
+	cmd, err := restModel.ParseGithubPatchComment(comment)
+	if err != nil {
+		return "", gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "invalid command").Error(),
+		}
+	}
+	if cmd == nil {
+		return "", gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    "comment is not a patch command",
+		}
+	}
+
+	caller, err := gh.findEvergreenUserForCommenter(userRepo.Username)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	apiPatch, err := gh.sc.FindLatestGithubPRPatch(userRepo.Owner, userRepo.Repo, prNum)
+	if err != nil {
+		return "", errors.Wrap(err, "can't find the PR's latest patch")
+	}
+	patchId := utility.FromStringPtr(apiPatch.Id)
+	patchLink := fmt.Sprintf("[%s](%s/version/%s)", patchId, gh.settings.Ui.Url, patchId)
+
+	switch cmd.Command {
+	case restModel.GithubCommentAbort:
+		if err = gh.sc.AbortPatch(patchId, caller); err != nil {
+			return "", errors.Wrapf(err, "can't abort patch '%s'", patchId)
+		}
+		return fmt.Sprintf("Aborted patch %s.", patchLink), nil
+	case restModel.GithubCommentRestart:
+		if cmd.FailedOnly {
+			if err = gh.sc.RestartFailedVersionTasks(patchId, caller); err != nil {
+				return "", errors.Wrapf(err, "can't restart failed tasks in patch '%s'", patchId)
+			}
+			return fmt.Sprintf("Restarted the failed tasks in patch %s.", patchLink), nil
+		}
+		if err = gh.sc.RestartVersion(patchId, caller); err != nil {
+			return "", errors.Wrapf(err, "can't restart patch '%s'", patchId)
+		}
+		return fmt.Sprintf("Restarted the finished tasks in patch %s.", patchLink), nil
+	case restModel.GithubCommentPatch:
+		scheduled, err := gh.schedulePatchCommand(ctx, apiPatch, cmd)
+		if err != nil {
+			return "", errors.Wrapf(err, "can't schedule tasks in patch '%s'", patchId)
+		}
+		return fmt.Sprintf("Scheduled %s in patch %s.", scheduled, patchLink), nil
+	}
+
+	return "", gimlet.ErrorResponse{
+		StatusCode: http.StatusBadRequest,
+		Message:    fmt.Sprintf("unrecognized command '%s'", cmd.Command),
+	}
+}
+
+// findEvergreenUserForCommenter returns the ID of the Evergreen user with the
+// given GitHub username, or the GitHub patch user if there's no such user.
+func (gh *githubHookApi) findEvergreenUserForCommenter(githubUser string) (string, error) {
+	u, err := gh.sc.FindUserByGithubName(githubUser)
+	if err != nil {
+		return "", errors.Wrapf(err, "can't find Evergreen user for GitHub user '%s'", githubUser)
+	}
+	if u == nil {
+		return evergreen.GithubPatchUser, nil
+	}
+	return u.Username(), nil
+}
+
+// schedulePatchCommand schedules the tasks selected by the patch command in
+// the same way as the schedule patch route, and returns a summary of the
+// scheduled tasks.
+func (gh *githubHookApi) schedulePatchCommand(ctx context.Context, apiPatch *restModel.APIPatch, cmd *restModel.GithubPatchComment) (string, error) {
+	dbPatch, err := apiPatch.ToService()
+	if err != nil {
+		return "", errors.Wrap(err, "unable to parse patch")
+	}
+	p := dbPatch.(patch.Patch)
+	token, err := gh.settings.GetGithubOauthToken()
+	if err != nil {
+		return "", errors.Wrap(err, "unable to get token")
+	}
+
+	variantIds := cmd.Variants
+	if len(variantIds) == 0 {
+		variantIds = []string{"*"}
+	}
+	taskNames := cmd.Tasks
+	if len(taskNames) == 0 {
+		taskNames = []string{"*"}
+	}
+	variantTasks := patchTasks{}
+	for _, v := range variantIds {
+		variantTasks.Variants = append(variantTasks.Variants, variant{Id: v, Tasks: taskNames})
+	}
+
+	patchId := p.Id.Hex()
+	dbVersion, _ := gh.sc.FindVersionById(patchId)
+	patchUpdateReq, err := makePatchUpdate(ctx, token, p, dbVersion, variantTasks)
+	if err != nil {
+		return "", err
+	}
+	err, code, msg, _ := graphql.SchedulePatch(ctx, patchId, dbVersion, patchUpdateReq)
+	if err != nil {
+		return "", err
+	}
+	if code != http.StatusOK {
+		return "", gimlet.ErrorResponse{
+			StatusCode: code,
+			Message:    msg,
+		}
+	}
+
+	numTasks := 0
+	var variants []string
+	for _, vt := range patchUpdateReq.VariantsTasks {
+		numTasks += len(vt.Tasks) + len(vt.DisplayTasks)
+		variants = append(variants, fmt.Sprintf("`%s`", vt.Variant))
+	}
+	return fmt.Sprintf("%d task(s) on %s", numTasks, strings.Join(variants, ", ")), nil
+}
+
 func (gh *githubHookApi) AddIntentForPR(pr *github.PullRequest, owner string) error {
 	ghi, err := patch.NewGithubIntent(gh.msgID, owner, pr)
 	if err != nil {
@@ -648,6 +899,56 @@ func (gh *githubHookApi) commitQueueEnqueue(ctx context.Context, event *github.I
 	return nil
 }
 
+// handleMergeGroup tests a merge group created by GitHub's merge queue, or
+// aborts the versions testing it once GitHub removes it from the queue.
+func (gh *githubHookApi) handleMergeGroup(ctx context.Context, event *thirdparty.GithubMergeGroupEvent) error {
+	ownerAndRepo := strings.Split(event.Repo.GetFullName(), "/")
+	owner, repo := ownerAndRepo[0], ownerAndRepo[1]
+	baseBranch := event.MergeGroup.BaseBranch()
+	projectRef, err := gh.sc.GetProjectWithCommitQueueByOwnerRepoAndBranch(owner, repo, baseBranch)
+	if err != nil {
+		return errors.Wrapf(err, "can't get project for '%s:%s' tracking branch '%s'", owner, repo, baseBranch)
+	}
+	if projectRef == nil || !projectRef.CommitQueue.IsGithubMergeQueueEnabled() {
+		grip.Debug(message.Fields{
+			"source":  "github hook",
+			"msg_id":  gh.msgID,
+			"event":   gh.eventType,
+			"owner":   owner,
+			"repo":    repo,
+			"branch":  baseBranch,
+			"message": "no project with GitHub merge queue enabled",
+		})
+		return nil
+	}
+
+	switch event.Action {
+	case thirdparty.GithubMergeGroupActionChecksRequested:
+		v, err := gh.sc.CreateVersionForGithubMergeGroup(ctx, *projectRef, event.MergeGroup)
+		if err != nil {
+			return errors.Wrapf(err, "can't create version for merge group '%s'", event.MergeGroup.HeadRef)
+		}
+		grip.Info(message.Fields{
+			"source":   "github hook",
+			"msg_id":   gh.msgID,
+			"event":    gh.eventType,
+			"project":  projectRef.Id,
+			"head_ref": event.MergeGroup.HeadRef,
+			"version":  v.Id,
+			"message":  "created version for merge group",
+		})
+	case thirdparty.GithubMergeGroupActionDestroyed:
+		if event.Reason == thirdparty.GithubMergeGroupReasonMerged {
+			return nil
+		}
+		if err = gh.sc.AbortGithubMergeGroupVersions(projectRef.Id, event.MergeGroup.HeadSHA, evergreen.GithubMergeRequester); err != nil {
+			return errors.Wrapf(err, "can't abort versions for merge group '%s'", event.MergeGroup.HeadRef)
+		}
+	}
+
+	return nil
+}
+
 func (gh *githubHookApi) requireSigned(ctx context.Context, userRepo data.UserRepoInfo, baseBranch string, pr *github.PullRequest, prNum int) error {
 	settings, err := gh.sc.GetEvergreenSettings()
 	if err != nil {
@@ -713,6 +1014,16 @@ func triggersRetry(action, comment string) bool {
 	return comment == retryComment
 }
 
+func triggersPatchCommand(action, comment string) bool {
+	// only new comments run commands so that editing a comment doesn't run
+	// its command again
+	if action != "created" {
+		return false
+	}
+	cmd, err := restModel.ParseGithubPatchComment(comment)
+	return cmd != nil || err != nil
+}
+
 func isTag(ref string) bool {
 	return strings.Contains(ref, refTags)
 }
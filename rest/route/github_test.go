@@ -5,23 +5,32 @@ import (
 	"context"
 	"io/ioutil"
 	"net/http"
+	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/build"
 	"github.com/evergreen-ci/evergreen/model/commitqueue"
+	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/user"
 	"github.com/evergreen-ci/evergreen/rest/data"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/evergreen-ci/evergreen/thirdparty"
 	"github.com/evergreen-ci/evergreen/util"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/evergreen-ci/utility"
 	"github.com/google/go-github/v34/github"
 	"github.com/mongodb/amboy"
 	"github.com/mongodb/grip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -269,6 +278,90 @@ func (s *GithubWebhookRouteSuite) TestRetryCommentTrigger() {
 	s.True(triggersRetry("created", "  evergreen retry "))
 }
 
+func (s *GithubWebhookRouteSuite) TestPatchCommandComment() {
+	s.True(triggersPatchCommand("created", "evergreen abort"))
+	s.True(triggersPatchCommand("created", "evergreen patch"))
+	s.False(triggersPatchCommand("deleted", "evergreen abort"))
+	s.False(triggersPatchCommand("edited", "evergreen abort"))
+	s.False(triggersPatchCommand("created", retryComment))
+
+	p := restModel.APIPatch{
+		Id:      utility.ToStringPtr("aabbccddeeff001122334455"),
+		Version: utility.ToStringPtr("aabbccddeeff001122334455"),
+	}
+	p.GithubPatchData.PRNumber = 1
+	p.GithubPatchData.BaseOwner = utility.ToStringPtr("baxterthehacker")
+	p.GithubPatchData.BaseRepo = utility.ToStringPtr("public-repo")
+	s.sc.MockPatchConnector = data.MockPatchConnector{
+		CachedPatches: []restModel.APIPatch{p},
+		CachedAborted: map[string]string{},
+	}
+
+	makeEvent := func(user, comment string) *github.IssueCommentEvent {
+		return &github.IssueCommentEvent{
+			Action: github.String("created"),
+			Issue: &github.Issue{
+				Number:           github.Int(1),
+				PullRequestLinks: &github.PullRequestLinks{URL: github.String("https://api.github.com/repos/baxterthehacker/public-repo/pulls/1")},
+			},
+			Comment: &github.IssueComment{
+				Body: github.String(comment),
+				User: &github.User{Login: github.String(user)},
+			},
+			Repo: &github.Repository{
+				Name:     github.String("public-repo"),
+				FullName: github.String("baxterthehacker/public-repo"),
+				Owner:    &github.User{Login: github.String("baxterthehacker")},
+			},
+			Sender: &github.User{Login: github.String(user)},
+		}
+	}
+
+	// commands from unauthorized users are ignored without a reply
+	s.h.event = makeEvent("someone", "evergreen abort")
+	resp := s.h.Run(context.Background())
+	s.Equal(http.StatusOK, resp.Status())
+	s.Empty(s.sc.MockPatchConnector.CachedAborted)
+	s.Empty(s.sc.MockCommitQueueConnector.PRComments[1])
+
+	// problems with the command are replied to rather than failing the hook
+	s.h.event = makeEvent("baxterthehacker", "evergreen abort now")
+	resp = s.h.Run(context.Background())
+	s.Equal(http.StatusOK, resp.Status())
+	s.Empty(s.sc.MockPatchConnector.CachedAborted)
+	if s.Len(s.sc.MockCommitQueueConnector.PRComments[1], 1) {
+		s.Contains(s.sc.MockCommitQueueConnector.PRComments[1][0], "takes no arguments")
+	}
+
+	s.h.event = makeEvent("baxterthehacker", "evergreen abort")
+	s.h.event.(*github.IssueCommentEvent).Issue.Number = github.Int(2)
+	resp = s.h.Run(context.Background())
+	s.Equal(http.StatusOK, resp.Status())
+	s.Empty(s.sc.MockPatchConnector.CachedAborted)
+	if s.Len(s.sc.MockCommitQueueConnector.PRComments[2], 1) {
+		s.Contains(s.sc.MockCommitQueueConnector.PRComments[2][0], "no patch found")
+	}
+
+	// a commenter without an Evergreen user runs the command as the GitHub
+	// patch user
+	s.h.event = makeEvent("baxterthehacker", "evergreen abort")
+	resp = s.h.Run(context.Background())
+	s.Equal(http.StatusOK, resp.Status())
+	s.Equal(evergreen.GithubPatchUser, s.sc.MockPatchConnector.CachedAborted[utility.FromStringPtr(p.Id)])
+	if s.Len(s.sc.MockCommitQueueConnector.PRComments[1], 2) {
+		s.Contains(s.sc.MockCommitQueueConnector.PRComments[1][1], "Aborted patch")
+	}
+
+	// otherwise it's run as the commenter's Evergreen user
+	s.sc.MockPatchConnector.CachedAborted = map[string]string{}
+	u := &user.DBUser{Id: "baxter"}
+	u.Settings.GithubUser.LastKnownAs = "baxterthehacker"
+	s.sc.MockUserConnector.CachedUsers = map[string]*user.DBUser{u.Id: u}
+	resp = s.h.Run(context.Background())
+	s.Equal(http.StatusOK, resp.Status())
+	s.Equal("baxter", s.sc.MockPatchConnector.CachedAborted[utility.FromStringPtr(p.Id)])
+}
+
 func (s *GithubWebhookRouteSuite) TestUnknownEventType() {
 	var emptyPayload []byte
 	event, err := github.ParseWebHook("unknown_type", emptyPayload)
@@ -361,3 +454,152 @@ func (s *GithubWebhookRouteSuite) TestCreateVersionForTag() {
 	s.NoError(err)
 	s.NotNil(v)
 }
+
+func TestGithubMergeGroupHook(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	collections := []string{model.ProjectRefCollection, model.ProjectAliasCollection, model.VersionCollection, model.ParserProjectCollection,
+		build.Collection, task.Collection, event.SubscriptionsCollection, model.RepositoriesCollection}
+	require.NoError(t, db.ClearCollections(collections...))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(collections...))
+	}()
+	// don't send GitHub statuses for the created version
+	originalFlags, err := evergreen.GetServiceFlags()
+	require.NoError(t, err)
+	require.NoError(t, evergreen.SetServiceFlags(evergreen.ServiceFlags{GithubStatusAPIDisabled: true}))
+	defer func() {
+		assert.NoError(t, evergreen.SetServiceFlags(*originalFlags))
+	}()
+
+	// the merge group's config is read from a local repository in place of
+	// GitHub
+	tmpDir := t.TempDir()
+	remote := filepath.Join(tmpDir, "remote.git")
+	work := filepath.Join(tmpDir, "work")
+	git := func(dir string, args ...string) string {
+		args = append([]string{"-c", "user.name=Evergreen", "-c", "user.email=evergreen@example.com"}, args...)
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	git(tmpDir, "init", "--bare", "--initial-branch=main", remote)
+	git(tmpDir, "clone", remote, work)
+	config := `
+buildvariants:
+- name: bv
+  display_name: bv
+  run_on:
+  - d
+  tasks:
+  - name: t1
+tasks:
+- name: t1
+  commands:
+  - command: shell.exec
+    params:
+      script: echo hi
+`
+	require.NoError(t, ioutil.WriteFile(filepath.Join(work, "evergreen.yml"), []byte(config), 0644))
+	git(work, "add", "evergreen.yml")
+	git(work, "commit", "-m", "merge group commit")
+	git(work, "push", "origin", "HEAD:gh-readonly-queue/main/pr-1")
+	headSHA := git(work, "rev-parse", "HEAD")
+
+	pRef := model.ProjectRef{
+		Id:         "mci",
+		Identifier: "mci",
+		Owner:      "evergreen-ci",
+		Repo:       "evergreen",
+		Branch:     "main",
+		Enabled:    utility.TruePtr(),
+		RemotePath: "evergreen.yml",
+		CodeHost:   thirdparty.CodeHostGit,
+		GitURL:     remote,
+		CommitQueue: model.CommitQueueParams{
+			Enabled:    utility.TruePtr(),
+			MergeQueue: model.MergeQueueGitHub,
+		},
+	}
+	require.NoError(t, pRef.Insert())
+	alias := model.ProjectAlias{ProjectID: pRef.Id, Alias: evergreen.CommitQueueAlias, Variant: ".*", Task: ".*"}
+	require.NoError(t, alias.Upsert())
+
+	rm := makeGithubHooksRoute(&data.DBConnector{}, evergreen.GetEnvironment().LocalQueue(), nil, evergreen.GetEnvironment().Settings())
+	h, ok := rm.Factory().(*githubHookApi)
+	require.True(t, ok)
+	h.eventType = thirdparty.GithubMergeGroupEventType
+	makeEvent := func(action, reason string) *thirdparty.GithubMergeGroupEvent {
+		return &thirdparty.GithubMergeGroupEvent{
+			Action: action,
+			Reason: reason,
+			MergeGroup: thirdparty.GithubMergeGroup{
+				HeadSHA: headSHA,
+				HeadRef: "refs/heads/gh-readonly-queue/main/pr-1",
+				BaseRef: "refs/heads/main",
+			},
+			Repo: &github.Repository{FullName: github.String("evergreen-ci/evergreen")},
+		}
+	}
+	findVersion := func(t *testing.T) *model.Version {
+		versions, err := model.VersionFind(model.VersionByGithubMergeGroup(pRef.Id, headSHA))
+		require.NoError(t, err)
+		require.Len(t, versions, 1)
+		return &versions[0]
+	}
+
+	t.Run("ChecksRequestedCreatesVersion", func(t *testing.T) {
+		h.event = makeEvent(thirdparty.GithubMergeGroupActionChecksRequested, "")
+		resp := h.Run(ctx)
+		require.Equal(t, http.StatusOK, resp.Status())
+
+		v := findVersion(t)
+		assert.Equal(t, evergreen.GithubMergeRequester, v.Requester)
+		assert.Equal(t, headSHA, v.Revision)
+		assert.True(t, utility.FromBoolPtr(v.Activated))
+		assert.Len(t, v.BuildIds, 1)
+
+		// GitHub's merge queue waits on the version's status, so it's
+		// always reported
+		subs, err := event.FindSubscriptions(event.ResourceTypeVersion, []event.Selector{{Type: event.SelectorID, Data: v.Id}})
+		require.NoError(t, err)
+		require.Len(t, subs, 1)
+		assert.Equal(t, event.TriggerGithubCheckOutcome, subs[0].Trigger)
+		target, ok := subs[0].Subscriber.Target.(*event.GithubCheckSubscriber)
+		require.True(t, ok)
+		assert.Equal(t, "evergreen-ci", target.Owner)
+		assert.Equal(t, "evergreen", target.Repo)
+		assert.Equal(t, headSHA, target.Ref)
+		subs, err = event.FindSubscriptions(event.ResourceTypeBuild, []event.Selector{{Type: event.SelectorInVersion, Data: v.Id}})
+		require.NoError(t, err)
+		assert.Len(t, subs, 1)
+	})
+	t.Run("DuplicateChecksRequestedReusesVersion", func(t *testing.T) {
+		h.event = makeEvent(thirdparty.GithubMergeGroupActionChecksRequested, "")
+		resp := h.Run(ctx)
+		require.Equal(t, http.StatusOK, resp.Status())
+		findVersion(t)
+	})
+	t.Run("DestroyedAfterMergeKeepsVersion", func(t *testing.T) {
+		h.event = makeEvent(thirdparty.GithubMergeGroupActionDestroyed, thirdparty.GithubMergeGroupReasonMerged)
+		resp := h.Run(ctx)
+		require.Equal(t, http.StatusOK, resp.Status())
+		assert.True(t, utility.FromBoolPtr(findVersion(t).Activated))
+	})
+	t.Run("DestroyedOtherwiseAbortsVersion", func(t *testing.T) {
+		h.event = makeEvent(thirdparty.GithubMergeGroupActionDestroyed, "invalidated")
+		resp := h.Run(ctx)
+		require.Equal(t, http.StatusOK, resp.Status())
+		v := findVersion(t)
+		assert.False(t, utility.FromBoolPtr(v.Activated))
+		tasks, err := task.FindAll(db.Query(task.ByVersion(v.Id)))
+		require.NoError(t, err)
+		require.NotEmpty(t, tasks)
+		for _, tsk := range tasks {
+			assert.False(t, tsk.Activated)
+		}
+	})
+}
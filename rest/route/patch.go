@@ -483,6 +483,78 @@ type variant struct {
 	Tasks []string `json:"tasks"`
 }
 
+// makePatchUpdate resolves the variants and tasks to schedule in the patch
+// against its project. A task name of "*" selects every task on the variant,
+// and a variant name of "*" selects every variant that has the given tasks.
+func makePatchUpdate(ctx context.Context, token string, p patch.Patch, dbVersion *dbModel.Version, variantTasks patchTasks) (graphql.PatchUpdate, error) {
+	var project *dbModel.Project
+	var err error
+	if dbVersion == nil {
+		project, _, err = dbModel.GetPatchedProject(ctx, &p, token)
+		if err != nil {
+			return graphql.PatchUpdate{}, errors.Wrap(err, "unable to find project from patch")
+		}
+	} else {
+		project, err = dbModel.FindProjectFromVersionID(dbVersion.Id)
+		if err != nil {
+			return graphql.PatchUpdate{}, errors.Wrap(err, "unable to find project from version")
+		}
+	}
+	patchUpdateReq := graphql.PatchUpdate{
+		Description: variantTasks.Description,
+	}
+	if patchUpdateReq.Description == "" && dbVersion != nil {
+		patchUpdateReq.Description = dbVersion.Message
+	}
+
+	var variants []variant
+	for _, v := range variantTasks.Variants {
+		if v.Id != "*" {
+			variants = append(variants, v)
+			continue
+		}
+		for _, bv := range project.BuildVariants {
+			var tasks []string
+			for _, t := range v.Tasks {
+				if project.FindTaskForVariant(t, bv.Name) != nil || project.GetDisplayTask(bv.Name, t) != nil {
+					tasks = append(tasks, t)
+				}
+			}
+			if len(tasks) > 0 {
+				variants = append(variants, variant{Id: bv.Name, Tasks: tasks})
+			}
+		}
+	}
+	if len(variants) == 0 {
+		return graphql.PatchUpdate{}, errors.New("no variants have the given tasks")
+	}
+
+	for _, v := range variants {
+		variantToSchedule := patch.VariantTasks{Variant: v.Id}
+		if len(v.Tasks) > 0 && v.Tasks[0] == "*" {
+			projectVariant := project.FindBuildVariant(v.Id)
+			if projectVariant == nil {
+				return graphql.PatchUpdate{}, errors.Errorf("variant not found: %s", v.Id)
+			}
+			variantToSchedule.DisplayTasks = projectVariant.DisplayTasks
+			for _, projectTask := range projectVariant.Tasks {
+				variantToSchedule.Tasks = append(variantToSchedule.Tasks, projectTask.Name)
+			}
+		} else {
+			for _, t := range v.Tasks {
+				dt := project.GetDisplayTask(v.Id, t)
+				if dt != nil {
+					variantToSchedule.DisplayTasks = append(variantToSchedule.DisplayTasks, *dt)
+				} else {
+					variantToSchedule.Tasks = append(variantToSchedule.Tasks, t)
+				}
+			}
+		}
+		patchUpdateReq.VariantsTasks = append(patchUpdateReq.VariantsTasks, variantToSchedule)
+	}
+	return patchUpdateReq, nil
+}
+
 type schedulePatchHandler struct {
 	variantTasks patchTasks
 
@@ -544,46 +616,9 @@ func (p *schedulePatchHandler) Run(ctx context.Context) gimlet.Responder {
 		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "unable to get token"))
 	}
 	dbVersion, _ := p.sc.FindVersionById(p.patchId)
-	var project *dbModel.Project
-	if dbVersion == nil {
-		project, _, err = dbModel.GetPatchedProject(ctx, &p.patch, token)
-		if err != nil {
-			return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "unable to find project from patch"))
-		}
-	} else {
-		project, err = dbModel.FindProjectFromVersionID(dbVersion.Id)
-		if err != nil {
-			return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "unable to find project from version"))
-		}
-	}
-	patchUpdateReq := graphql.PatchUpdate{
-		Description: p.variantTasks.Description,
-	}
-	if patchUpdateReq.Description == "" && dbVersion != nil {
-		patchUpdateReq.Description = dbVersion.Message
-	}
-	for _, v := range p.variantTasks.Variants {
-		variantToSchedule := patch.VariantTasks{Variant: v.Id}
-		if len(v.Tasks) > 0 && v.Tasks[0] == "*" {
-			projectVariant := project.FindBuildVariant(v.Id)
-			if projectVariant == nil {
-				return gimlet.MakeJSONErrorResponder(errors.Errorf("variant not found: %s", v.Id))
-			}
-			variantToSchedule.DisplayTasks = projectVariant.DisplayTasks
-			for _, projectTask := range projectVariant.Tasks {
-				variantToSchedule.Tasks = append(variantToSchedule.Tasks, projectTask.Name)
-			}
-		} else {
-			for _, t := range v.Tasks {
-				dt := project.GetDisplayTask(v.Id, t)
-				if dt != nil {
-					variantToSchedule.DisplayTasks = append(variantToSchedule.DisplayTasks, *dt)
-				} else {
-					variantToSchedule.Tasks = append(variantToSchedule.Tasks, t)
-				}
-			}
-		}
-		patchUpdateReq.VariantsTasks = append(patchUpdateReq.VariantsTasks, variantToSchedule)
+	patchUpdateReq, err := makePatchUpdate(ctx, token, p.patch, dbVersion, p.variantTasks)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(err)
 	}
 	err, code, msg, versionId := graphql.SchedulePatch(ctx, p.patchId, dbVersion, patchUpdateReq)
 	if err != nil {
@@ -0,0 +1,280 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/evergreen-ci/evergreen/model/perf"
+	"github.com/evergreen-ci/evergreen/rest/data"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+const (
+	perfRegressionsDefaultLimit = 100
+	perfRegressionsMaxLimit     = 1000
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// Handler for the agent to send the metrics of a task's performance tests
+//
+//    /tasks/{task_id}/perf_results
+type taskPerfResultsPostHandler struct {
+	taskID  string
+	results []perf.TestResult
+	sc      data.Connector
+}
+
+func makeAddTaskPerfResults(sc data.Connector) gimlet.RouteHandler {
+	return &taskPerfResultsPostHandler{sc: sc}
+}
+
+func (h *taskPerfResultsPostHandler) Factory() gimlet.RouteHandler {
+	return &taskPerfResultsPostHandler{sc: h.sc}
+}
+
+func (h *taskPerfResultsPostHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.taskID = gimlet.GetVars(r)["task_id"]
+
+	if err := gimlet.GetJSON(r.Body, &h.results); err != nil {
+		return errors.Wrap(err, "unmarshaling the request body")
+	}
+	for _, result := range h.results {
+		if result.TestName == "" {
+			return gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    "perf results must have a test name",
+			}
+		}
+		for _, m := range result.Metrics {
+			if m.Name == "" {
+				return gimlet.ErrorResponse{
+					StatusCode: http.StatusBadRequest,
+					Message:    fmt.Sprintf("metrics of test '%s' must have a name", result.TestName),
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (h *taskPerfResultsPostHandler) Run(ctx context.Context) gimlet.Responder {
+	t, err := h.sc.FindTaskById(h.taskID)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrapf(err, "finding task '%s'", h.taskID))
+	}
+	if t == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("task '%s' not found", h.taskID),
+		})
+	}
+
+	if err = h.sc.AddPerfResults(t, h.results); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "adding perf results to task '%s'", h.taskID))
+	}
+	return gimlet.NewTextResponse("perf results added to task")
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// Handler for the performance regressions detected in a project
+//
+//    /projects/{project_id}/perf_regressions
+type perfRegressionsGetHandler struct {
+	opts perf.RegressionOptions
+	sc   data.Connector
+}
+
+func makeGetProjectPerfRegressions(sc data.Connector) gimlet.RouteHandler {
+	return &perfRegressionsGetHandler{sc: sc}
+}
+
+func (h *perfRegressionsGetHandler) Factory() gimlet.RouteHandler {
+	return &perfRegressionsGetHandler{sc: h.sc}
+}
+
+func (h *perfRegressionsGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	vals := r.URL.Query()
+	h.opts = perf.RegressionOptions{
+		Project:  gimlet.GetVars(r)["project_id"],
+		Status:   vals.Get("status"),
+		Variant:  vals.Get("variant"),
+		TaskName: vals.Get("task_name"),
+		TestName: vals.Get("test_name"),
+		Metric:   vals.Get("metric"),
+		Limit:    perfRegressionsDefaultLimit,
+	}
+	if h.opts.Status != "" {
+		if err := perf.ValidateStatus(h.opts.Status); err != nil {
+			return gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    err.Error(),
+			}
+		}
+	}
+	if limit := vals.Get("limit"); limit != "" {
+		var err error
+		h.opts.Limit, err = strconv.Atoi(limit)
+		if err != nil || h.opts.Limit <= 0 || h.opts.Limit > perfRegressionsMaxLimit {
+			return gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    fmt.Sprintf("limit must be between 1 and %d", perfRegressionsMaxLimit),
+			}
+		}
+	}
+
+	return nil
+}
+
+func (h *perfRegressionsGetHandler) Run(ctx context.Context) gimlet.Responder {
+	projectRef, err := h.sc.FindProjectById(h.opts.Project, false, false)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrapf(err, "finding project '%s'", h.opts.Project))
+	}
+	h.opts.Project = projectRef.Id
+
+	regressions, err := h.sc.FindPerfRegressions(h.opts)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "finding perf regressions"))
+	}
+
+	apiRegressions := make([]model.APIPerfRegression, 0, len(regressions))
+	for _, r := range regressions {
+		apiRegression := model.APIPerfRegression{}
+		if err = apiRegression.BuildFromService(r); err != nil {
+			return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "converting perf regression to API model"))
+		}
+		apiRegressions = append(apiRegressions, apiRegression)
+	}
+	return gimlet.NewJSONResponse(apiRegressions)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// Handler for a single performance regression detected in a project
+//
+//    /projects/{project_id}/perf_regressions/{regression_id}
+type perfRegressionGetHandler struct {
+	projectID    string
+	regressionID string
+	sc           data.Connector
+}
+
+func makeGetPerfRegression(sc data.Connector) gimlet.RouteHandler {
+	return &perfRegressionGetHandler{sc: sc}
+}
+
+func (h *perfRegressionGetHandler) Factory() gimlet.RouteHandler {
+	return &perfRegressionGetHandler{sc: h.sc}
+}
+
+func (h *perfRegressionGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	vars := gimlet.GetVars(r)
+	h.projectID = vars["project_id"]
+	h.regressionID = vars["regression_id"]
+	return nil
+}
+
+func (h *perfRegressionGetHandler) Run(ctx context.Context) gimlet.Responder {
+	if err := checkPerfRegressionProject(h.sc, h.projectID, h.regressionID); err != nil {
+		return gimlet.MakeJSONErrorResponder(err)
+	}
+	r, err := h.sc.FindPerfRegressionByID(h.regressionID)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(err)
+	}
+	return makePerfRegressionResponse(r)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// Handler for triaging a performance regression detected in a project
+//
+//    /projects/{project_id}/perf_regressions/{regression_id}
+type perfRegressionTriageHandler struct {
+	projectID    string
+	regressionID string
+	status       string
+	note         string
+	sc           data.Connector
+}
+
+func makeTriagePerfRegression(sc data.Connector) gimlet.RouteHandler {
+	return &perfRegressionTriageHandler{sc: sc}
+}
+
+func (h *perfRegressionTriageHandler) Factory() gimlet.RouteHandler {
+	return &perfRegressionTriageHandler{sc: h.sc}
+}
+
+func (h *perfRegressionTriageHandler) Parse(ctx context.Context, r *http.Request) error {
+	vars := gimlet.GetVars(r)
+	h.projectID = vars["project_id"]
+	h.regressionID = vars["regression_id"]
+
+	body := struct {
+		Status *string `json:"status"`
+		Note   *string `json:"note"`
+	}{}
+	if err := gimlet.GetJSON(r.Body, &body); err != nil {
+		return errors.Wrap(err, "unmarshaling the request body")
+	}
+	h.status = utility.FromStringPtr(body.Status)
+	h.note = utility.FromStringPtr(body.Note)
+	if err := perf.ValidateStatus(h.status); err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		}
+	}
+
+	return nil
+}
+
+func (h *perfRegressionTriageHandler) Run(ctx context.Context) gimlet.Responder {
+	if err := checkPerfRegressionProject(h.sc, h.projectID, h.regressionID); err != nil {
+		return gimlet.MakeJSONErrorResponder(err)
+	}
+	u := MustHaveUser(ctx)
+	r, err := h.sc.TriagePerfRegression(h.regressionID, h.status, u.Username(), h.note)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrapf(err, "triaging regression '%s'", h.regressionID))
+	}
+	return makePerfRegressionResponse(r)
+}
+
+// checkPerfRegressionProject returns an error if the regression wasn't
+// detected in the project, so that users can only access the regressions of
+// projects they have permission to view.
+func checkPerfRegressionProject(sc data.Connector, projectID, regressionID string) error {
+	projectRef, err := sc.FindProjectById(projectID, false, false)
+	if err != nil {
+		return errors.Wrapf(err, "finding project '%s'", projectID)
+	}
+	r, err := sc.FindPerfRegressionByID(regressionID)
+	if err != nil {
+		return err
+	}
+	if r.Project != projectRef.Id {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("regression '%s' not found in project '%s'", regressionID, projectID),
+		}
+	}
+	return nil
+}
+
+func makePerfRegressionResponse(r *perf.Regression) gimlet.Responder {
+	apiRegression := &model.APIPerfRegression{}
+	if err := apiRegression.BuildFromService(r); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "converting perf regression to API model"))
+	}
+	return gimlet.NewJSONResponse(apiRegression)
+}
@@ -0,0 +1,142 @@
+package route
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/perf"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/user"
+	"github.com/evergreen-ci/evergreen/rest/data"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskPerfResultsRoute(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sc := &data.MockConnector{}
+	sc.MockTaskConnector.CachedTasks = []task.Task{
+		{Id: "t1", Project: "project_id", BuildVariant: "ubuntu", Requester: evergreen.RepotrackerVersionRequester},
+		{Id: "t2", Project: "project_id", BuildVariant: "ubuntu", Requester: evergreen.PatchVersionRequester},
+	}
+
+	post := func(taskID string, results []perf.TestResult) (gimlet.RouteHandler, error) {
+		body, err := json.Marshal(results)
+		require.NoError(t, err)
+		r, err := http.NewRequest(http.MethodPost, "/tasks/"+taskID+"/perf_results", bytes.NewBuffer(body))
+		require.NoError(t, err)
+		r = gimlet.SetURLVars(r, map[string]string{"task_id": taskID})
+		h := makeAddTaskPerfResults(sc).Factory()
+		return h, h.Parse(ctx, r)
+	}
+
+	_, err := post("t1", []perf.TestResult{{Metrics: []perf.Metric{{Name: "latency", Value: 1}}}})
+	assert.Error(t, err)
+	_, err = post("t1", []perf.TestResult{{TestName: "insert", Metrics: []perf.Metric{{Value: 1}}}})
+	assert.Error(t, err)
+
+	h, err := post("t2", []perf.TestResult{{TestName: "insert", Metrics: []perf.Metric{{Name: "latency", Value: 1}}}})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, h.Run(ctx).Status())
+	assert.Empty(t, sc.MockPerfConnector.CachedPerfResults, "patch results should not be stored")
+
+	h, err = post("t1", []perf.TestResult{{TestName: "insert", Metrics: []perf.Metric{{Name: "latency", Value: 1}}}})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, h.Run(ctx).Status())
+	require.Len(t, sc.MockPerfConnector.CachedPerfResults, 1)
+	assert.Equal(t, "ubuntu", sc.MockPerfConnector.CachedPerfResults[0].Variant)
+	assert.Equal(t, "insert", sc.MockPerfConnector.CachedPerfResults[0].TestName)
+}
+
+func TestPerfRegressionRoutes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = gimlet.AttachUser(ctx, &user.DBUser{Id: "me"})
+
+	sc := &data.MockConnector{}
+	sc.MockProjectConnector.CachedProjects = []dbModel.ProjectRef{
+		{Id: "project_id", Identifier: "project"},
+		{Id: "other_id", Identifier: "other"},
+	}
+	sc.MockPerfConnector.CachedRegressions = []perf.Regression{
+		{ID: "r1", SeriesID: perf.SeriesID{Project: "project_id", Metric: "latency"}, Status: perf.RegressionStatusUntriaged},
+		{ID: "r2", SeriesID: perf.SeriesID{Project: "project_id", Metric: "latency"}, Status: perf.RegressionStatusResolved},
+		{ID: "r3", SeriesID: perf.SeriesID{Project: "other_id", Metric: "latency"}, Status: perf.RegressionStatusUntriaged},
+	}
+
+	list := func(query string) (gimlet.Responder, error) {
+		r, err := http.NewRequest(http.MethodGet, "/projects/project/perf_regressions?"+query, nil)
+		require.NoError(t, err)
+		r = gimlet.SetURLVars(r, map[string]string{"project_id": "project"})
+		h := makeGetProjectPerfRegressions(sc).Factory()
+		if err = h.Parse(ctx, r); err != nil {
+			return nil, err
+		}
+		return h.Run(ctx), nil
+	}
+	triage := func(regressionID string, body map[string]string) (gimlet.Responder, error) {
+		b, err := json.Marshal(body)
+		require.NoError(t, err)
+		r, err := http.NewRequest(http.MethodPatch, "/projects/project/perf_regressions/"+regressionID, bytes.NewBuffer(b))
+		require.NoError(t, err)
+		r = gimlet.SetURLVars(r, map[string]string{"project_id": "project", "regression_id": regressionID})
+		h := makeTriagePerfRegression(sc).Factory()
+		if err = h.Parse(ctx, r); err != nil {
+			return nil, err
+		}
+		return h.Run(ctx), nil
+	}
+
+	t.Run("List", func(t *testing.T) {
+		resp, err := list("status=untriaged")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.Status())
+		regressions, ok := resp.Data().([]model.APIPerfRegression)
+		require.True(t, ok)
+		require.Len(t, regressions, 1)
+		assert.Equal(t, "r1", utility.FromStringPtr(regressions[0].ID))
+
+		for _, query := range []string{"status=ignored", "limit=0", "limit=many"} {
+			_, err = list(query)
+			assert.Error(t, err, query)
+		}
+	})
+	t.Run("Get", func(t *testing.T) {
+		for id, status := range map[string]int{"r2": http.StatusOK, "r3": http.StatusNotFound, "r4": http.StatusNotFound} {
+			r, err := http.NewRequest(http.MethodGet, "/projects/project/perf_regressions/"+id, nil)
+			require.NoError(t, err)
+			r = gimlet.SetURLVars(r, map[string]string{"project_id": "project", "regression_id": id})
+			h := makeGetPerfRegression(sc).Factory()
+			require.NoError(t, h.Parse(ctx, r))
+			assert.Equal(t, status, h.Run(ctx).Status(), id)
+		}
+	})
+	t.Run("Triage", func(t *testing.T) {
+		_, err := triage("r1", map[string]string{"status": "ignored"})
+		assert.Error(t, err)
+
+		resp, err := triage("r3", map[string]string{"status": perf.RegressionStatusAcknowledged})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.Status())
+
+		resp, err = triage("r1", map[string]string{"status": perf.RegressionStatusFalsePositive, "note": "noisy host"})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.Status())
+		regression, ok := resp.Data().(*model.APIPerfRegression)
+		require.True(t, ok)
+		assert.Equal(t, perf.RegressionStatusFalsePositive, utility.FromStringPtr(regression.Status))
+		assert.Equal(t, "me", utility.FromStringPtr(regression.TriagedBy))
+		assert.Equal(t, "noisy host", utility.FromStringPtr(regression.Note))
+		assert.NotNil(t, regression.TriagedAt)
+	})
+}
@@ -507,6 +507,27 @@ func (h *projectIDPatchHandler) Run(ctx context.Context) gimlet.Responder {
 		return gimlet.MakeJSONErrorResponder(errors.Wrap(catcher.Resolve(), "error validating triggers"))
 	}
 
+	if err = h.newProjectRef.ArtifactRetention.Validate(); err != nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	if err = h.newProjectRef.CommitQueue.Validate(); err != nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	if !utility.StringSliceContains(dbModel.ValidMergeQueues, h.newProjectRef.CommitQueue.MergeQueue) {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("invalid merge queue '%s'", h.newProjectRef.CommitQueue.MergeQueue),
+		})
+	}
+
 	err = dbModel.BbProjectIsValid(h.newProjectRef.Id, h.newProjectRef.BuildBaronSettings)
 	if err != nil {
 		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "error validating build baron config"))
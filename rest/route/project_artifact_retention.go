@@ -0,0 +1,72 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/rest/data"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// Handler for the dry run of a project's artifact retention policy
+//
+//    /projects/{project_id}/artifact_retention
+type artifactRetentionReportHandler struct {
+	projectID string
+	limit     int
+	sc        data.Connector
+}
+
+func makeGetArtifactRetentionReport(sc data.Connector) gimlet.RouteHandler {
+	return &artifactRetentionReportHandler{sc: sc}
+}
+
+func (h *artifactRetentionReportHandler) Factory() gimlet.RouteHandler {
+	return &artifactRetentionReportHandler{sc: h.sc}
+}
+
+func (h *artifactRetentionReportHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.projectID = gimlet.GetVars(r)["project_id"]
+
+	h.limit = dbModel.ArtifactRetentionBatchSize
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		var err error
+		h.limit, err = strconv.Atoi(limit)
+		if err != nil || h.limit <= 0 {
+			return gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    fmt.Sprintf("invalid limit '%s'", limit),
+			}
+		}
+	}
+
+	return nil
+}
+
+func (h *artifactRetentionReportHandler) Run(ctx context.Context) gimlet.Responder {
+	projectRef, err := h.sc.FindProjectById(h.projectID, false, false)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrapf(err, "finding project '%s'", h.projectID))
+	}
+
+	report := &model.APIArtifactRetentionReport{}
+	entries, err := h.sc.FindExpiredArtifacts(projectRef, time.Now(), h.limit)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "finding expired artifacts"))
+	}
+	if err = report.BuildFromService(entries); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "converting artifact retention report to API model"))
+	}
+	report.ProjectId = utility.ToStringPtr(projectRef.Id)
+
+	return gimlet.NewJSONResponse(report)
+}
@@ -0,0 +1,86 @@
+package route
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/rest/data"
+	restModel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArtifactRetentionReportHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sc := &data.MockConnector{}
+	sc.MockProjectConnector.CachedProjects = []model.ProjectRef{
+		{
+			Id:                "p1",
+			ArtifactRetention: model.ArtifactRetentionPolicy{MainlineDays: 90, Buckets: []string{"bucket"}},
+		},
+	}
+	sc.MockProjectConnector.CachedExpiredArtifacts = []artifact.Entry{
+		{
+			TaskId:    "t1",
+			Execution: 1,
+			Files: []artifact.File{
+				{Name: "binary", Bucket: "bucket", FileKey: "t1/binary"},
+				{Name: "logs", Bucket: "bucket", FileKey: "t1/logs"},
+			},
+		},
+		{
+			TaskId: "t2",
+			Files:  []artifact.File{{Name: "binary", Bucket: "bucket", FileKey: "t2/binary"}},
+		},
+	}
+
+	parse := func(query string) (*artifactRetentionReportHandler, error) {
+		r, err := http.NewRequest(http.MethodGet, "/projects/p1/artifact_retention?"+query, nil)
+		require.NoError(t, err)
+		r = gimlet.SetURLVars(r, map[string]string{"project_id": "p1"})
+		h := makeGetArtifactRetentionReport(sc).Factory().(*artifactRetentionReportHandler)
+		return h, h.Parse(ctx, r)
+	}
+
+	t.Run("DefaultLimit", func(t *testing.T) {
+		h, err := parse("")
+		require.NoError(t, err)
+		assert.Equal(t, model.ArtifactRetentionBatchSize, h.limit)
+	})
+	t.Run("InvalidLimit", func(t *testing.T) {
+		_, err := parse("limit=0")
+		assert.Error(t, err)
+		_, err = parse("limit=abc")
+		assert.Error(t, err)
+	})
+	t.Run("Report", func(t *testing.T) {
+		h, err := parse("limit=10")
+		require.NoError(t, err)
+		resp := h.Run(ctx)
+		require.Equal(t, http.StatusOK, resp.Status())
+
+		report, ok := resp.Data().(*restModel.APIArtifactRetentionReport)
+		require.True(t, ok)
+		assert.Equal(t, "p1", utility.FromStringPtr(report.ProjectId))
+		assert.Equal(t, 2, report.NumTasks)
+		assert.Equal(t, 3, report.NumFiles)
+		require.Len(t, report.Artifacts, 2)
+		assert.Equal(t, "t1", utility.FromStringPtr(report.Artifacts[0].TaskId))
+		require.Len(t, report.Artifacts[0].Files, 2)
+		assert.Equal(t, "t1/logs", utility.FromStringPtr(report.Artifacts[0].Files[1].FileKey))
+	})
+	t.Run("ProjectNotFound", func(t *testing.T) {
+		h, err := parse("")
+		require.NoError(t, err)
+		h.projectID = "nonexistent"
+		resp := h.Run(ctx)
+		assert.Equal(t, http.StatusNotFound, resp.Status())
+	})
+}
@@ -154,6 +154,7 @@ func (h *repoIDPatchHandler) Run(ctx context.Context) gimlet.Responder {
 
 	catcher := grip.NewSimpleCatcher()
 	catcher.Add(h.newRepoRef.ValidateOwnerAndRepo(h.settings.GithubOrgs))
+	catcher.Add(h.newRepoRef.CommitQueue.Validate())
 
 	// validate triggers before updating project
 	for i, trigger := range h.newRepoRef.Triggers {
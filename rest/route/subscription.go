@@ -159,3 +159,42 @@ func (s *subscriptionDeleteHandler) Run(ctx context.Context) gimlet.Responder {
 
 	return gimlet.NewJSONResponse(struct{}{})
 }
+
+////////////////////////////////////////////////////////////////////////
+//
+// POST /rest/v2/subscriptions/{subscription_id}/test
+
+type subscriptionTestHandler struct {
+	id string
+	sc data.Connector
+}
+
+func makeTestSubscription(sc data.Connector) gimlet.RouteHandler {
+	return &subscriptionTestHandler{
+		sc: sc,
+	}
+}
+
+func (s *subscriptionTestHandler) Factory() gimlet.RouteHandler {
+	return &subscriptionTestHandler{sc: s.sc}
+}
+
+func (s *subscriptionTestHandler) Parse(ctx context.Context, r *http.Request) error {
+	s.id = gimlet.GetVars(r)["subscription_id"]
+	if s.id == "" {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Must specify an ID to test",
+		}
+	}
+
+	return nil
+}
+
+func (s *subscriptionTestHandler) Run(ctx context.Context) gimlet.Responder {
+	if err := s.sc.TestSubscription(ctx, MustHaveUser(ctx), s.id); err != nil {
+		return gimlet.MakeJSONErrorResponder(err)
+	}
+
+	return gimlet.NewJSONResponse(struct{}{})
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -557,3 +559,29 @@ func (s *SubscriptionRouteSuite) TestAcceptSubscriptionWithOnlyRegexSelectors()
 	s.NoError(err)
 	s.NoError(s.postHandler.Parse(ctx, request))
 }
+
+func TestSubscriptionTestHandler(t *testing.T) {
+	ctx := gimlet.AttachUser(context.Background(), &user.DBUser{Id: "me"})
+	sc := &data.MockConnector{
+		MockSubscriptionConnector: data.MockSubscriptionConnector{
+			MockSubscriptions: []model.APISubscription{
+				{ID: utility.ToStringPtr("sub0")},
+			},
+		},
+	}
+	h := makeTestSubscription(sc)
+
+	r, err := http.NewRequest(http.MethodPost, "/subscriptions//test", nil)
+	require.NoError(t, err)
+	assert.Error(t, h.Parse(ctx, r))
+
+	r = gimlet.SetURLVars(r, map[string]string{"subscription_id": "sub0"})
+	require.NoError(t, h.Parse(ctx, r))
+	resp := h.Run(ctx)
+	assert.Equal(t, http.StatusOK, resp.Status())
+
+	r = gimlet.SetURLVars(r, map[string]string{"subscription_id": "sub1"})
+	require.NoError(t, h.Parse(ctx, r))
+	resp = h.Run(ctx)
+	assert.Equal(t, http.StatusNotFound, resp.Status())
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/evergreen-ci/evergreen/apimodels"
 	dbModel "github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/evergreen/rest/data"
 	"github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/gimlet"
@@ -450,6 +451,24 @@ func (rh *taskSetHasCedarResultsHandler) Run(ctx context.Context) gimlet.Respond
 		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "could not find task with ID '%s'", rh.taskID))
 	}
 
+	if len(rh.info.Metadata) > 0 {
+		metadata := make([]testresult.CedarMetadata, 0, len(rh.info.Metadata))
+		for _, m := range rh.info.Metadata {
+			metadata = append(metadata, testresult.CedarMetadata{
+				TaskID:         t.Id,
+				Execution:      t.Execution,
+				TestName:       m.TestName,
+				FailureMessage: m.FailureMessage,
+				StackTrace:     m.StackTrace,
+				Tags:           m.Tags,
+				Owners:         m.Owners,
+				Retries:        m.Retries,
+			})
+		}
+		if err = testresult.InsertCedarMetadata(metadata); err != nil {
+			return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "failed to save test result metadata for task with ID '%s'", rh.taskID))
+		}
+	}
 	if err = t.SetHasCedarResults(true, rh.info.Failed); err != nil {
 		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "failed to set HasCedarResults flag for task with ID '%s'", rh.taskID))
 	}
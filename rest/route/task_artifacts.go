@@ -0,0 +1,244 @@
+package route
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/rest/data"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+// taskArtifactRequest identifies a task's artifact file and, optionally, a
+// file in it if the artifact is an archive.
+type taskArtifactRequest struct {
+	taskID    string
+	execution *int
+	name      string
+	path      string
+}
+
+func parseTaskArtifactRequest(r *http.Request) (taskArtifactRequest, error) {
+	vals := r.URL.Query()
+	req := taskArtifactRequest{
+		taskID: gimlet.GetVars(r)["task_id"],
+		name:   vals.Get("name"),
+		path:   vals.Get("path"),
+	}
+	if req.name == "" {
+		return req, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    "must specify the name of the artifact",
+		}
+	}
+	if vals.Get("execution") != "" {
+		execution, err := parseTaskLogsInt(vals, "execution")
+		if err != nil {
+			return req, err
+		}
+		req.execution = &execution
+	}
+	return req, nil
+}
+
+// findArtifact returns the requested artifact file, checking that it is an
+// archive if a file in it is requested.
+func (req *taskArtifactRequest) findArtifact(sc data.Connector, requireArchive bool) (*artifact.File, error) {
+	t, err := findTaskExecution(sc, req.taskID, req.execution)
+	if err != nil {
+		return nil, err
+	}
+	file, err := sc.FindTaskArtifact(t, req.name)
+	if err != nil {
+		return nil, err
+	}
+	if requireArchive && file.ArchiveFormat() == "" {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("artifact '%s' is not a tar or zip archive", req.name),
+		}
+	}
+	return file, nil
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// Handler for the content of a task's artifact or of a file in an
+// artifact archive
+//
+//    /tasks/{task_id}/artifacts/content
+//
+// This is a plain HTTP handler rather than a gimlet.RouteHandler so that the
+// response can have the content type of the artifact.
+func makeGetTaskArtifactContent(sc data.Connector) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		req, err := parseTaskArtifactRequest(r)
+		if err != nil {
+			gimlet.WriteResponse(w, gimlet.MakeJSONErrorResponder(err))
+			return
+		}
+		file, err := req.findArtifact(sc, req.path != "")
+		if err != nil {
+			gimlet.WriteResponse(w, gimlet.MakeJSONErrorResponder(err))
+			return
+		}
+
+		body, upstreamContentType, err := sc.GetArtifactContent(ctx, *file)
+		if err != nil {
+			gimlet.WriteResponse(w, gimlet.MakeJSONErrorResponder(errors.Wrapf(err, "getting content of artifact '%s'", req.name)))
+			return
+		}
+		defer body.Close()
+
+		if req.path == "" {
+			writeArtifactContent(ctx, w, artifactFileName(*file), upstreamContentType, body)
+			return
+		}
+
+		err = artifact.ReadArchiveFile(body, file.ArchiveFormat(), req.path, func(_ artifact.ArchiveEntry, content io.Reader) error {
+			writeArtifactContent(ctx, w, req.path, "", content)
+			return nil
+		})
+		if errors.Cause(err) == artifact.ErrArchiveFileNotFound {
+			gimlet.WriteResponse(w, gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+				StatusCode: http.StatusNotFound,
+				Message:    fmt.Sprintf("file '%s' not found in artifact '%s'", req.path, req.name),
+			}))
+			return
+		}
+		if errors.Cause(err) == artifact.ErrArchiveTooLarge {
+			gimlet.WriteResponse(w, gimlet.MakeJSONErrorResponder(archiveTooLargeError(req.name, err)))
+			return
+		}
+		if err != nil {
+			gimlet.WriteResponse(w, gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "reading artifact archive '%s'", req.name)))
+		}
+	}
+}
+
+// archiveTooLargeError returns the error for an archive artifact that is too
+// large to browse.
+func archiveTooLargeError(name string, err error) gimlet.ErrorResponse {
+	return gimlet.ErrorResponse{
+		StatusCode: http.StatusRequestEntityTooLarge,
+		Message:    fmt.Sprintf("artifact '%s' cannot be browsed: %s", name, err.Error()),
+	}
+}
+
+// writeArtifactContent writes the content with its content type. Artifacts
+// are arbitrary files uploaded by tasks, so HTML artifacts are sandboxed to
+// keep their scripts from acting on behalf of the user.
+func writeArtifactContent(ctx context.Context, w http.ResponseWriter, name, upstreamContentType string, content io.Reader) {
+	br := bufio.NewReader(content)
+	// The error is ignored because a short or empty file is still sniffed.
+	head, _ := br.Peek(512)
+
+	w.Header().Set("Content-Type", artifactContentType(name, upstreamContentType, head))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": path.Base(name)}))
+	w.Header().Set("Content-Security-Policy", "sandbox allow-scripts")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := io.Copy(w, br); err != nil {
+		grip.Warning(message.WrapError(err, message.Fields{
+			"message":  "problem writing artifact content",
+			"artifact": name,
+			"aborted":  ctx.Err() != nil,
+		}))
+	}
+}
+
+// artifactContentType returns the content type reported by the server storing
+// the artifact unless it is generic, and otherwise determines it from the
+// file's extension or content.
+func artifactContentType(name, upstreamContentType string, head []byte) string {
+	switch strings.ToLower(strings.TrimSpace(strings.Split(upstreamContentType, ";")[0])) {
+	case "", "application/octet-stream", "binary/octet-stream":
+	default:
+		return upstreamContentType
+	}
+	if contentType := mime.TypeByExtension(path.Ext(name)); contentType != "" {
+		return contentType
+	}
+	return http.DetectContentType(head)
+}
+
+// artifactFileName returns the name of the file the artifact is stored as,
+// which has the extension that determines its content type.
+func artifactFileName(file artifact.File) string {
+	if file.FileKey != "" {
+		return file.FileKey
+	}
+	if u, err := url.Parse(file.Link); err == nil && path.Base(u.Path) != "/" && path.Base(u.Path) != "." {
+		return u.Path
+	}
+	return file.Name
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// Handler for listing the contents of a task's artifact archive
+//
+//    /tasks/{task_id}/artifacts/archive
+type taskArtifactArchiveHandler struct {
+	req taskArtifactRequest
+	sc  data.Connector
+}
+
+func makeGetTaskArtifactArchive(sc data.Connector) gimlet.RouteHandler {
+	return &taskArtifactArchiveHandler{sc: sc}
+}
+
+func (h *taskArtifactArchiveHandler) Factory() gimlet.RouteHandler {
+	return &taskArtifactArchiveHandler{sc: h.sc}
+}
+
+func (h *taskArtifactArchiveHandler) Parse(ctx context.Context, r *http.Request) error {
+	var err error
+	h.req, err = parseTaskArtifactRequest(r)
+	return err
+}
+
+func (h *taskArtifactArchiveHandler) Run(ctx context.Context) gimlet.Responder {
+	file, err := h.req.findArtifact(h.sc, true)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(err)
+	}
+
+	body, _, err := h.sc.GetArtifactContent(ctx, *file)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrapf(err, "getting content of artifact '%s'", h.req.name))
+	}
+	defer body.Close()
+
+	format := file.ArchiveFormat()
+	entries, err := artifact.ListArchive(body, format)
+	if errors.Cause(err) == artifact.ErrArchiveTooLarge {
+		return gimlet.MakeJSONErrorResponder(archiveTooLargeError(h.req.name, err))
+	}
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "listing artifact archive '%s'", h.req.name))
+	}
+
+	archive := &model.APIArtifactArchive{}
+	if err = archive.BuildFromService(entries); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "converting artifact archive to API model"))
+	}
+	archive.Name = utility.ToStringPtr(file.Name)
+	archive.Format = utility.ToStringPtr(format)
+
+	return gimlet.NewJSONResponse(archive)
+}
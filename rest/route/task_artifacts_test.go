@@ -0,0 +1,105 @@
+package route
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/rest/data"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArtifactContentType(t *testing.T) {
+	assert.Equal(t, "text/html; charset=utf-8", artifactContentType("coverage/index.html", "", nil))
+	assert.Equal(t, "application/json", artifactContentType("results.json", "binary/octet-stream", nil))
+	assert.Equal(t, "image/svg+xml", artifactContentType("graph", "image/svg+xml", nil))
+	assert.Equal(t, "text/plain; charset=utf-8", artifactContentType("output", "", []byte("plain text")))
+}
+
+func TestTaskArtifactRoutes(t *testing.T) {
+	tarball := &bytes.Buffer{}
+	gz := gzip.NewWriter(tarball)
+	tw := tar.NewWriter(gz)
+	content := []byte(`{"coverage": 0.8}`)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "report/data.json", Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(content))}))
+	_, err := tw.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	sc := &data.MockConnector{}
+	sc.MockTaskConnector.CachedTasks = []task.Task{{Id: "t1"}}
+	sc.MockTaskConnector.CachedArtifacts = []artifact.File{
+		{Name: "Coverage Report", FileKey: "t1/coverage.html"},
+		{Name: "Report Archive", FileKey: "t1/report.tgz"},
+		{Name: "Hidden", FileKey: "t1/hidden.txt", Visibility: artifact.None},
+		{Name: "Expired", FileKey: "t1/expired.txt", Expired: true},
+	}
+	sc.MockTaskConnector.CachedArtifactContent = map[string][]byte{
+		"Coverage Report": []byte("<html></html>"),
+		"Report Archive":  tarball.Bytes(),
+	}
+
+	getContent := func(query string) *httptest.ResponseRecorder {
+		r, err := http.NewRequest(http.MethodGet, "/tasks/t1/artifacts/content?"+query, nil)
+		require.NoError(t, err)
+		r = gimlet.SetURLVars(r, map[string]string{"task_id": "t1"})
+		rw := httptest.NewRecorder()
+		makeGetTaskArtifactContent(sc)(rw, r)
+		return rw
+	}
+
+	t.Run("Content", func(t *testing.T) {
+		rw := getContent("name=Coverage+Report")
+		require.Equal(t, http.StatusOK, rw.Code)
+		assert.Equal(t, "text/html; charset=utf-8", rw.Header().Get("Content-Type"))
+		assert.Contains(t, rw.Header().Get("Content-Security-Policy"), "sandbox")
+		assert.Equal(t, "<html></html>", rw.Body.String())
+	})
+	t.Run("ArchiveFileContent", func(t *testing.T) {
+		rw := getContent("name=Report+Archive&path=report/data.json")
+		require.Equal(t, http.StatusOK, rw.Code)
+		assert.Equal(t, "application/json", rw.Header().Get("Content-Type"))
+		assert.Equal(t, string(content), rw.Body.String())
+	})
+	t.Run("MissingArchiveFile", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, getContent("name=Report+Archive&path=report/missing.json").Code)
+	})
+	t.Run("FileInNonArchive", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, getContent("name=Coverage+Report&path=index.html").Code)
+	})
+	t.Run("HiddenArtifact", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, getContent("name=Hidden").Code)
+	})
+	t.Run("ExpiredArtifact", func(t *testing.T) {
+		assert.Equal(t, http.StatusGone, getContent("name=Expired").Code)
+	})
+	t.Run("MissingName", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, getContent("").Code)
+	})
+	t.Run("ListArchive", func(t *testing.T) {
+		r, err := http.NewRequest(http.MethodGet, "/tasks/t1/artifacts/archive?name=Report+Archive", nil)
+		require.NoError(t, err)
+		r = gimlet.SetURLVars(r, map[string]string{"task_id": "t1"})
+		h := makeGetTaskArtifactArchive(sc).Factory()
+		require.NoError(t, h.Parse(r.Context(), r))
+
+		resp := h.Run(r.Context())
+		require.Equal(t, http.StatusOK, resp.Status())
+		archive, ok := resp.Data().(*model.APIArtifactArchive)
+		require.True(t, ok)
+		assert.Equal(t, artifact.ArchiveFormatTarGz, utility.FromStringPtr(archive.Format))
+		require.Len(t, archive.Entries, 1)
+		assert.Equal(t, "report/data.json", utility.FromStringPtr(archive.Entries[0].Path))
+		assert.EqualValues(t, len(content), archive.Entries[0].Size)
+	})
+}
@@ -0,0 +1,89 @@
+package route
+
+import (
+	"context"
+	"net/http"
+
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/rest/data"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// Handler for searching a task's logs
+//
+//    /tasks/{task_id}/logs/search
+type taskLogSearchHandler struct {
+	opts      dbModel.TaskLogSearchOptions
+	execution *int
+	sc        data.Connector
+}
+
+func makeSearchTaskLogs(sc data.Connector) gimlet.RouteHandler {
+	return &taskLogSearchHandler{sc: sc}
+}
+
+func (h *taskLogSearchHandler) Factory() gimlet.RouteHandler {
+	return &taskLogSearchHandler{sc: h.sc}
+}
+
+func (h *taskLogSearchHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.opts = dbModel.TaskLogSearchOptions{TaskID: gimlet.GetVars(r)["task_id"]}
+
+	vals := r.URL.Query()
+	h.opts.Pattern = vals.Get("pattern")
+	h.opts.Severities = readTestMetadataList(vals["severities"])
+	h.opts.LogType = vals.Get("type")
+
+	var err error
+	if h.opts.ContextLines, err = parseTaskLogsInt(vals, "context"); err != nil {
+		return err
+	}
+	if h.opts.Page, err = parseTaskLogsInt(vals, "page"); err != nil {
+		return err
+	}
+	if h.opts.Limit, err = parseTaskLogsInt(vals, "limit"); err != nil {
+		return err
+	}
+	if vals.Get("execution") != "" {
+		execution, err := parseTaskLogsInt(vals, "execution")
+		if err != nil {
+			return err
+		}
+		h.execution = &execution
+	}
+
+	if err = h.opts.SetDefaultsAndValidate(); err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		}
+	}
+
+	return nil
+}
+
+func (h *taskLogSearchHandler) Run(ctx context.Context) gimlet.Responder {
+	t, defaultLogger, err := findTaskForLogs(h.sc, h.opts.TaskID, h.execution)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(err)
+	}
+	opts := h.opts
+	opts.Execution = t.Execution
+	opts.DefaultLogger = defaultLogger
+
+	result, err := h.sc.SearchTaskLogs(ctx, opts)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "searching task logs"))
+	}
+
+	apiResult := &model.APITaskLogSearchResult{}
+	if err = apiResult.BuildFromService(result); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "converting task log search result to API model"))
+	}
+
+	return gimlet.NewJSONResponse(apiResult)
+}
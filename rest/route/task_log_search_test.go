@@ -0,0 +1,59 @@
+package route
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/rest/data"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskLogSearchHandlerParse(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	parse := func(query string) (*taskLogSearchHandler, error) {
+		r, err := http.NewRequest(http.MethodGet, "/tasks/t1/logs/search?"+query, nil)
+		require.NoError(t, err)
+		r = gimlet.SetURLVars(r, map[string]string{"task_id": "t1"})
+		h := makeSearchTaskLogs(&data.MockConnector{}).(*taskLogSearchHandler)
+		return h, h.Parse(ctx, r)
+	}
+
+	t.Run("AllParameters", func(t *testing.T) {
+		h, err := parse("pattern=error.*timeout&severities=E,W&type=T&context=3&page=2&limit=20&execution=1")
+		require.NoError(t, err)
+		assert.Equal(t, "t1", h.opts.TaskID)
+		assert.Equal(t, "error.*timeout", h.opts.Pattern)
+		assert.Equal(t, []string{apimodels.LogErrorPrefix, apimodels.LogWarnPrefix}, h.opts.Severities)
+		assert.Equal(t, apimodels.TaskLogPrefix, h.opts.LogType)
+		assert.Equal(t, 3, h.opts.ContextLines)
+		assert.Equal(t, 2, h.opts.Page)
+		assert.Equal(t, 20, h.opts.Limit)
+		require.NotNil(t, h.execution)
+		assert.Equal(t, 1, *h.execution)
+	})
+	t.Run("Defaults", func(t *testing.T) {
+		h, err := parse("pattern=error")
+		require.NoError(t, err)
+		assert.Equal(t, apimodels.AllTaskLevelLogs, h.opts.LogType)
+		assert.Zero(t, h.opts.ContextLines)
+		assert.Nil(t, h.execution)
+	})
+	t.Run("MissingPattern", func(t *testing.T) {
+		_, err := parse("context=2")
+		assert.Error(t, err)
+	})
+	t.Run("InvalidPattern", func(t *testing.T) {
+		_, err := parse("pattern=(")
+		assert.Error(t, err)
+	})
+	t.Run("InvalidContext", func(t *testing.T) {
+		_, err := parse("pattern=error&context=some")
+		assert.Error(t, err)
+	})
+}
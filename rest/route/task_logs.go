@@ -0,0 +1,170 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/rest/data"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// Handler for reading a task's logs from a cursor, so that the logs of a
+// running task can be followed
+//
+//    /tasks/{task_id}/logs
+type taskLogsGetHandler struct {
+	opts      dbModel.TaskLogPageOptions
+	execution *int
+	sc        data.Connector
+}
+
+func makeGetTaskLogs(sc data.Connector) gimlet.RouteHandler {
+	return &taskLogsGetHandler{sc: sc}
+}
+
+func (h *taskLogsGetHandler) Factory() gimlet.RouteHandler {
+	return &taskLogsGetHandler{sc: h.sc}
+}
+
+func (h *taskLogsGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.opts = dbModel.TaskLogPageOptions{TaskID: gimlet.GetVars(r)["task_id"]}
+
+	vals := r.URL.Query()
+	h.opts.Severities = readTestMetadataList(vals["severities"])
+	h.opts.LogType = vals.Get("type")
+
+	var err error
+	if startTime := vals.Get("start_time"); startTime != "" {
+		if h.opts.StartTime, err = time.Parse(time.RFC3339Nano, startTime); err != nil {
+			return gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    fmt.Sprintf("invalid start_time '%s'", startTime),
+			}
+		}
+	}
+	if h.opts.StartSequence, err = parseTaskLogsInt(vals, "start_sequence"); err != nil {
+		return err
+	}
+	if h.opts.Limit, err = parseTaskLogsInt(vals, "limit"); err != nil {
+		return err
+	}
+	if vals.Get("execution") != "" {
+		execution, err := parseTaskLogsInt(vals, "execution")
+		if err != nil {
+			return err
+		}
+		h.execution = &execution
+	}
+
+	if err = h.opts.SetDefaultsAndValidate(); err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		}
+	}
+
+	return nil
+}
+
+func (h *taskLogsGetHandler) Run(ctx context.Context) gimlet.Responder {
+	t, defaultLogger, err := findTaskForLogs(h.sc, h.opts.TaskID, h.execution)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(err)
+	}
+	opts := h.opts
+	opts.Execution = t.Execution
+	opts.DefaultLogger = defaultLogger
+
+	page, err := h.sc.GetTaskLogPage(ctx, opts)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "getting task logs"))
+	}
+
+	apiPage := &model.APITaskLogPage{}
+	if err = apiPage.BuildFromService(page); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "converting task log page to API model"))
+	}
+	// The task's status is read before its logs, so once a client sees that
+	// the task is finished, the remaining pages have all of its logs.
+	apiPage.Execution = t.Execution
+	apiPage.Status = utility.ToStringPtr(t.Status)
+	apiPage.Finished = t.IsFinished()
+
+	return gimlet.NewJSONResponse(apiPage)
+}
+
+// findTaskExecution returns the requested task execution, or the task's latest
+// execution if execution is nil.
+func findTaskExecution(sc data.Connector, taskID string, execution *int) (*task.Task, error) {
+	var t *task.Task
+	var err error
+	if execution != nil {
+		t, err = sc.FindTaskByIdAndExecution(taskID, *execution)
+	} else {
+		t, err = sc.FindTaskById(taskID)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding task '%s'", taskID)
+	}
+	if t == nil {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("task '%s' not found", taskID),
+		}
+	}
+	return t, nil
+}
+
+// findTaskForLogs returns the task execution whose logs are requested, or its
+// latest execution if execution is nil, along with the logger its project
+// sends logs to.
+func findTaskForLogs(sc data.Connector, taskID string, execution *int) (*task.Task, string, error) {
+	t, err := findTaskExecution(sc, taskID, execution)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// The project's default logger determines where the logs are stored.
+	p, err := sc.FindProjectById(t.Project, true, true)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "finding project '%s'", t.Project)
+	}
+	if p == nil {
+		return nil, "", gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("project '%s' not found", t.Project),
+		}
+	}
+	defaultLogger := p.DefaultLogger
+	if defaultLogger == "" {
+		defaultLogger = evergreen.GetEnvironment().Settings().LoggerConfig.DefaultLogger
+	}
+
+	return t, defaultLogger, nil
+}
+
+// parseTaskLogsInt parses an optional integer query parameter.
+func parseTaskLogsInt(vals map[string][]string, key string) (int, error) {
+	if len(vals[key]) == 0 || vals[key][0] == "" {
+		return 0, nil
+	}
+	val, err := strconv.Atoi(vals[key][0])
+	if err != nil {
+		return 0, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("invalid %s '%s'", key, vals[key][0]),
+		}
+	}
+	return val, nil
+}
@@ -0,0 +1,68 @@
+package route
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/rest/data"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskLogsGetHandlerParse(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	parse := func(query string) (*taskLogsGetHandler, error) {
+		r, err := http.NewRequest(http.MethodGet, "/tasks/t1/logs?"+query, nil)
+		require.NoError(t, err)
+		r = gimlet.SetURLVars(r, map[string]string{"task_id": "t1"})
+		h := makeGetTaskLogs(&data.MockConnector{}).(*taskLogsGetHandler)
+		return h, h.Parse(ctx, r)
+	}
+
+	t.Run("AllParameters", func(t *testing.T) {
+		h, err := parse("type=E&severities=E,W&start_time=2021-03-04T05:06:07.123Z&start_sequence=2&limit=10&execution=2")
+		require.NoError(t, err)
+		assert.Equal(t, model.TaskLogPageOptions{
+			TaskID:        "t1",
+			LogType:       apimodels.AgentLogPrefix,
+			Severities:    []string{apimodels.LogErrorPrefix, apimodels.LogWarnPrefix},
+			StartTime:     time.Date(2021, 3, 4, 5, 6, 7, 123000000, time.UTC),
+			StartSequence: 2,
+			Limit:         10,
+		}, h.opts)
+		require.NotNil(t, h.execution)
+		assert.Equal(t, 2, *h.execution)
+	})
+	t.Run("Defaults", func(t *testing.T) {
+		h, err := parse("")
+		require.NoError(t, err)
+		assert.Equal(t, apimodels.AllTaskLevelLogs, h.opts.LogType)
+		assert.Equal(t, model.DefaultTaskLogPageLimit, h.opts.Limit)
+		assert.Zero(t, h.opts.StartTime)
+		assert.Zero(t, h.opts.StartSequence)
+		assert.Nil(t, h.execution)
+	})
+	t.Run("InvalidStartTime", func(t *testing.T) {
+		_, err := parse("type=T&start_time=yesterday")
+		assert.Error(t, err)
+	})
+	t.Run("StartTimeForAllLogs", func(t *testing.T) {
+		_, err := parse("start_time=2021-03-04T05:06:07Z")
+		assert.Error(t, err)
+	})
+	t.Run("InvalidStartSequence", func(t *testing.T) {
+		_, err := parse("type=T&start_time=2021-03-04T05:06:07Z&start_sequence=-1")
+		assert.Error(t, err)
+	})
+	t.Run("InvalidSeverity", func(t *testing.T) {
+		_, err := parse("severities=F")
+		assert.Error(t, err)
+	})
+}
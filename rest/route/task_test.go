@@ -12,6 +12,7 @@ import (
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/model/artifact"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/evergreen/model/user"
 	"github.com/evergreen-ci/evergreen/rest/data"
 	"github.com/evergreen-ci/evergreen/rest/model"
@@ -328,3 +329,45 @@ func TestGetTaskSyncPath(t *testing.T) {
 	require.True(t, ok)
 	assert.Equal(t, path, expected.S3Path(expected.BuildVariant, expected.DisplayName))
 }
+
+func TestTaskSetHasCedarResultsSavesMetadata(t *testing.T) {
+	require.NoError(t, db.ClearCollections(task.Collection, testresult.CedarMetadataCollection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(task.Collection, testresult.CedarMetadataCollection))
+	}()
+	tsk := task.Task{Id: "task_id", Execution: 2}
+	require.NoError(t, tsk.Insert())
+
+	h := makeTaskSetHasCedarResultsHandler(&data.DBConnector{})
+	rh, ok := h.(*taskSetHasCedarResultsHandler)
+	require.True(t, ok)
+	rh.taskID = tsk.Id
+	rh.info = apimodels.CedarTestResultsTaskInfo{
+		Failed: true,
+		Metadata: []apimodels.CedarTestResultMetadata{
+			{TestName: "random", FailureMessage: "expected 1 but was 2", Tags: []string{"slow"}, Owners: []string{"team"}, Retries: 1},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	resp := rh.Run(ctx)
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusOK, resp.Status())
+
+	dbTask, err := task.FindOneId(tsk.Id)
+	require.NoError(t, err)
+	require.NotNil(t, dbTask)
+	assert.True(t, dbTask.HasCedarResults)
+	assert.True(t, dbTask.CedarResultsFailed)
+
+	metadata, err := testresult.FindCedarMetadata([]string{tsk.Id}, []int{tsk.Execution})
+	require.NoError(t, err)
+	require.Len(t, metadata, 1)
+	m, ok := metadata[testresult.CedarMetadataKey(tsk.Id, tsk.Execution, "random")]
+	require.True(t, ok)
+	assert.Equal(t, "expected 1 but was 2", m.FailureMessage)
+	assert.Equal(t, []string{"slow"}, m.Tags)
+	assert.Equal(t, []string{"team"}, m.Owners)
+	assert.Equal(t, 1, m.Retries)
+}
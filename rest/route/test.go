@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/evergreen/rest/data"
 	"github.com/evergreen-ci/evergreen/rest/model"
@@ -22,6 +24,8 @@ type testGetHandler struct {
 	displayTask   bool
 	cedarResults  bool
 	testStatus    []string
+	tags          []string
+	owners        []string
 	testID        string
 	testName      string
 	testExecution int
@@ -75,6 +79,8 @@ func (tgh *testGetHandler) Parse(ctx context.Context, r *http.Request) error {
 	}
 	tgh.key = vals.Get("start_at")
 	tgh.testName = vals.Get("test_name")
+	tgh.tags = readTestMetadataList(vals["tags"])
+	tgh.owners = readTestMetadataList(vals["owners"])
 	tgh.limit, err = getLimit(vals)
 	if err != nil {
 		return errors.WithStack(err)
@@ -106,6 +112,13 @@ func (tgh *testGetHandler) Run(ctx context.Context) gimlet.Responder {
 			Limit:       tgh.limit,
 			Page:        page,
 		}
+		// Cedar does not store test tags or owners, so all of the results
+		// are fetched and filtered by the metadata Evergreen keeps for them.
+		filterByMetadata := len(tgh.tags) > 0 || len(tgh.owners) > 0
+		if filterByMetadata {
+			opts.Limit = 0
+			opts.Page = 0
+		}
 		cedarTestResults, status, err := apimodels.GetCedarTestResults(ctx, opts)
 		if err != nil {
 			return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "getting test results"))
@@ -113,12 +126,23 @@ func (tgh *testGetHandler) Run(ctx context.Context) gimlet.Responder {
 		if status != http.StatusOK && status != http.StatusNotFound {
 			return gimlet.MakeJSONInternalErrorResponder(errors.Errorf("getting test results from Cedar returned status '%d'", status))
 		}
+		if err = task.AddCedarTestResultMetadata(cedarTestResults.Results); err != nil {
+			return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "getting test result metadata"))
+		}
 
-		if page*tgh.limit < utility.FromIntPtr(cedarTestResults.Stats.FilteredCount) {
+		results := cedarTestResults.Results
+		filteredCount := utility.FromIntPtr(cedarTestResults.Stats.FilteredCount)
+		if filterByMetadata {
+			results = task.FilterCedarTestResultsByMetadata(results, tgh.tags, tgh.owners)
+			filteredCount = len(results)
+			results = task.PageCedarTestResults(results, tgh.limit, page)
+		}
+
+		if page*tgh.limit < filteredCount {
 			key = fmt.Sprintf("%d", page+1)
 		}
 
-		return tgh.buildResponse(cedarTestResults.Results, nil, key)
+		return tgh.buildResponse(results, nil, key)
 	}
 
 	var tests []testresult.TestResult
@@ -134,6 +158,8 @@ func (tgh *testGetHandler) Run(ctx context.Context) gimlet.Responder {
 			Execution: tgh.testExecution,
 			Limit:     tgh.limit + 1,
 			Statuses:  tgh.testStatus,
+			Tags:      tgh.tags,
+			Owners:    tgh.owners,
 			TaskID:    tgh.taskID,
 			TestID:    tgh.key,
 			TestName:  tgh.testName,
@@ -216,3 +242,17 @@ func (tgh *testGetHandler) addDataToResponse(resp gimlet.Responder, testResult i
 
 	return nil
 }
+
+// readTestMetadataList reads a query parameter that may be repeated or given
+// as a comma-separated list.
+func readTestMetadataList(values []string) []string {
+	var parsed []string
+	for _, val := range values {
+		for _, elem := range strings.Split(val, ",") {
+			if elem = strings.TrimSpace(elem); elem != "" {
+				parsed = append(parsed, elem)
+			}
+		}
+	}
+	return parsed
+}
@@ -0,0 +1,114 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/rest/data"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// Handler for the history of a single test in a project
+//
+//    /projects/{project_id}/test_history
+type testHistoryHandler struct {
+	opts dbModel.TestHistoryOptions
+	sc   data.Connector
+}
+
+func makeGetProjectTestHistory(sc data.Connector) gimlet.RouteHandler {
+	return &testHistoryHandler{sc: sc}
+}
+
+func (h *testHistoryHandler) Factory() gimlet.RouteHandler {
+	return &testHistoryHandler{sc: h.sc}
+}
+
+func (h *testHistoryHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.opts = dbModel.TestHistoryOptions{ProjectID: gimlet.GetVars(r)["project_id"]}
+
+	vals := r.URL.Query()
+	h.opts.TestName = vals.Get("test_name")
+	if h.opts.TestName == "" {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    "must specify a test name",
+		}
+	}
+	h.opts.BuildVariants = readTestMetadataList(vals["variants"])
+	h.opts.TaskNames = readTestMetadataList(vals["tasks"])
+	h.opts.Statuses = readTestMetadataList(vals["statuses"])
+
+	var err error
+	if h.opts.MinDuration, err = parseTestHistoryDuration(vals.Get("min_duration")); err != nil {
+		return err
+	}
+	if h.opts.MaxDuration, err = parseTestHistoryDuration(vals.Get("max_duration")); err != nil {
+		return err
+	}
+	if revisions := vals.Get("revisions"); revisions != "" {
+		h.opts.NumRevisions, err = strconv.Atoi(revisions)
+		if err != nil {
+			return gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    fmt.Sprintf("invalid number of revisions '%s'", revisions),
+			}
+		}
+	}
+
+	if err = h.opts.SetDefaultsAndValidate(); err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		}
+	}
+
+	return nil
+}
+
+func (h *testHistoryHandler) Run(ctx context.Context) gimlet.Responder {
+	projectID, err := dbModel.GetIdForProject(h.opts.ProjectID)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("project '%s' not found", h.opts.ProjectID),
+		})
+	}
+	opts := h.opts
+	opts.ProjectID = projectID
+
+	history, err := h.sc.GetTestHistory(opts)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "getting test history"))
+	}
+
+	apiHistory := &model.APITestHistory{}
+	if err = apiHistory.BuildFromService(history); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "converting test history to API model"))
+	}
+
+	return gimlet.NewJSONResponse(apiHistory)
+}
+
+// parseTestHistoryDuration parses a duration threshold such as "1m30s".
+func parseTestHistoryDuration(val string) (time.Duration, error) {
+	if val == "" {
+		return 0, nil
+	}
+	duration, err := time.ParseDuration(val)
+	if err != nil {
+		return 0, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("invalid duration '%s'", val),
+		}
+	}
+	return duration, nil
+}
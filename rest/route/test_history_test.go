@@ -0,0 +1,60 @@
+package route
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/rest/data"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestHistoryHandlerParse(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	parse := func(query string) (*testHistoryHandler, error) {
+		r, err := http.NewRequest(http.MethodGet, "/projects/project/test_history?"+query, nil)
+		require.NoError(t, err)
+		r = gimlet.SetURLVars(r, map[string]string{"project_id": "project"})
+		h := makeGetProjectTestHistory(&data.MockConnector{}).(*testHistoryHandler)
+		return h, h.Parse(ctx, r)
+	}
+
+	t.Run("AllParameters", func(t *testing.T) {
+		h, err := parse("test_name=TestFoo&variants=ubuntu,rhel&tasks=unit&statuses=fail&min_duration=10s&max_duration=1m&revisions=20")
+		require.NoError(t, err)
+		assert.Equal(t, model.TestHistoryOptions{
+			ProjectID:     "project",
+			TestName:      "TestFoo",
+			BuildVariants: []string{"ubuntu", "rhel"},
+			TaskNames:     []string{"unit"},
+			NumRevisions:  20,
+			Statuses:      []string{evergreen.TestFailedStatus},
+			MinDuration:   10 * time.Second,
+			MaxDuration:   time.Minute,
+		}, h.opts)
+	})
+	t.Run("Defaults", func(t *testing.T) {
+		h, err := parse("test_name=TestFoo")
+		require.NoError(t, err)
+		assert.Equal(t, model.DefaultTestHistoryRevisions, h.opts.NumRevisions)
+	})
+	t.Run("MissingTestName", func(t *testing.T) {
+		_, err := parse("variants=ubuntu")
+		assert.Error(t, err)
+	})
+	t.Run("InvalidDuration", func(t *testing.T) {
+		_, err := parse("test_name=TestFoo&min_duration=ten")
+		assert.Error(t, err)
+	})
+	t.Run("InvalidStatus", func(t *testing.T) {
+		_, err := parse("test_name=TestFoo&statuses=flaky")
+		assert.Error(t, err)
+	})
+}
@@ -370,6 +370,9 @@ func (as *APIServer) AttachFiles(w http.ResponseWriter, r *http.Request) {
 		BuildId:         t.BuildId,
 		Execution:       t.Execution,
 		CreateTime:      time.Now(),
+		ProjectId:       t.Project,
+		Requester:       t.Requester,
+		VersionId:       t.Version,
 	}
 
 	err := utility.ReadJSON(utility.NewRequestReader(r), &entry.Files)
@@ -379,7 +382,6 @@ func (as *APIServer) AttachFiles(w http.ResponseWriter, r *http.Request) {
 		gimlet.WriteJSONError(w, message)
 		return
 	}
-
 	if err := entry.Upsert(); err != nil {
 		message := fmt.Sprintf("Error updating artifact file info for task %v: %v", t.Id, err)
 		grip.Error(message)
@@ -60,6 +60,8 @@ func (as *APIServer) submitPatch(w http.ResponseWriter, r *http.Request) {
 		Alias             string             `json:"alias"`
 		ReuseDefinition   bool               `json:"reuse_definition"`
 		GithubAuthor      string             `json:"github_author"`
+		StackedOn         string             `json:"stacked_on"`
+		WaitForStackedOn  bool               `json:"wait_for_stacked_on"`
 	}{}
 	if err := utility.ReadJSON(utility.NewRequestReaderWithSize(r, patch.SizeLimit), &data); err != nil {
 		as.LoggedError(w, r, http.StatusBadRequest, err)
@@ -147,22 +149,24 @@ func (as *APIServer) submitPatch(w http.ResponseWriter, r *http.Request) {
 	}
 
 	intent, err := patch.NewCliIntent(patch.CLIIntentParams{
-		User:            author,
-		Project:         pref.Id,
-		Path:            data.Path,
-		BaseGitHash:     data.Githash,
-		Module:          r.FormValue("module"),
-		PatchContent:    patchString,
-		Description:     data.Description,
-		Finalize:        data.Finalize,
-		Parameters:      data.Parameters,
-		Variants:        data.Variants,
-		Tasks:           data.Tasks,
-		Alias:           data.Alias,
-		TriggerAliases:  data.TriggerAliases,
-		BackportOf:      data.BackportInfo,
-		GitInfo:         data.GitMetadata,
-		ReuseDefinition: data.ReuseDefinition,
+		User:             author,
+		Project:          pref.Id,
+		Path:             data.Path,
+		BaseGitHash:      data.Githash,
+		Module:           r.FormValue("module"),
+		PatchContent:     patchString,
+		Description:      data.Description,
+		Finalize:         data.Finalize,
+		Parameters:       data.Parameters,
+		Variants:         data.Variants,
+		Tasks:            data.Tasks,
+		Alias:            data.Alias,
+		TriggerAliases:   data.TriggerAliases,
+		BackportOf:       data.BackportInfo,
+		GitInfo:          data.GitMetadata,
+		ReuseDefinition:  data.ReuseDefinition,
+		StackedOn:        data.StackedOn,
+		WaitForStackedOn: data.WaitForStackedOn,
 		SyncParams: patch.SyncAtEndOptions{
 			BuildVariants: data.SyncBuildVariants,
 			Tasks:         data.SyncTasks,
@@ -314,7 +318,11 @@ func (as *APIServer) updatePatchModule(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
 	defer cancel()
 
-	_, err = thirdparty.GetCommitEvent(ctx, githubOauthToken, repoOwner, repo, githash)
+	moduleHost := thirdparty.NewGitHubCodeHost(githubOauthToken, repoOwner, repo)
+	if projectRef.IsGitHosted() {
+		moduleHost = thirdparty.NewGitCodeHost(module.Repo)
+	}
+	_, err = moduleHost.GetCommit(ctx, githash)
 	if err != nil {
 		as.LoggedError(w, r, http.StatusInternalServerError, err)
 		return
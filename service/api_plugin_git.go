@@ -21,6 +21,10 @@ func (as *APIServer) gitServePatch(w http.ResponseWriter, r *http.Request) {
 		patchId = t.Version
 	}
 	p, err := patch.FindOne(patch.ByVersion(patchId))
+	if err == nil && p == nil && patch.IsValidId(patchId) {
+		// patches that a patch is stacked on may not have been finalized
+		p, err = patch.FindOneId(patchId)
+	}
 	if err != nil {
 		as.LoggedError(w, r, http.StatusInternalServerError,
 			errors.Wrapf(err, "problem fetching patch '%s'", patchId))
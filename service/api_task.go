@@ -258,6 +258,17 @@ func (as *APIServer) EndTask(w http.ResponseWriter, r *http.Request) {
 			errors.Wrap(err, "couldn't queue job to update task stats accounting"))
 		return
 	}
+	// only mainline failures are clustered, since patches are expected to
+	// fail in ways that don't say anything about the project
+	if details.Status == evergreen.TaskFailed && !t.Aborted && utility.StringSliceContains(evergreen.SystemVersionRequesterTypes, t.Requester) {
+		if err = as.queue.Put(r.Context(), units.NewFailureClusteringJob(t.Id, t.Execution)); err != nil {
+			grip.Error(message.WrapError(err, message.Fields{
+				"message":   "could not queue job to cluster task failure",
+				"task_id":   t.Id,
+				"execution": t.Execution,
+			}))
+		}
+	}
 
 	if checkHostHealth(currentHost) {
 		if _, err := as.prepareHostForAgentExit(r.Context(), currentHost); err != nil {
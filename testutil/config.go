@@ -208,6 +208,14 @@ func MockConfig() *evergreen.Settings {
 			ApplicationID: "8888888",
 		},
 		Notify: evergreen.NotifyConfig{
+			RateLimits: evergreen.NotificationRateLimitsConfig{
+				IntervalSeconds: 300,
+				SubscriberLimit: 10,
+				SubscriberTypeLimits: []evergreen.SubscriberTypeRateLimit{
+					{SubscriberType: "slack", Limit: 100},
+				},
+				CollapseIdentical: true,
+			},
 			SMTP: evergreen.SMTPConfig{
 				Server:     "server",
 				Port:       2285,
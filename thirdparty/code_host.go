@@ -0,0 +1,135 @@
+package thirdparty
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v34/github"
+	"github.com/pkg/errors"
+)
+
+const (
+	// CodeHostGitHub is a project repository hosted on GitHub. This is the
+	// default when a project doesn't specify a code host.
+	CodeHostGitHub = "github"
+	// CodeHostGit is a project repository hosted on a plain git server that is
+	// only reachable with git over SSH or HTTPS. It has no pull request
+	// integration.
+	CodeHostGit = "git"
+)
+
+// ValidCodeHosts are the code host types that a project can use.
+var ValidCodeHosts = []string{"", CodeHostGitHub, CodeHostGit}
+
+// CodeHostCommit is a single commit as reported by a code host.
+type CodeHostCommit struct {
+	Revision    string
+	Author      string
+	AuthorEmail string
+	Message     string
+	CreateTime  time.Time
+}
+
+// CodeHost is the service that hosts a single project repository. It
+// abstracts the operations the commit queue, patches and the repotracker need
+// so that they do not depend on the GitHub API directly.
+type CodeHost interface {
+	// Type returns the kind of code host, e.g. CodeHostGitHub.
+	Type() string
+	// SupportsPullRequests returns whether the host can be used for PR
+	// testing, GitHub checks and PR-based commit queue items.
+	SupportsPullRequests() bool
+	// CloneURL returns the URL to clone or push to the repository.
+	CloneURL() string
+	// GetBranchHead returns the revision at the tip of the branch.
+	GetBranchHead(ctx context.Context, branch string) (string, error)
+	// GetCommit returns the commit for the revision, or an error if the
+	// revision does not exist in the repository.
+	GetCommit(ctx context.Context, revision string) (*CodeHostCommit, error)
+	// GetFile returns the contents of the file at the path as of the given
+	// revision or branch. It returns a FileNotFoundError if the file does not
+	// exist.
+	GetFile(ctx context.Context, path, revision string) ([]byte, error)
+}
+
+// NewGitHubCodeHost returns a CodeHost for the GitHub repository owner/repo.
+func NewGitHubCodeHost(oauthToken, owner, repo string) CodeHost {
+	return &githubCodeHost{
+		oauthToken: oauthToken,
+		owner:      owner,
+		repo:       repo,
+	}
+}
+
+type githubCodeHost struct {
+	oauthToken string
+	owner      string
+	repo       string
+}
+
+func (h *githubCodeHost) Type() string               { return CodeHostGitHub }
+func (h *githubCodeHost) SupportsPullRequests() bool { return true }
+
+func (h *githubCodeHost) CloneURL() string {
+	return fmt.Sprintf("git@github.com:%s/%s.git", h.owner, h.repo)
+}
+
+func (h *githubCodeHost) GetBranchHead(ctx context.Context, branch string) (string, error) {
+	branchEvent, err := GetBranchEvent(ctx, h.oauthToken, h.owner, h.repo, branch)
+	if err != nil {
+		return "", errors.Wrapf(err, "getting branch '%s' for '%s/%s'", branch, h.owner, h.repo)
+	}
+	if err = validateBranch(branchEvent); err != nil {
+		return "", errors.Wrap(err, "GitHub returned invalid branch")
+	}
+
+	return branchEvent.Commit.GetSHA(), nil
+}
+
+func validateBranch(branch *github.Branch) error {
+	if branch == nil {
+		return errors.New("branch is nil")
+	}
+	if branch.Commit == nil {
+		return errors.New("commit is nil")
+	}
+	if branch.Commit.SHA == nil {
+		return errors.New("SHA is nil")
+	}
+	return nil
+}
+
+func (h *githubCodeHost) GetCommit(ctx context.Context, revision string) (*CodeHostCommit, error) {
+	commit, err := GetCommitEvent(ctx, h.oauthToken, h.owner, h.repo, revision)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting commit '%s' for '%s/%s'", revision, h.owner, h.repo)
+	}
+	if commit == nil || commit.Commit == nil {
+		return nil, errors.Errorf("GitHub returned malformed commit '%s' for '%s/%s'", revision, h.owner, h.repo)
+	}
+
+	return &CodeHostCommit{
+		Revision:    commit.GetSHA(),
+		Author:      commit.Commit.GetAuthor().GetName(),
+		AuthorEmail: commit.Commit.GetAuthor().GetEmail(),
+		Message:     commit.Commit.GetMessage(),
+		CreateTime:  commit.Commit.GetCommitter().GetDate(),
+	}, nil
+}
+
+func (h *githubCodeHost) GetFile(ctx context.Context, path, revision string) ([]byte, error) {
+	file, err := GetGithubFile(ctx, h.oauthToken, h.owner, h.repo, path, revision)
+	if err != nil {
+		// Return file not found errors unwrapped so that callers can use
+		// IsFileNotFound on them.
+		return nil, err
+	}
+
+	contents, err := base64.StdEncoding.DecodeString(*file.Content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "decoding file '%s' from '%s/%s'", path, h.owner, h.repo)
+	}
+	return contents, nil
+}
@@ -0,0 +1,319 @@
+package thirdparty
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	gitLogFieldSeparator  = "\x1f"
+	gitLogRecordSeparator = "\x1e"
+	gitLogFormat          = "--format=%H%x1f%an%x1f%ae%x1f%ct%x1f%B%x1e"
+)
+
+// gitMirrorLocks serializes operations on a single mirror directory, since
+// concurrent fetches into the same repository fail on git's lock files.
+var gitMirrorLocks sync.Map
+
+// GitCodeHost is a CodeHost for a repository served by a plain git server.
+// Branch heads are read with ls-remote and everything else is read from a
+// local bare mirror of the repository, so the URL can be anything git can
+// fetch from: SSH, HTTPS or a path to a local bare repository.
+type GitCodeHost struct {
+	// URL is the remote repository URL.
+	URL string
+	// MirrorDir is the local directory holding the bare mirror of the
+	// repository. It is created on first use.
+	MirrorDir string
+}
+
+// NewGitCodeHost returns a GitCodeHost for the repository at url that keeps
+// its mirror in the system temporary directory.
+func NewGitCodeHost(url string) *GitCodeHost {
+	return &GitCodeHost{
+		URL:       url,
+		MirrorDir: filepath.Join(os.TempDir(), "evergreen-git-mirrors", fmt.Sprintf("%x", sha256.Sum256([]byte(url)))[:16]),
+	}
+}
+
+func (h *GitCodeHost) Type() string               { return CodeHostGit }
+func (h *GitCodeHost) SupportsPullRequests() bool { return false }
+func (h *GitCodeHost) CloneURL() string           { return h.URL }
+
+// GetBranchHead returns the revision at the tip of the branch on the remote.
+func (h *GitCodeHost) GetBranchHead(ctx context.Context, branch string) (string, error) {
+	if err := validateGitArgs(h.URL, branch); err != nil {
+		return "", err
+	}
+	out, err := runGit(ctx, "", "ls-remote", "--end-of-options", h.URL, "refs/heads/"+branch)
+	if err != nil {
+		return "", errors.Wrapf(err, "listing branch '%s' in '%s'", branch, h.URL)
+	}
+	fields := strings.Fields(out)
+	if len(fields) == 0 {
+		return "", errors.Errorf("branch '%s' not found in '%s'", branch, h.URL)
+	}
+
+	return fields[0], nil
+}
+
+// GetCommit returns the commit for the revision, which can be a SHA or a
+// branch name.
+func (h *GitCodeHost) GetCommit(ctx context.Context, revision string) (*CodeHostCommit, error) {
+	if err := validateGitArgs(revision); err != nil {
+		return nil, err
+	}
+	unlock, err := h.sync(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	commits, err := h.log(ctx, []string{"-n", "1"}, revision)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting commit '%s' in '%s'", revision, h.URL)
+	}
+	if len(commits) == 0 {
+		return nil, errors.Errorf("commit '%s' not found in '%s'", revision, h.URL)
+	}
+
+	return &commits[0], nil
+}
+
+// GetFile returns the contents of the file at the path as of the revision.
+func (h *GitCodeHost) GetFile(ctx context.Context, path, revision string) ([]byte, error) {
+	if err := validateGitArgs(revision); err != nil {
+		return nil, err
+	}
+	unlock, err := h.sync(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if _, err = runGit(ctx, h.MirrorDir, "rev-parse", "--verify", "--quiet", "--end-of-options", revision+"^{commit}"); err != nil {
+		return nil, errors.Wrapf(err, "resolving revision '%s' in '%s'", revision, h.URL)
+	}
+	out, err := runGit(ctx, h.MirrorDir, "ls-tree", "--name-only", "--end-of-options", revision, "--", path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "looking up file '%s' at '%s' in '%s'", path, revision, h.URL)
+	}
+	if strings.TrimSpace(out) == "" {
+		return nil, FileNotFoundError{filepath: path}
+	}
+	contents, err := runGit(ctx, h.MirrorDir, "show", "--end-of-options", fmt.Sprintf("%s:%s", revision, path))
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading file '%s' at '%s' in '%s'", path, revision, h.URL)
+	}
+
+	return []byte(contents), nil
+}
+
+// GetChangedFiles returns the paths of all files modified by the revision.
+func (h *GitCodeHost) GetChangedFiles(ctx context.Context, revision string) ([]string, error) {
+	if err := validateGitArgs(revision); err != nil {
+		return nil, err
+	}
+	unlock, err := h.sync(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	out, err := runGit(ctx, h.MirrorDir, "diff-tree", "--no-commit-id", "--name-only", "-r", "--root", "--end-of-options", revision)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting changed files for '%s' in '%s'", revision, h.URL)
+	}
+
+	files := []string{}
+	for _, line := range strings.Split(out, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			files = append(files, line)
+		}
+	}
+	return files, nil
+}
+
+// GetRecentCommits returns up to maxCommits of the most recent commits on the
+// branch, most recent first.
+func (h *GitCodeHost) GetRecentCommits(ctx context.Context, branch string, maxCommits int) ([]CodeHostCommit, error) {
+	if err := validateGitArgs(branch); err != nil {
+		return nil, err
+	}
+	unlock, err := h.sync(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	commits, err := h.log(ctx, []string{"-n", strconv.Itoa(maxCommits)}, "refs/heads/"+branch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting recent commits on branch '%s' in '%s'", branch, h.URL)
+	}
+	return commits, nil
+}
+
+// GetLatestCommitBefore returns the most recent commit on the branch that was
+// committed at or before the given time.
+func (h *GitCodeHost) GetLatestCommitBefore(ctx context.Context, branch string, before time.Time) (*CodeHostCommit, error) {
+	if err := validateGitArgs(branch); err != nil {
+		return nil, err
+	}
+	unlock, err := h.sync(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	commits, err := h.log(ctx, []string{"-n", "1", fmt.Sprintf("--before=@%d", before.Unix())}, "refs/heads/"+branch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting commit on branch '%s' before %s in '%s'", branch, before, h.URL)
+	}
+	if len(commits) == 0 {
+		return nil, errors.Errorf("no commits on branch '%s' before %s in '%s'", branch, before, h.URL)
+	}
+	return &commits[0], nil
+}
+
+// GetCommitsSince returns the commits on the branch made after the
+// revision, most recent first. It returns an error if the revision is not an
+// ancestor of the branch or if more than maxCommits commits were made since.
+func (h *GitCodeHost) GetCommitsSince(ctx context.Context, branch, revision string, maxCommits int) ([]CodeHostCommit, error) {
+	if err := validateGitArgs(branch, revision); err != nil {
+		return nil, err
+	}
+	unlock, err := h.sync(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	ref := "refs/heads/" + branch
+	if _, err = runGit(ctx, h.MirrorDir, "merge-base", "--is-ancestor", "--end-of-options", revision, ref); err != nil {
+		return nil, errors.Wrapf(err, "revision '%s' is not on branch '%s' in '%s'", revision, branch, h.URL)
+	}
+	revRange := fmt.Sprintf("%s..%s", revision, ref)
+	if maxCommits > 0 {
+		out, err := runGit(ctx, h.MirrorDir, "rev-list", "--count", "--end-of-options", revRange)
+		if err != nil {
+			return nil, errors.Wrapf(err, "counting commits since '%s' in '%s'", revision, h.URL)
+		}
+		count, err := strconv.Atoi(strings.TrimSpace(out))
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing commit count '%s'", out)
+		}
+		if count > maxCommits {
+			return nil, errors.Errorf("revision '%s' not found in the last %d commits on branch '%s'", revision, maxCommits, branch)
+		}
+	}
+
+	commits, err := h.log(ctx, nil, revRange)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting commits since '%s' in '%s'", revision, h.URL)
+	}
+	return commits, nil
+}
+
+// sync creates or updates the local mirror and returns a function that
+// releases the lock on it.
+func (h *GitCodeHost) sync(ctx context.Context) (func(), error) {
+	if err := validateGitArgs(h.URL); err != nil {
+		return nil, err
+	}
+	lock, _ := gitMirrorLocks.LoadOrStore(h.MirrorDir, &sync.Mutex{})
+	mu := lock.(*sync.Mutex)
+	mu.Lock()
+
+	if _, err := os.Stat(h.MirrorDir); os.IsNotExist(err) {
+		if err = os.MkdirAll(filepath.Dir(h.MirrorDir), 0755); err != nil {
+			mu.Unlock()
+			return nil, errors.Wrap(err, "creating mirror parent directory")
+		}
+		if _, err = runGit(ctx, "", "clone", "--mirror", "--quiet", "--end-of-options", h.URL, h.MirrorDir); err != nil {
+			mu.Unlock()
+			return nil, errors.Wrapf(err, "cloning mirror of '%s'", h.URL)
+		}
+		return mu.Unlock, nil
+	}
+
+	if _, err := runGit(ctx, h.MirrorDir, "fetch", "--prune", "--quiet", "origin"); err != nil {
+		mu.Unlock()
+		return nil, errors.Wrapf(err, "fetching '%s'", h.URL)
+	}
+	return mu.Unlock, nil
+}
+
+// log runs git log with the options on the revisions.
+func (h *GitCodeHost) log(ctx context.Context, options []string, revisions ...string) ([]CodeHostCommit, error) {
+	args := append([]string{"log", gitLogFormat}, options...)
+	args = append(args, "--end-of-options")
+	args = append(args, revisions...)
+	out, err := runGit(ctx, h.MirrorDir, append(args, "--")...)
+	if err != nil {
+		return nil, err
+	}
+	return parseGitLog(out)
+}
+
+// parseGitLog parses the output of git log run with gitLogFormat.
+func parseGitLog(out string) ([]CodeHostCommit, error) {
+	commits := []CodeHostCommit{}
+	for _, record := range strings.Split(out, gitLogRecordSeparator) {
+		record = strings.TrimLeft(record, "\n")
+		if record == "" {
+			continue
+		}
+		fields := strings.SplitN(record, gitLogFieldSeparator, 5)
+		if len(fields) != 5 {
+			return nil, errors.Errorf("malformed git log record '%s'", record)
+		}
+		timestamp, err := strconv.ParseInt(fields[3], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing commit time '%s'", fields[3])
+		}
+		commits = append(commits, CodeHostCommit{
+			Revision:    fields[0],
+			Author:      fields[1],
+			AuthorEmail: fields[2],
+			CreateTime:  time.Unix(timestamp, 0),
+			Message:     strings.TrimSpace(fields[4]),
+		})
+	}
+	return commits, nil
+}
+
+// validateGitArgs checks that none of the user-supplied arguments can be
+// mistaken for an option by git.
+func validateGitArgs(args ...string) error {
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "-") {
+			return errors.Errorf("invalid git argument '%s': cannot start with '-'", arg)
+		}
+	}
+	return nil
+}
+
+func runGit(ctx context.Context, dir string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// Never prompt for credentials, since there is no one to answer.
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if err := cmd.Run(); err != nil {
+		return "", errors.Wrapf(err, "running git %s: %s", strings.Join(args, " "), strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
@@ -0,0 +1,148 @@
+package thirdparty
+
+import (
+	"context"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitCodeHost(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tmpDir := t.TempDir()
+	remote := filepath.Join(tmpDir, "remote.git")
+	work := filepath.Join(tmpDir, "work")
+
+	git := func(dir string, args ...string) string {
+		args = append([]string{"-c", "user.name=Evergreen", "-c", "user.email=evergreen@example.com"}, args...)
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	commitFile := func(name, contents, msg string) string {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(work, name), []byte(contents), 0644))
+		git(work, "add", name)
+		git(work, "commit", "-m", msg)
+		git(work, "push", "origin", "HEAD:main")
+		return git(work, "rev-parse", "HEAD")
+	}
+
+	git(tmpDir, "init", "--bare", "--initial-branch=main", remote)
+	git(tmpDir, "clone", remote, work)
+	first := commitFile("evergreen.yml", "tasks: []", "first commit")
+	second := commitFile("main.go", "package main", "second commit")
+
+	host := NewGitCodeHost(remote)
+	host.MirrorDir = filepath.Join(tmpDir, "mirror")
+	var _ CodeHost = host
+	assert.False(t, host.SupportsPullRequests())
+	assert.Equal(t, remote, host.CloneURL())
+
+	t.Run("GetBranchHead", func(t *testing.T) {
+		head, err := host.GetBranchHead(ctx, "main")
+		require.NoError(t, err)
+		assert.Equal(t, second, head)
+
+		_, err = host.GetBranchHead(ctx, "nonexistent")
+		assert.Error(t, err)
+	})
+	t.Run("GetCommit", func(t *testing.T) {
+		commit, err := host.GetCommit(ctx, first)
+		require.NoError(t, err)
+		assert.Equal(t, first, commit.Revision)
+		assert.Equal(t, "Evergreen", commit.Author)
+		assert.Equal(t, "evergreen@example.com", commit.AuthorEmail)
+		assert.Equal(t, "first commit", commit.Message)
+		assert.False(t, commit.CreateTime.IsZero())
+
+		_, err = host.GetCommit(ctx, "0000000000000000000000000000000000000000")
+		assert.Error(t, err)
+	})
+	t.Run("GetFile", func(t *testing.T) {
+		contents, err := host.GetFile(ctx, "evergreen.yml", "main")
+		require.NoError(t, err)
+		assert.Equal(t, "tasks: []", string(contents))
+
+		_, err = host.GetFile(ctx, "main.go", first)
+		assert.True(t, IsFileNotFound(err))
+	})
+	t.Run("GetChangedFiles", func(t *testing.T) {
+		files, err := host.GetChangedFiles(ctx, second)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"main.go"}, files)
+
+		files, err = host.GetChangedFiles(ctx, first)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"evergreen.yml"}, files)
+	})
+	t.Run("GetCommitsSinceIncludesNewlyPushedCommits", func(t *testing.T) {
+		third := commitFile("README", "hi", "third commit")
+
+		commits, err := host.GetCommitsSince(ctx, "main", first, 10)
+		require.NoError(t, err)
+		require.Len(t, commits, 2)
+		assert.Equal(t, third, commits[0].Revision)
+		assert.Equal(t, second, commits[1].Revision)
+
+		_, err = host.GetCommitsSince(ctx, "main", first, 1)
+		assert.Error(t, err)
+	})
+	t.Run("GetRecentCommits", func(t *testing.T) {
+		commits, err := host.GetRecentCommits(ctx, "main", 2)
+		require.NoError(t, err)
+		require.Len(t, commits, 2)
+		assert.Equal(t, "third commit", commits[0].Message)
+		assert.Equal(t, "second commit", commits[1].Message)
+	})
+	t.Run("GetLatestCommitBefore", func(t *testing.T) {
+		commit, err := host.GetLatestCommitBefore(ctx, "main", time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, "third commit", commit.Message)
+
+		_, err = host.GetLatestCommitBefore(ctx, "main", time.Now().Add(-24*time.Hour))
+		assert.Error(t, err)
+	})
+	t.Run("RejectsArgumentsThatLookLikeOptions", func(t *testing.T) {
+		_, err := host.GetBranchHead(ctx, "--upload-pack=touch pwned")
+		assert.Error(t, err)
+		_, err = host.GetCommit(ctx, "--output=pwned")
+		assert.Error(t, err)
+		_, err = host.GetFile(ctx, "evergreen.yml", "--output=pwned")
+		assert.Error(t, err)
+		_, err = host.GetChangedFiles(ctx, "--output=pwned")
+		assert.Error(t, err)
+		_, err = host.GetCommitsSince(ctx, "main", "--output=pwned", 10)
+		assert.Error(t, err)
+		_, err = host.GetRecentCommits(ctx, "-main", 1)
+		assert.Error(t, err)
+
+		optionHost := NewGitCodeHost("--upload-pack=touch pwned")
+		optionHost.MirrorDir = filepath.Join(tmpDir, "option_mirror")
+		_, err = optionHost.GetCommit(ctx, first)
+		assert.Error(t, err)
+		assert.NoDirExists(t, optionHost.MirrorDir)
+	})
+}
+
+func TestParseGitLog(t *testing.T) {
+	out := "abc\x1fA\x1fa@example.com\x1f1600000000\x1fsubject\n\nbody\n\x1e\ndef\x1fB\x1fb@example.com\x1f1600000001\x1fother\n\x1e\n"
+	commits, err := parseGitLog(out)
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	assert.Equal(t, "abc", commits[0].Revision)
+	assert.Equal(t, "subject\n\nbody", commits[0].Message)
+	assert.Equal(t, int64(1600000001), commits[1].CreateTime.Unix())
+
+	_, err = parseGitLog("abc\x1fA")
+	assert.Error(t, err)
+}
@@ -51,6 +51,9 @@ type GithubPatch struct {
 	MergeCommitSHA string `bson:"merge_commit_sha"`
 	CommitTitle    string `bson:"commit_title"`
 	CommitMessage  string `bson:"commit_message"`
+	// StackedOnPR is the number of the pull request that this pull request
+	// is stacked on, as declared in its description.
+	StackedOnPR int `bson:"stacked_on_pr,omitempty"`
 }
 
 var (
@@ -747,6 +750,19 @@ func GetGithubPullRequest(ctx context.Context, token, baseOwner, baseRepo string
 	return pr, nil
 }
 
+// PostCommentToPullRequest leaves a comment on the given pull request.
+func PostCommentToPullRequest(ctx context.Context, token, owner, repo string, PRNumber int, comment string) error {
+	httpClient := getGithubClient(token, "PostCommentToPullRequest")
+	defer utility.PutHTTPClient(httpClient)
+
+	client := github.NewClient(httpClient)
+
+	_, _, err := client.Issues.CreateComment(ctx, owner, repo, PRNumber, &github.IssueComment{
+		Body: github.String(comment),
+	})
+	return errors.Wrapf(err, "can't comment on PR #%d in '%s/%s'", PRNumber, owner, repo)
+}
+
 func GetGithubPullRequestCommits(ctx context.Context, token, owner, repo string, PRNumber int) ([]*github.RepositoryCommit, error) {
 	httpClient := getGithubClientRetryWith404s(token, "GetGithubPullRequestCommits")
 	defer utility.PutHTTPClient(httpClient)
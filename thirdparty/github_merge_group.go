@@ -0,0 +1,81 @@
+package thirdparty
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/google/go-github/v34/github"
+	"github.com/pkg/errors"
+)
+
+const (
+	// GithubMergeGroupEventType is the X-Github-Event header value for
+	// webhooks sent by GitHub's merge queue.
+	GithubMergeGroupEventType = "merge_group"
+
+	// GithubMergeGroupActionChecksRequested is sent when GitHub's merge queue
+	// creates a merge group that needs to be tested.
+	GithubMergeGroupActionChecksRequested = "checks_requested"
+	// GithubMergeGroupActionDestroyed is sent when a merge group is merged,
+	// invalidated or dequeued.
+	GithubMergeGroupActionDestroyed = "destroyed"
+
+	// GithubMergeGroupReasonMerged is the reason a merge group is destroyed
+	// once it's been merged into the base branch.
+	GithubMergeGroupReasonMerged = "merged"
+
+	githubRefHeadsPrefix = "refs/heads/"
+)
+
+// GithubMergeGroup is a merge group created by GitHub's merge queue. The head
+// commit is the base branch with the queued pull requests merged in, and lives
+// on a temporary gh-readonly-queue/* branch.
+type GithubMergeGroup struct {
+	HeadSHA    string             `json:"head_sha" bson:"head_sha"`
+	HeadRef    string             `json:"head_ref" bson:"head_ref"`
+	BaseSHA    string             `json:"base_sha" bson:"base_sha"`
+	BaseRef    string             `json:"base_ref" bson:"base_ref"`
+	HeadCommit *github.HeadCommit `json:"head_commit,omitempty" bson:"-"`
+}
+
+// BaseBranch returns the name of the branch the merge group merges into.
+func (g *GithubMergeGroup) BaseBranch() string {
+	return strings.TrimPrefix(g.BaseRef, githubRefHeadsPrefix)
+}
+
+// GithubMergeGroupEvent is the payload of a merge_group webhook. The GitHub
+// client library doesn't support merge group events, so they're parsed here.
+type GithubMergeGroupEvent struct {
+	Action     string             `json:"action"`
+	Reason     string             `json:"reason,omitempty"`
+	MergeGroup GithubMergeGroup   `json:"merge_group"`
+	Repo       *github.Repository `json:"repository,omitempty"`
+	Sender     *github.User       `json:"sender,omitempty"`
+}
+
+// ParseGithubMergeGroupEvent parses the body of a merge_group webhook.
+func ParseGithubMergeGroupEvent(body []byte) (*GithubMergeGroupEvent, error) {
+	event := &GithubMergeGroupEvent{}
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, errors.Wrap(err, "parsing merge group event")
+	}
+	return event, nil
+}
+
+// Validate checks that the event has the fields needed to test the merge
+// group.
+func (e *GithubMergeGroupEvent) Validate() error {
+	if e.Action == "" {
+		return errors.New("merge group event has no action")
+	}
+	if len(strings.Split(e.Repo.GetFullName(), "/")) != 2 {
+		return errors.New("repo name is invalid (expected [owner]/[repo])")
+	}
+	if e.MergeGroup.HeadSHA == "" {
+		return errors.New("merge group has no head SHA")
+	}
+	if e.MergeGroup.BaseBranch() == "" {
+		return errors.New("merge group has no base branch")
+	}
+	return nil
+}
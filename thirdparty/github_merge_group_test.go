@@ -0,0 +1,52 @@
+package thirdparty
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGithubMergeGroupEvent(t *testing.T) {
+	body := []byte(`{
+		"action": "checks_requested",
+		"merge_group": {
+			"head_sha": "abc123",
+			"head_ref": "refs/heads/gh-readonly-queue/main/pr-5-def456",
+			"base_sha": "def456",
+			"base_ref": "refs/heads/main",
+			"head_commit": {
+				"id": "abc123",
+				"message": "Merge pull request #5",
+				"author": {"name": "octocat", "email": "octocat@github.com"}
+			}
+		},
+		"repository": {"name": "evergreen", "full_name": "evergreen-ci/evergreen"},
+		"sender": {"login": "octocat"}
+	}`)
+
+	event, err := ParseGithubMergeGroupEvent(body)
+	require.NoError(t, err)
+	assert.Equal(t, GithubMergeGroupActionChecksRequested, event.Action)
+	assert.Equal(t, "abc123", event.MergeGroup.HeadSHA)
+	assert.Equal(t, "main", event.MergeGroup.BaseBranch())
+	require.NotNil(t, event.MergeGroup.HeadCommit)
+	assert.Equal(t, "Merge pull request #5", event.MergeGroup.HeadCommit.GetMessage())
+	assert.Equal(t, "evergreen-ci/evergreen", event.Repo.GetFullName())
+	assert.NoError(t, event.Validate())
+
+	t.Run("MissingHeadSHA", func(t *testing.T) {
+		missing := *event
+		missing.MergeGroup.HeadSHA = ""
+		assert.Error(t, missing.Validate())
+	})
+	t.Run("InvalidRepoName", func(t *testing.T) {
+		invalid := *event
+		invalid.Repo = nil
+		assert.Error(t, invalid.Validate())
+	})
+	t.Run("MalformedBody", func(t *testing.T) {
+		_, err := ParseGithubMergeGroupEvent([]byte("{"))
+		assert.Error(t, err)
+	})
+}
@@ -109,6 +109,22 @@ func (s *githubSuite) TestGetBranchEvent() {
 	})
 }
 
+func TestValidateBranch(t *testing.T) {
+	var branch *github.Branch
+	assert.Error(t, validateBranch(branch))
+
+	branch = &github.Branch{}
+	assert.Error(t, validateBranch(branch))
+
+	branch.Commit = &github.RepositoryCommit{}
+	assert.Error(t, validateBranch(branch))
+
+	sha := "abcdef"
+	branch.Commit.SHA = &sha
+
+	assert.NoError(t, validateBranch(branch))
+}
+
 func (s *githubSuite) TestGithubMergeBaseRevision() {
 	rev, err := GetGithubMergeBaseRevision(s.ctx, s.token, "evergreen-ci", "evergreen",
 		"105bbb4b34e7da59c42cb93d92954710b1f101ee", "49bb297759edd1284ef6adee665180e7b7bac299")
@@ -302,7 +302,8 @@ func (t *buildTriggers) makeData(sub *event.Subscription, pastTenseOverride stri
 	if t.data.GithubCheckStatus != "" {
 		data.PastTenseStatus = t.data.GithubCheckStatus
 	}
-	if t.build.Requester == evergreen.GithubPRRequester || t.build.Requester == evergreen.RepotrackerVersionRequester {
+	if t.build.Requester == evergreen.GithubPRRequester || t.build.Requester == evergreen.RepotrackerVersionRequester ||
+		t.build.Requester == evergreen.GithubMergeRequester {
 		data.githubContext = fmt.Sprintf("evergreen/%s", t.build.BuildVariant)
 		data.githubDescription = t.taskStatusToDesc()
 	}
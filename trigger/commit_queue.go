@@ -88,6 +88,8 @@ func (t *commitQueueTriggers) makeData(sub *event.Subscription) (*commonTemplate
 	text := t.patch.Description
 	if t.data.Error != "" {
 		text = t.data.Error
+	} else if t.data.Reason != "" {
+		text = t.data.Reason
 	}
 	url := ""
 	if t.patch.Version != "" {
@@ -0,0 +1,56 @@
+package trigger
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/mongodb/grip/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitQueueOutcomeNotifiesAuthorOfReason(t *testing.T) {
+	require.NoError(t, db.ClearCollections(event.AllLogCollection, patch.Collection, event.SubscriptionsCollection))
+	ui := &evergreen.UIConfig{
+		Url: "https://evergreen.mongodb.com",
+	}
+	require.NoError(t, ui.Set())
+
+	// a CLI item has no pull request, so its author is only notified
+	// through their subscription
+	p := patch.Patch{
+		Id:          mgobson.NewObjectId(),
+		Project:     "project",
+		Author:      "me",
+		Description: "my change",
+		Alias:       evergreen.CommitQueueAlias,
+	}
+	require.NoError(t, p.Insert())
+	sub := event.NewCommitQueueSubscriptionByOwner("me", event.Subscriber{
+		Type:   event.EmailSubscriberType,
+		Target: "me@example.com",
+	})
+	require.NoError(t, sub.Upsert())
+
+	reason := "removed because it failed when retested on its own"
+	e := event.EventLogEntry{
+		ID:           mgobson.NewObjectId().Hex(),
+		ResourceType: event.ResourceTypeCommitQueue,
+		EventType:    event.CommitQueueConcludeTest,
+		ResourceId:   p.Id.Hex(),
+		Data: &event.CommitQueueEventData{
+			Status: evergreen.MergeTestFailed,
+			Reason: reason,
+		},
+	}
+	n, err := NotificationsFromEvent(&e)
+	require.NoError(t, err)
+	require.Len(t, n, 1)
+	email, ok := n[0].Payload.(*message.Email)
+	require.True(t, ok)
+	assert.Contains(t, email.Body, reason)
+}
@@ -27,6 +27,15 @@ type eventHandler interface {
 	ValidateTrigger(string) bool
 }
 
+// multiRecipientEventHandler is implemented by event handlers whose triggers
+// can notify more than one recipient for a subscription, such as all of a
+// project's admins.
+type multiRecipientEventHandler interface {
+	// AdditionalNotifications returns the notifications other than the one
+	// returned by Process for the last subscription that was processed.
+	AdditionalNotifications() []notification.Notification
+}
+
 type trigger func(*event.Subscription) (*notification.Notification, error)
 
 type base struct {
@@ -116,6 +116,12 @@ func (t *patchTriggers) patchOutcome(sub *event.Subscription) (*notification.Not
 			}
 			return nil, nil
 		}
+		if t.data.Status == evergreen.PatchFailed {
+			if err := failStackedChildPatch(target, t.patch.Id.Hex()); err != nil {
+				return nil, errors.Wrap(err, "failed to fail stacked child patch")
+			}
+		}
+		return nil, nil
 	}
 
 	isReady, err := t.waitOnChildrenOrSiblings(sub)
@@ -262,6 +268,20 @@ func finalizeChildPatch(sub *event.Subscription) error {
 	return nil
 }
 
+// failStackedChildPatch fails a child patch that is stacked on the given
+// failed parent patch, since a patch waiting on its stacked on patch to
+// succeed is never finalized once that patch fails.
+func failStackedChildPatch(target *event.ChildPatchSubscriber, parentPatchID string) error {
+	childPatch, err := patch.FindOneId(target.ChildPatchId)
+	if err != nil {
+		return errors.Wrap(err, "can't fetch child patch")
+	}
+	if childPatch == nil || childPatch.StackedOn != parentPatchID {
+		return nil
+	}
+	return model.FailStackedPatch(childPatch)
+}
+
 func (t *patchTriggers) patchSuccess(sub *event.Subscription) (*notification.Notification, error) {
 	if t.data.Status != evergreen.PatchSucceeded {
 		return nil, nil
@@ -383,7 +403,14 @@ func (t *patchTriggers) generate(sub *event.Subscription) (*notification.Notific
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to build notification")
 	}
-	return notification.New(t.event.ID, sub.Trigger, &sub.Subscriber, payload)
+	n, err := notification.New(t.event.ID, sub.Trigger, &sub.Subscriber, payload)
+	if err != nil {
+		return nil, err
+	}
+	if evergreen.IsFinishedPatchStatus(t.data.Status) {
+		n.Metadata.PatchID = t.patch.Id.Hex()
+	}
+	return n, nil
 }
 
 func (t *patchTriggers) getGithubContext() (string, error) {
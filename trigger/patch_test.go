@@ -205,7 +205,8 @@ func (s *patchSuite) TestPatchOutcome() {
 	s.data.Status = evergreen.PatchFailed
 	n, err = s.t.patchOutcome(&s.subs[0])
 	s.NoError(err)
-	s.NotNil(n)
+	s.Require().NotNil(n)
+	s.Equal(s.patch.Id.Hex(), n.Metadata.PatchID)
 }
 
 func (s *patchSuite) TestRunChildrenOnPatchOutcome() {
@@ -269,6 +270,34 @@ func (s *patchSuite) TestRunChildrenOnPatchOutcome() {
 
 }
 
+func (s *patchSuite) TestFailStackedChildOnPatchFailure() {
+	child := patch.Patch{
+		Id:        mgobson.NewObjectId(),
+		Project:   "test",
+		Status:    evergreen.PatchCreated,
+		StackedOn: s.patch.Id.Hex(),
+	}
+	s.Require().NoError(child.Insert())
+	sub := event.NewSubscriptionByID(event.ResourceTypePatch, event.TriggerOutcome, s.event.ResourceId, event.Subscriber{
+		Type: event.RunChildPatchSubscriberType,
+		Target: &event.ChildPatchSubscriber{
+			ParentStatus: evergreen.PatchSucceeded,
+			ChildPatchId: child.Id.Hex(),
+		},
+	})
+	s.Require().NoError(sub.Upsert())
+
+	s.data.Status = evergreen.PatchFailed
+	n, err := s.t.patchOutcome(&sub)
+	s.NoError(err)
+	s.Nil(n)
+
+	dbChild, err := patch.FindOneId(child.Id.Hex())
+	s.Require().NoError(err)
+	s.Require().NotNil(dbChild)
+	s.Equal(evergreen.PatchFailed, dbChild.Status)
+}
+
 func (s *patchSuite) TestPatchStarted() {
 	n, err := s.t.patchStarted(&s.subs[0])
 	s.Nil(err)
@@ -277,5 +306,6 @@ func (s *patchSuite) TestPatchStarted() {
 	s.data.Status = evergreen.PatchStarted
 	n, err = s.t.patchStarted(&s.subs[0])
 	s.Nil(err)
-	s.NotNil(n)
+	s.Require().NotNil(n)
+	s.Empty(n.Metadata.PatchID)
 }
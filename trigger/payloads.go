@@ -34,6 +34,14 @@ const (
 	// or the link back to Github Pull Requests.
 	// This number MUST NOT exceed 100, and Slack recommends a limit of 10
 	slackAttachmentsLimit = 10
+
+	// chatFailedTestsLimit is a limit to the number of failed tests listed
+	// in a Microsoft Teams or generic chat webhook message.
+	chatFailedTestsLimit = 10
+
+	teamsAdaptiveCardContentType = "application/vnd.microsoft.card.adaptive"
+	teamsAdaptiveCardSchema      = "http://adaptivecards.io/schemas/adaptive-card.json"
+	teamsAdaptiveCardVersion     = "1.4"
 )
 
 type commonTemplateData struct {
@@ -55,6 +63,7 @@ type commonTemplateData struct {
 
 	apiModel restModel.Model
 	slack    []message.SlackAttachment
+	logURL   string
 
 	githubContext     string
 	githubState       message.GithubState
@@ -215,6 +224,10 @@ const jiraIssueTitle string = "Evergreen {{ .Object }} '{{ .DisplayName }}' in '
 
 const slackTemplate string = `The {{ .Object }} <{{ .URL }}|{{ .DisplayName }}> in '{{ .Project }}' has {{ .PastTenseStatus }}!`
 
+const teamsTemplate string = `The {{ .Object }} [{{ .DisplayName }}]({{ .URL }}) in '{{ .Project }}' has {{ .PastTenseStatus }}!`
+
+const chatWebhookTemplate string = `The {{ .Object }} '{{ .DisplayName }}' in '{{ .Project }}' has {{ .PastTenseStatus }}! {{ .URL }}`
+
 func makeHeaders(selectors []event.Selector) http.Header {
 	headers := http.Header{}
 	for i := range selectors {
@@ -346,6 +359,215 @@ func slack(t *commonTemplateData) (*notification.SlackPayload, error) {
 	}, nil
 }
 
+// teamsMessage is the body of a message posted to a Microsoft Teams incoming
+// webhook, wrapping a single adaptive card.
+type teamsMessage struct {
+	Type        string            `json:"type"`
+	Attachments []teamsAttachment `json:"attachments"`
+}
+
+type teamsAttachment struct {
+	ContentType string            `json:"contentType"`
+	Content     teamsAdaptiveCard `json:"content"`
+}
+
+type teamsAdaptiveCard struct {
+	Schema  string               `json:"$schema"`
+	Type    string               `json:"type"`
+	Version string               `json:"version"`
+	Body    []teamsCardElement   `json:"body"`
+	Actions []teamsOpenURLAction `json:"actions,omitempty"`
+}
+
+type teamsCardElement struct {
+	Type     string          `json:"type"`
+	Text     string          `json:"text,omitempty"`
+	Size     string          `json:"size,omitempty"`
+	Weight   string          `json:"weight,omitempty"`
+	Color    string          `json:"color,omitempty"`
+	IsSubtle bool            `json:"isSubtle,omitempty"`
+	Wrap     bool            `json:"wrap,omitempty"`
+	Facts    []teamsCardFact `json:"facts,omitempty"`
+}
+
+type teamsCardFact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+type teamsOpenURLAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// teamsStatusColor maps the status of the notification's object to an
+// adaptive card text color.
+func teamsStatusColor(t *commonTemplateData) string {
+	switch t.PastTenseStatus {
+	case evergreen.TaskFailed, evergreen.TaskSystemFailed, evergreen.TaskTestTimedOut:
+		return "Attention"
+	case "succeeded", evergreen.TaskSucceeded:
+		return "Good"
+	default:
+		return "Default"
+	}
+}
+
+func teams(t *commonTemplateData) (*util.ChatWebhook, error) {
+	titleTmpl, err := ttemplate.New("teams").Parse(teamsTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse teams template")
+	}
+
+	buf := &bytes.Buffer{}
+	if err = titleTmpl.Execute(buf, t); err != nil {
+		return nil, errors.Wrap(err, "failed to make teams message")
+	}
+
+	body := []teamsCardElement{
+		{
+			Type:   "TextBlock",
+			Text:   buf.String(),
+			Size:   "Medium",
+			Weight: "Bolder",
+			Color:  teamsStatusColor(t),
+			Wrap:   true,
+		},
+	}
+
+	facts := []teamsCardFact{{Title: "Project", Value: t.Project}}
+	if t.Build != nil {
+		facts = append(facts, teamsCardFact{Title: "Build Variant", Value: t.Build.DisplayName})
+	}
+	if t.Task != nil {
+		facts = append(facts, teamsCardFact{Title: "Version", Value: t.Task.Version})
+		if t.Task.Details.Type != "" {
+			facts = append(facts, teamsCardFact{Title: "Failure Type", Value: t.Task.Details.Type})
+		}
+		if t.Task.Details.TimedOut {
+			facts = append(facts, teamsCardFact{Title: "Timed Out", Value: "true"})
+		}
+		if t.Task.Details.Description != "" {
+			facts = append(facts, teamsCardFact{Title: "Failing Command", Value: t.Task.Details.Description})
+		}
+		if t.Task.TimeTaken > 0 {
+			facts = append(facts, teamsCardFact{Title: "Duration", Value: t.Task.TimeTaken.String()})
+		}
+	}
+	body = append(body, teamsCardElement{
+		Type:  "FactSet",
+		Facts: facts,
+	})
+
+	if len(t.FailedTests) > 0 {
+		body = append(body, teamsCardElement{
+			Type:   "TextBlock",
+			Text:   fmt.Sprintf("Failed tests (%d)", len(t.FailedTests)),
+			Weight: "Bolder",
+			Wrap:   true,
+		})
+		for i, test := range t.FailedTests {
+			if i == chatFailedTestsLimit {
+				body = append(body, teamsCardElement{
+					Type:     "TextBlock",
+					Text:     fmt.Sprintf("and %d more", len(t.FailedTests)-chatFailedTestsLimit),
+					IsSubtle: true,
+					Wrap:     true,
+				})
+				break
+			}
+			text := "- " + test.GetDisplayTestName()
+			if test.URL != "" {
+				text = fmt.Sprintf("- [%s](%s)", test.GetDisplayTestName(), test.URL)
+			}
+			body = append(body, teamsCardElement{
+				Type: "TextBlock",
+				Text: text,
+				Wrap: true,
+			})
+		}
+	}
+
+	body = append(body, teamsCardElement{
+		Type:     "TextBlock",
+		Text:     fmt.Sprintf("Subscription: %s; Event: %s", t.SubscriptionID, t.EventID),
+		Size:     "Small",
+		IsSubtle: true,
+		Wrap:     true,
+	})
+
+	actions := []teamsOpenURLAction{
+		{
+			Type:  "Action.OpenUrl",
+			Title: "View in Evergreen",
+			URL:   t.URL,
+		},
+	}
+	if t.logURL != "" {
+		actions = append(actions, teamsOpenURLAction{
+			Type:  "Action.OpenUrl",
+			Title: "View Task Logs",
+			URL:   t.logURL,
+		})
+	}
+
+	msg := teamsMessage{
+		Type: "message",
+		Attachments: []teamsAttachment{
+			{
+				ContentType: teamsAdaptiveCardContentType,
+				Content: teamsAdaptiveCard{
+					Schema:  teamsAdaptiveCardSchema,
+					Type:    "AdaptiveCard",
+					Version: teamsAdaptiveCardVersion,
+					Body:    body,
+					Actions: actions,
+				},
+			},
+		},
+	}
+
+	bytes, err := json.Marshal(msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building teams adaptive card")
+	}
+
+	return &util.ChatWebhook{
+		Body: bytes,
+	}, nil
+}
+
+func chatWebhook(t *commonTemplateData) (*util.ChatWebhook, error) {
+	msgTmpl, err := ttemplate.New("chat-webhook").Parse(chatWebhookTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse chat webhook template")
+	}
+
+	buf := &bytes.Buffer{}
+	if err = msgTmpl.Execute(buf, t); err != nil {
+		return nil, errors.Wrap(err, "failed to make chat webhook message")
+	}
+	for i, test := range t.FailedTests {
+		if i == chatFailedTestsLimit {
+			buf.WriteString(fmt.Sprintf("\nand %d more failed tests", len(t.FailedTests)-chatFailedTestsLimit))
+			break
+		}
+		buf.WriteString(fmt.Sprintf("\nFailed test: %s %s", test.GetDisplayTestName(), test.URL))
+	}
+
+	bytes, err := json.Marshal(struct {
+		Text string `json:"text"`
+	}{Text: buf.String()})
+	if err != nil {
+		return nil, errors.Wrap(err, "error building chat webhook message")
+	}
+
+	return &util.ChatWebhook{
+		Body: bytes,
+	}, nil
+}
+
 // truncateString splits a string into two parts, with the following behavior:
 // If the entire string is <= capacity, it's returned unchanged.
 // Otherwise, the string is split at the (capacity-3)'th byte. The first string
@@ -420,6 +642,12 @@ func makeCommonPayload(sub *event.Subscription, selectors []event.Selector,
 
 	case event.SlackSubscriberType:
 		return slack(data)
+
+	case event.MSTeamsSubscriberType:
+		return teams(data)
+
+	case event.ChatWebhookSubscriberType:
+		return chatWebhook(data)
 	}
 
 	return nil, errors.Errorf("unknown type: '%s'", sub.Subscriber.Type)
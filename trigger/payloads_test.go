@@ -1,6 +1,8 @@
 package trigger
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -151,6 +153,73 @@ func (s *payloadSuite) TestSlack() {
 	s.Empty(m.Attachments)
 }
 
+func (s *payloadSuite) TestTeams() {
+	s.t.Object = "task"
+	s.t.Task = &task.Task{
+		Id:          "taskid",
+		DisplayName: "thetask",
+		Version:     "theversion",
+		Details: apimodels.TaskEndDetail{
+			Type:        evergreen.CommandTypeTest,
+			Description: "shell.exec",
+		},
+	}
+	s.t.Build = &build.Build{DisplayName: "Ubuntu 18.04"}
+	s.t.logURL = "https://example.com/task_log_raw/taskid/0?type=T"
+	for i := 0; i < chatFailedTestsLimit+2; i++ {
+		s.t.FailedTests = append(s.t.FailedTests, task.TestResult{
+			TestFile: fmt.Sprintf("test%d", i),
+			URL:      fmt.Sprintf("https://example.com/test%d", i),
+			Status:   evergreen.TestFailedStatus,
+		})
+	}
+
+	m, err := teams(&s.t)
+	s.NoError(err)
+	s.Require().NotNil(m)
+
+	msg := teamsMessage{}
+	s.Require().NoError(json.Unmarshal(m.Body, &msg))
+	s.Equal("message", msg.Type)
+	s.Require().Len(msg.Attachments, 1)
+	s.Equal(teamsAdaptiveCardContentType, msg.Attachments[0].ContentType)
+
+	card := msg.Attachments[0].Content
+	s.Equal("AdaptiveCard", card.Type)
+	s.Require().NotEmpty(card.Body)
+	s.Equal("The task [display-1234](https://example.com/patch/1234) in 'test' has failed!", card.Body[0].Text)
+	s.Equal("Attention", card.Body[0].Color)
+	s.Contains(card.Body[1].Facts, teamsCardFact{Title: "Build Variant", Value: "Ubuntu 18.04"})
+	s.Contains(card.Body[1].Facts, teamsCardFact{Title: "Failing Command", Value: "shell.exec"})
+	s.Contains(string(m.Body), "[test0](https://example.com/test0)")
+	s.NotContains(string(m.Body), "https://example.com/test11")
+	s.Contains(string(m.Body), "and 2 more")
+
+	s.Require().Len(card.Actions, 2)
+	s.Equal(s.url, card.Actions[0].URL)
+	s.Equal(s.t.logURL, card.Actions[1].URL)
+}
+
+func (s *payloadSuite) TestChatWebhook() {
+	s.t.FailedTests = []task.TestResult{
+		{
+			TestFile: "test0",
+			URL:      "https://example.com/test0",
+			Status:   evergreen.TestFailedStatus,
+		},
+	}
+
+	m, err := chatWebhook(&s.t)
+	s.NoError(err)
+	s.Require().NotNil(m)
+
+	msg := struct {
+		Text string `json:"text"`
+	}{}
+	s.Require().NoError(json.Unmarshal(m.Body, &msg))
+	s.Equal("The patch 'display-1234' in 'test' has failed! https://example.com/patch/1234\nFailed test: test0 https://example.com/test0", msg.Text)
+}
+
 func (s *payloadSuite) TestGetFailedTestsFromTemplate() {
 	test1 := task.TestResult{
 		URL:    "http://www.something.com/absolute",
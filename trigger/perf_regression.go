@@ -0,0 +1,157 @@
+package trigger
+
+import (
+	"fmt"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/evergreen-ci/evergreen/model/perf"
+	restModel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+func init() {
+	registry.registerEventHandler(event.ResourceTypePerfRegression, event.PerfRegressionDetected, makePerfRegressionTriggers)
+}
+
+type perfRegressionTriggers struct {
+	event      *event.EventLogEntry
+	regression *perf.Regression
+	uiConfig   evergreen.UIConfig
+
+	base
+}
+
+func makePerfRegressionTriggers() eventHandler {
+	t := &perfRegressionTriggers{}
+	t.base.triggers = map[string]trigger{
+		event.TriggerRegression: t.perfRegression,
+	}
+	return t
+}
+
+func (t *perfRegressionTriggers) Fetch(e *event.EventLogEntry) error {
+	var err error
+	if err = t.uiConfig.Get(evergreen.GetEnvironment()); err != nil {
+		return errors.Wrap(err, "Failed to fetch ui config")
+	}
+
+	t.regression, err = perf.FindOneRegressionByID(e.ResourceId)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch perf regression")
+	}
+	if t.regression == nil {
+		return errors.Errorf("perf regression '%s' doesn't exist", e.ResourceId)
+	}
+	t.event = e
+
+	return nil
+}
+
+func (t *perfRegressionTriggers) Selectors() []event.Selector {
+	return []event.Selector{
+		{
+			Type: event.SelectorID,
+			Data: t.regression.ID,
+		},
+		{
+			Type: event.SelectorObject,
+			Data: event.ObjectPerfRegression,
+		},
+		{
+			Type: event.SelectorProject,
+			Data: t.regression.Project,
+		},
+		{
+			Type: event.SelectorRequester,
+			Data: evergreen.RepotrackerVersionRequester,
+		},
+		{
+			Type: event.SelectorBuildVariant,
+			Data: t.regression.Variant,
+		},
+		{
+			Type: event.SelectorDisplayName,
+			Data: t.regression.TaskName,
+		},
+	}
+}
+
+func (t *perfRegressionTriggers) makeData(sub *event.Subscription) (*commonTemplateData, error) {
+	api := restModel.APIPerfRegression{}
+	if err := api.BuildFromService(t.regression); err != nil {
+		return nil, errors.Wrap(err, "error building json model")
+	}
+
+	r := t.regression
+	data := commonTemplateData{
+		ID:              r.ID,
+		EventID:         t.event.ID,
+		SubscriptionID:  sub.ID,
+		DisplayName:     fmt.Sprintf("%s %s", r.TestName, r.Metric),
+		Object:          event.ObjectPerfRegression,
+		Project:         r.Project,
+		URL:             versionLink(versionLinkInput{uiBase: t.uiConfig.Url, versionID: r.Version}),
+		PastTenseStatus: fmt.Sprintf("changed by %+.1f%%", r.PercentChange),
+		Description: fmt.Sprintf("The mean of '%s' in test '%s' of task '%s' on build variant '%s' changed from %.4g to %.4g at revision %s.",
+			r.Metric, r.TestName, r.TaskName, r.Variant, r.PreviousMean, r.NewMean, r.Revision),
+		apiModel: &api,
+	}
+	data.slack = []message.SlackAttachment{
+		{
+			Title:     "Evergreen Performance Regression",
+			TitleLink: data.URL,
+			Color:     evergreenFailColor,
+			Text:      data.Description,
+			Fields: []*message.SlackAttachmentField{
+				{
+					Title: "Build Variant",
+					Value: r.Variant,
+					Short: true,
+				},
+				{
+					Title: "Task",
+					Value: r.TaskName,
+					Short: true,
+				},
+				{
+					Title: "Previous Mean",
+					Value: fmt.Sprintf("%.4g", r.PreviousMean),
+					Short: true,
+				},
+				{
+					Title: "New Mean",
+					Value: fmt.Sprintf("%.4g", r.NewMean),
+					Short: true,
+				},
+			},
+		},
+	}
+
+	return &data, nil
+}
+
+func (t *perfRegressionTriggers) generate(sub *event.Subscription) (*notification.Notification, error) {
+	data, err := t.makeData(sub)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to collect perf regression data")
+	}
+
+	payload, err := makeCommonPayload(sub, t.Selectors(), data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build notification")
+	}
+
+	return notification.New(t.event.ID, sub.Trigger, &sub.Subscriber, payload)
+}
+
+func (t *perfRegressionTriggers) perfRegression(sub *event.Subscription) (*notification.Notification, error) {
+	// Only notify about regressions that are still untriaged, since someone
+	// may have triaged the regression before the event was processed.
+	if t.regression.Status != perf.RegressionStatusUntriaged {
+		return nil, nil
+	}
+	return t.generate(sub)
+}
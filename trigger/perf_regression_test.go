@@ -0,0 +1,88 @@
+package trigger
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/evergreen-ci/evergreen/model/perf"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPerfRegressionTriggers(t *testing.T) {
+	require.Implements(t, (*eventHandler)(nil), &perfRegressionTriggers{})
+
+	makeTriggers := func(status string) *perfRegressionTriggers {
+		triggers := makePerfRegressionTriggers().(*perfRegressionTriggers)
+		triggers.event = &event.EventLogEntry{ID: "e0"}
+		triggers.uiConfig = evergreen.UIConfig{Url: "https://evergreen.example.com"}
+		triggers.regression = &perf.Regression{
+			ID: "r0",
+			SeriesID: perf.SeriesID{
+				Project:  "project",
+				Variant:  "ubuntu",
+				TaskName: "bench",
+				TestName: "insert",
+				Metric:   "latency",
+			},
+			Version:       "v2",
+			Revision:      "abcdef",
+			PreviousMean:  10,
+			NewMean:       12,
+			PercentChange: 20,
+			Status:        status,
+		}
+		return triggers
+	}
+	sub := &event.Subscription{
+		ID:           "s0",
+		ResourceType: event.ResourceTypePerfRegression,
+		Trigger:      event.TriggerRegression,
+		Selectors:    []event.Selector{{Type: event.SelectorProject, Data: "project"}},
+		Subscriber: event.Subscriber{
+			Type:   event.SlackSubscriberType,
+			Target: "#perf",
+		},
+	}
+
+	t.Run("Selectors", func(t *testing.T) {
+		selectors := makeTriggers(perf.RegressionStatusUntriaged).Selectors()
+		assert.Contains(t, selectors, event.Selector{Type: event.SelectorObject, Data: event.ObjectPerfRegression})
+		assert.Contains(t, selectors, event.Selector{Type: event.SelectorBuildVariant, Data: "ubuntu"})
+		assert.Contains(t, selectors, event.Selector{Type: event.SelectorRequester, Data: evergreen.RepotrackerVersionRequester})
+	})
+	t.Run("Slack", func(t *testing.T) {
+		n, err := makeTriggers(perf.RegressionStatusUntriaged).Process(sub)
+		require.NoError(t, err)
+		require.NotNil(t, n)
+		payload, ok := n.Payload.(*notification.SlackPayload)
+		require.True(t, ok)
+		assert.Equal(t, "The perf-regression <https://evergreen.example.com/version/v2|insert latency> in 'project' has changed by +20.0%!", payload.Body)
+		require.Len(t, payload.Attachments, 1)
+		assert.Contains(t, payload.Attachments[0].Text, "changed from 10 to 12 at revision abcdef")
+	})
+	t.Run("Webhook", func(t *testing.T) {
+		webhookSub := *sub
+		webhookSub.Subscriber = event.Subscriber{
+			Type: event.EvergreenWebhookSubscriberType,
+			Target: &event.WebhookSubscriber{
+				URL:    "https://example.com",
+				Secret: []byte("secret"),
+			},
+		}
+		n, err := makeTriggers(perf.RegressionStatusUntriaged).Process(&webhookSub)
+		require.NoError(t, err)
+		require.NotNil(t, n)
+		payload, ok := n.Payload.(*util.EvergreenWebhook)
+		require.True(t, ok)
+		assert.Contains(t, string(payload.Body), `"metric":"latency"`)
+	})
+	t.Run("Triaged", func(t *testing.T) {
+		n, err := makeTriggers(perf.RegressionStatusFalsePositive).Process(sub)
+		assert.NoError(t, err)
+		assert.Nil(t, n)
+	})
+}
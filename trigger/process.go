@@ -71,6 +71,11 @@ func NotificationsFromEvent(e *event.EventLogEntry) ([]notification.Notification
 		if n != nil {
 			msg["notification_id"] = n.ID
 		}
+		var additional []notification.Notification
+		if multi, ok := h.(multiRecipientEventHandler); ok {
+			additional = multi.AdditionalNotifications()
+			msg["num_additional_notifications"] = len(additional)
+		}
 		catcher.Add(err)
 		grip.Error(message.WrapError(err, msg))
 		grip.InfoWhen(err == nil, msg)
@@ -79,6 +84,7 @@ func NotificationsFromEvent(e *event.EventLogEntry) ([]notification.Notification
 		}
 
 		notifications = append(notifications, *n)
+		notifications = append(notifications, additional...)
 	}
 
 	return notifications, catcher.Resolve()
@@ -16,6 +16,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/host"
 	"github.com/evergreen-ci/evergreen/model/notification"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/user"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/grip"
@@ -28,6 +29,7 @@ func init() {
 	registry.registerEventHandler(event.ResourceTypeTask, event.TaskStarted, makeTaskTriggers)
 	registry.registerEventHandler(event.ResourceTypeTask, event.TaskFinished, makeTaskTriggers)
 	registry.registerEventHandler(event.ResourceTypeTask, event.TaskBlocked, makeTaskTriggers)
+	registry.registerEventHandler(event.ResourceTypeTask, event.TaskStillFailing, makeTaskPersistentFailureTriggers)
 }
 
 const (
@@ -57,6 +59,21 @@ func makeTaskTriggers() eventHandler {
 		triggerTaskRegressionByTest:              t.taskRegressionByTest,
 		triggerBuildBreak:                        t.buildBreak,
 		triggerTaskFailedOrBlocked:               t.taskFailedOrBlocked,
+		event.TriggerPersistentFailure:           t.persistentFailure,
+	}
+
+	return t
+}
+
+// makeTaskPersistentFailureTriggers handles the events logged periodically for
+// mainline tasks that are still failing. Only persistent failures are
+// evaluated for them, since nothing else about the task has changed.
+func makeTaskPersistentFailureTriggers() eventHandler {
+	t := &taskTriggers{
+		oldTestResults: map[string]*task.TestResult{},
+	}
+	t.base.triggers = map[string]trigger{
+		event.TriggerPersistentFailure: t.persistentFailure,
 	}
 
 	return t
@@ -139,16 +156,25 @@ type taskTriggers struct {
 
 	oldTestResults map[string]*task.TestResult
 
+	// additionalNotifications are the notifications for recipients other
+	// than the first created while processing the last subscription.
+	additionalNotifications []notification.Notification
+
 	base
 }
 
 func (t *taskTriggers) Process(sub *event.Subscription) (*notification.Notification, error) {
+	t.additionalNotifications = nil
 	if t.task.Aborted {
 		return nil, nil
 	}
 	return t.base.Process(sub)
 }
 
+func (t *taskTriggers) AdditionalNotifications() []notification.Notification {
+	return t.additionalNotifications
+}
+
 func (t *taskTriggers) Fetch(e *event.EventLogEntry) error {
 	var ok bool
 	t.data, ok = e.Data.(*event.TaskEventData)
@@ -283,6 +309,7 @@ func (t *taskTriggers) makeData(sub *event.Subscription, pastTenseOverride, test
 		URL:             taskLink(t.uiConfig.Url, t.task.Id, t.task.Execution),
 		PastTenseStatus: status,
 		apiModel:        &api,
+		logURL:          taskLogLink(t.uiConfig.Url, t.task.Id, t.task.Execution),
 		Task:            t.task,
 		ProjectRef:      projectRef,
 		Build:           buildDoc,
@@ -395,6 +422,10 @@ func (t *taskTriggers) generate(sub *event.Subscription, pastTenseOverride, test
 		return nil, errors.Wrap(err, "failed to create a notification")
 	}
 	n.SetTaskMetadata(t.task.Id, t.task.Execution)
+	// the same task failing the same way across build variants of a version
+	// is collapsible into one notification
+	n.SetCollapseKey(strings.Join([]string{sub.Trigger, t.task.Version, t.task.DisplayName,
+		t.task.Status, t.task.Details.Type, testNames}, "-"))
 
 	return n, nil
 }
@@ -1083,3 +1114,269 @@ func (t *taskTriggers) buildBreak(sub *event.Subscription) (*notification.Notifi
 	}
 	return n, nil
 }
+
+// persistentFailure escalates a task that has failed in consecutive mainline
+// versions past the subscription's thresholds, and notifies everyone it was
+// escalated to once the task passes again. The hours threshold is measured up
+// to the time the trigger runs, and is also checked periodically for tasks
+// that are still failing (see event.TaskStillFailing).
+func (t *taskTriggers) persistentFailure(sub *event.Subscription) (*notification.Notification, error) {
+	if t.task.IsPartOfDisplay() || !utility.StringSliceContains(evergreen.SystemVersionRequesterTypes, t.task.Requester) {
+		return nil, nil
+	}
+
+	switch t.task.Status {
+	case evergreen.TaskFailed:
+		return t.persistentFailureEscalation(sub)
+	case evergreen.TaskSucceeded:
+		return t.persistentFailureResolution(sub)
+	default:
+		return nil, nil
+	}
+}
+
+func (t *taskTriggers) persistentFailureEscalation(sub *event.Subscription) (*notification.Notification, error) {
+	// failures caused by a host that was terminated or an agent that died
+	// aren't the project's breakage
+	if t.task.IsSystemUnresponsive() {
+		return nil, nil
+	}
+
+	failures, err := mainlineFailureStreak(t.task, t.task.RevisionOrderNumber)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(failures) == 0 {
+		return nil, nil
+	}
+	first := failures[0]
+	failingFor := time.Since(first.FinishTime)
+	level := persistentFailureEscalationLevel(sub, len(failures), failingFor)
+	if level < 0 {
+		return nil, nil
+	}
+
+	rec, err := alertrecord.FindByPersistentFailureEscalation(sub.ID, t.task.DisplayName, t.task.BuildVariant, t.task.Project, first.RevisionOrderNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch persistent failure escalation")
+	}
+	if rec != nil && escalationTierLevel(rec.EscalationTier) >= level {
+		return nil, nil
+	}
+
+	tier := event.EscalationTiers[level]
+	subscribers, err := t.escalationSubscribers(sub, tier, first.Version)
+	if err != nil {
+		return nil, err
+	}
+	notifications, err := t.generateForSubscribers(sub, subscribers, fmt.Sprintf("been failing for %s across %d consecutive mainline versions",
+		failingFor.Round(time.Minute), len(failures)))
+	if err != nil {
+		return nil, err
+	}
+
+	// The escalation is recorded even if there's no one to notify at this
+	// tier, so that the failure can escalate to the next tier.
+	escalation := newAlertRecord(sub.ID, t.task, alertrecord.PersistentFailureEscalationId)
+	escalation.EscalationTier = tier
+	if err = escalation.Insert(); err != nil {
+		return nil, errors.Wrap(err, "failed to process persistent failure trigger")
+	}
+
+	return t.splitNotifications(notifications), nil
+}
+
+func (t *taskTriggers) persistentFailureResolution(sub *event.Subscription) (*notification.Notification, error) {
+	failures, err := mainlineFailureStreak(t.task, t.task.RevisionOrderNumber-1)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(failures) == 0 {
+		return nil, nil
+	}
+
+	escalation, err := alertrecord.FindByPersistentFailureEscalation(sub.ID, t.task.DisplayName, t.task.BuildVariant, t.task.Project, failures[0].RevisionOrderNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch persistent failure escalation")
+	}
+	if escalation == nil {
+		return nil, nil
+	}
+	resolution, err := alertrecord.FindByPersistentFailureResolution(sub.ID, t.task.DisplayName, t.task.BuildVariant, t.task.Project, escalation.RevisionOrderNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch persistent failure resolution")
+	}
+	if resolution != nil {
+		return nil, nil
+	}
+
+	// everyone who was notified of the failure is notified of its resolution
+	var subscribers []event.Subscriber
+	for _, tier := range event.EscalationTiers[:escalationTierLevel(escalation.EscalationTier)+1] {
+		tierSubscribers, err := t.escalationSubscribers(sub, tier, failures[0].Version)
+		if err != nil {
+			return nil, err
+		}
+		subscribers = append(subscribers, tierSubscribers...)
+	}
+	notifications, err := t.generateForSubscribers(sub, subscribers, fmt.Sprintf("passed again after %d consecutive mainline failures", len(failures)))
+	if err != nil {
+		return nil, err
+	}
+	if len(notifications) == 0 {
+		return nil, nil
+	}
+
+	if err = newAlertRecord(sub.ID, t.task, alertrecord.PersistentFailureResolvedId).Insert(); err != nil {
+		return nil, errors.Wrap(err, "failed to process persistent failure trigger")
+	}
+
+	return t.splitNotifications(notifications), nil
+}
+
+// generateForSubscribers generates a notification of the subscription's
+// trigger for each of the subscribers, skipping duplicate subscribers.
+func (t *taskTriggers) generateForSubscribers(sub *event.Subscription, subscribers []event.Subscriber, pastTenseOverride string) ([]notification.Notification, error) {
+	var notifications []notification.Notification
+	seen := map[string]bool{}
+	for _, subscriber := range subscribers {
+		key := fmt.Sprintf("%s-%s", subscriber.Type, subscriber.String())
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		recipientSub := *sub
+		recipientSub.Subscriber = subscriber
+		n, err := t.generate(&recipientSub, pastTenseOverride, "")
+		if err != nil {
+			return nil, err
+		}
+		if n != nil {
+			notifications = append(notifications, *n)
+		}
+	}
+	return notifications, nil
+}
+
+// splitNotifications returns the first of the notifications and keeps the
+// rest to be returned by AdditionalNotifications.
+func (t *taskTriggers) splitNotifications(notifications []notification.Notification) *notification.Notification {
+	if len(notifications) == 0 {
+		return nil
+	}
+	t.additionalNotifications = notifications[1:]
+	return &notifications[0]
+}
+
+// mainlineFailureStreak returns the failed mainline tasks with the same name
+// and variant as the given task since the last success, up to and including
+// the given revision order number, oldest first.
+func mainlineFailureStreak(t *task.Task, throughOrder int) ([]task.Task, error) {
+	lastSuccess, err := task.FindOne(task.ByBeforeRevisionWithStatusesAndRequesters(throughOrder+1, []string{evergreen.TaskSucceeded},
+		t.BuildVariant, t.DisplayName, t.Project, evergreen.SystemVersionRequesterTypes).
+		Sort([]string{"-" + task.RevisionOrderNumberKey}).
+		WithFields(task.RevisionOrderNumberKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching last successful task")
+	}
+	afterOrder := 0
+	if lastSuccess != nil {
+		afterOrder = lastSuccess.RevisionOrderNumber
+	}
+
+	failures, err := task.Find(task.ByAfterRevisionWithStatusesAndRequesters(afterOrder, throughOrder, []string{evergreen.TaskFailed},
+		t.BuildVariant, t.DisplayName, t.Project, evergreen.SystemVersionRequesterTypes).
+		WithFields(task.IdKey, task.VersionKey, task.RevisionOrderNumberKey, task.FinishTimeKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching failed tasks")
+	}
+
+	return failures, nil
+}
+
+// persistentFailureEscalationLevel returns the index in event.EscalationTiers
+// of the tier that a persistent failure has reached, or -1 if it hasn't
+// reached any. A failure reaches the first tier when it exceeds one of the
+// subscription's thresholds, and each following tier when it exceeds that
+// threshold again, up to the subscription's escalation tier. For example, with
+// a threshold of 3 versions, the committer is notified after 3 failures, the
+// project admins after 6, and the paging webhook after 9.
+func persistentFailureEscalationLevel(sub *event.Subscription, consecutiveFailures int, failingFor time.Duration) int {
+	level := -1
+	versions, err := strconv.Atoi(sub.TriggerData[event.PersistentFailureVersionsKey])
+	if err == nil && versions > 0 && consecutiveFailures/versions-1 > level {
+		level = consecutiveFailures/versions - 1
+	}
+	hours, err := strconv.Atoi(sub.TriggerData[event.PersistentFailureHoursKey])
+	if err == nil && hours > 0 && int(failingFor/(time.Duration(hours)*time.Hour))-1 > level {
+		level = int(failingFor/(time.Duration(hours)*time.Hour)) - 1
+	}
+
+	if maxLevel := escalationTierLevel(sub.TriggerData[event.EscalationTierKey]); level > maxLevel {
+		return maxLevel
+	}
+	return level
+}
+
+// escalationTierLevel returns the index of the tier in event.EscalationTiers.
+// An unset tier is the committer tier.
+func escalationTierLevel(tier string) int {
+	for i, escalationTier := range event.EscalationTiers {
+		if tier == escalationTier {
+			return i
+		}
+	}
+	return 0
+}
+
+// escalationSubscribers returns the subscribers to notify at an escalation
+// tier. The committer tier notifies the author of the first failing version
+// and the project admins tier notifies the project's admins, each according to
+// their build break preference. The paging tier notifies the subscription's
+// own subscriber, which is also notified at the other tiers if there's no one
+// else to notify and it isn't reserved for paging.
+func (t *taskTriggers) escalationSubscribers(sub *event.Subscription, tier, versionID string) ([]event.Subscriber, error) {
+	var userIDs []string
+	switch tier {
+	case event.EscalationTierPaging:
+		return []event.Subscriber{sub.Subscriber}, nil
+	case event.EscalationTierProjectAdmins:
+		projectRef, err := model.FindMergedProjectRef(t.task.Project, "", false)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch project ref '%s'", t.task.Project)
+		}
+		if projectRef != nil {
+			userIDs = projectRef.Admins
+		}
+	default:
+		v, err := model.VersionFindOne(model.VersionById(versionID))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch version '%s'", versionID)
+		}
+		if v != nil && v.AuthorID != "" && v.TriggerID == "" {
+			userIDs = []string{v.AuthorID}
+		}
+	}
+
+	var subscribers []event.Subscriber
+	for _, userID := range userIDs {
+		subscriber, err := user.MakeBuildBreakSubscriber(userID)
+		if err != nil || subscriber == nil {
+			grip.Debug(message.WrapError(err, message.Fields{
+				"source":          "notifications",
+				"message":         "can't notify user of persistent failure",
+				"subscription_id": sub.ID,
+				"escalation_tier": tier,
+				"user":            userID,
+			}))
+			continue
+		}
+		subscribers = append(subscribers, *subscriber)
+	}
+	if len(subscribers) == 0 && sub.TriggerData[event.EscalationTierKey] != event.EscalationTierPaging {
+		subscribers = append(subscribers, sub.Subscriber)
+	}
+
+	return subscribers, nil
+}
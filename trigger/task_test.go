@@ -1369,3 +1369,206 @@ func TestTaskRegressionByTestDisplayTask(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Nil(t, notification)
 }
+
+func (s *taskSuite) TestPersistentFailure() {
+	lastGreen := s.task
+	lastGreen.Id = "last_green"
+	lastGreen.RevisionOrderNumber = -1
+	lastGreen.Status = evergreen.TaskSucceeded
+	s.NoError(lastGreen.Insert())
+
+	firstFailure := s.task
+	firstFailure.Id = "first_failure"
+	firstFailure.RevisionOrderNumber = 0
+	firstFailure.Status = evergreen.TaskFailed
+	firstFailure.FinishTime = s.task.FinishTime.Add(-2 * time.Hour)
+	s.NoError(firstFailure.Insert())
+
+	s.task.Status = evergreen.TaskFailed
+	s.NoError(db.Update(task.Collection, bson.M{"_id": s.task.Id}, &s.task))
+
+	sub := event.Subscription{
+		ID:           mgobson.NewObjectId().Hex(),
+		ResourceType: event.ResourceTypeTask,
+		Trigger:      event.TriggerPersistentFailure,
+		Selectors: []event.Selector{
+			{
+				Type: "project",
+				Data: "test_project",
+			},
+		},
+		Subscriber: event.Subscriber{
+			Type:   event.EmailSubscriberType,
+			Target: "admins@example.com",
+		},
+		Owner:     "test_project",
+		OwnerType: event.OwnerTypeProject,
+		TriggerData: map[string]string{
+			event.PersistentFailureVersionsKey: "3",
+			event.EscalationTierKey:            event.EscalationTierProjectAdmins,
+		},
+	}
+	s.NoError(sub.Validate())
+
+	// two consecutive failures shouldn't escalate
+	n, err := s.t.persistentFailure(&sub)
+	s.NoError(err)
+	s.Nil(n)
+
+	// but a third should
+	thirdFailure := s.task
+	thirdFailure.Id = "third_failure"
+	thirdFailure.RevisionOrderNumber = 2
+	s.NoError(thirdFailure.Insert())
+	s.t.task = &thirdFailure
+	n, err = s.t.persistentFailure(&sub)
+	s.NoError(err)
+	s.Require().NotNil(n)
+	s.Equal("admins@example.com", n.Subscriber.Target)
+
+	// further failures in the same run shouldn't escalate again
+	n, err = s.t.persistentFailure(&sub)
+	s.NoError(err)
+	s.Nil(n)
+
+	// the first success afterwards should send a resolution
+	fixed := s.task
+	fixed.Id = "fixed"
+	fixed.RevisionOrderNumber = 3
+	fixed.Status = evergreen.TaskSucceeded
+	s.NoError(fixed.Insert())
+	s.t.task = &fixed
+	n, err = s.t.persistentFailure(&sub)
+	s.NoError(err)
+	s.Require().NotNil(n)
+	s.Equal("admins@example.com", n.Subscriber.Target)
+
+	n, err = s.t.persistentFailure(&sub)
+	s.NoError(err)
+	s.Nil(n)
+
+	// failing for long enough should escalate regardless of how many
+	// versions have failed
+	s.t.task = &s.task
+	sub.ID = mgobson.NewObjectId().Hex()
+	sub.TriggerData = map[string]string{
+		event.PersistentFailureHoursKey: "1",
+	}
+	s.NoError(sub.Validate())
+	n, err = s.t.persistentFailure(&sub)
+	s.NoError(err)
+	s.NotNil(n)
+}
+
+func TestTaskStillFailingOnlyChecksPersistentFailures(t *testing.T) {
+	h := registry.eventHandler(event.ResourceTypeTask, event.TaskStillFailing)
+	require.NotNil(t, h)
+	assert.True(t, h.ValidateTrigger(event.TriggerPersistentFailure))
+	assert.False(t, h.ValidateTrigger(event.TriggerFailure))
+	assert.False(t, h.ValidateTrigger(event.TriggerOutcome))
+}
+
+func (s *taskSuite) TestPersistentFailureEscalatesThroughTiers() {
+	s.NoError(db.ClearCollections(user.Collection))
+	defer func() {
+		s.NoError(db.ClearCollections(user.Collection))
+	}()
+	for _, id := range []string{"me", "admin1", "admin2"} {
+		u := user.DBUser{
+			Id:           id,
+			EmailAddress: id + "@example.com",
+			Settings: user.UserSettings{
+				Notifications: user.NotificationPreferences{
+					BuildBreak: user.PreferenceEmail,
+				},
+			},
+		}
+		s.NoError(u.Insert())
+	}
+	s.projectRef.Admins = []string{"admin1", "admin2"}
+	s.NoError(s.projectRef.Upsert())
+
+	sub := event.Subscription{
+		ID:           mgobson.NewObjectId().Hex(),
+		ResourceType: event.ResourceTypeTask,
+		Trigger:      event.TriggerPersistentFailure,
+		Selectors: []event.Selector{
+			{
+				Type: "project",
+				Data: "test_project",
+			},
+		},
+		Subscriber: event.Subscriber{
+			Type:   event.ChatWebhookSubscriberType,
+			Target: "https://pager.example.com/hook",
+		},
+		Owner:     "test_project",
+		OwnerType: event.OwnerTypeProject,
+		TriggerData: map[string]string{
+			event.PersistentFailureVersionsKey: "1",
+			event.EscalationTierKey:            event.EscalationTierPaging,
+		},
+	}
+	s.NoError(sub.Validate())
+
+	targets := func(n *notification.Notification) []interface{} {
+		s.Require().NotNil(n)
+		out := []interface{}{n.Subscriber.Target}
+		for _, additional := range s.t.AdditionalNotifications() {
+			out = append(out, additional.Subscriber.Target)
+		}
+		return out
+	}
+
+	lastGreen := s.task
+	lastGreen.Id = "last_green"
+	lastGreen.RevisionOrderNumber = -1
+	lastGreen.Status = evergreen.TaskSucceeded
+	s.NoError(lastGreen.Insert())
+
+	// the first failure notifies the committer
+	firstFailure := s.task
+	firstFailure.Id = "first_failure"
+	firstFailure.RevisionOrderNumber = 0
+	firstFailure.Status = evergreen.TaskFailed
+	s.NoError(firstFailure.Insert())
+	s.t.task = &firstFailure
+	n, err := s.t.Process(&sub)
+	s.NoError(err)
+	s.Equal([]interface{}{"me@example.com"}, targets(n))
+
+	// the second notifies the project admins
+	s.task.Status = evergreen.TaskFailed
+	s.NoError(db.Update(task.Collection, bson.M{"_id": s.task.Id}, &s.task))
+	s.t.task = &s.task
+	n, err = s.t.Process(&sub)
+	s.NoError(err)
+	s.ElementsMatch([]interface{}{"admin1@example.com", "admin2@example.com"}, targets(n))
+
+	// the third pages
+	thirdFailure := s.task
+	thirdFailure.Id = "third_failure"
+	thirdFailure.RevisionOrderNumber = 2
+	s.NoError(thirdFailure.Insert())
+	s.t.task = &thirdFailure
+	n, err = s.t.Process(&sub)
+	s.NoError(err)
+	s.Equal([]interface{}{"https://pager.example.com/hook"}, targets(n))
+
+	// there are no tiers left to escalate to
+	n, err = s.t.Process(&sub)
+	s.NoError(err)
+	s.Nil(n)
+	s.Empty(s.t.AdditionalNotifications())
+
+	// everyone who was notified is told when the task passes again
+	fixed := s.task
+	fixed.Id = "fixed"
+	fixed.RevisionOrderNumber = 3
+	fixed.Status = evergreen.TaskSucceeded
+	s.NoError(fixed.Insert())
+	s.t.task = &fixed
+	n, err = s.t.Process(&sub)
+	s.NoError(err)
+	s.ElementsMatch([]interface{}{"me@example.com", "admin1@example.com", "admin2@example.com", "https://pager.example.com/hook"}, targets(n))
+}
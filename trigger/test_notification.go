@@ -0,0 +1,68 @@
+package trigger
+
+import (
+	"fmt"
+
+	"github.com/evergreen-ci/evergreen"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/notification"
+	restModel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/pkg/errors"
+)
+
+// TriggerSubscriptionTest is the trigger recorded on notifications sent to
+// check that a subscription's subscriber can be reached.
+const TriggerSubscriptionTest = "subscription-test"
+
+// MakeTestNotification creates a notification for the subscription's
+// subscriber without waiting for an event that matches the subscription, so
+// that users can check that the subscriber is configured correctly.
+func MakeTestNotification(sub *event.Subscription, uiConfig evergreen.UIConfig) (*notification.Notification, error) {
+	switch sub.Subscriber.Type {
+	case event.EmailSubscriberType, event.SlackSubscriberType, event.MSTeamsSubscriberType,
+		event.ChatWebhookSubscriberType, event.JIRACommentSubscriberType, event.EvergreenWebhookSubscriberType:
+	default:
+		return nil, errors.Errorf("cannot send test notifications to '%s' subscribers", sub.Subscriber.Type)
+	}
+
+	api := &restModel.APISubscription{}
+	if err := api.BuildFromService(*sub); err != nil {
+		return nil, errors.Wrap(err, "error building json model")
+	}
+
+	testSub := *sub
+	testSub.Trigger = TriggerSubscriptionTest
+	eventID := mgobson.NewObjectId().Hex()
+	data := &commonTemplateData{
+		ID:              sub.ID,
+		EventID:         eventID,
+		SubscriptionID:  sub.ID,
+		DisplayName:     fmt.Sprintf("%s/%s", sub.ResourceType, sub.Trigger),
+		Object:          "subscription",
+		Project:         sub.Owner,
+		URL:             uiConfig.Url,
+		PastTenseStatus: "been tested",
+		apiModel:        api,
+	}
+	payload, err := makeCommonPayload(&testSub, []event.Selector{
+		{
+			Type: event.SelectorID,
+			Data: sub.ID,
+		},
+		{
+			Type: event.SelectorObject,
+			Data: data.Object,
+		},
+	}, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build notification")
+	}
+
+	n, err := notification.New(eventID, TriggerSubscriptionTest, &sub.Subscriber, payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create a notification")
+	}
+
+	return n, nil
+}
@@ -0,0 +1,65 @@
+package trigger
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeTestNotification(t *testing.T) {
+	uiConfig := evergreen.UIConfig{Url: "https://evergreen.example.com"}
+	sub := event.Subscription{
+		ID:           "sub0",
+		ResourceType: event.ResourceTypeTask,
+		Trigger:      event.TriggerFailure,
+		Selectors: []event.Selector{
+			{
+				Type: event.SelectorProject,
+				Data: "project",
+			},
+		},
+		Owner:     "project",
+		OwnerType: event.OwnerTypeProject,
+		Subscriber: event.Subscriber{
+			Type:   event.SlackSubscriberType,
+			Target: "#evergreen",
+		},
+	}
+
+	n, err := MakeTestNotification(&sub, uiConfig)
+	require.NoError(t, err)
+	require.NotNil(t, n)
+	assert.Equal(t, sub.Subscriber, n.Subscriber)
+	payload, ok := n.Payload.(*notification.SlackPayload)
+	require.True(t, ok)
+	assert.Contains(t, payload.Body, "TASK/failure")
+	assert.Contains(t, payload.Body, "has been tested")
+
+	other, err := MakeTestNotification(&sub, uiConfig)
+	require.NoError(t, err)
+	assert.NotEqual(t, n.ID, other.ID, "each test should be a distinct notification")
+
+	sub.Subscriber = event.Subscriber{
+		Type:   event.ChatWebhookSubscriberType,
+		Target: "https://chat.example.com/hook",
+	}
+	n, err = MakeTestNotification(&sub, uiConfig)
+	require.NoError(t, err)
+	_, ok = n.Payload.(*util.ChatWebhook)
+	assert.True(t, ok)
+
+	sub.Subscriber = event.Subscriber{
+		Type: event.JIRAIssueSubscriberType,
+		Target: &event.JIRAIssueSubscriber{
+			Project:   "BF",
+			IssueType: "Build Failure",
+		},
+	}
+	_, err = MakeTestNotification(&sub, uiConfig)
+	assert.Error(t, err, "JIRA issues shouldn't be filed for tests")
+}
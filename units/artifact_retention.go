@@ -0,0 +1,159 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/pail"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const artifactRetentionJobName = "artifact-retention"
+
+func init() {
+	registry.AddJobType(artifactRetentionJobName, func() amboy.Job { return makeArtifactRetentionJob() })
+}
+
+type artifactRetentionJob struct {
+	ProjectID string `bson:"project_id" json:"project_id" yaml:"project_id"`
+	job.Base  `bson:"metadata" json:"metadata" yaml:"metadata"`
+
+	env    evergreen.Environment
+	remove func(ctx context.Context, file artifact.File) error
+}
+
+func makeArtifactRetentionJob() *artifactRetentionJob {
+	j := &artifactRetentionJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    artifactRetentionJobName,
+				Version: 0,
+			},
+		},
+	}
+	return j
+}
+
+// NewArtifactRetentionJob deletes the files attached to the project's tasks
+// that its artifact retention policy has expired from their buckets and marks
+// them as expired.
+func NewArtifactRetentionJob(projectID string, ts time.Time) amboy.Job {
+	j := makeArtifactRetentionJob()
+	j.ProjectID = projectID
+	j.SetID(fmt.Sprintf("%s.%s.%s", artifactRetentionJobName, projectID, ts.Format(TSFormat)))
+	j.UpdateTimeInfo(amboy.JobTimeInfo{MaxTime: 30 * time.Minute})
+	return j
+}
+
+func (j *artifactRetentionJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+	if j.remove == nil {
+		j.remove = j.removeFromS3
+	}
+
+	flags, err := evergreen.GetServiceFlags()
+	if err != nil {
+		j.AddError(errors.Wrap(err, "retrieving service flags"))
+		return
+	}
+	if flags.ArtifactRetentionDisabled {
+		grip.Info(message.Fields{
+			"job_type": artifactRetentionJobName,
+			"job_id":   j.ID(),
+			"project":  j.ProjectID,
+			"message":  "artifact retention is disabled",
+		})
+		return
+	}
+
+	projectRef, err := model.FindBranchProjectRef(j.ProjectID)
+	if err != nil {
+		j.AddError(errors.Wrapf(err, "finding project '%s'", j.ProjectID))
+		return
+	}
+	if projectRef == nil {
+		j.AddError(errors.Errorf("project '%s' not found", j.ProjectID))
+		return
+	}
+	if !projectRef.ArtifactRetention.IsEnabled() {
+		return
+	}
+
+	entries, err := model.FindExpiredArtifacts(projectRef, time.Now(), model.ArtifactRetentionBatchSize)
+	if err != nil {
+		j.AddError(err)
+		return
+	}
+
+	var numExpired, numFailed int
+	for _, entry := range entries {
+		for _, file := range entry.Files {
+			if ctx.Err() != nil {
+				j.AddError(ctx.Err())
+				return
+			}
+			// The file is only marked as expired once it's deleted, so a
+			// file that fails to be deleted is retried by the next jobs
+			// until it has failed too many times.
+			if err = j.remove(ctx, file); err != nil {
+				numFailed++
+				j.AddError(errors.Wrapf(err, "deleting file '%s' from bucket '%s'", file.FileKey, file.Bucket))
+				j.AddError(errors.Wrapf(entry.MarkFileExpirationFailed(file.Bucket, file.FileKey), "recording failure to expire file '%s' for task '%s'", file.FileKey, entry.TaskId))
+				continue
+			}
+			if err = entry.MarkFileExpired(file.Bucket, file.FileKey); err != nil {
+				j.AddError(errors.Wrapf(err, "marking file '%s' for task '%s' as expired", file.FileKey, entry.TaskId))
+				continue
+			}
+			numExpired++
+		}
+	}
+
+	grip.Info(message.Fields{
+		"job_type":    artifactRetentionJobName,
+		"job_id":      j.ID(),
+		"project":     j.ProjectID,
+		"num_entries": len(entries),
+		"num_expired": numExpired,
+		"num_failed":  numFailed,
+		"message":     "expired artifacts",
+	})
+}
+
+// removeFromS3 deletes the file from its bucket with Evergreen's own
+// credentials.
+func (j *artifactRetentionJob) removeFromS3(ctx context.Context, file artifact.File) error {
+	creds := j.env.Settings().Providers.AWS.S3
+	if creds.Key == "" || creds.Secret == "" {
+		return errors.New("Evergreen does not have S3 credentials")
+	}
+	region := file.Region
+	if region == "" {
+		region = endpoints.UsEast1RegionID
+	}
+
+	bucket, err := pail.NewS3Bucket(pail.S3Options{
+		Credentials: pail.CreateAWSCredentials(creds.Key, creds.Secret, ""),
+		Region:      region,
+		Name:        file.Bucket,
+	})
+	if err != nil {
+		return errors.Wrap(err, "creating S3 bucket")
+	}
+
+	return bucket.Remove(ctx, file.FileKey)
+}
@@ -0,0 +1,145 @@
+package units
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArtifactRetentionJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	collections := []string{model.ProjectRefCollection, artifact.Collection}
+	runJob := func(t *testing.T, remove func(context.Context, artifact.File) error) *artifactRetentionJob {
+		j, ok := NewArtifactRetentionJob("project", time.Now()).(*artifactRetentionJob)
+		require.True(t, ok)
+		j.remove = remove
+		j.Run(ctx)
+		return j
+	}
+	oldEntry := func(taskID, requester, version string) artifact.Entry {
+		return artifact.Entry{
+			TaskId:     taskID,
+			ProjectId:  "project",
+			Requester:  requester,
+			VersionId:  version,
+			CreateTime: time.Now().Add(-30 * 24 * time.Hour),
+			Files: []artifact.File{
+				{Name: "stored", Bucket: "bucket", FileKey: taskID + "/stored"},
+				{Name: "linked", Link: "https://example.com/" + taskID},
+			},
+		}
+	}
+	isExpired := func(t *testing.T, taskID string) bool {
+		entry, err := artifact.FindOne(artifact.ByTaskId(taskID))
+		require.NoError(t, err)
+		require.NotNil(t, entry)
+		require.Len(t, entry.Files, 2)
+		assert.False(t, entry.Files[1].Expired)
+		return entry.Files[0].Expired
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"ExpiresFilesOlderThanPolicy": func(t *testing.T) {
+			pRef := model.ProjectRef{
+				Id: "project",
+				ArtifactRetention: model.ArtifactRetentionPolicy{
+					MainlineDays:   90,
+					PatchDays:      14,
+					Buckets:        []string{"bucket"},
+					PinnedVersions: []string{"pinned"},
+				},
+			}
+			require.NoError(t, pRef.Insert())
+			for _, entry := range []artifact.Entry{
+				oldEntry("mainline", evergreen.RepotrackerVersionRequester, "v1"),
+				oldEntry("patch", evergreen.PatchVersionRequester, "v2"),
+				oldEntry("pinned", evergreen.PatchVersionRequester, "pinned"),
+			} {
+				require.NoError(t, entry.Upsert())
+			}
+
+			var removed []string
+			j := runJob(t, func(_ context.Context, file artifact.File) error {
+				removed = append(removed, file.FileKey)
+				return nil
+			})
+			require.NoError(t, j.Error())
+
+			assert.Equal(t, []string{"patch/stored"}, removed)
+			assert.True(t, isExpired(t, "patch"))
+			assert.False(t, isExpired(t, "mainline"))
+			assert.False(t, isExpired(t, "pinned"))
+		},
+		"DoesNotMarkFilesThatFailToBeDeleted": func(t *testing.T) {
+			pRef := model.ProjectRef{
+				Id:                "project",
+				ArtifactRetention: model.ArtifactRetentionPolicy{MainlineDays: 7, Buckets: []string{"bucket"}},
+			}
+			require.NoError(t, pRef.Insert())
+			require.NoError(t, oldEntry("mainline", evergreen.RepotrackerVersionRequester, "v1").Upsert())
+
+			var attempts int
+			for i := 0; i < artifact.MaxExpirationFailures+1; i++ {
+				j := runJob(t, func(context.Context, artifact.File) error {
+					attempts++
+					return errors.New("access denied")
+				})
+				assert.Equal(t, i < artifact.MaxExpirationFailures, j.HasErrors())
+			}
+			assert.Equal(t, artifact.MaxExpirationFailures, attempts, "file should not be retried after failing too many times")
+			assert.False(t, isExpired(t, "mainline"))
+		},
+		"DoesNotExpireFilesInOtherBuckets": func(t *testing.T) {
+			pRef := model.ProjectRef{
+				Id:                "project",
+				ArtifactRetention: model.ArtifactRetentionPolicy{MainlineDays: 7, Buckets: []string{"other_bucket"}},
+			}
+			require.NoError(t, pRef.Insert())
+			require.NoError(t, oldEntry("mainline", evergreen.RepotrackerVersionRequester, "v1").Upsert())
+
+			j := runJob(t, func(context.Context, artifact.File) error {
+				assert.Fail(t, "should not remove files from buckets the policy does not list")
+				return nil
+			})
+			require.NoError(t, j.Error())
+			assert.False(t, isExpired(t, "mainline"))
+		},
+		"NoopsIfDisabled": func(t *testing.T) {
+			require.NoError(t, evergreen.SetServiceFlags(evergreen.ServiceFlags{ArtifactRetentionDisabled: true}))
+			defer func() {
+				assert.NoError(t, evergreen.SetServiceFlags(evergreen.ServiceFlags{}))
+			}()
+			pRef := model.ProjectRef{
+				Id:                "project",
+				ArtifactRetention: model.ArtifactRetentionPolicy{MainlineDays: 7, Buckets: []string{"bucket"}},
+			}
+			require.NoError(t, pRef.Insert())
+			require.NoError(t, oldEntry("mainline", evergreen.RepotrackerVersionRequester, "v1").Upsert())
+
+			j := runJob(t, func(context.Context, artifact.File) error {
+				assert.Fail(t, "should not remove files while artifact retention is disabled")
+				return nil
+			})
+			require.NoError(t, j.Error())
+			assert.False(t, isExpired(t, "mainline"))
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(collections...))
+			defer func() {
+				assert.NoError(t, db.ClearCollections(collections...))
+			}()
+			tCase(t)
+		})
+	}
+}
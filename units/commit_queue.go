@@ -137,15 +137,24 @@ func (j *commitQueueJob) Run(ctx context.Context) {
 	}
 	j.TryUnstick(ctx, cq, projectRef, githubToken)
 
-	if cq.Processing() {
-		return
-	}
-
-	batchSize := conf.CommitQueue.BatchSize
-	if batchSize < 1 {
-		batchSize = 1
+	var nextItems []commitqueue.CommitQueueItem
+	if projectRef.CommitQueue.BisectBatchSize > 1 {
+		// In bisection mode, the CLI patches at the front of the queue are
+		// tested together in a single version. Anything that can't be
+		// batched is tested on its own.
+		if cq.Processing() {
+			return
+		}
+		batch := cq.NextBisectBatch(projectRef.CommitQueue.BisectBatchSize)
+		if len(batch) > 1 {
+			j.processCLIPatchBatch(ctx, cq, batch, projectRef, githubToken)
+			return
+		}
+		nextItems = cq.NextUnprocessed(1)
+	} else {
+		nextItems = nextCommitQueueItems(cq, projectRef.CommitQueue, conf.CommitQueue.BatchSize)
 	}
-	nextItems := cq.NextUnprocessed(batchSize)
+	nextItems = cq.LimitByLane(nextItems, projectRef.CommitQueue.Lanes)
 	if len(nextItems) == 0 {
 		return
 	}
@@ -197,6 +206,23 @@ func (j *commitQueueJob) Run(ctx context.Context) {
 	j.AddError(j.addMergeTaskDependencies(*cq))
 }
 
+// nextCommitQueueItems returns the items to start testing. In speculative
+// mode, items are started as soon as they enter the window at the front of the
+// queue and are tested on top of all the items ahead of them. Otherwise, a new
+// batch is only started once the previous one is done.
+func nextCommitQueueItems(cq *commitqueue.CommitQueue, params model.CommitQueueParams, batchSize int) []commitqueue.CommitQueueItem {
+	if params.SpeculativeWindow > 0 {
+		return cq.NextUnprocessed(params.SpeculativeWindow)
+	}
+	if cq.Processing() {
+		return nil
+	}
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	return cq.NextUnprocessed(batchSize)
+}
+
 func (j *commitQueueJob) addMergeTaskDependencies(cq commitqueue.CommitQueue) error {
 	var prevMergeTask string
 	for i, currentItem := range cq.Queue {
@@ -271,6 +297,9 @@ func (j *commitQueueJob) TryUnstick(ctx context.Context, cq *commitqueue.CommitQ
 		return
 	}
 
+	// all the items in a batch are tested by the same version
+	items := cq.ItemsForVersion(nextItem.Version)
+
 	mergeTask, err := task.FindMergeTaskForVersion(nextItem.Version)
 	if err != nil {
 		j.AddError(errors.Wrap(err, "unable to find merge task for version"))
@@ -290,18 +319,18 @@ func (j *commitQueueJob) TryUnstick(ctx context.Context, cq *commitqueue.CommitQ
 				"source":   "commit queue",
 				"job_id":   j.ID(),
 			})
-			j.dequeue(cq, nextItem)
+			j.dequeueBatch(cq, items, evergreen.EnqueueFailed)
 			event.LogCommitQueueConcludeTest(nextItem.Version, evergreen.EnqueueFailed)
 		}
 	}
 
 	// patch is done
 	if !utility.IsZeroTime(patchDoc.FinishTime) {
-		j.dequeue(cq, nextItem)
 		status := evergreen.MergeTestSucceeded
 		if patchDoc.Status == evergreen.PatchFailed {
 			status = evergreen.MergeTestFailed
 		}
+		j.dequeueBatch(cq, items, status)
 		event.LogCommitQueueConcludeTest(nextItem.Version, status)
 		grip.Info(message.Fields{
 			"source":                "commit queue",
@@ -438,6 +467,106 @@ func (j *commitQueueJob) processCLIPatchItem(ctx context.Context, cq *commitqueu
 	event.LogCommitQueueStartTestEvent(v.Id)
 }
 
+// processCLIPatchBatch creates a single version that tests the changes from
+// all the given CLI patch items together.
+func (j *commitQueueJob) processCLIPatchBatch(ctx context.Context, cq *commitqueue.CommitQueue, batch []commitqueue.CommitQueueItem, projectRef *model.ProjectRef, githubToken string) {
+	var project *model.Project
+	patches := []patch.Patch{}
+	items := []commitqueue.CommitQueueItem{}
+	for _, item := range batch {
+		patchDoc, err := patch.FindOneId(item.Issue)
+		if err != nil {
+			j.logError(err, "can't find patch", item)
+			event.LogCommitQueueEnqueueFailed(item.Issue, err)
+			j.dequeue(cq, item)
+			continue
+		}
+		if patchDoc == nil {
+			err = errors.Errorf("patch '%s' not found", item.Issue)
+			j.logError(err, "patch not found", item)
+			event.LogCommitQueueEnqueueFailed(item.Issue, err)
+			j.dequeue(cq, item)
+			continue
+		}
+
+		itemProject, err := updatePatch(ctx, githubToken, projectRef, patchDoc)
+		if err != nil {
+			j.logError(err, "can't update patch", item)
+			event.LogCommitQueueEnqueueFailed(item.Issue, err)
+			j.dequeue(cq, item)
+			continue
+		}
+		if project == nil {
+			project = itemProject
+		}
+		patches = append(patches, *patchDoc)
+		items = append(items, item)
+	}
+	if len(items) == 0 {
+		return
+	}
+
+	batchPatch, err := patch.MakeNewBatchPatch(patches)
+	if err != nil {
+		j.failBatch(cq, items, err, "can't make batch patch")
+		return
+	}
+	if err = AddMergeTaskAndVariant(batchPatch, project, projectRef, commitqueue.SourceDiff); err != nil {
+		j.failBatch(cq, items, err, "can't set batch patch project config")
+		return
+	}
+	if err = batchPatch.Insert(); err != nil {
+		j.failBatch(cq, items, err, "can't insert batch patch")
+		return
+	}
+
+	v, err := model.FinalizePatch(ctx, batchPatch, evergreen.MergeTestRequester, githubToken)
+	if err != nil {
+		j.failBatch(cq, items, err, "can't finalize batch patch")
+		return
+	}
+	for i, item := range items {
+		item.Version = v.Id
+		if err = cq.UpdateVersion(item); err != nil {
+			j.logError(err, "problem saving version", item)
+			j.dequeue(cq, item)
+			continue
+		}
+		if err = setDefaultNotification(patches[i].Author); err != nil {
+			j.logError(err, "failed to set default notification", item)
+		}
+		event.LogCommitQueueStartTestEvent(item.Issue)
+	}
+	grip.Info(message.Fields{
+		"source":     "commit queue",
+		"job_id":     j.ID(),
+		"project_id": cq.ProjectID,
+		"version":    v.Id,
+		"batch_size": len(items),
+		"message":    "started testing commit queue batch",
+	})
+}
+
+// failBatch dequeues all the items of a batch that couldn't be started.
+func (j *commitQueueJob) failBatch(cq *commitqueue.CommitQueue, items []commitqueue.CommitQueueItem, err error, msg string) {
+	for _, item := range items {
+		j.logError(err, msg, item)
+		event.LogCommitQueueEnqueueFailed(item.Issue, err)
+		j.dequeue(cq, item)
+	}
+}
+
+// dequeueBatch dequeues all the items tested by a finished version. If there
+// is more than one, each item's conclusion is logged individually.
+func (j *commitQueueJob) dequeueBatch(cq *commitqueue.CommitQueue, items []commitqueue.CommitQueueItem, status string) {
+	for _, item := range items {
+		j.dequeue(cq, item)
+		if len(items) > 1 {
+			event.LogCommitQueueConcludeTest(item.Issue, status)
+		}
+	}
+}
+
 func (j *commitQueueJob) logError(err error, msg string, item commitqueue.CommitQueueItem) {
 	if err == nil {
 		return
@@ -509,19 +638,6 @@ func sendCommitQueueGithubStatus(env evergreen.Environment, pr *github.PullReque
 	return nil
 }
 
-func validateBranch(branch *github.Branch) error {
-	if branch == nil {
-		return errors.New("branch is nil")
-	}
-	if branch.Commit == nil {
-		return errors.New("commit is nil")
-	}
-	if branch.Commit.SHA == nil {
-		return errors.New("SHA is nil")
-	}
-	return nil
-}
-
 func AddMergeTaskAndVariant(patchDoc *patch.Patch, project *model.Project, projectRef *model.ProjectRef, source string) error {
 	settings, err := evergreen.GetConfig()
 	if err != nil {
@@ -661,15 +777,10 @@ func setDefaultNotification(username string) error {
 }
 
 func updatePatch(ctx context.Context, githubToken string, projectRef *model.ProjectRef, patchDoc *patch.Patch) (*model.Project, error) {
-	branch, err := thirdparty.GetBranchEvent(ctx, githubToken, projectRef.Owner, projectRef.Repo, projectRef.Branch)
+	sha, err := projectRef.GetCodeHost(githubToken).GetBranchHead(ctx, projectRef.Branch)
 	if err != nil {
 		return nil, errors.Wrap(err, "can't get branch")
 	}
-	if err = validateBranch(branch); err != nil {
-		return nil, errors.Wrap(err, "GitHub returned invalid branch")
-	}
-
-	sha := *branch.Commit.SHA
 	patchDoc.Githash = sha
 
 	// Refresh the cached project config
@@ -691,20 +802,16 @@ func updatePatch(ctx context.Context, githubToken string, projectRef *model.Proj
 		if err != nil {
 			return nil, errors.Wrapf(err, "can't get module for module name '%s'", mod.ModuleName)
 		}
-		owner, repo, err := thirdparty.ParseGitUrl(module.Repo)
+		moduleHost, err := projectRef.GetModuleCodeHost(*module, githubToken)
 		if err != nil {
-			return nil, errors.Wrapf(err, "module '%s' misconfigured (malformed URL)", mod.ModuleName)
+			return nil, errors.WithStack(err)
 		}
-
-		branch, err = thirdparty.GetBranchEvent(ctx, githubToken, owner, repo, module.Branch)
+		moduleSHA, err := moduleHost.GetBranchHead(ctx, module.Branch)
 		if err != nil {
 			return nil, errors.Wrap(err, "can't get branch")
 		}
-		if err = validateBranch(branch); err != nil {
-			return nil, errors.Wrap(err, "GitHub returned invalid branch")
-		}
 
-		patchDoc.Patches[i].Githash = *branch.Commit.SHA
+		patchDoc.Patches[i].Githash = moduleSHA
 	}
 
 	// rebuild patch build variants and tasks
@@ -128,22 +128,6 @@ func (s *commitQueueSuite) TestNewCommitQueueJob() {
 	s.Equal("commit-queue:mci_job-1", job.ID())
 }
 
-func (s *commitQueueSuite) TestValidateBranch() {
-	var branch *github.Branch
-	s.Error(validateBranch(branch))
-
-	branch = &github.Branch{}
-	s.Error(validateBranch(branch))
-
-	branch.Commit = &github.RepositoryCommit{}
-	s.Error(validateBranch(branch))
-
-	sha := "abcdef"
-	branch.Commit.SHA = &sha
-
-	s.NoError(validateBranch(branch))
-}
-
 func (s *commitQueueSuite) TestAddMergeTaskAndVariant() {
 	s.NoError(db.ClearCollections(distro.Collection, evergreen.ConfigCollection))
 	config, err := evergreen.GetConfig()
@@ -303,3 +287,55 @@ func TestAddMergeTaskDependencies(t *testing.T) {
 	assert.Len(t, dbTask3.DependsOn, 1)
 	assert.Equal(t, dbTask2.Id, dbTask3.DependsOn[0].TaskId)
 }
+
+func TestNextCommitQueueItems(t *testing.T) {
+	issues := func(items []commitqueue.CommitQueueItem) []string {
+		out := []string{}
+		for _, item := range items {
+			out = append(out, item.Issue)
+		}
+		return out
+	}
+	cq := &commitqueue.CommitQueue{
+		Queue: []commitqueue.CommitQueueItem{
+			{Issue: "1"},
+			{Issue: "2"},
+			{Issue: "3"},
+			{Issue: "4"},
+			{Issue: "5"},
+		},
+	}
+	params := model.CommitQueueParams{SpeculativeWindow: 3}
+
+	t.Run("StartsItemsInWindow", func(t *testing.T) {
+		assert.Equal(t, []string{"1", "2", "3"}, issues(nextCommitQueueItems(cq, params, 1)))
+	})
+	t.Run("StartsItemsEnteringWindow", func(t *testing.T) {
+		cq.Queue[0].Version = "v1"
+		cq.Queue[1].Version = "v2"
+		defer func() {
+			cq.Queue[0].Version = ""
+			cq.Queue[1].Version = ""
+		}()
+		assert.Equal(t, []string{"3"}, issues(nextCommitQueueItems(cq, params, 1)))
+	})
+	t.Run("WaitsForFullWindow", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			cq.Queue[i].Version = "v"
+		}
+		defer func() {
+			for i := 0; i < 3; i++ {
+				cq.Queue[i].Version = ""
+			}
+		}()
+		assert.Empty(t, nextCommitQueueItems(cq, params, 1))
+	})
+	t.Run("BatchesWithoutWindow", func(t *testing.T) {
+		assert.Equal(t, []string{"1", "2"}, issues(nextCommitQueueItems(cq, model.CommitQueueParams{}, 2)))
+		assert.Equal(t, []string{"1"}, issues(nextCommitQueueItems(cq, model.CommitQueueParams{}, 0)))
+
+		cq.Queue[0].Version = "v1"
+		defer func() { cq.Queue[0].Version = "" }()
+		assert.Empty(t, nextCommitQueueItems(cq, model.CommitQueueParams{}, 2))
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/host"
 	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/evergreen-ci/evergreen/model/perf"
 	"github.com/evergreen-ci/evergreen/model/pod"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/user"
@@ -995,6 +996,7 @@ func PopulatePeriodicNotificationJobs(parts int) amboy.QueueOperation {
 		catcher := grip.NewBasicCatcher()
 		catcher.Add(amboy.EnqueueUniqueJob(ctx, queue, NewSpawnhostExpirationWarningsJob(ts)))
 		catcher.Add(amboy.EnqueueUniqueJob(ctx, queue, NewVolumeExpirationWarningsJob(ts)))
+		catcher.Add(amboy.EnqueueUniqueJob(ctx, queue, NewPersistentFailureCheckJob(ts)))
 		return errors.Wrap(catcher.Resolve(), "populating periodic notifications")
 	}
 }
@@ -1140,6 +1142,66 @@ func PopulatePeriodicBuilds() amboy.QueueOperation {
 	}
 }
 
+// PopulateArtifactRetentionJobs enqueues the jobs to delete the files that
+// projects' artifact retention policies have expired.
+func PopulateArtifactRetentionJobs() amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		flags, err := evergreen.GetServiceFlags()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if flags.ArtifactRetentionDisabled {
+			grip.InfoWhen(sometimes.Percent(evergreen.DegradedLoggingPercent), message.Fields{
+				"message": "artifact retention is disabled",
+				"impact":  "expired artifacts are not deleted",
+				"mode":    "degraded",
+			})
+			return nil
+		}
+
+		projects, err := model.FindProjectRefsWithArtifactRetention()
+		if err != nil {
+			return errors.Wrap(err, "finding projects with artifact retention policies")
+		}
+		catcher := grip.NewBasicCatcher()
+		ts := utility.RoundPartOfHour(0)
+		for _, project := range projects {
+			catcher.Wrapf(amboy.EnqueueUniqueJob(ctx, queue, NewArtifactRetentionJob(project.Id, ts)), "project '%s'", project.Id)
+		}
+		return errors.Wrap(catcher.Resolve(), "populating artifact retention jobs")
+	}
+}
+
+// PopulatePerfChangePointDetectionJobs enqueues the jobs to detect change
+// points in the performance series of projects with new mainline results.
+func PopulatePerfChangePointDetectionJobs() amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		flags, err := evergreen.GetServiceFlags()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if flags.PerfAnalysisDisabled {
+			grip.InfoWhen(sometimes.Percent(evergreen.DegradedLoggingPercent), message.Fields{
+				"message": "perf analysis is disabled",
+				"impact":  "perf regressions are not detected",
+				"mode":    "degraded",
+			})
+			return nil
+		}
+
+		projects, err := perf.FindProjectsWithUnanalyzedResults()
+		if err != nil {
+			return errors.Wrap(err, "finding projects with unanalyzed perf results")
+		}
+		catcher := grip.NewBasicCatcher()
+		ts := utility.RoundPartOfHour(0)
+		for _, projectID := range projects {
+			catcher.Wrapf(amboy.EnqueueUniqueJob(ctx, queue, NewPerfChangePointDetectionJob(projectID, ts)), "project '%s'", projectID)
+		}
+		return errors.Wrap(catcher.Resolve(), "populating perf change-point detection jobs")
+	}
+}
+
 // PopulateUserDataDoneJobs enqueues the jobs to check whether a spawn host
 // provisioning with user data is done running its user data script yet.
 func PopulateUserDataDoneJobs(env evergreen.Environment) amboy.QueueOperation {
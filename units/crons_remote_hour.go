@@ -54,6 +54,8 @@ func (j *cronsRemoteHourJob) Run(ctx context.Context) {
 		PopulateVolumeExpirationJob(),
 		PopulateSSHKeyUpdates(j.env),
 		PopulateDuplicateTaskCheckJobs(),
+		PopulateArtifactRetentionJobs(),
+		PopulatePerfChangePointDetectionJobs(),
 	}
 
 	queue := j.env.RemoteQueue()
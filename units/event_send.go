@@ -3,6 +3,7 @@ package units
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/model/event"
@@ -75,7 +76,7 @@ func (j *eventSendJob) setup() error {
 	return nil
 }
 
-func (j *eventSendJob) Run(_ context.Context) {
+func (j *eventSendJob) Run(ctx context.Context) {
 	defer j.MarkComplete()
 
 	if err := j.setup(); err != nil {
@@ -98,7 +99,22 @@ func (j *eventSendJob) Run(_ context.Context) {
 	}
 
 	if !utility.IsZeroTime(n.SentAt) {
-		j.AddError(errors.Errorf("notification '%s' has already been processed", n.ID))
+		// notifications collapsed into another one are suppressed before
+		// their own job runs
+		if n.SuppressedReason == "" {
+			j.AddError(errors.Errorf("notification '%s' has already been processed", n.ID))
+		}
+		return
+	}
+
+	suppressed, err := j.applyRateLimits(n)
+	grip.Error(message.WrapError(err, message.Fields{
+		"job_id":            j.ID(),
+		"notification_id":   n.ID,
+		"notification_type": n.Subscriber.Type,
+		"message":           "could not apply rate limits, sending anyway",
+	}))
+	if suppressed {
 		return
 	}
 
@@ -112,6 +128,21 @@ func (j *eventSendJob) Run(_ context.Context) {
 	j.AddError(err)
 	j.AddError(n.MarkSent())
 	j.AddError(n.MarkError(err))
+	j.AddError(j.enqueueCoverageComment(ctx, n))
+}
+
+// enqueueCoverageComment enqueues a job to comment on a GitHub PR patch's pull
+// request with its coverage once the patch's outcome has been sent to GitHub.
+func (j *eventSendJob) enqueueCoverageComment(ctx context.Context, n *notification.Notification) error {
+	if n.Subscriber.Type != event.GithubPullRequestSubscriberType || n.Metadata.PatchID == "" {
+		return nil
+	}
+
+	err := j.env.RemoteQueue().Put(ctx, NewGithubCoverageCommentJob(n.Metadata.PatchID))
+	if amboy.IsDuplicateJobError(err) {
+		return nil
+	}
+	return errors.Wrapf(err, "enqueueing coverage comment job for patch '%s'", n.Metadata.PatchID)
 }
 
 func (j *eventSendJob) send(n *notification.Notification) error {
@@ -140,6 +171,116 @@ func (j *eventSendJob) send(n *notification.Notification) error {
 	return nil
 }
 
+// applyRateLimits suppresses the notification if the subscriber or its
+// subscriber type has been sent too many notifications recently. If collapsing
+// is enabled, it collapses identical notifications that are waiting to be sent
+// into this one. A notification identical to one that was recently sent to the
+// same subscriber is left unsent until that notification's collapse window
+// ends, at which point it is dispatched again and starts a new window,
+// summarizing any other identical notifications that arrived in the meantime.
+func (j *eventSendJob) applyRateLimits(n *notification.Notification) (bool, error) {
+	if !notification.IsRateLimitable(n.Subscriber.Type) {
+		return false, nil
+	}
+	if n.SubscriberKey == "" {
+		n.SubscriberKey = n.Subscriber.String()
+	}
+
+	settings := j.env.Settings()
+	if settings == nil {
+		return false, errors.New("no admin settings")
+	}
+	limits := settings.Notify.RateLimits
+	since := time.Now().Add(-limits.Interval())
+	collapse := limits.CollapseIdentical && n.Metadata.CollapseKey != "" && notification.IsCollapsible(n.Subscriber.Type)
+
+	if collapse {
+		duplicate, err := notification.FindSentDuplicate(n, since)
+		if err != nil {
+			return false, err
+		}
+		if duplicate != nil {
+			// The notification stays unprocessed, so it's sent as a
+			// follow-up by a later job once the window ends.
+			grip.Info(message.Fields{
+				"job_id":            j.ID(),
+				"notification_id":   n.ID,
+				"notification_type": n.Subscriber.Type,
+				"duplicate_id":      duplicate.ID,
+				"message":           "deferred notification until the collapse window of its sent duplicate ends",
+			})
+			return true, nil
+		}
+	}
+
+	if limits.SubscriberLimit > 0 {
+		count, err := notification.CountSentToSubscriber(n.SubscriberKey, since)
+		if err != nil {
+			return false, errors.Wrap(err, "can't count notifications sent to subscriber")
+		}
+		if count >= limits.SubscriberLimit {
+			_, err = j.suppress(n, notification.SuppressedSubscriberRateLimit)
+			return err == nil, err
+		}
+	}
+
+	if limit := limits.SubscriberTypeLimit(n.Subscriber.Type); limit > 0 {
+		count, err := notification.CountSentToSubscriberType(n.Subscriber.Type, since)
+		if err != nil {
+			return false, errors.Wrap(err, "can't count notifications sent to subscriber type")
+		}
+		if count >= limit {
+			_, err = j.suppress(n, notification.SuppressedSubscriberTypeRateLimit)
+			return err == nil, err
+		}
+	}
+
+	if !collapse {
+		return false, nil
+	}
+
+	claimed, err := n.Claim()
+	if err != nil {
+		return false, err
+	}
+	if !claimed {
+		// another job already processed this notification
+		return true, nil
+	}
+	count, err := notification.SuppressUnsentDuplicates(n)
+	if err != nil {
+		return false, err
+	}
+	if count == 0 {
+		return false, nil
+	}
+	n.CollapsedCount += count
+	if err = notification.IncCollapsedCount(n.ID, count); err != nil {
+		return false, err
+	}
+
+	return false, errors.Wrap(n.AddCollapsedSummary(), "can't summarize collapsed notifications")
+}
+
+// suppress marks the notification as suppressed for the given reason. It
+// returns false if the notification had already been processed by another
+// job, in which case it must not be sent either.
+func (j *eventSendJob) suppress(n *notification.Notification, reason string) (bool, error) {
+	suppressed, err := n.MarkSuppressed(reason)
+	if err != nil {
+		return false, err
+	}
+	grip.InfoWhen(suppressed, message.Fields{
+		"job_id":            j.ID(),
+		"notification_id":   n.ID,
+		"notification_type": n.Subscriber.Type,
+		"reason":            reason,
+		"message":           "suppressed notification",
+	})
+
+	return suppressed, nil
+}
+
 func (j *eventSendJob) checkDegradedMode(n *notification.Notification) error {
 	switch n.Subscriber.Type {
 	case event.GithubPullRequestSubscriberType, event.GithubCheckSubscriberType:
@@ -154,7 +295,7 @@ func (j *eventSendJob) checkDegradedMode(n *notification.Notification) error {
 	case event.JIRACommentSubscriberType:
 		return checkFlag(j.flags.JIRANotificationsDisabled)
 
-	case event.EvergreenWebhookSubscriberType:
+	case event.EvergreenWebhookSubscriberType, event.MSTeamsSubscriberType, event.ChatWebhookSubscriberType:
 		return checkFlag(j.flags.WebhookNotificationsDisabled)
 
 	case event.EmailSubscriberType:
@@ -15,6 +15,7 @@ import (
 	"github.com/evergreen-ci/evergreen/util"
 	"github.com/mongodb/grip/message"
 	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 type eventNotificationSuite struct {
@@ -248,6 +249,24 @@ func (s *eventNotificationSuite) TestJIRAIssue() {
 	})
 }
 
+func (s *eventNotificationSuite) TestGithubPatchOutcomeEnqueuesCoverageComment() {
+	outcome := s.notifications[5]
+	outcome.ID = "github-patch-outcome"
+	outcome.Metadata.PatchID = "5aab4514f27e4f9984646d97"
+	s.NoError(notification.InsertMany(outcome))
+
+	for _, id := range []string{"github-status", outcome.ID} {
+		job := NewEventSendJob(id, "").(*eventSendJob)
+		job.env = s.env
+		job.Run(s.ctx)
+		s.NoError(job.Error())
+	}
+
+	_, ok := s.env.Remote.Get(s.ctx, NewGithubCoverageCommentJob(outcome.Metadata.PatchID).ID())
+	s.True(ok)
+	s.Equal(1, s.env.Remote.Stats(s.ctx).Total)
+}
+
 func (s *eventNotificationSuite) TestSendFailureResultsInNoMessages() {
 	s.Require().NoError(db.ClearCollections(notification.Collection))
 	n := s.notifications[:len(s.notifications)-1]
@@ -267,3 +286,169 @@ func (s *eventNotificationSuite) TestSendFailureResultsInNoMessages() {
 
 	s.NotZero(s.notificationHasError(s.webhook.ID, "^composer is not loggable$"))
 }
+
+func (s *eventNotificationSuite) TestSubscriberRateLimit() {
+	s.env.EvergreenSettings.Notify.RateLimits = evergreen.NotificationRateLimitsConfig{
+		IntervalSeconds: 60,
+		SubscriberLimit: 1,
+	}
+	s.Require().NoError(db.ClearCollections(notification.Collection))
+	for _, id := range []string{"slack-1", "slack-2"} {
+		n, err := notification.New(id, "outcome", &s.slack.Subscriber, &notification.SlackPayload{Body: "Hi"})
+		s.Require().NoError(err)
+		s.Require().NoError(notification.InsertMany(*n))
+
+		job := NewEventSendJob(n.ID, "").(*eventSendJob)
+		job.env = s.env
+		job.Run(s.ctx)
+		s.NoError(job.Error())
+	}
+
+	_, recv := s.env.InternalSender.GetMessageSafe()
+	s.True(recv)
+	_, recv = s.env.InternalSender.GetMessageSafe()
+	s.False(recv)
+
+	n, err := notification.Find("slack-2-outcome-" + s.slack.Subscriber.String())
+	s.Require().NoError(err)
+	s.Require().NotNil(n)
+	s.NotZero(n.SentAt)
+	s.Equal(notification.SuppressedSubscriberRateLimit, n.SuppressedReason)
+
+	stats, err := notification.CollectSuppressedNotificationStats(time.Now().Add(-time.Minute))
+	s.NoError(err)
+	s.Require().NotNil(stats)
+	s.Equal(1, stats.SubscriberRateLimit)
+}
+
+func (s *eventNotificationSuite) TestSubscriberTypeRateLimit() {
+	s.env.EvergreenSettings.Notify.RateLimits = evergreen.NotificationRateLimitsConfig{
+		IntervalSeconds: 60,
+		SubscriberTypeLimits: []evergreen.SubscriberTypeRateLimit{
+			{SubscriberType: event.SlackSubscriberType, Limit: 1},
+		},
+	}
+	s.Require().NoError(db.ClearCollections(notification.Collection))
+	for _, channel := range []string{"#channel-1", "#channel-2"} {
+		sub := event.NewSlackSubscriber(channel)
+		n, err := notification.New("event", "outcome", &sub, &notification.SlackPayload{Body: "Hi"})
+		s.Require().NoError(err)
+		s.Require().NoError(notification.InsertMany(*n))
+
+		job := NewEventSendJob(n.ID, "").(*eventSendJob)
+		job.env = s.env
+		job.Run(s.ctx)
+		s.NoError(job.Error())
+	}
+
+	_, recv := s.env.InternalSender.GetMessageSafe()
+	s.True(recv)
+	_, recv = s.env.InternalSender.GetMessageSafe()
+	s.False(recv)
+
+	sub := event.NewSlackSubscriber("#channel-2")
+	n, err := notification.Find("event-outcome-" + sub.String())
+	s.Require().NoError(err)
+	s.Require().NotNil(n)
+	s.Equal(notification.SuppressedSubscriberTypeRateLimit, n.SuppressedReason)
+}
+
+func (s *eventNotificationSuite) TestCollapseIdentical() {
+	s.env.EvergreenSettings.Notify.RateLimits = evergreen.NotificationRateLimitsConfig{
+		IntervalSeconds:   60,
+		CollapseIdentical: true,
+	}
+	s.Require().NoError(db.ClearCollections(notification.Collection))
+	ids := []string{}
+	for _, eventID := range []string{"variant-1", "variant-2", "variant-3"} {
+		n, err := notification.New(eventID, "outcome", &s.slack.Subscriber, &notification.SlackPayload{Body: "Hi"})
+		s.Require().NoError(err)
+		n.SetCollapseKey("outcome-version-task-failed")
+		s.Require().NoError(notification.InsertMany(*n))
+		ids = append(ids, n.ID)
+	}
+
+	for _, id := range ids {
+		job := NewEventSendJob(id, "").(*eventSendJob)
+		job.env = s.env
+		job.Run(s.ctx)
+		s.NoError(job.Error())
+	}
+
+	msg, recv := s.env.InternalSender.GetMessageSafe()
+	s.Require().True(recv)
+	s.NotPanics(func() {
+		slack := msg.Message.Raw().(*message.Slack)
+		s.Contains(slack.Msg, "Hi")
+		s.Contains(slack.Msg, "also covers 2 identical notification(s)")
+	})
+	_, recv = s.env.InternalSender.GetMessageSafe()
+	s.False(recv)
+
+	for i, id := range ids {
+		n, err := notification.Find(id)
+		s.Require().NoError(err)
+		s.Require().NotNil(n)
+		s.NotZero(n.SentAt)
+		if i == 0 {
+			s.Empty(n.SuppressedReason)
+			s.Equal(2, n.CollapsedCount)
+		} else {
+			s.Equal(notification.SuppressedCollapsed, n.SuppressedReason)
+		}
+	}
+
+	// identical notifications after the first was sent are deferred until
+	// its collapse window ends
+	lateIDs := []string{}
+	for _, eventID := range []string{"variant-4", "variant-5"} {
+		n, err := notification.New(eventID, "outcome", &s.slack.Subscriber, &notification.SlackPayload{Body: "Hi"})
+		s.Require().NoError(err)
+		n.SetCollapseKey("outcome-version-task-failed")
+		s.Require().NoError(notification.InsertMany(*n))
+		lateIDs = append(lateIDs, n.ID)
+
+		job := NewEventSendJob(n.ID, "").(*eventSendJob)
+		job.env = s.env
+		job.Run(s.ctx)
+		s.NoError(job.Error())
+	}
+
+	_, recv = s.env.InternalSender.GetMessageSafe()
+	s.False(recv)
+	n, err := notification.Find(ids[0])
+	s.Require().NoError(err)
+	s.Equal(2, n.CollapsedCount)
+	unprocessed, err := notification.FindUnprocessed()
+	s.Require().NoError(err)
+	s.Len(unprocessed, 2)
+
+	// once the window ends, a deferred notification is sent as a follow-up
+	// summarizing the other
+	_, err = db.UpdateAll(notification.Collection, bson.M{"_id": bson.M{"$in": ids}}, bson.M{
+		"$set": bson.M{"sent_at": time.Now().Add(-2 * time.Minute)},
+	})
+	s.Require().NoError(err)
+	job := NewEventSendJob(lateIDs[0], "").(*eventSendJob)
+	job.env = s.env
+	job.Run(s.ctx)
+	s.NoError(job.Error())
+
+	msg, recv = s.env.InternalSender.GetMessageSafe()
+	s.Require().True(recv)
+	s.NotPanics(func() {
+		slack := msg.Message.Raw().(*message.Slack)
+		s.Contains(slack.Msg, "also covers 1 identical notification(s)")
+	})
+	_, recv = s.env.InternalSender.GetMessageSafe()
+	s.False(recv)
+
+	n, err = notification.Find(lateIDs[0])
+	s.Require().NoError(err)
+	s.Empty(n.SuppressedReason)
+	s.Equal(1, n.CollapsedCount)
+	n, err = notification.Find(lateIDs[1])
+	s.Require().NoError(err)
+	s.NotZero(n.SentAt)
+	s.Equal(notification.SuppressedCollapsed, n.SuppressedReason)
+}
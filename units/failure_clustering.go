@@ -0,0 +1,245 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/annotations"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	failureClusteringJobName = "failure-clustering"
+
+	// maxFailureSignaturesPerTask limits how many clusters a single task's
+	// failure can be added to, so that a task with many failing tests does
+	// not flood its annotation.
+	maxFailureSignaturesPerTask = 10
+	// failureClusteringLogLines is how many lines of a test or task log are
+	// searched for the line that describes the failure.
+	failureClusteringLogLines = 200
+)
+
+var failureLinePattern = regexp.MustCompile(`(?i)\b(error|exception|fail(ed|ure)?|panic|assert(ion)?|fatal)\b`)
+
+func init() {
+	registry.AddJobType(failureClusteringJobName, func() amboy.Job { return makeFailureClusteringJob() })
+}
+
+type failureClusteringJob struct {
+	TaskID    string `bson:"task_id" json:"task_id" yaml:"task_id"`
+	Execution int    `bson:"execution" json:"execution" yaml:"execution"`
+	job.Base  `bson:"metadata" json:"metadata" yaml:"metadata"`
+
+	task *task.Task
+	env  evergreen.Environment
+}
+
+func makeFailureClusteringJob() *failureClusteringJob {
+	j := &failureClusteringJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    failureClusteringJobName,
+				Version: 0,
+			},
+		},
+	}
+	return j
+}
+
+// NewFailureClusteringJob extracts the normalized failure signatures of a
+// failed task execution, adds the task to the failure cluster for each
+// signature, and links the clusters to the task's annotation as suspected
+// issues.
+func NewFailureClusteringJob(taskID string, execution int) amboy.Job {
+	j := makeFailureClusteringJob()
+	j.TaskID = taskID
+	j.Execution = execution
+	j.SetID(fmt.Sprintf("%s.%s.%d", failureClusteringJobName, taskID, execution))
+	j.SetPriority(-2)
+	return j
+}
+
+func (j *failureClusteringJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+
+	flags, err := evergreen.GetServiceFlags()
+	if err != nil {
+		j.AddError(errors.Wrap(err, "retrieving service flags"))
+		return
+	}
+	if flags.FailureClusteringDisabled {
+		grip.Info(message.Fields{
+			"job_type": failureClusteringJobName,
+			"job_id":   j.ID(),
+			"task_id":  j.TaskID,
+			"message":  "failure clustering is disabled",
+		})
+		return
+	}
+
+	if j.task == nil {
+		j.task, err = task.FindOneIdAndExecution(j.TaskID, j.Execution)
+		if err != nil {
+			j.AddError(errors.Wrapf(err, "finding task '%s'", j.TaskID))
+			return
+		}
+	}
+	// The task was restarted before the job ran.
+	if j.task == nil {
+		j.task, err = task.FindOneOldByIdAndExecution(j.TaskID, j.Execution)
+		if err != nil {
+			j.AddError(errors.Wrapf(err, "finding old task '%s'", j.TaskID))
+			return
+		}
+	}
+	if j.task == nil {
+		j.AddError(errors.Errorf("task '%s' execution %d not found", j.TaskID, j.Execution))
+		return
+	}
+	if j.task.Status != evergreen.TaskFailed || !utility.StringSliceContains(evergreen.SystemVersionRequesterTypes, j.task.Requester) {
+		return
+	}
+
+	signatures, err := j.failureSignatures()
+	if err != nil {
+		j.AddError(errors.Wrapf(err, "getting failure signatures for task '%s'", j.TaskID))
+		return
+	}
+
+	occurrence := annotations.FailureOccurrence{
+		TaskId:        j.task.Id,
+		TaskExecution: j.task.Execution,
+		VersionId:     j.task.Version,
+		Time:          j.task.FinishTime,
+	}
+	if occurrence.Time.IsZero() {
+		occurrence.Time = time.Now()
+	}
+	for _, signature := range signatures {
+		cluster, err := annotations.AddFailureOccurrence(j.task.Project, signature, occurrence)
+		if err != nil {
+			j.AddError(err)
+			continue
+		}
+		url := fmt.Sprintf("%s/task/%s/%d", j.env.Settings().Ui.Url, cluster.FirstOccurrence.TaskId, cluster.FirstOccurrence.TaskExecution)
+		j.AddError(annotations.AddFailureClusterToAnnotation(j.task.Id, j.task.Execution, *cluster, url))
+	}
+}
+
+// failureSignatures returns the signature of each failing test in the task.
+// If no tests failed, it returns the signature of the task's own failure.
+func (j *failureClusteringJob) failureSignatures() ([]string, error) {
+	results, err := testresult.Find(testresult.FilterByTaskIDAndExecution(j.task.Id, j.task.Execution))
+	if err != nil {
+		return nil, errors.Wrap(err, "finding test results")
+	}
+
+	var signatures []string
+	seen := map[string]bool{}
+	for _, res := range results {
+		if res.Status != evergreen.TestFailedStatus {
+			continue
+		}
+		line := res.FailureMessage
+		if line == "" && res.LogID != "" {
+			testLog, err := model.FindOneTestLogById(res.LogID)
+			if err != nil {
+				return nil, errors.Wrapf(err, "finding log for test '%s'", res.TestFile)
+			}
+			if testLog != nil && res.LineNum < len(testLog.Lines) {
+				line = findFailureLine(testLog.Lines[res.LineNum:])
+			}
+		}
+
+		testName := res.DisplayTestName
+		if testName == "" {
+			testName = res.TestFile
+		}
+		signature := makeFailureSignature(testName, line)
+		if seen[signature] {
+			continue
+		}
+		seen[signature] = true
+		signatures = append(signatures, signature)
+		if len(signatures) == maxFailureSignaturesPerTask {
+			break
+		}
+	}
+	if len(signatures) > 0 {
+		return signatures, nil
+	}
+
+	// The most recent messages are returned first.
+	msgs, err := model.FindMostRecentLogMessages(j.task.Id, j.task.Execution, failureClusteringLogLines, []string{apimodels.LogErrorPrefix}, []string{apimodels.TaskLogPrefix})
+	if err != nil {
+		return nil, errors.Wrap(err, "finding task log messages")
+	}
+	lines := make([]string, 0, len(msgs))
+	for i := len(msgs) - 1; i >= 0; i-- {
+		lines = append(lines, msgs[i].Message)
+	}
+
+	command := j.task.Details.Description
+	if command == "" {
+		command = j.task.DisplayName
+	}
+	if j.task.Details.TimedOut {
+		command = fmt.Sprintf("%s (timed out)", command)
+	}
+	line := findFailureLine(lines)
+	if line == "" && j.task.Details.Description == "" && !j.task.Details.TimedOut {
+		// There is nothing about the failure to cluster on.
+		return nil, nil
+	}
+
+	return []string{makeFailureSignature(command, line)}, nil
+}
+
+// makeFailureSignature returns the signature of a failure in a test or
+// command, given the line describing the failure.
+func makeFailureSignature(name, line string) string {
+	line = annotations.NormalizeFailureSignature(line)
+	if line == "" {
+		return name
+	}
+	return fmt.Sprintf("%s: %s", name, line)
+}
+
+// findFailureLine returns the first line of the log that looks like an
+// error, or the last non-empty line if none does.
+func findFailureLine(lines []string) string {
+	if len(lines) > failureClusteringLogLines {
+		lines = lines[:failureClusteringLogLines]
+	}
+	var last string
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if failureLinePattern.MatchString(line) {
+			return line
+		}
+		last = line
+	}
+	return last
+}
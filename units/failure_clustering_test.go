@@ -0,0 +1,194 @@
+package units
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/mock"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/annotations"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindFailureLine(t *testing.T) {
+	assert.Equal(t, "Error: connection refused", findFailureLine([]string{
+		"starting test",
+		"  Error: connection refused  ",
+		"assertion failed",
+	}))
+	assert.Equal(t, "exit status 2", findFailureLine([]string{"running", "exit status 2", ""}))
+	assert.Empty(t, findFailureLine(nil))
+}
+
+func TestFailureClusteringJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	env := &mock.Environment{}
+	require.NoError(t, env.Configure(ctx))
+
+	collections := []string{task.Collection, task.OldCollection, testresult.Collection, model.TestLogCollection, annotations.Collection, annotations.FailureClusterCollection}
+	runJob := func(t *testing.T, tsk task.Task) {
+		j, ok := NewFailureClusteringJob(tsk.Id, tsk.Execution).(*failureClusteringJob)
+		require.True(t, ok)
+		j.env = env
+		j.Run(ctx)
+		require.NoError(t, j.Error())
+	}
+	failedTask := func(id, version string, finish time.Time) task.Task {
+		return task.Task{
+			Id:          id,
+			Project:     "project",
+			Version:     version,
+			DisplayName: "unit",
+			Requester:   evergreen.RepotrackerVersionRequester,
+			Status:      evergreen.TaskFailed,
+			FinishTime:  finish,
+			Details:     apimodels.TaskEndDetail{Status: evergreen.TaskFailed, Description: "shell.exec"},
+		}
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"ClustersTestFailuresAcrossVersions": func(t *testing.T) {
+			start := time.Now().Add(-time.Hour)
+			for i, id := range []string{"t1", "t2"} {
+				tsk := failedTask(id, "v"+id, start.Add(time.Duration(i)*time.Minute))
+				require.NoError(t, tsk.Insert())
+				res := testresult.TestResult{
+					TaskID:         tsk.Id,
+					TestFile:       "TestFoo",
+					Status:         evergreen.TestFailedStatus,
+					FailureMessage: "open /data/mci/" + id + "/src/config.yml: no such file at 2021-10-01T12:00:0" + id[1:] + "Z",
+				}
+				require.NoError(t, res.Insert())
+				passing := testresult.TestResult{TaskID: tsk.Id, TestFile: "TestBar", Status: evergreen.TestSucceededStatus}
+				require.NoError(t, passing.Insert())
+				runJob(t, tsk)
+			}
+
+			clusterID := annotations.FailureClusterId("project", "TestFoo: open config.yml: no such file at <time>")
+			clusters, err := annotations.FindFailureClustersByIds([]string{clusterID})
+			require.NoError(t, err)
+			require.Len(t, clusters, 1)
+			assert.Equal(t, 2, clusters[0].Size)
+			assert.Equal(t, "t1", clusters[0].FirstOccurrence.TaskId)
+			assert.Equal(t, "t2", clusters[0].LastOccurrence.TaskId)
+
+			for _, id := range []string{"t1", "t2"} {
+				annotation, err := annotations.FindOneByTaskIdAndExecution(id, 0)
+				require.NoError(t, err)
+				require.NotNil(t, annotation)
+				assert.Equal(t, []string{clusterID}, annotation.FailureClusterIds())
+				assert.Contains(t, annotation.SuspectedIssues[0].URL, "/task/t1/0")
+			}
+		},
+		"UsesTestLogWithoutFailureMessage": func(t *testing.T) {
+			tsk := failedTask("t1", "v1", time.Now())
+			require.NoError(t, tsk.Insert())
+			testLog := model.TestLog{
+				Id:    "log",
+				Name:  "TestFoo",
+				Task:  tsk.Id,
+				Lines: []string{"=== RUN TestFoo", "panic: runtime error at 0xc000123456", "exit status 2"},
+			}
+			require.NoError(t, testLog.Insert())
+			res := testresult.TestResult{TaskID: tsk.Id, TestFile: "TestFoo", Status: evergreen.TestFailedStatus, LogID: "log"}
+			require.NoError(t, res.Insert())
+			runJob(t, tsk)
+
+			clusters, err := annotations.FindFailureClustersByIds([]string{annotations.FailureClusterId("project", "TestFoo: panic: runtime error at <hex>")})
+			require.NoError(t, err)
+			assert.Len(t, clusters, 1)
+		},
+		"FallsBackToFailingCommand": func(t *testing.T) {
+			tsk := failedTask("t1", "v1", time.Now())
+			require.NoError(t, tsk.Insert())
+			runJob(t, tsk)
+
+			clusters, err := annotations.FindFailureClustersByIds([]string{annotations.FailureClusterId("project", "shell.exec")})
+			require.NoError(t, err)
+			require.Len(t, clusters, 1)
+			assert.Equal(t, 1, clusters[0].Size)
+		},
+		"RerunningJobDoesNotDuplicateSuspectedIssue": func(t *testing.T) {
+			tsk := failedTask("t1", "v1", time.Now())
+			require.NoError(t, tsk.Insert())
+			runJob(t, tsk)
+			j, ok := NewFailureClusteringJob(tsk.Id, tsk.Execution).(*failureClusteringJob)
+			require.True(t, ok)
+			j.env = env
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			annotation, err := annotations.FindOneByTaskIdAndExecution(tsk.Id, tsk.Execution)
+			require.NoError(t, err)
+			require.NotNil(t, annotation)
+			assert.Len(t, annotation.SuspectedIssues, 1)
+
+			clusters, err := annotations.FindFailureClustersByIds([]string{annotations.FailureClusterId("project", "shell.exec")})
+			require.NoError(t, err)
+			require.Len(t, clusters, 1)
+			assert.Equal(t, 1, clusters[0].Size)
+		},
+		"KeepsExistingSuspectedIssues": func(t *testing.T) {
+			tsk := failedTask("t1", "v1", time.Now())
+			require.NoError(t, tsk.Insert())
+			require.NoError(t, annotations.AddSuspectedIssueToAnnotation(tsk.Id, tsk.Execution, annotations.IssueLink{URL: "https://jira.example.com/browse/EVG-1", IssueKey: "EVG-1"}, "someone"))
+			runJob(t, tsk)
+
+			annotation, err := annotations.FindOneByTaskIdAndExecution(tsk.Id, tsk.Execution)
+			require.NoError(t, err)
+			require.NotNil(t, annotation)
+			assert.Len(t, annotation.SuspectedIssues, 2)
+			assert.Equal(t, []string{annotations.FailureClusterId("project", "shell.exec")}, annotation.FailureClusterIds())
+		},
+		"IgnoresPatchTasks": func(t *testing.T) {
+			tsk := failedTask("t1", "v1", time.Now())
+			tsk.Requester = evergreen.PatchVersionRequester
+			require.NoError(t, tsk.Insert())
+			runJob(t, tsk)
+
+			annotation, err := annotations.FindOneByTaskIdAndExecution(tsk.Id, tsk.Execution)
+			require.NoError(t, err)
+			assert.Nil(t, annotation)
+		},
+		"IgnoresTasksThatDidNotFail": func(t *testing.T) {
+			tsk := failedTask("t1", "v1", time.Now())
+			tsk.Status = evergreen.TaskSucceeded
+			require.NoError(t, tsk.Insert())
+			runJob(t, tsk)
+
+			annotation, err := annotations.FindOneByTaskIdAndExecution(tsk.Id, tsk.Execution)
+			require.NoError(t, err)
+			assert.Nil(t, annotation)
+		},
+		"NoopsIfDisabled": func(t *testing.T) {
+			require.NoError(t, evergreen.SetServiceFlags(evergreen.ServiceFlags{FailureClusteringDisabled: true}))
+			defer func() {
+				assert.NoError(t, evergreen.SetServiceFlags(evergreen.ServiceFlags{}))
+			}()
+			tsk := failedTask("t1", "v1", time.Now())
+			require.NoError(t, tsk.Insert())
+			runJob(t, tsk)
+
+			annotation, err := annotations.FindOneByTaskIdAndExecution(tsk.Id, tsk.Execution)
+			require.NoError(t, err)
+			assert.Nil(t, annotation)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(collections...))
+			defer func() {
+				assert.NoError(t, db.ClearCollections(collections...))
+			}()
+			tCase(t)
+		})
+	}
+}
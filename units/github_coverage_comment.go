@@ -0,0 +1,154 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/coverage"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/grip/sometimes"
+	"github.com/pkg/errors"
+)
+
+const githubCoverageCommentJobName = "github-coverage-comment"
+
+func init() {
+	registry.AddJobType(githubCoverageCommentJobName, func() amboy.Job { return makeGithubCoverageCommentJob() })
+}
+
+type githubCoverageCommentJob struct {
+	job.Base `bson:"job_base" json:"job_base" yaml:"job_base"`
+	env      evergreen.Environment
+
+	PatchID string `bson:"patch_id" json:"patch_id" yaml:"patch_id"`
+}
+
+func makeGithubCoverageCommentJob() *githubCoverageCommentJob {
+	j := &githubCoverageCommentJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    githubCoverageCommentJobName,
+				Version: 0,
+			},
+		},
+	}
+	return j
+}
+
+// NewGithubCoverageCommentJob creates a job that comments on a finished
+// patch's pull request with the change in coverage from the patch's base
+// commit. The job ID is unique per patch so that the pull request is
+// commented on at most once, even if the patch finishes again after a
+// restart.
+func NewGithubCoverageCommentJob(patchID string) amboy.Job {
+	j := makeGithubCoverageCommentJob()
+	j.PatchID = patchID
+	j.SetID(fmt.Sprintf("%s.%s", githubCoverageCommentJobName, patchID))
+	return j
+}
+
+func (j *githubCoverageCommentJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+
+	flags, err := evergreen.GetServiceFlags()
+	if err != nil {
+		j.AddError(errors.Wrap(err, "error retrieving admin settings"))
+		return
+	}
+	if flags.GithubStatusAPIDisabled {
+		grip.InfoWhen(sometimes.Percent(evergreen.DegradedLoggingPercent), message.Fields{
+			"job":     githubCoverageCommentJobName,
+			"message": "github API is disabled, not commenting coverage",
+		})
+		return
+	}
+
+	p, err := patch.FindOneId(j.PatchID)
+	if err != nil {
+		j.AddError(errors.Wrapf(err, "finding patch '%s'", j.PatchID))
+		return
+	}
+	if p == nil {
+		j.AddError(errors.Errorf("patch '%s' not found", j.PatchID))
+		return
+	}
+	if !p.IsGithubPRPatch() || !evergreen.IsFinishedPatchStatus(p.Status) {
+		return
+	}
+
+	j.AddError(j.commentCoverage(ctx, p))
+}
+
+// commentCoverage comments on the patch's pull request with the change in
+// coverage of each build variant from the patch's base commit. Patches that
+// did not report coverage are not commented on.
+func (j *githubCoverageCommentJob) commentCoverage(ctx context.Context, p *patch.Patch) error {
+	patchCoverage, err := coverage.FindVersionCoverage(p.Version)
+	if err != nil {
+		return errors.Wrapf(err, "finding coverage for patch '%s'", p.Id.Hex())
+	}
+	if len(patchCoverage) == 0 {
+		return nil
+	}
+
+	var baseCoverage []coverage.VersionCoverage
+	baseVersion, err := model.VersionFindOne(model.BaseVersionByProjectIdAndRevision(p.Project, p.Githash))
+	if err != nil {
+		return errors.Wrapf(err, "finding base version for patch '%s'", p.Id.Hex())
+	}
+	if baseVersion != nil {
+		baseCoverage, err = coverage.FindVersionCoverage(baseVersion.Id)
+		if err != nil {
+			return errors.Wrapf(err, "finding coverage for base version '%s'", baseVersion.Id)
+		}
+	}
+
+	settings := j.env.Settings()
+	ghToken, err := settings.GetGithubOauthToken()
+	if err != nil {
+		return errors.Wrap(err, "can't get Github OAuth token from configuration")
+	}
+
+	patchURL := fmt.Sprintf("%s/version/%s?redirect_spruce_users=true", settings.Ui.Url, url.PathEscape(p.Version))
+	comment := makeCoverageComment(patchURL, p.Githash, coverage.CompareVersions(patchCoverage, baseCoverage))
+
+	return errors.Wrap(thirdparty.PostCommentToPullRequest(ctx, ghToken, p.GithubPatchData.BaseOwner,
+		p.GithubPatchData.BaseRepo, p.GithubPatchData.PRNumber, comment), "posting coverage comment")
+}
+
+// makeCoverageComment returns a Markdown table of the coverage of each build
+// variant in a patch compared with its base commit.
+func makeCoverageComment(patchURL, baseRevision string, deltas []coverage.Delta) string {
+	formatCoverage := func(c *coverage.VersionCoverage) string {
+		if c == nil {
+			return "n/a"
+		}
+		return fmt.Sprintf("%.2f%% (%d/%d)", c.Percent(), c.LinesCovered, c.LinesTotal)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Coverage of the [Evergreen patch](%s) compared with base commit %s:\n\n", patchURL, baseRevision)
+	b.WriteString("| Build Variant | Coverage | Base | Change |\n")
+	b.WriteString("| --- | --- | --- | --- |\n")
+	for _, d := range deltas {
+		change := "n/a"
+		if percentChange, ok := d.PercentChange(); ok {
+			change = fmt.Sprintf("%+.2f%%", percentChange)
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", d.BuildVariant, formatCoverage(d.Coverage), formatCoverage(d.Base), change)
+	}
+	return b.String()
+}
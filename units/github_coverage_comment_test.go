@@ -0,0 +1,28 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/model/coverage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMakeCoverageComment(t *testing.T) {
+	deltas := coverage.CompareVersions([]coverage.VersionCoverage{
+		{BuildVariant: "ubuntu", Summary: coverage.Summary{LinesCovered: 812, LinesTotal: 1000}},
+		{BuildVariant: "windows", Summary: coverage.Summary{LinesCovered: 10, LinesTotal: 20}},
+	}, []coverage.VersionCoverage{
+		{BuildVariant: "ubuntu", Summary: coverage.Summary{LinesCovered: 800, LinesTotal: 1000}},
+	})
+
+	comment := makeCoverageComment("https://evergreen.example.com/version/p1", "abc123", deltas)
+	assert.Contains(t, comment, "[Evergreen patch](https://evergreen.example.com/version/p1)")
+	assert.Contains(t, comment, "base commit abc123")
+	assert.Contains(t, comment, "| ubuntu | 81.20% (812/1000) | 80.00% (800/1000) | +1.20% |")
+	assert.Contains(t, comment, "| windows | 50.00% (10/20) | n/a | n/a |")
+}
+
+func TestGithubCoverageCommentJobIDIsUniquePerPatch(t *testing.T) {
+	assert.Equal(t, NewGithubCoverageCommentJob("p1").ID(), NewGithubCoverageCommentJob("p1").ID())
+	assert.NotEqual(t, NewGithubCoverageCommentJob("p1").ID(), NewGithubCoverageCommentJob("p2").ID())
+}
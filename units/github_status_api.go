@@ -28,6 +28,7 @@ const (
 	githubUpdateTypePushToCommitQueue     = "commit-queue-push"
 	githubUpdateTypeDeleteFromCommitQueue = "commit-queue-delete"
 	githubUpdateTypeProcessingError       = "processing-error"
+	githubUpdateTypeSkippedPatch          = "skipped-patch"
 
 	evergreenContext = "evergreen"
 )
@@ -44,6 +45,8 @@ const (
 	EmptyConfig            = "config file was empty"
 	ProjectFailsValidation = "Project fails validation"
 	OtherErrors            = "Evergreen error"
+
+	NoAffectedTasks = "no tasks are affected by the changed files"
 )
 
 func init() {
@@ -141,6 +144,21 @@ func NewGithubStatusUpdateJobForProcessingError(githubContext, owner, repo, ref,
 	return job
 }
 
+// NewGithubStatusUpdateJobForSkippedPatch marks a ref as successful because
+// no patch was created for it, since none of its tasks are affected by the
+// files it changes.
+func NewGithubStatusUpdateJobForSkippedPatch(owner, repo, ref string) amboy.Job {
+	job := makeGithubStatusUpdateJob()
+	job.Owner = owner
+	job.Repo = repo
+	job.Ref = ref
+	job.UpdateType = githubUpdateTypeSkippedPatch
+
+	job.SetID(fmt.Sprintf("%s:%s-%s-%s-%s-%s", githubStatusUpdateJobName, job.UpdateType, owner, repo, ref, time.Now().String()))
+
+	return job
+}
+
 func (j *githubStatusUpdateJob) preamble() error {
 	if j.env == nil {
 		j.env = evergreen.GetEnvironment()
@@ -212,6 +230,10 @@ func (j *githubStatusUpdateJob) fetch() (*message.GithubStatus, error) {
 		status.Context = commitqueue.GithubContext
 		status.Description = "removed from queue"
 		status.State = message.GithubStateSuccess
+	} else if j.UpdateType == githubUpdateTypeSkippedPatch {
+		status.Context = evergreenContext
+		status.Description = NoAffectedTasks
+		status.State = message.GithubStateSuccess
 	}
 
 	if j.UpdateType == githubUpdateTypeRequestAuth || j.UpdateType == githubUpdateTypeNewPatch {
@@ -164,6 +164,25 @@ func (s *githubStatusUpdateSuite) TestForDeleteFromCommitQueue() {
 	s.Equal(message.GithubStateSuccess, status.State)
 }
 
+func (s *githubStatusUpdateSuite) TestForSkippedPatch() {
+	owner, repo, ref := "evergreen-ci", "evergreen", "776f608b5b12cd27b8d931c8ee4ca0c13f857299"
+	job, ok := NewGithubStatusUpdateJobForSkippedPatch(owner, repo, ref).(*githubStatusUpdateJob)
+	s.Require().True(ok)
+	s.Require().Equal(githubUpdateTypeSkippedPatch, job.UpdateType)
+	job.env = s.env
+	job.Run(context.Background())
+	s.False(job.HasErrors())
+
+	status := s.msgToStatus(s.env.InternalSender)
+
+	s.Equal(owner, status.Owner)
+	s.Equal(repo, status.Repo)
+	s.Equal(ref, status.Ref)
+	s.Equal(evergreenContext, status.Context)
+	s.Equal(NoAffectedTasks, status.Description)
+	s.Equal(message.GithubStateSuccess, status.State)
+}
+
 func (s *githubStatusUpdateSuite) TestForProcessingError() {
 	intent, err := patch.NewGithubIntent("1", "", testutil.NewGithubPR(448,
 		"evergreen-ci/evergreen", "tychoish/evergreen", "776f608b5b12cd27b8d931c8ee4ca0c13f857299", "tychoish", "Title"))
@@ -47,6 +47,9 @@ type patchIntentProcessor struct {
 	intent patch.Intent
 
 	gitHubError string
+	// skipped is set if the patch wasn't created because none of its tasks
+	// are affected by the files it changes.
+	skipped bool
 }
 
 // NewPatchIntentProcessor creates an amboy job to create a patch from the
@@ -128,7 +131,13 @@ func (j *patchIntentProcessor) Run(ctx context.Context) {
 
 	if j.IntentType == patch.GithubIntentType {
 		var update amboy.Job
-		if len(patchDoc.Version) == 0 {
+		if j.skipped {
+			update = NewGithubStatusUpdateJobForSkippedPatch(
+				patchDoc.GithubPatchData.BaseOwner,
+				patchDoc.GithubPatchData.BaseRepo,
+				patchDoc.GithubPatchData.HeadHash,
+			)
+		} else if len(patchDoc.Version) == 0 {
 			update = NewGithubStatusUpdateJobForExternalPatch(patchDoc.Id.Hex())
 
 		} else {
@@ -306,6 +315,21 @@ func (j *patchIntentProcessor) finishPatch(ctx context.Context, patchDoc *patch.
 		return errors.New("patch has no build variants or tasks")
 	}
 
+	// A PR patch that wouldn't run any tasks would never report a status, so
+	// it isn't created at all.
+	if patchDoc.IsGithubPRPatch() && !model.HasTasksAffectedByChangedFiles(project, patchDoc) {
+		grip.Info(message.Fields{
+			"message":   "skipping PR patch because none of its tasks are affected by its changed files",
+			"job":       j.ID(),
+			"patch_id":  j.PatchID,
+			"project":   patchDoc.Project,
+			"pr_number": patchDoc.GithubPatchData.PRNumber,
+			"source":    "patch intents",
+		})
+		j.skipped = true
+		return nil
+	}
+
 	if shouldTaskSync := len(patchDoc.SyncAtEndOpts.BuildVariants) != 0 || len(patchDoc.SyncAtEndOpts.Tasks) != 0; shouldTaskSync {
 		patchDoc.SyncAtEndOpts.VariantsTasks = patchDoc.ResolveSyncVariantTasks(project.GetAllVariantTasks())
 		// If the user requested task sync in their patch, it should match at least
@@ -412,6 +436,13 @@ func (j *patchIntentProcessor) finishPatch(ctx context.Context, patchDoc *patch.
 	}
 	event.LogPatchStateChangeEvent(patchDoc.Id.Hex(), patchDoc.Status)
 
+	if canFinalize && j.intent.ShouldFinalizePatch() && j.intent.ShouldWaitForStackedOn() {
+		canFinalize, err = waitForStackedOnPatch(patchDoc, j.intent.RequesterIdentity())
+		if err != nil {
+			return errors.Wrap(err, "can't wait for stacked on patch")
+		}
+	}
+
 	if canFinalize && j.intent.ShouldFinalizePatch() {
 		if _, err = model.FinalizePatch(ctx, patchDoc, j.intent.RequesterIdentity(), githubOauthToken); err != nil {
 			if strings.Contains(err.Error(), thirdparty.Github502Error) {
@@ -574,19 +605,24 @@ func (j *patchIntentProcessor) buildCliPatchDoc(ctx context.Context, patchDoc *p
 		return j.buildBackportPatchDoc(ctx, projectRef, patchDoc)
 	}
 
+	if patchDoc.IsStacked() {
+		if err = setStackedOnBase(patchDoc); err != nil {
+			return errors.Wrapf(err, "can't stack patch on '%s'", patchDoc.StackedOn)
+		}
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	commit, err := thirdparty.GetCommitEvent(ctx, githubOauthToken, projectRef.Owner,
-		projectRef.Repo, patchDoc.Githash)
+	commit, err := projectRef.GetCodeHost(githubOauthToken).GetCommit(ctx, patchDoc.Githash)
 	if err != nil {
 		return errors.Wrapf(err, "could not find base revision '%s' for project '%s'",
 			patchDoc.Githash, projectRef.Id)
 	}
 	// With `evergreen patch-file`, a user can pass a branch name or tag instead of a hash. We
 	// must normalize this to a hash before storing the patch doc.
-	if commit != nil && commit.SHA != nil && patchDoc.Githash != *commit.SHA {
-		patchDoc.Githash = *commit.SHA
+	if commit != nil && commit.Revision != "" && patchDoc.Githash != commit.Revision {
+		patchDoc.Githash = commit.Revision
 	}
 
 	if len(patchDoc.Patches) > 0 {
@@ -598,6 +634,90 @@ func (j *patchIntentProcessor) buildCliPatchDoc(ctx context.Context, patchDoc *p
 	return nil
 }
 
+// setStackedOnPR stacks a pull request patch on the latest patch for the pull
+// request it is stacked on. A pull request stacked on another one is usually
+// opened against the other one's branch, which no project tracks, so the
+// project falls back to the project of the stacked on pull request. The diff
+// of the pull request already contains the changes of the pull request it's
+// stacked on, since GitHub merges it into its base branch.
+func setStackedOnPR(patchDoc *patch.Patch, projectRef *model.ProjectRef) (*model.ProjectRef, error) {
+	parent, err := patch.FindLatestGithubPRPatch(patchDoc.GithubPatchData.BaseOwner,
+		patchDoc.GithubPatchData.BaseRepo, patchDoc.GithubPatchData.StackedOnPR)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't find patch for stacked on pull request")
+	}
+	if parent == nil {
+		return projectRef, nil
+	}
+	if projectRef == nil {
+		projectRef, err = model.FindMergedProjectRef(parent.Project, "", true)
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't find project '%s' of stacked on pull request", parent.Project)
+		}
+		if projectRef == nil || !projectRef.IsPRTestingEnabled() {
+			return nil, nil
+		}
+	}
+	if parent.Project == projectRef.Id {
+		patchDoc.StackedOn = parent.Id.Hex()
+	}
+	return projectRef, nil
+}
+
+// setStackedOnBase checks that the patch can be stacked on the patch it names
+// and bases it on the same revision, since its diff is only meaningful on top
+// of the stacked on patch's changes.
+func setStackedOnBase(patchDoc *patch.Patch) error {
+	parent, err := patch.FindOneId(patchDoc.StackedOn)
+	if err != nil {
+		return errors.Wrap(err, "can't find stacked on patch")
+	}
+	if parent == nil {
+		return errors.New("stacked on patch not found")
+	}
+	if parent.Project != patchDoc.Project {
+		return errors.Errorf("stacked on patch is for project '%s', not '%s'", parent.Project, patchDoc.Project)
+	}
+	if parent.IsGithubPRPatch() || parent.IsCommitQueuePatch() {
+		return errors.New("can't stack on a pull request or commit queue patch")
+	}
+	if _, err = patchDoc.GetStackAncestors(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	patchDoc.Githash = parent.Githash
+	for i, modulePatch := range patchDoc.Patches {
+		if modulePatch.ModuleName == "" {
+			patchDoc.Patches[i].Githash = parent.Githash
+		}
+	}
+	return nil
+}
+
+// waitForStackedOnPatch subscribes the patch to be finalized once the patch it
+// is stacked on succeeds. It returns whether the patch can be finalized right
+// away because the stacked on patch has already succeeded. If the stacked on
+// patch already failed, the patch is marked failed, since it will never be
+// finalized.
+func waitForStackedOnPatch(patchDoc *patch.Patch, requester string) (bool, error) {
+	parent, err := patch.FindOneId(patchDoc.StackedOn)
+	if err != nil {
+		return false, errors.Wrap(err, "can't find stacked on patch")
+	}
+	if parent == nil {
+		return false, errors.Errorf("stacked on patch '%s' not found", patchDoc.StackedOn)
+	}
+
+	switch parent.Status {
+	case evergreen.PatchSucceeded:
+		return true, nil
+	case evergreen.PatchFailed:
+		return false, errors.Wrapf(model.FailStackedPatch(patchDoc), "failing patch stacked on failed patch '%s'", parent.Id.Hex())
+	default:
+		return false, model.SubscribeOnParentOutcome(evergreen.PatchSucceeded, patchDoc.Id.Hex(), parent, requester)
+	}
+}
+
 // getModulePatch reads the patch from GridFS, processes it, and
 // stores the resulting summaries in the returned ModulePatch
 func getModulePatch(modulePatch patch.ModulePatch) (patch.ModulePatch, error) {
@@ -701,6 +821,12 @@ func (j *patchIntentProcessor) buildGithubPatchDoc(ctx context.Context, patchDoc
 			patchDoc.GithubPatchData.BaseOwner, patchDoc.GithubPatchData.BaseRepo,
 			patchDoc.GithubPatchData.BaseBranch)
 	}
+	if patchDoc.GithubPatchData.StackedOnPR != 0 {
+		projectRef, err = setStackedOnPR(patchDoc, projectRef)
+		if err != nil {
+			return false, errors.Wrapf(err, "can't stack pull request on #%d", patchDoc.GithubPatchData.StackedOnPR)
+		}
+	}
 	if projectRef == nil {
 		return false, errors.Errorf("Could not find project ref for repo '%s/%s' with branch '%s'",
 			patchDoc.GithubPatchData.BaseOwner, patchDoc.GithubPatchData.BaseRepo,
@@ -596,6 +596,89 @@ index ca20f6c..224168e 100644
 	s.NoError(err)
 }
 
+func (s *PatchIntentUnitsSuite) TestSetStackedOnBase() {
+	parent := &patch.Patch{
+		Id:      mgobson.NewObjectId(),
+		Project: s.project,
+		Githash: "parent-hash",
+	}
+	s.Require().NoError(parent.Insert())
+
+	patchDoc := &patch.Patch{
+		Id:        mgobson.NewObjectId(),
+		Project:   s.project,
+		Githash:   "base-hash",
+		StackedOn: parent.Id.Hex(),
+		Patches: []patch.ModulePatch{
+			{Githash: "base-hash"},
+			{ModuleName: "module", Githash: "module-hash"},
+		},
+	}
+	s.NoError(setStackedOnBase(patchDoc))
+	s.Equal(parent.Githash, patchDoc.Githash)
+	s.Equal(parent.Githash, patchDoc.Patches[0].Githash)
+	s.Equal("module-hash", patchDoc.Patches[1].Githash)
+
+	otherProject := &patch.Patch{
+		Id:      mgobson.NewObjectId(),
+		Project: "other",
+	}
+	s.Require().NoError(otherProject.Insert())
+	patchDoc.StackedOn = otherProject.Id.Hex()
+	s.Error(setStackedOnBase(patchDoc))
+
+	commitQueuePatch := &patch.Patch{
+		Id:      mgobson.NewObjectId(),
+		Project: s.project,
+		Alias:   evergreen.CommitQueueAlias,
+	}
+	s.Require().NoError(commitQueuePatch.Insert())
+	patchDoc.StackedOn = commitQueuePatch.Id.Hex()
+	s.Error(setStackedOnBase(patchDoc))
+
+	patchDoc.StackedOn = mgobson.NewObjectId().Hex()
+	s.Error(setStackedOnBase(patchDoc))
+}
+
+func (s *PatchIntentUnitsSuite) TestWaitForStackedOnPatch() {
+	parent := &patch.Patch{
+		Id:      mgobson.NewObjectId(),
+		Project: s.project,
+		Status:  evergreen.PatchStarted,
+	}
+	s.Require().NoError(parent.Insert())
+	patchDoc := &patch.Patch{
+		Id:        mgobson.NewObjectId(),
+		Project:   s.project,
+		Status:    evergreen.PatchCreated,
+		StackedOn: parent.Id.Hex(),
+	}
+	s.Require().NoError(patchDoc.Insert())
+
+	canFinalize, err := waitForStackedOnPatch(patchDoc, evergreen.PatchVersionRequester)
+	s.NoError(err)
+	s.False(canFinalize)
+	dbPatch, err := patch.FindOneId(patchDoc.Id.Hex())
+	s.Require().NoError(err)
+	s.Require().NotNil(dbPatch)
+	s.Equal(evergreen.PatchCreated, dbPatch.Status)
+
+	s.Require().NoError(parent.UpdateStatus(evergreen.PatchSucceeded))
+	canFinalize, err = waitForStackedOnPatch(patchDoc, evergreen.PatchVersionRequester)
+	s.NoError(err)
+	s.True(canFinalize)
+
+	s.Require().NoError(parent.UpdateStatus(evergreen.PatchFailed))
+	canFinalize, err = waitForStackedOnPatch(patchDoc, evergreen.PatchVersionRequester)
+	s.NoError(err)
+	s.False(canFinalize)
+	dbPatch, err = patch.FindOneId(patchDoc.Id.Hex())
+	s.Require().NoError(err)
+	s.Require().NotNil(dbPatch)
+	s.Equal(evergreen.PatchFailed, dbPatch.Status)
+	s.False(dbPatch.FinishTime.IsZero())
+}
+
 func (s *PatchIntentUnitsSuite) TestCliBackport() {
 	sourcePatch := &patch.Patch{
 		Id:      mgobson.NewObjectId(),
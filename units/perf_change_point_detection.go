@@ -0,0 +1,205 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/perf"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	perfChangePointDetectionJobName = "perf-change-point-detection"
+
+	// perfChangePointDetectionBatchSize is the maximum number of new results
+	// analyzed by a single job.
+	perfChangePointDetectionBatchSize = 1000
+	// perfSeriesWindow is the number of the latest mainline versions in a
+	// series that change-point detection runs over.
+	perfSeriesWindow = 100
+)
+
+func init() {
+	registry.AddJobType(perfChangePointDetectionJobName, func() amboy.Job { return makePerfChangePointDetectionJob() })
+}
+
+type perfChangePointDetectionJob struct {
+	ProjectID string `bson:"project_id" json:"project_id" yaml:"project_id"`
+	job.Base  `bson:"metadata" json:"metadata" yaml:"metadata"`
+}
+
+func makePerfChangePointDetectionJob() *perfChangePointDetectionJob {
+	j := &perfChangePointDetectionJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    perfChangePointDetectionJobName,
+				Version: 0,
+			},
+		},
+	}
+	return j
+}
+
+// NewPerfChangePointDetectionJob runs change-point detection over the
+// project's performance series that have new mainline results and records the
+// regressions it finds.
+func NewPerfChangePointDetectionJob(projectID string, ts time.Time) amboy.Job {
+	j := makePerfChangePointDetectionJob()
+	j.ProjectID = projectID
+	j.SetID(fmt.Sprintf("%s.%s.%s", perfChangePointDetectionJobName, projectID, ts.Format(TSFormat)))
+	j.UpdateTimeInfo(amboy.JobTimeInfo{MaxTime: 30 * time.Minute})
+	return j
+}
+
+func (j *perfChangePointDetectionJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+
+	flags, err := evergreen.GetServiceFlags()
+	if err != nil {
+		j.AddError(errors.Wrap(err, "retrieving service flags"))
+		return
+	}
+	if flags.PerfAnalysisDisabled {
+		grip.Info(message.Fields{
+			"job_type": perfChangePointDetectionJobName,
+			"job_id":   j.ID(),
+			"project":  j.ProjectID,
+			"message":  "perf analysis is disabled",
+		})
+		return
+	}
+
+	results, err := perf.FindUnanalyzedResults(j.ProjectID, perfChangePointDetectionBatchSize)
+	if err != nil {
+		j.AddError(err)
+		return
+	}
+	if len(results) == 0 {
+		return
+	}
+	unanalyzed := make(map[string]bool, len(results))
+	for _, r := range results {
+		unanalyzed[r.ID] = true
+	}
+
+	series := perf.SeriesFromResults(results)
+	var numRegressions int
+	failed := map[string]bool{}
+	for _, s := range series {
+		if ctx.Err() != nil {
+			j.AddError(ctx.Err())
+			return
+		}
+		n, err := j.analyzeSeries(s, unanalyzed)
+		if err != nil {
+			j.AddError(errors.Wrapf(err, "analyzing series '%s'", s.String()))
+			for _, r := range results {
+				if r.InSeries(s) {
+					failed[r.ID] = true
+				}
+			}
+			continue
+		}
+		numRegressions += n
+	}
+
+	// The results are only marked as analyzed once all of their series have
+	// been analyzed, so that a series that fails is retried by the next job
+	// until it has failed too many times. Regressions that were already found
+	// are not recorded again.
+	var analyzedIDs, failedIDs []string
+	for _, r := range results {
+		if failed[r.ID] {
+			failedIDs = append(failedIDs, r.ID)
+		} else {
+			analyzedIDs = append(analyzedIDs, r.ID)
+		}
+	}
+	j.AddError(perf.MarkResultsAnalyzed(analyzedIDs))
+	j.AddError(perf.RecordFailedAnalysis(failedIDs))
+
+	grip.Info(message.Fields{
+		"job_type":        perfChangePointDetectionJobName,
+		"job_id":          j.ID(),
+		"project":         j.ProjectID,
+		"num_results":     len(results),
+		"num_failed":      len(failedIDs),
+		"num_series":      len(series),
+		"num_regressions": numRegressions,
+		"message":         "analyzed perf results",
+	})
+}
+
+// analyzeSeries detects the change points in the series and records the ones
+// that the new results revealed as regressions. It returns the number of new
+// regressions.
+func (j *perfChangePointDetectionJob) analyzeSeries(series perf.SeriesID, unanalyzed map[string]bool) (int, error) {
+	points, err := perf.FindSeries(series, perfSeriesWindow)
+	if err != nil {
+		return 0, err
+	}
+	firstNew := len(points)
+	values := make([]float64, 0, len(points))
+	for i, p := range points {
+		if unanalyzed[p.ResultID] && i < firstNew {
+			firstNew = i
+		}
+		values = append(values, p.Value)
+	}
+
+	opts := perf.DefaultDetectionOptions
+	var numRegressions int
+	for _, cp := range perf.DetectChangePoints(values, opts) {
+		// A change point whose following values are all old would have been
+		// detectable by an earlier job.
+		if cp.Index+opts.MinSegment <= firstNew {
+			continue
+		}
+
+		// As a series grows, the same change may be detected at a nearby
+		// version, so it's only recorded if there isn't a regression close
+		// to it already.
+		lo := cp.Index - opts.MinSegment
+		if lo < 0 {
+			lo = 0
+		}
+		hi := util.Min(cp.Index+opts.MinSegment-1, len(points)-1)
+		count, err := perf.CountRegressionsInRange(series, points[lo].RevisionOrderNumber, points[hi].RevisionOrderNumber)
+		if err != nil {
+			return numRegressions, errors.Wrap(err, "counting nearby regressions")
+		}
+		if count > 0 {
+			continue
+		}
+
+		r := perf.NewRegression(series, points, cp)
+		inserted, err := r.Insert()
+		if err != nil {
+			return numRegressions, err
+		}
+		if !inserted {
+			continue
+		}
+		numRegressions++
+		event.LogPerfRegressionDetected(r.ID, event.PerfRegressionEventData{
+			Project:       r.Project,
+			Variant:       r.Variant,
+			TaskName:      r.TaskName,
+			TestName:      r.TestName,
+			Metric:        r.Metric,
+			Version:       r.Version,
+			PercentChange: r.PercentChange,
+		})
+	}
+
+	return numRegressions, nil
+}
@@ -0,0 +1,82 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/perf"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPerfChangePointDetectionJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	collections := []string{perf.Collection, perf.RegressionCollection, event.AllLogCollection}
+	require.NoError(t, db.ClearCollections(collections...))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(collections...))
+	}()
+
+	addResults := func(t *testing.T, order int, value float64) {
+		tsk := &task.Task{
+			Id:                  fmt.Sprintf("t%d", order),
+			Project:             "project",
+			Version:             fmt.Sprintf("v%d", order),
+			BuildVariant:        "ubuntu",
+			DisplayName:         "bench",
+			Requester:           evergreen.RepotrackerVersionRequester,
+			RevisionOrderNumber: order,
+		}
+		require.NoError(t, perf.InsertResults(perf.NewResults(tsk, []perf.TestResult{
+			{TestName: "insert", Metrics: []perf.Metric{{Name: "latency", Value: value}, {Name: "throughput", Value: 100}}},
+		})))
+	}
+	runJob := func(t *testing.T) {
+		j := NewPerfChangePointDetectionJob("project", time.Now())
+		j.Run(ctx)
+		require.NoError(t, j.Error())
+	}
+	findRegressions := func(t *testing.T) []perf.Regression {
+		regressions, err := perf.FindRegressions(perf.RegressionOptions{Project: "project"})
+		require.NoError(t, err)
+		return regressions
+	}
+
+	for order, value := range []float64{10, 11, 10, 9, 10} {
+		addResults(t, order+1, value)
+	}
+	runJob(t)
+	assert.Empty(t, findRegressions(t))
+	unanalyzed, err := perf.FindUnanalyzedResults("project", 10)
+	require.NoError(t, err)
+	assert.Empty(t, unanalyzed)
+
+	for order, value := range []float64{20, 21, 20} {
+		addResults(t, order+6, value)
+	}
+	runJob(t)
+	regressions := findRegressions(t)
+	require.Len(t, regressions, 1)
+	assert.Equal(t, "latency", regressions[0].Metric)
+	assert.Equal(t, "v6", regressions[0].Version)
+	assert.Equal(t, perf.RegressionStatusUntriaged, regressions[0].Status)
+
+	events, err := event.Find(event.AllLogCollection, db.Query(event.ResourceTypeKeyIs(event.ResourceTypePerfRegression)))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, regressions[0].ID, events[0].ResourceId)
+
+	// New results that don't change the series don't record the regression
+	// again.
+	addResults(t, 9, 21)
+	runJob(t)
+	assert.Len(t, findRegressions(t), 1)
+}
@@ -0,0 +1,118 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/grip/sometimes"
+	"github.com/pkg/errors"
+)
+
+const (
+	persistentFailureCheckName = "persistent-failure-check"
+
+	// persistentFailureLookback is how recently a mainline task must have
+	// last run for its failure to be checked.
+	persistentFailureLookback = 7 * 24 * time.Hour
+)
+
+func init() {
+	registry.AddJobType(persistentFailureCheckName,
+		func() amboy.Job { return makePersistentFailureCheckJob() })
+}
+
+type persistentFailureCheckJob struct {
+	job.Base `bson:"job_base" json:"job_base" yaml:"job_base"`
+}
+
+func makePersistentFailureCheckJob() *persistentFailureCheckJob {
+	j := &persistentFailureCheckJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    persistentFailureCheckName,
+				Version: 0,
+			},
+		},
+	}
+	return j
+}
+
+// NewPersistentFailureCheckJob returns a job that logs an event for each
+// mainline task that is still failing in a project with a persistent failure
+// subscription that escalates after a number of hours, so that the failure
+// escalates even if the task doesn't run again.
+func NewPersistentFailureCheckJob(id string) amboy.Job {
+	j := makePersistentFailureCheckJob()
+	j.SetID(fmt.Sprintf("%s.%s", persistentFailureCheckName, id))
+	j.SetScopes([]string{persistentFailureCheckName})
+	j.SetEnqueueAllScopes(true)
+	return j
+}
+
+func (j *persistentFailureCheckJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+
+	flags, err := evergreen.GetServiceFlags()
+	if err != nil {
+		j.AddError(errors.Wrap(err, "error retrieving admin settings"))
+		return
+	}
+	if flags.AlertsDisabled {
+		grip.InfoWhen(sometimes.Percent(evergreen.DegradedLoggingPercent), message.Fields{
+			"runner":  "alerter",
+			"id":      j.ID(),
+			"message": "alerts are disabled, exiting",
+		})
+		return
+	}
+
+	subs, err := event.FindTimedPersistentFailureSubscriptions()
+	if err != nil {
+		j.AddError(err)
+		return
+	}
+	projectIDs := []string{}
+	for _, sub := range subs {
+		projectID := persistentFailureSubscriptionProject(sub)
+		if projectID != "" && !utility.StringSliceContains(projectIDs, projectID) {
+			projectIDs = append(projectIDs, projectID)
+		}
+	}
+
+	failures, err := task.FindLatestMainlineFailures(projectIDs, time.Now().Add(-persistentFailureLookback))
+	if err != nil {
+		j.AddError(err)
+		return
+	}
+	for _, t := range failures {
+		if ctx.Err() != nil {
+			j.AddError(errors.New("persistent failure check canceled"))
+			return
+		}
+		event.LogTaskStillFailing(t.Id, t.Execution)
+	}
+}
+
+// persistentFailureSubscriptionProject returns the project whose tasks the
+// subscription applies to.
+func persistentFailureSubscriptionProject(sub event.Subscription) string {
+	for _, selector := range sub.Selectors {
+		if selector.Type == event.SelectorProject {
+			return selector.Data
+		}
+	}
+	if sub.OwnerType == event.OwnerTypeProject {
+		return sub.Owner
+	}
+	return ""
+}
@@ -0,0 +1,67 @@
+package units
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersistentFailureCheck(t *testing.T) {
+	require.NoError(t, db.ClearCollections(task.Collection, event.SubscriptionsCollection, event.AllLogCollection))
+
+	sub := event.Subscription{
+		ID:           "timed",
+		ResourceType: event.ResourceTypeTask,
+		Trigger:      event.TriggerPersistentFailure,
+		Selectors: []event.Selector{
+			{Type: event.SelectorProject, Data: "p0"},
+		},
+		Subscriber: event.Subscriber{
+			Type:   event.EmailSubscriberType,
+			Target: "admins@example.com",
+		},
+		Owner:     "p0",
+		OwnerType: event.OwnerTypeProject,
+		TriggerData: map[string]string{
+			event.PersistentFailureHoursKey: "1",
+		},
+	}
+	require.NoError(t, sub.Upsert())
+
+	now := time.Now()
+	tasks := []task.Task{
+		// still failing
+		{Id: "t0", Project: "p0", BuildVariant: "bv", DisplayName: "compile", RevisionOrderNumber: 1, Status: evergreen.TaskFailed, FinishTime: now.Add(-3 * time.Hour)},
+		{Id: "t1", Project: "p0", BuildVariant: "bv", DisplayName: "compile", RevisionOrderNumber: 2, Status: evergreen.TaskFailed, FinishTime: now.Add(-2 * time.Hour)},
+		// passing again
+		{Id: "t2", Project: "p0", BuildVariant: "bv", DisplayName: "test", RevisionOrderNumber: 1, Status: evergreen.TaskFailed, FinishTime: now.Add(-3 * time.Hour)},
+		{Id: "t3", Project: "p0", BuildVariant: "bv", DisplayName: "test", RevisionOrderNumber: 2, Status: evergreen.TaskSucceeded, FinishTime: now.Add(-2 * time.Hour)},
+		// not run recently
+		{Id: "t4", Project: "p0", BuildVariant: "bv", DisplayName: "lint", RevisionOrderNumber: 1, Status: evergreen.TaskFailed, FinishTime: now.Add(-2 * persistentFailureLookback)},
+		// no timed subscription for the project
+		{Id: "t5", Project: "p1", BuildVariant: "bv", DisplayName: "compile", RevisionOrderNumber: 1, Status: evergreen.TaskFailed, FinishTime: now.Add(-2 * time.Hour)},
+	}
+	for _, tsk := range tasks {
+		tsk.Requester = evergreen.RepotrackerVersionRequester
+		require.NoError(t, tsk.Insert())
+	}
+	patchFailure := task.Task{Id: "t6", Project: "p0", BuildVariant: "bv", DisplayName: "test", RevisionOrderNumber: 3, Status: evergreen.TaskFailed, FinishTime: now.Add(-time.Hour), Requester: evergreen.PatchVersionRequester}
+	require.NoError(t, patchFailure.Insert())
+
+	j := makePersistentFailureCheckJob()
+	j.Run(context.Background())
+	require.NoError(t, j.Error())
+
+	events, err := event.FindUnprocessedEvents(evergreen.DefaultEventProcessingLimit)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "t1", events[0].ResourceId)
+	assert.Equal(t, event.TaskStillFailing, events[0].EventType)
+}
@@ -90,6 +90,14 @@ func (j *notificationsStatsCollector) Run(ctx context.Context) {
 
 	msg["pending_notifications_by_type"] = stats
 
+	suppressedStats, err := notification.CollectSuppressedNotificationStats(time.Now().Add(-notification.SuppressedStatsWindow))
+	j.AddError(errors.Wrap(err, "failed to collect suppressed notification stats"))
+	if j.HasErrors() {
+		return
+	}
+
+	msg["suppressed_notifications_by_reason"] = suppressedStats
+
 	if ctx.Err() == nil {
 		j.logger.Info(msg)
 	}
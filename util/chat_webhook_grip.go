@@ -0,0 +1,118 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/grip/send"
+	"github.com/pkg/errors"
+)
+
+const chatWebhookTimeout = 10 * time.Second
+
+// ChatWebhook is a JSON message to be posted to a chat service's incoming
+// webhook, such as a Microsoft Teams channel connector. Unlike
+// EvergreenWebhook, the request is not signed, since chat services do not
+// verify signatures on incoming webhooks.
+type ChatWebhook struct {
+	URL  string `bson:"url"`
+	Body []byte `bson:"body"`
+}
+
+type chatWebhookMessage struct {
+	raw ChatWebhook
+
+	message.Base
+}
+
+func NewChatWebhookMessage(raw ChatWebhook) message.Composer {
+	return &chatWebhookMessage{
+		raw: raw,
+	}
+}
+
+func (w *chatWebhookMessage) Loggable() bool {
+	if len(w.raw.URL) == 0 || len(w.raw.Body) == 0 {
+		return false
+	}
+
+	u, err := url.Parse(w.raw.URL)
+	if err != nil {
+		return false
+	}
+
+	return u.Scheme == "http" || u.Scheme == "https"
+}
+
+func (w *chatWebhookMessage) Raw() interface{} {
+	return &w.raw
+}
+
+func (w *chatWebhookMessage) String() string {
+	return string(w.raw.Body)
+}
+
+type chatWebhookLogger struct {
+	client *http.Client
+	*send.Base
+}
+
+func NewChatWebhookLogger() (send.Sender, error) {
+	s := &chatWebhookLogger{
+		Base: send.NewBase("evergreen"),
+	}
+
+	return s, nil
+}
+
+func (w *chatWebhookLogger) Send(m message.Composer) {
+	if w.Level().ShouldLog(m) {
+		if err := w.send(m); err != nil {
+			w.ErrorHandler()(err, m)
+		}
+	}
+}
+
+func (w *chatWebhookLogger) send(m message.Composer) error {
+	raw, ok := m.Raw().(*ChatWebhook)
+	if !ok {
+		return errors.New("chat-webhook sender received unexpected composer")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, raw.URL, bytes.NewReader(raw.Body))
+	if err != nil {
+		return errors.Wrap(err, "chat-webhook failed to create http request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	ctx, cancel := context.WithTimeout(req.Context(), chatWebhookTimeout)
+	defer cancel()
+
+	req = req.WithContext(ctx)
+
+	var client *http.Client = w.client
+	if client == nil {
+		client = utility.GetHTTPClient()
+		defer utility.PutHTTPClient(client)
+	}
+
+	resp, err := client.Do(req)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return errors.Wrap(err, "chat-webhook failed to send webhook data")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("chat-webhook response status was %d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+
+	return nil
+}
+
+func (w *chatWebhookLogger) Flush(_ context.Context) error { return nil }
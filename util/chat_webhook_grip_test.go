@@ -0,0 +1,83 @@
+package util
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/mongodb/grip/message"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChatWebhookComposer(t *testing.T) {
+	assert := assert.New(t)
+
+	m := NewChatWebhookMessage(ChatWebhook{})
+	assert.False(m.Loggable())
+
+	m = NewChatWebhookMessage(ChatWebhook{URL: "ftp://example.com", Body: []byte(`{"text": "hi"}`)})
+	assert.False(m.Loggable())
+
+	m = NewChatWebhookMessage(ChatWebhook{URL: "https://example.com/webhook", Body: []byte{}})
+	assert.False(m.Loggable())
+
+	m = NewChatWebhookMessage(ChatWebhook{URL: "https://example.com/webhook", Body: []byte(`{"text": "hi"}`)})
+	assert.True(m.Loggable())
+	assert.Equal(`{"text": "hi"}`, m.String())
+	raw, ok := m.Raw().(*ChatWebhook)
+	assert.True(ok)
+	assert.Equal("https://example.com/webhook", raw.URL)
+}
+
+func TestChatWebhookSender(t *testing.T) {
+	assert := assert.New(t)
+
+	sender, err := NewChatWebhookLogger()
+	assert.NoError(err)
+	assert.NotNil(sender)
+
+	s, ok := sender.(*chatWebhookLogger)
+	assert.True(ok)
+	transport := &mockChatWebhookTransport{status: http.StatusOK}
+	s.client = &http.Client{Transport: transport}
+
+	assert.NoError(s.SetErrorHandler(func(err error, _ message.Composer) {
+		t.Error("error handler was called, but shouldn't have been")
+		t.FailNow()
+	}))
+	s.Send(NewChatWebhookMessage(ChatWebhook{URL: "https://example.com/webhook", Body: []byte(`{"text": "hi"}`)}))
+	assert.Equal("https://example.com/webhook", transport.lastURL)
+	assert.Equal(`{"text": "hi"}`, string(transport.lastBody))
+	assert.Equal("application/json", transport.lastHeader.Get("Content-Type"))
+
+	transport.status = http.StatusBadRequest
+	channel := make(chan error, 1)
+	assert.NoError(s.SetErrorHandler(func(err error, _ message.Composer) {
+		channel <- err
+	}))
+	s.Send(NewChatWebhookMessage(ChatWebhook{URL: "https://example.com/webhook", Body: []byte(`{"text": "hi"}`)}))
+	assert.EqualError(<-channel, "chat-webhook response status was 400 Bad Request")
+}
+
+type mockChatWebhookTransport struct {
+	status     int
+	lastURL    string
+	lastBody   []byte
+	lastHeader http.Header
+}
+
+func (t *mockChatWebhookTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.lastURL = req.URL.String()
+	t.lastHeader = req.Header
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	t.lastBody = body
+
+	return &http.Response{
+		StatusCode: t.status,
+		Body:       ioutil.NopCloser(bytes.NewBuffer(nil)),
+	}, nil
+}
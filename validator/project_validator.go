@@ -137,6 +137,7 @@ var projectWarningValidators = []projectValidator{
 
 var projectSettingsValidators = []projectSettingsValidator{
 	validateTaskSyncSettings,
+	validateNotifications,
 }
 
 // These validators have the potential to be very long, and may not be fully run unless specified.
@@ -417,6 +418,50 @@ func validateAliases(p *model.Project) ValidationErrors {
 	return validationErrs
 }
 
+// validateNotifications ensures that each notification declared in the
+// project can be converted into a valid subscription. The subscriptions are
+// built for the project ref's ID, which is the owner that the repotracker
+// reconciles them for.
+func validateNotifications(p *model.Project, ref *model.ProjectRef) ValidationErrors {
+	errs := ValidationErrors{}
+	subIDs := map[string]bool{}
+	for i, notification := range p.Notifications {
+		name := fmt.Sprintf("notification %d (%s/%s)", i, notification.ResourceType, notification.Trigger)
+		if !utility.StringSliceContains(model.ProjectNotificationResourceTypes, notification.ResourceType) {
+			errs = append(errs, ValidationError{
+				Level: Error,
+				Message: fmt.Sprintf("%s has invalid resource type '%s', must be one of: %s",
+					name, notification.ResourceType, strings.Join(model.ProjectNotificationResourceTypes, ", ")),
+			})
+			continue
+		}
+		sub, err := notification.ToSubscription(ref.Id)
+		if err != nil {
+			errs = append(errs, ValidationError{
+				Level:   Error,
+				Message: fmt.Sprintf("%s is invalid: %s", name, err.Error()),
+			})
+			continue
+		}
+		if err = sub.Validate(); err != nil {
+			errs = append(errs, ValidationError{
+				Level:   Error,
+				Message: fmt.Sprintf("%s is invalid: %s", name, err.Error()),
+			})
+			continue
+		}
+		if subIDs[sub.ID] {
+			errs = append(errs, ValidationError{
+				Level:   Error,
+				Message: fmt.Sprintf("%s is declared more than once", name),
+			})
+		}
+		subIDs[sub.ID] = true
+	}
+
+	return errs
+}
+
 // Ensures that the project has at least one buildvariant and also that all the
 // fields required for any buildvariant definition are present
 func validateBVFields(project *model.Project) ValidationErrors {
@@ -1271,7 +1316,7 @@ func validateTaskGroups(p *model.Project) ValidationErrors {
 		// validate that attach commands aren't used in the teardown_group phase
 		if tg.TeardownGroup != nil {
 			for _, cmd := range tg.TeardownGroup.List() {
-				if cmd.Command == "attach.results" || cmd.Command == "attach.artifacts" {
+				if utility.StringSliceContains(evergreen.AttachCommandNames, cmd.Command) {
 					errs = append(errs, ValidationError{
 						Message: fmt.Sprintf("%s cannot be used in the group teardown stage", cmd.Command),
 						Level:   Error,
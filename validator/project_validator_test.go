@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strings"
 	"testing"
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/patch"
 	_ "github.com/evergreen-ci/evergreen/plugin"
 	tu "github.com/evergreen-ci/evergreen/testutil"
@@ -2136,6 +2138,19 @@ buildvariants:
 	assert.Len(validationErrs, 1)
 	assert.Contains(validationErrs[0].Message, "attach.results cannot be used in the group teardown stage")
 
+	// every command that attaches to the task is rejected in the group teardown
+	for _, cmd := range evergreen.AttachCommandNames {
+		proj = model.Project{}
+		yml := strings.Replace(attachInGroupTeardownYml, "command: attach.results", "command: "+cmd, 1)
+		pp, _, err = model.LoadProjectInto(ctx, []byte(yml), nil, "", &proj)
+		assert.NotNil(pp)
+		assert.NoError(err)
+		validationErrs = validateTaskGroups(&proj)
+		if assert.Len(validationErrs, 1, cmd) {
+			assert.Contains(validationErrs[0].Message, cmd+" cannot be used in the group teardown stage")
+		}
+	}
+
 	largeMaxHostYml := `
 tasks:
 - name: example_task_1
@@ -2460,6 +2475,49 @@ func TestValidateParameters(t *testing.T) {
 	assert.Len(t, validateParameters(p), 0)
 }
 
+func TestValidateNotifications(t *testing.T) {
+	ref := &model.ProjectRef{Id: "proj"}
+	p := &model.Project{
+		Notifications: []model.ProjectNotification{
+			{
+				ResourceType: event.ResourceTypeTask,
+				Trigger:      event.TriggerFailure,
+				Subscriber: model.ProjectNotificationSubscriber{
+					Type:   event.SlackSubscriberType,
+					Target: "#alerts",
+				},
+			},
+		},
+	}
+	assert.Len(t, validateNotifications(p, ref), 0)
+
+	p.Notifications = append(p.Notifications, p.Notifications[0])
+	assert.Len(t, validateNotifications(p, ref), 1)
+
+	p.Notifications[1].ResourceType = event.ResourceTypeHost
+	assert.Len(t, validateNotifications(p, ref), 1)
+
+	p.Notifications[1].ResourceType = event.ResourceTypeBuild
+	p.Notifications[1].Subscriber = model.ProjectNotificationSubscriber{
+		Type:   event.EvergreenWebhookSubscriberType,
+		Target: "https://example.com",
+	}
+	assert.Len(t, validateNotifications(p, ref), 1)
+
+	p.Notifications[1].Subscriber = model.ProjectNotificationSubscriber{
+		Type:   event.EmailSubscriberType,
+		Target: "a@example.com",
+	}
+	p.Notifications[1].Selectors = []model.ProjectNotificationSelector{{Type: event.SelectorProject, Data: "other"}}
+	assert.Len(t, validateNotifications(p, ref), 1)
+
+	p.Notifications[1].Selectors = []model.ProjectNotificationSelector{{Type: event.SelectorBuildVariant, Data: ""}}
+	assert.Len(t, validateNotifications(p, ref), 1)
+
+	p.Notifications[1].Selectors = []model.ProjectNotificationSelector{{Type: event.SelectorBuildVariant, Data: "bv"}}
+	assert.Len(t, validateNotifications(p, ref), 0)
+}
+
 func TestDuplicateTaskInBV(t *testing.T) {
 	assert := assert.New(t)
 